@@ -10,6 +10,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,6 +29,25 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ApplicationStatus.
+const (
+	ApplicationStatusAccepted ApplicationStatus = "accepted"
+	ApplicationStatusPending  ApplicationStatus = "pending"
+	ApplicationStatusRejected ApplicationStatus = "rejected"
+)
+
+// Defines values for AssignmentMode.
+const (
+	FirstCome AssignmentMode = "first_come"
+	Review    AssignmentMode = "review"
+)
+
+// Defines values for CommentVisibility.
+const (
+	Private CommentVisibility = "private"
+	Public  CommentVisibility = "public"
+)
+
 // Defines values for CreateQuestRequestDifficulty.
 const (
 	CreateQuestRequestDifficultyEasy   CreateQuestRequestDifficulty = "easy"
@@ -34,6 +55,50 @@ const (
 	CreateQuestRequestDifficultyMedium CreateQuestRequestDifficulty = "medium"
 )
 
+// Defines values for EligibilityPolicy.
+const (
+	Off    EligibilityPolicy = "off"
+	Strict EligibilityPolicy = "strict"
+	Warn   EligibilityPolicy = "warn"
+)
+
+// Defines values for EvidenceStatus.
+const (
+	EvidenceStatusApproved EvidenceStatus = "approved"
+	EvidenceStatusPending  EvidenceStatus = "pending"
+	EvidenceStatusRejected EvidenceStatus = "rejected"
+)
+
+// Defines values for GeoJSONPointType.
+const (
+	Point GeoJSONPointType = "Point"
+)
+
+// Defines values for LeaderboardMetric.
+const (
+	LeaderboardMetricCompleted LeaderboardMetric = "completed"
+	LeaderboardMetricPoints    LeaderboardMetric = "points"
+)
+
+// Defines values for LeaderboardPeriod.
+const (
+	Day   LeaderboardPeriod = "day"
+	Month LeaderboardPeriod = "month"
+	Week  LeaderboardPeriod = "week"
+)
+
+// Defines values for LedgerEntryKind.
+const (
+	QuestReward LedgerEntryKind = "quest_reward"
+)
+
+// Defines values for NotificationKind.
+const (
+	QuestAssigned      NotificationKind = "quest_assigned"
+	QuestCommentAdded  NotificationKind = "quest_comment_added"
+	QuestStatusChanged NotificationKind = "quest_status_changed"
+)
+
 // Defines values for QuestDifficulty.
 const (
 	QuestDifficultyEasy   QuestDifficulty = "easy"
@@ -41,56 +106,201 @@ const (
 	QuestDifficultyMedium QuestDifficulty = "medium"
 )
 
+// Defines values for QuestFeatureType.
+const (
+	Feature QuestFeatureType = "Feature"
+)
+
+// Defines values for QuestFeatureCollectionType.
+const (
+	FeatureCollection QuestFeatureCollectionType = "FeatureCollection"
+)
+
+// Defines values for QuestFeaturePropertiesDifficulty.
+const (
+	QuestFeaturePropertiesDifficultyEasy   QuestFeaturePropertiesDifficulty = "easy"
+	QuestFeaturePropertiesDifficultyHard   QuestFeaturePropertiesDifficulty = "hard"
+	QuestFeaturePropertiesDifficultyMedium QuestFeaturePropertiesDifficulty = "medium"
+)
+
+// Defines values for QuestFeaturePropertiesLocationRole.
+const (
+	QuestFeaturePropertiesLocationRoleExecution QuestFeaturePropertiesLocationRole = "execution"
+	QuestFeaturePropertiesLocationRoleTarget    QuestFeaturePropertiesLocationRole = "target"
+)
+
 // Defines values for QuestStatus.
 const (
-	QuestStatusAssigned   QuestStatus = "assigned"
-	QuestStatusCompleted  QuestStatus = "completed"
-	QuestStatusCreated    QuestStatus = "created"
-	QuestStatusDeclined   QuestStatus = "declined"
-	QuestStatusInProgress QuestStatus = "in_progress"
-	QuestStatusPosted     QuestStatus = "posted"
+	QuestStatusAssigned      QuestStatus = "assigned"
+	QuestStatusCompleted     QuestStatus = "completed"
+	QuestStatusCreated       QuestStatus = "created"
+	QuestStatusDeclined      QuestStatus = "declined"
+	QuestStatusInProgress    QuestStatus = "in_progress"
+	QuestStatusPendingReview QuestStatus = "pending_review"
+	QuestStatusPosted        QuestStatus = "posted"
+)
+
+// Defines values for QuestWithDistanceDifficulty.
+const (
+	QuestWithDistanceDifficultyEasy   QuestWithDistanceDifficulty = "easy"
+	QuestWithDistanceDifficultyHard   QuestWithDistanceDifficulty = "hard"
+	QuestWithDistanceDifficultyMedium QuestWithDistanceDifficulty = "medium"
 )
 
 // Defines values for ListQuestsParamsStatus.
 const (
-	ListQuestsParamsStatusAssigned   ListQuestsParamsStatus = "assigned"
-	ListQuestsParamsStatusCompleted  ListQuestsParamsStatus = "completed"
-	ListQuestsParamsStatusCreated    ListQuestsParamsStatus = "created"
-	ListQuestsParamsStatusDeclined   ListQuestsParamsStatus = "declined"
-	ListQuestsParamsStatusInProgress ListQuestsParamsStatus = "in_progress"
-	ListQuestsParamsStatusPosted     ListQuestsParamsStatus = "posted"
+	ListQuestsParamsStatusAssigned      ListQuestsParamsStatus = "assigned"
+	ListQuestsParamsStatusCompleted     ListQuestsParamsStatus = "completed"
+	ListQuestsParamsStatusCreated       ListQuestsParamsStatus = "created"
+	ListQuestsParamsStatusDeclined      ListQuestsParamsStatus = "declined"
+	ListQuestsParamsStatusInProgress    ListQuestsParamsStatus = "in_progress"
+	ListQuestsParamsStatusPendingReview ListQuestsParamsStatus = "pending_review"
+	ListQuestsParamsStatusPosted        ListQuestsParamsStatus = "posted"
+)
+
+// Defines values for SearchQuestsByAreaParamsMatch.
+const (
+	SearchQuestsByAreaParamsMatchAny       SearchQuestsByAreaParamsMatch = "any"
+	SearchQuestsByAreaParamsMatchExecution SearchQuestsByAreaParamsMatch = "execution"
+	SearchQuestsByAreaParamsMatchTarget    SearchQuestsByAreaParamsMatch = "target"
 )
 
+// Defines values for SearchQuestsByRadiusParamsSort.
+const (
+	CreatedAt SearchQuestsByRadiusParamsSort = "created_at"
+	Distance  SearchQuestsByRadiusParamsSort = "distance"
+	Reward    SearchQuestsByRadiusParamsSort = "reward"
+)
+
+// Defines values for GetQuestTileParamsFormat.
+const (
+	Json GetQuestTileParamsFormat = "json"
+	Mvt  GetQuestTileParamsFormat = "mvt"
+)
+
+// Achievement defines model for Achievement.
+type Achievement struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+
+	// Progress Facts counted toward the achievement, at most target
+	Progress int `json:"progress"`
+
+	// Target Count the achievement needs; 0 if it is no longer defined
+	Target     int        `json:"target"`
+	Title      string     `json:"title"`
+	Unlocked   bool       `json:"unlocked"`
+	UnlockedAt *time.Time `json:"unlocked_at"`
+}
+
+// AddQuestCommentRequest defines model for AddQuestCommentRequest.
+type AddQuestCommentRequest struct {
+	Body string `json:"body"`
+
+	// Visibility public is visible to every user, private to the quest creator and participants only
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+}
+
+// AddQuestPrerequisiteRequest defines model for AddQuestPrerequisiteRequest.
+type AddQuestPrerequisiteRequest struct {
+	// PrerequisiteId Quest that has to be completed first
+	PrerequisiteId openapi_types.UUID `json:"prerequisite_id"`
+}
+
+// Application defines model for Application.
+type Application struct {
+	ApplicantId openapi_types.UUID `json:"applicant_id"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Id          openapi_types.UUID `json:"id"`
+	Message     string             `json:"message"`
+	QuestId     openapi_types.UUID `json:"quest_id"`
+	Status      ApplicationStatus  `json:"status"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// ApplicationStatus defines model for ApplicationStatus.
+type ApplicationStatus string
+
+// ApplyToQuestRequest defines model for ApplyToQuestRequest.
+type ApplyToQuestRequest struct {
+	// Message Note to the quest creator
+	Message *string `json:"message,omitempty"`
+}
+
 // AssignQuestResult defines model for AssignQuestResult.
 type AssignQuestResult struct {
 	// Assignee User ID who was assigned to the quest
 	Assignee openapi_types.UUID `json:"assignee"`
 
+	// EligibilityWarnings Requirements the user does not meet (quests with the warn eligibility policy only)
+	EligibilityWarnings *[]EligibilityViolation `json:"eligibility_warnings,omitempty"`
+
 	// Id Quest ID
 	Id openapi_types.UUID `json:"id"`
 
+	// Participants All participants of the quest, in joining order
+	Participants []Participant `json:"participants"`
+
 	// Status Quest status
 	Status QuestStatus `json:"status"`
 }
 
+// AssignmentMode How the quest gets its assignee: first_come assigns the first user who takes it,
+// review lets users apply and the creator accept one of the applications
+type AssignmentMode string
+
+// Balance defines model for Balance.
+type Balance struct {
+	// Points Sum of the points credited to the user
+	Points int                `json:"points"`
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
 // ChangeQuestStatusResult defines model for ChangeQuestStatusResult.
 type ChangeQuestStatusResult struct {
 	// Assignee User ID who is assigned to the quest (null if not assigned)
 	Assignee *openapi_types.UUID `json:"assignee"`
 
+	// CompletionQuorum Number of participant confirmations that complete the quest
+	CompletionQuorum int `json:"completion_quorum"`
+
 	// Id Quest ID
 	Id openapi_types.UUID `json:"id"`
 
+	// Participants All participants of the quest, in joining order
+	Participants []Participant `json:"participants"`
+
 	// Status Quest status
 	Status QuestStatus `json:"status"`
 }
 
 // ChangeStatusRequest defines model for ChangeStatusRequest.
 type ChangeStatusRequest struct {
+	Position *Coordinate `json:"position,omitempty"`
+
 	// Status Quest status
 	Status QuestStatus `json:"status"`
 }
 
+// CommentVisibility public is visible to every user, private to the quest creator and participants only
+type CommentVisibility string
+
+// CompletionEvidence defines model for CompletionEvidence.
+type CompletionEvidence struct {
+	Attachments []EvidenceAttachment `json:"attachments"`
+	Id          openapi_types.UUID   `json:"id"`
+	Note        string               `json:"note"`
+	Position    Coordinate           `json:"position"`
+	QuestId     openapi_types.UUID   `json:"quest_id"`
+
+	// RejectionReason Reason given by the creator when the completion was rejected
+	RejectionReason *string            `json:"rejection_reason,omitempty"`
+	ReviewedAt      *time.Time         `json:"reviewed_at"`
+	Status          EvidenceStatus     `json:"status"`
+	SubmittedAt     time.Time          `json:"submitted_at"`
+	SubmittedBy     openapi_types.UUID `json:"submitted_by"`
+}
+
 // Coordinate defines model for Coordinate.
 type Coordinate struct {
 	// Address Optional address for this location (1-500 chars)
@@ -101,10 +311,23 @@ type Coordinate struct {
 
 	// Longitude Longitude coordinate
 	Longitude float32 `json:"longitude"`
+
+	// Name Optional human-readable name for this location (1-200 chars)
+	Name *string `json:"name,omitempty"`
 }
 
 // CreateQuestRequest defines model for CreateQuestRequest.
 type CreateQuestRequest struct {
+	// AssignmentMode How the quest gets its assignee: first_come assigns the first user who takes it,
+	// review lets users apply and the creator accept one of the applications
+	AssignmentMode *AssignmentMode `json:"assignment_mode,omitempty"`
+
+	// Capacity Number of participants the quest takes (default 1)
+	Capacity *int `json:"capacity,omitempty"`
+
+	// CompletionQuorum Number of participants who have to confirm completion, at most the capacity (default a majority of the capacity)
+	CompletionQuorum *int `json:"completion_quorum,omitempty"`
+
 	// Description Quest description (1-1000 chars, cannot be only whitespace)
 	Description string                       `json:"description"`
 	Difficulty  CreateQuestRequestDifficulty `json:"difficulty"`
@@ -112,902 +335,6630 @@ type CreateQuestRequest struct {
 	// DurationMinutes Quest duration in minutes (1 minute to 1 week)
 	DurationMinutes int `json:"duration_minutes"`
 
+	// EligibilityPolicy How assignments are checked against the quest's skills, equipment and execution location:
+	// strict rejects users who do not meet them, warn assigns and reports what is missing, off skips the check
+	EligibilityPolicy *EligibilityPolicy `json:"eligibility_policy,omitempty"`
+
 	// Equipment List of required equipment (max 50 items)
-	Equipment         *[]string  `json:"equipment,omitempty"`
-	ExecutionLocation Coordinate `json:"execution_location"`
+	Equipment *[]string `json:"equipment,omitempty"`
+
+	// ExecutionLocation Location of a quest given by coordinates, by address, or both.
+	// A missing address is filled in by reverse geocoding the coordinates (best effort);
+	// missing coordinates are resolved by geocoding the address.
+	ExecutionLocation LocationInput `json:"execution_location"`
+
+	// GeofenceRadius Radius in meters around the target location for starting and the execution location for completing the quest (default 0, no geofence)
+	GeofenceRadius *int `json:"geofence_radius,omitempty"`
 
 	// Reward Reward level from 1 to 5
 	Reward int `json:"reward"`
 
 	// Skills List of required skills (max 50 items)
-	Skills         *[]string  `json:"skills,omitempty"`
-	TargetLocation Coordinate `json:"target_location"`
+	Skills *[]string `json:"skills,omitempty"`
+
+	// TargetLocation Location of a quest given by coordinates, by address, or both.
+	// A missing address is filled in by reverse geocoding the coordinates (best effort);
+	// missing coordinates are resolved by geocoding the address.
+	TargetLocation LocationInput `json:"target_location"`
 
 	// Title Quest title (1-200 chars, cannot be only whitespace)
 	Title string `json:"title"`
+
+	// Waypoints Intermediate stops between the target and the execution location, in travel order (max 25)
+	Waypoints *[]Waypoint `json:"waypoints,omitempty"`
 }
 
 // CreateQuestRequestDifficulty defines model for CreateQuestRequest.Difficulty.
 type CreateQuestRequestDifficulty string
 
-// Quest defines model for Quest.
-type Quest struct {
-	Assignee    *openapi_types.UUID `json:"assignee"`
-	CreatedAt   time.Time           `json:"created_at"`
-	Creator     string              `json:"creator"`
-	Description string              `json:"description"`
-	Difficulty  QuestDifficulty     `json:"difficulty"`
-
-	// DurationMinutes Quest duration in minutes
-	DurationMinutes   int        `json:"duration_minutes"`
-	Equipment         *[]string  `json:"equipment,omitempty"`
-	ExecutionLocation Coordinate `json:"execution_location"`
-
-	// ExecutionLocationId ID of the execution location in locations table (if any)
-	ExecutionLocationId *string            `json:"execution_location_id"`
-	Id                  openapi_types.UUID `json:"id"`
+// EditQuestCommentRequest defines model for EditQuestCommentRequest.
+type EditQuestCommentRequest struct {
+	Body string `json:"body"`
+}
 
-	// Reward Reward level from 1 to 5
-	Reward int       `json:"reward"`
-	Skills *[]string `json:"skills,omitempty"`
+// EligibilityPolicy How assignments are checked against the quest's skills, equipment and execution location:
+// strict rejects users who do not meet them, warn assigns and reports what is missing, off skips the check
+type EligibilityPolicy string
 
-	// Status Quest status
-	Status         QuestStatus `json:"status"`
-	TargetLocation Coordinate  `json:"target_location"`
+// EligibilityViolation Unmet quest requirement
+type EligibilityViolation struct {
+	// Field skills, equipment or execution_location
+	Field   string `json:"field"`
+	Message string `json:"message"`
 
-	// TargetLocationId ID of the target location in locations table (if any)
-	TargetLocationId *string   `json:"target_location_id"`
-	Title            string    `json:"title"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	// Values Missing skills or equipment
+	Values *[]string `json:"values,omitempty"`
 }
 
-// QuestDifficulty defines model for Quest.Difficulty.
-type QuestDifficulty string
-
-// QuestStatus Quest status
-type QuestStatus string
+// EvidenceAttachment defines model for EvidenceAttachment.
+type EvidenceAttachment struct {
+	ContentType string             `json:"content_type"`
+	FileName    string             `json:"file_name"`
+	Id          openapi_types.UUID `json:"id"`
 
-// ListQuestsParams defines parameters for ListQuests.
-type ListQuestsParams struct {
-	// Status Filter quests by status
-	Status *ListQuestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	// Size Size in bytes
+	Size int64 `json:"size"`
 }
 
-// ListQuestsParamsStatus defines parameters for ListQuests.
-type ListQuestsParamsStatus string
+// EvidenceStatus defines model for EvidenceStatus.
+type EvidenceStatus string
 
-// SearchQuestsByRadiusParams defines parameters for SearchQuestsByRadius.
-type SearchQuestsByRadiusParams struct {
-	// Lat Center latitude (-90 to 90)
-	Lat float32 `form:"lat" json:"lat"`
+// GeoJSONPoint defines model for GeoJSONPoint.
+type GeoJSONPoint struct {
+	// Coordinates Position as `[longitude, latitude]`
+	Coordinates []float64        `json:"coordinates"`
+	Type        GeoJSONPointType `json:"type"`
+}
 
-	// Lon Center longitude (-180 to 180)
-	Lon float32 `form:"lon" json:"lon"`
+// GeoJSONPointType defines model for GeoJSONPoint.Type.
+type GeoJSONPointType string
 
-	// RadiusKm Search radius in kilometers (0.1 to 20000 km)
-	RadiusKm float32 `form:"radius_km" json:"radius_km"`
+// GeoPoint Geographic point
+type GeoPoint struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
 }
 
-// CreateQuestJSONRequestBody defines body for CreateQuest for application/json ContentType.
-type CreateQuestJSONRequestBody = CreateQuestRequest
+// Leaderboard defines model for Leaderboard.
+type Leaderboard struct {
+	// Metric completed ranks by completed quests, points by credited points
+	Metric LeaderboardMetric `json:"metric"`
 
-// ChangeQuestStatusJSONRequestBody defines body for ChangeQuestStatus for application/json ContentType.
-type ChangeQuestStatusJSONRequestBody = ChangeStatusRequest
+	// Period Calendar period in UTC; weeks start on Monday
+	Period LeaderboardPeriod `json:"period"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get a list of all quests
-	// (GET /quests)
-	ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams)
-	// Create a new quest
-	// (POST /quests)
-	CreateQuest(w http.ResponseWriter, r *http.Request)
-	// Get quests assigned to the authenticated user
-	// (GET /quests/assigned)
-	ListAssignedQuests(w http.ResponseWriter, r *http.Request)
-	// Search quests within a radius
-	// (GET /quests/search-radius)
-	SearchQuestsByRadius(w http.ResponseWriter, r *http.Request, params SearchQuestsByRadiusParams)
-	// Get quest details by ID
-	// (GET /quests/{quest_id})
-	GetQuestById(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
-	// Assign quest to the authenticated user
-	// (POST /quests/{quest_id}/assign)
-	AssignQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
-	// Change quest status
-	// (PATCH /quests/{quest_id}/status)
-	ChangeQuestStatus(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Since Start of the period
+	Since     time.Time             `json:"since"`
+	Standings []LeaderboardStanding `json:"standings"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// LeaderboardMetric completed ranks by completed quests, points by credited points
+type LeaderboardMetric string
 
-type Unimplemented struct{}
+// LeaderboardPeriod Calendar period in UTC; weeks start on Monday
+type LeaderboardPeriod string
 
-// Get a list of all quests
-// (GET /quests)
-func (_ Unimplemented) ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// LeaderboardStanding defines model for LeaderboardStanding.
+type LeaderboardStanding struct {
+	// Rank Position on the leaderboard, shared by equal scores
+	Rank int `json:"rank"`
 
-// Create a new quest
-// (POST /quests)
-func (_ Unimplemented) CreateQuest(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Score Completed quests or points in the period
+	Score  int                `json:"score"`
+	UserId openapi_types.UUID `json:"user_id"`
 }
 
-// Get quests assigned to the authenticated user
-// (GET /quests/assigned)
-func (_ Unimplemented) ListAssignedQuests(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// LedgerEntry defines model for LedgerEntry.
+type LedgerEntry struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
 
-// Search quests within a radius
-// (GET /quests/search-radius)
-func (_ Unimplemented) SearchQuestsByRadius(w http.ResponseWriter, r *http.Request, params SearchQuestsByRadiusParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Kind Why the points were credited
+	Kind   LedgerEntryKind `json:"kind"`
+	Points int             `json:"points"`
 
-// Get quest details by ID
-// (GET /quests/{quest_id})
-func (_ Unimplemented) GetQuestById(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// QuestId Quest the points were credited for
+	QuestId *openapi_types.UUID `json:"quest_id"`
 }
 
-// Assign quest to the authenticated user
-// (POST /quests/{quest_id}/assign)
-func (_ Unimplemented) AssignQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// LedgerEntryKind Why the points were credited
+type LedgerEntryKind string
 
-// Change quest status
-// (PATCH /quests/{quest_id}/status)
-func (_ Unimplemented) ChangeQuestStatus(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// LedgerPage defines model for LedgerPage.
+type LedgerPage struct {
+	Entries []LedgerEntry `json:"entries"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	// Total Total number of entries of the user
+	Total int `json:"total"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// LocationInput Location of a quest given by coordinates, by address, or both.
+// A missing address is filled in by reverse geocoding the coordinates (best effort);
+// missing coordinates are resolved by geocoding the address.
+type LocationInput struct {
+	// Address Address for this location (1-500 chars), geocoded when coordinates are omitted
+	Address *string `json:"address,omitempty"`
 
-// ListQuests operation middleware
-func (siw *ServerInterfaceWrapper) ListQuests(w http.ResponseWriter, r *http.Request) {
+	// Latitude Latitude coordinate (required together with longitude unless address is given)
+	Latitude *float32 `json:"latitude,omitempty"`
 
-	var err error
+	// Longitude Longitude coordinate (required together with latitude unless address is given)
+	Longitude *float32 `json:"longitude,omitempty"`
 
-	ctx := r.Context()
+	// Name Optional human-readable name for this location (1-200 chars)
+	Name *string `json:"name,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// LocationSuggestion defines model for LocationSuggestion.
+type LocationSuggestion struct {
+	// Address Location address (if any)
+	Address *string `json:"address"`
 
-	r = r.WithContext(ctx)
+	// Id Location ID
+	Id        openapi_types.UUID `json:"id"`
+	Latitude  float32            `json:"latitude"`
+	Longitude float32            `json:"longitude"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListQuestsParams
+	// Name Location name (if any)
+	Name *string `json:"name"`
 
-	// ------------- Optional query parameter "status" -------------
+	// Score Relevance score (1 is the best match)
+	Score float32 `json:"score"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
+// MarkAllNotificationsReadResponse defines model for MarkAllNotificationsReadResponse.
+type MarkAllNotificationsReadResponse struct {
+	// Marked Number of notifications marked read
+	Marked int `json:"marked"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListQuests(w, r, params)
-	}))
+// Notification defines model for Notification.
+type Notification struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Kind quest_assigned - someone took a quest of the user;
+	// quest_status_changed - a quest the user created or takes part in changed status;
+	// quest_comment_added - a new comment on such a quest
+	Kind    NotificationKind   `json:"kind"`
+	Message string             `json:"message"`
+	QuestId openapi_types.UUID `json:"quest_id"`
 
-	handler.ServeHTTP(w, r)
+	// ReadAt When the user read the notification, null if unread
+	ReadAt *time.Time `json:"read_at"`
 }
 
-// CreateQuest operation middleware
-func (siw *ServerInterfaceWrapper) CreateQuest(w http.ResponseWriter, r *http.Request) {
+// NotificationKind quest_assigned - someone took a quest of the user;
+// quest_status_changed - a quest the user created or takes part in changed status;
+// quest_comment_added - a new comment on such a quest
+type NotificationKind string
 
-	ctx := r.Context()
+// NotificationPage defines model for NotificationPage.
+type NotificationPage struct {
+	Notifications []Notification `json:"notifications"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Total Total number of notifications listed
+	Total int `json:"total"`
 
-	r = r.WithContext(ctx)
+	// Unread Number of unread notifications of the user
+	Unread int `json:"unread"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateQuest(w, r)
-	}))
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	QuestAssigned      bool `json:"quest_assigned"`
+	QuestCommentAdded  bool `json:"quest_comment_added"`
+	QuestStatusChanged bool `json:"quest_status_changed"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Participant defines model for Participant.
+type Participant struct {
+	// CompletedAt When the participant confirmed completion
+	CompletedAt *time.Time `json:"completed_at"`
+	JoinedAt    time.Time  `json:"joined_at"`
 
-	handler.ServeHTTP(w, r)
+	// StartedAt When the participant started the quest
+	StartedAt *time.Time         `json:"started_at"`
+	UserId    openapi_types.UUID `json:"user_id"`
 }
 
-// ListAssignedQuests operation middleware
-func (siw *ServerInterfaceWrapper) ListAssignedQuests(w http.ResponseWriter, r *http.Request) {
+// Quest defines model for Quest.
+type Quest struct {
+	// Assignee First participant of the quest
+	Assignee *openapi_types.UUID `json:"assignee"`
 
-	ctx := r.Context()
+	// AssignmentMode How the quest gets its assignee: first_come assigns the first user who takes it,
+	// review lets users apply and the creator accept one of the applications
+	AssignmentMode AssignmentMode `json:"assignment_mode"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Capacity Number of participants the quest takes
+	Capacity int `json:"capacity"`
 
-	r = r.WithContext(ctx)
+	// CompletionQuorum Number of participant confirmations that complete the quest
+	CompletionQuorum int       `json:"completion_quorum"`
+	CreatedAt        time.Time `json:"created_at"`
+	Creator          string    `json:"creator"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAssignedQuests(w, r)
-	}))
+	// CreatorReputation Reviews received by a user; in quest listings, those of the creator
+	CreatorReputation *Reputation     `json:"creator_reputation,omitempty"`
+	Description       string          `json:"description"`
+	Difficulty        QuestDifficulty `json:"difficulty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// DurationMinutes Quest duration in minutes
+	DurationMinutes int `json:"duration_minutes"`
 
-	handler.ServeHTTP(w, r)
-}
+	// EligibilityPolicy How assignments are checked against the quest's skills, equipment and execution location:
+	// strict rejects users who do not meet them, warn assigns and reports what is missing, off skips the check
+	EligibilityPolicy EligibilityPolicy `json:"eligibility_policy"`
+	Equipment         *[]string         `json:"equipment,omitempty"`
 
-// SearchQuestsByRadius operation middleware
-func (siw *ServerInterfaceWrapper) SearchQuestsByRadius(w http.ResponseWriter, r *http.Request) {
+	// EstimatedTravelMinutes Estimated time to travel the route at walking pace (5 km/h), rounded up
+	EstimatedTravelMinutes int        `json:"estimated_travel_minutes"`
+	ExecutionLocation      Coordinate `json:"execution_location"`
 
-	var err error
+	// ExecutionLocationId ID of the execution location in locations table (if any)
+	ExecutionLocationId *string `json:"execution_location_id"`
 
-	ctx := r.Context()
+	// GeofenceRadius Radius in meters within which the quest is started and completed, 0 if there is no geofence
+	GeofenceRadius int                `json:"geofence_radius"`
+	Id             openapi_types.UUID `json:"id"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+	// Participants Users taking part in the quest, in joining order
+	Participants []Participant `json:"participants"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchQuestsByRadiusParams
+	// Prerequisites Quests that have to be completed before this one can be assigned, in the order they were added
+	Prerequisites *[]QuestPrerequisite `json:"prerequisites,omitempty"`
 
-	// ------------- Required query parameter "lat" -------------
+	// Reward Reward level from 1 to 5
+	Reward int `json:"reward"`
 
-	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
+	// RouteDistanceKm Great-circle length of the route target location → waypoints → execution location in kilometers
+	RouteDistanceKm float64   `json:"route_distance_km"`
+	Skills          *[]string `json:"skills,omitempty"`
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lat"})
-		return
-	}
+	// Status Quest status
+	Status         QuestStatus `json:"status"`
+	TargetLocation Coordinate  `json:"target_location"`
 
-	err = runtime.BindQueryParameter("form", true, true, "lat", r.URL.Query(), &params.Lat)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
-		return
-	}
+	// TargetLocationId ID of the target location in locations table (if any)
+	TargetLocationId *string   `json:"target_location_id"`
+	Title            string    `json:"title"`
+	UpdatedAt        time.Time `json:"updated_at"`
 
-	// ------------- Required query parameter "lon" -------------
+	// Waypoints Intermediate stops between the target and the execution location, in travel order
+	Waypoints []Waypoint `json:"waypoints"`
+}
 
-	if paramValue := r.URL.Query().Get("lon"); paramValue != "" {
+// QuestDifficulty defines model for Quest.Difficulty.
+type QuestDifficulty string
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lon"})
-		return
-	}
+// QuestCluster defines model for QuestCluster.
+type QuestCluster struct {
+	// Bbox Cell bounds as `[minLon, minLat, maxLon, maxLat]`
+	Bbox []float64 `json:"bbox"`
 
-	err = runtime.BindQueryParameter("form", true, true, "lon", r.URL.Query(), &params.Lon)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
-		return
-	}
+	// Count Number of quests with the target location in the cell
+	Count int `json:"count"`
 
-	// ------------- Required query parameter "radius_km" -------------
+	// Latitude Mean latitude of the quests in the cell
+	Latitude float64 `json:"latitude"`
 
-	if paramValue := r.URL.Query().Get("radius_km"); paramValue != "" {
+	// Longitude Mean longitude of the quests in the cell
+	Longitude float64 `json:"longitude"`
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "radius_km"})
-		return
-	}
+	// Statuses Number of quests per status
+	Statuses QuestStatusCounts `json:"statuses"`
+	X        int               `json:"x"`
+	Y        int               `json:"y"`
 
-	err = runtime.BindQueryParameter("form", true, true, "radius_km", r.URL.Query(), &params.RadiusKm)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius_km", Err: err})
-		return
-	}
+	// Z Zoom level of the cell (tile zoom + resolution)
+	Z int `json:"z"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SearchQuestsByRadius(w, r, params)
-	}))
+// QuestComment defines model for QuestComment.
+type QuestComment struct {
+	AuthorId  openapi_types.UUID `json:"author_id"`
+	Body      string             `json:"body"`
+	CreatedAt time.Time          `json:"created_at"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// EditedAt When the author last edited the comment, null if never
+	EditedAt *time.Time         `json:"edited_at"`
+	Id       openapi_types.UUID `json:"id"`
+	QuestId  openapi_types.UUID `json:"quest_id"`
 
-	handler.ServeHTTP(w, r)
+	// Visibility public is visible to every user, private to the quest creator and participants only
+	Visibility CommentVisibility `json:"visibility"`
 }
 
-// GetQuestById operation middleware
-func (siw *ServerInterfaceWrapper) GetQuestById(w http.ResponseWriter, r *http.Request) {
+// QuestCommentPage defines model for QuestCommentPage.
+type QuestCommentPage struct {
+	Comments []QuestComment `json:"comments"`
 
-	var err error
+	// Total Total number of comments the user can see
+	Total int `json:"total"`
+}
 
-	// ------------- Path parameter "quest_id" -------------
-	var questId openapi_types.UUID
+// QuestDependencyEdge defines model for QuestDependencyEdge.
+type QuestDependencyEdge struct {
+	// Completed Whether the prerequisite quest is completed
+	Completed bool `json:"completed"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
-		return
-	}
+	// PrerequisiteId Quest that has to be completed first
+	PrerequisiteId openapi_types.UUID `json:"prerequisite_id"`
 
-	ctx := r.Context()
+	// QuestId Quest depending on the prerequisite
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// QuestDependencyGraph defines model for QuestDependencyGraph.
+type QuestDependencyGraph struct {
+	Edges []QuestDependencyEdge `json:"edges"`
 
-	r = r.WithContext(ctx)
+	// Nodes Quests linked through prerequisites, oldest first
+	Nodes []QuestDependencyNode `json:"nodes"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetQuestById(w, r, questId)
-	}))
+	// QuestId Quest the graph was requested for
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// QuestDependencyNode defines model for QuestDependencyNode.
+type QuestDependencyNode struct {
+	Id openapi_types.UUID `json:"id"`
 
-	handler.ServeHTTP(w, r)
+	// Status Quest status
+	Status QuestStatus `json:"status"`
+	Title  string      `json:"title"`
 }
 
-// AssignQuest operation middleware
-func (siw *ServerInterfaceWrapper) AssignQuest(w http.ResponseWriter, r *http.Request) {
+// QuestFeature GeoJSON Feature for one location (target or execution) of a quest
+type QuestFeature struct {
+	Geometry GeoJSONPoint `json:"geometry"`
 
-	var err error
+	// Id Feature ID in the form `{quest_id}:{location_role}`
+	Id         string                 `json:"id"`
+	Properties QuestFeatureProperties `json:"properties"`
+	Type       QuestFeatureType       `json:"type"`
+}
 
-	// ------------- Path parameter "quest_id" -------------
-	var questId openapi_types.UUID
+// QuestFeatureType defines model for QuestFeature.Type.
+type QuestFeatureType string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
-		return
-	}
+// QuestFeatureCollection GeoJSON FeatureCollection (RFC 7946) of quest locations
+type QuestFeatureCollection struct {
+	Features []QuestFeature             `json:"features"`
+	Type     QuestFeatureCollectionType `json:"type"`
+}
 
-	ctx := r.Context()
+// QuestFeatureCollectionType defines model for QuestFeatureCollection.Type.
+type QuestFeatureCollectionType string
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// QuestFeatureProperties defines model for QuestFeatureProperties.
+type QuestFeatureProperties struct {
+	Assignee    *openapi_types.UUID              `json:"assignee"`
+	CreatedAt   time.Time                        `json:"created_at"`
+	Creator     string                           `json:"creator"`
+	Description string                           `json:"description"`
+	Difficulty  QuestFeaturePropertiesDifficulty `json:"difficulty"`
 
-	r = r.WithContext(ctx)
+	// DistanceKm Distance from the search center in kilometers (radius search only)
+	DistanceKm      *float64 `json:"distance_km,omitempty"`
+	DurationMinutes int      `json:"duration_minutes"`
+	Equipment       []string `json:"equipment"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AssignQuest(w, r, questId)
-	}))
+	// LocationId ID of the location in locations table (if any)
+	LocationId *string `json:"location_id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// LocationRole Which quest location the feature represents
+	LocationRole QuestFeaturePropertiesLocationRole `json:"location_role"`
+	QuestId      openapi_types.UUID                 `json:"quest_id"`
+	Reward       int                                `json:"reward"`
+	Skills       []string                           `json:"skills"`
 
-	handler.ServeHTTP(w, r)
+	// Status Quest status
+	Status    QuestStatus `json:"status"`
+	Title     string      `json:"title"`
+	UpdatedAt time.Time   `json:"updated_at"`
 }
 
-// ChangeQuestStatus operation middleware
-func (siw *ServerInterfaceWrapper) ChangeQuestStatus(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "quest_id" -------------
-	var questId openapi_types.UUID
+// QuestFeaturePropertiesDifficulty defines model for QuestFeatureProperties.Difficulty.
+type QuestFeaturePropertiesDifficulty string
+
+// QuestFeaturePropertiesLocationRole Which quest location the feature represents
+type QuestFeaturePropertiesLocationRole string
+
+// QuestOverrides Fields replacing the template values for one quest; every field is optional
+type QuestOverrides struct {
+	// AssignmentMode How the quest gets its assignee: first_come assigns the first user who takes it,
+	// review lets users apply and the creator accept one of the applications
+	AssignmentMode   *AssignmentMode               `json:"assignment_mode,omitempty"`
+	Capacity         *int                          `json:"capacity,omitempty"`
+	CompletionQuorum *int                          `json:"completion_quorum,omitempty"`
+	Description      *string                       `json:"description,omitempty"`
+	Difficulty       *CreateQuestRequestDifficulty `json:"difficulty,omitempty"`
+	DurationMinutes  *int                          `json:"duration_minutes,omitempty"`
+
+	// EligibilityPolicy How assignments are checked against the quest's skills, equipment and execution location:
+	// strict rejects users who do not meet them, warn assigns and reports what is missing, off skips the check
+	EligibilityPolicy *EligibilityPolicy `json:"eligibility_policy,omitempty"`
+	Equipment         *[]string          `json:"equipment,omitempty"`
+
+	// ExecutionLocation Location of a quest given by coordinates, by address, or both.
+	// A missing address is filled in by reverse geocoding the coordinates (best effort);
+	// missing coordinates are resolved by geocoding the address.
+	ExecutionLocation *LocationInput `json:"execution_location,omitempty"`
+	GeofenceRadius    *int           `json:"geofence_radius,omitempty"`
+	Reward            *int           `json:"reward,omitempty"`
+	Skills            *[]string      `json:"skills,omitempty"`
+
+	// TargetLocation Location of a quest given by coordinates, by address, or both.
+	// A missing address is filled in by reverse geocoding the coordinates (best effort);
+	// missing coordinates are resolved by geocoding the address.
+	TargetLocation *LocationInput `json:"target_location,omitempty"`
+	Title          *string        `json:"title,omitempty"`
+	Waypoints      *[]Waypoint    `json:"waypoints,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
-		return
-	}
+// QuestPrerequisite defines model for QuestPrerequisite.
+type QuestPrerequisite struct {
+	// Completed Whether the prerequisite quest is completed
+	Completed bool               `json:"completed"`
+	QuestId   openapi_types.UUID `json:"quest_id"`
+}
 
-	ctx := r.Context()
+// QuestRecommendation defines model for QuestRecommendation.
+type QuestRecommendation struct {
+	// DistanceKm Distance from the position to the nearer of target and execution location
+	DistanceKm float64 `json:"distance_km"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Explanation Partial scores (0 to 1) and the facts they are based on
+	Explanation RecommendationExplanation `json:"explanation"`
+	Quest       Quest                     `json:"quest"`
 
-	r = r.WithContext(ctx)
+	// Score Total score between 0 and 1 (weighted sum of the explanation scores)
+	Score float64 `json:"score"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ChangeQuestStatus(w, r, questId)
-	}))
+// QuestReview defines model for QuestReview.
+type QuestReview struct {
+	Comment    string             `json:"comment"`
+	CreatedAt  time.Time          `json:"created_at"`
+	Id         openapi_types.UUID `json:"id"`
+	QuestId    openapi_types.UUID `json:"quest_id"`
+	Rating     int                `json:"rating"`
+	RevieweeId openapi_types.UUID `json:"reviewee_id"`
+	ReviewerId openapi_types.UUID `json:"reviewer_id"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// QuestStatus Quest status
+type QuestStatus string
 
-	handler.ServeHTTP(w, r)
+// QuestStatusCounts Number of quests per status
+type QuestStatusCounts struct {
+	Assigned      int `json:"assigned"`
+	Completed     int `json:"completed"`
+	Created       int `json:"created"`
+	Declined      int `json:"declined"`
+	InProgress    int `json:"in_progress"`
+	PendingReview int `json:"pending_review"`
+	Posted        int `json:"posted"`
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// QuestTemplate defines model for QuestTemplate.
+type QuestTemplate struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// NextOccurrenceAt Time the next quest is created on schedule; missing if there is no further occurrence
+	NextOccurrenceAt *time.Time `json:"next_occurrence_at,omitempty"`
+
+	// OwnerId User the template belongs to, creator of the quests created from it
+	OwnerId openapi_types.UUID `json:"owner_id"`
+	Quest   CreateQuestRequest `json:"quest"`
+
+	// Recurrence Schedule of a quest template as a subset of the iCalendar RRULE: FREQ (DAILY, WEEKLY
+	// or MONTHLY), INTERVAL, and either COUNT or UNTIL. The first occurrence is at `starts_at`,
+	// the next ones are INTERVAL days, weeks or months apart. Monthly occurrences on a day
+	// the month does not have, like February 30, are skipped.
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	UpdatedAt  time.Time   `json:"updated_at"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// QuestTemplateRequest defines model for QuestTemplateRequest.
+type QuestTemplateRequest struct {
+	// Name Name of the template (1-200 chars)
+	Name  string             `json:"name"`
+	Quest CreateQuestRequest `json:"quest"`
+
+	// Recurrence Schedule of a quest template as a subset of the iCalendar RRULE: FREQ (DAILY, WEEKLY
+	// or MONTHLY), INTERVAL, and either COUNT or UNTIL. The first occurrence is at `starts_at`,
+	// the next ones are INTERVAL days, weeks or months apart. Monthly occurrences on a day
+	// the month does not have, like February 30, are skipped.
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// QuestTile defines model for QuestTile.
+type QuestTile struct {
+	// Clusters Non-empty cells, north to south and west to east
+	Clusters []QuestCluster `json:"clusters"`
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	// Resolution Grid resolution used for clustering
+	Resolution int `json:"resolution"`
+	X          int `json:"x"`
+	Y          int `json:"y"`
+	Z          int `json:"z"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// QuestWithDistance defines model for QuestWithDistance.
+type QuestWithDistance struct {
+	// Assignee First participant of the quest
+	Assignee *openapi_types.UUID `json:"assignee"`
+
+	// AssignmentMode How the quest gets its assignee: first_come assigns the first user who takes it,
+	// review lets users apply and the creator accept one of the applications
+	AssignmentMode AssignmentMode `json:"assignment_mode"`
+
+	// Capacity Number of participants the quest takes
+	Capacity int `json:"capacity"`
+
+	// CompletionQuorum Number of participant confirmations that complete the quest
+	CompletionQuorum int       `json:"completion_quorum"`
+	CreatedAt        time.Time `json:"created_at"`
+	Creator          string    `json:"creator"`
+
+	// CreatorReputation Reviews received by a user; in quest listings, those of the creator
+	CreatorReputation *Reputation                 `json:"creator_reputation,omitempty"`
+	Description       string                      `json:"description"`
+	Difficulty        QuestWithDistanceDifficulty `json:"difficulty"`
+
+	// DurationMinutes Quest duration in minutes
+	DurationMinutes int `json:"duration_minutes"`
+
+	// EligibilityPolicy How assignments are checked against the quest's skills, equipment and execution location:
+	// strict rejects users who do not meet them, warn assigns and reports what is missing, off skips the check
+	EligibilityPolicy EligibilityPolicy `json:"eligibility_policy"`
+	Equipment         *[]string         `json:"equipment,omitempty"`
+
+	// EstimatedTravelMinutes Estimated time to travel the route at walking pace (5 km/h), rounded up
+	EstimatedTravelMinutes int `json:"estimated_travel_minutes"`
+
+	// ExecutionDistanceKm Distance from the search center to the execution location in kilometers
+	ExecutionDistanceKm float64    `json:"execution_distance_km"`
+	ExecutionLocation   Coordinate `json:"execution_location"`
+
+	// ExecutionLocationId ID of the execution location in locations table (if any)
+	ExecutionLocationId *string `json:"execution_location_id"`
+
+	// GeofenceRadius Radius in meters within which the quest is started and completed, 0 if there is no geofence
+	GeofenceRadius int                `json:"geofence_radius"`
+	Id             openapi_types.UUID `json:"id"`
+
+	// Participants Users taking part in the quest, in joining order
+	Participants []Participant `json:"participants"`
+
+	// Prerequisites Quests that have to be completed before this one can be assigned, in the order they were added
+	Prerequisites *[]QuestPrerequisite `json:"prerequisites,omitempty"`
+
+	// Reward Reward level from 1 to 5
+	Reward int `json:"reward"`
+
+	// RouteDistanceKm Great-circle length of the route target location → waypoints → execution location in kilometers
+	RouteDistanceKm float64   `json:"route_distance_km"`
+	Skills          *[]string `json:"skills,omitempty"`
+
+	// Status Quest status
+	Status QuestStatus `json:"status"`
+
+	// TargetDistanceKm Distance from the search center to the target location in kilometers
+	TargetDistanceKm float64    `json:"target_distance_km"`
+	TargetLocation   Coordinate `json:"target_location"`
+
+	// TargetLocationId ID of the target location in locations table (if any)
+	TargetLocationId *string   `json:"target_location_id"`
+	Title            string    `json:"title"`
+	UpdatedAt        time.Time `json:"updated_at"`
+
+	// Waypoints Intermediate stops between the target and the execution location, in travel order
+	Waypoints []Waypoint `json:"waypoints"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// QuestWithDistanceDifficulty defines model for QuestWithDistance.Difficulty.
+type QuestWithDistanceDifficulty string
+
+// RecommendationExplanation Partial scores (0 to 1) and the facts they are based on
+type RecommendationExplanation struct {
+	// CompletedAtDifficulty Number of quests the user completed with the same difficulty
+	CompletedAtDifficulty int `json:"completed_at_difficulty"`
+
+	// DifficultyScore Closeness of the quest difficulty to the mean difficulty of the user's completed quests;
+	// users without completed quests are matched with easy quests (weight 0.15)
+	DifficultyScore float64 `json:"difficulty_score"`
+
+	// DistanceScore 1 at the position, 0 at the edge of the search radius (weight 0.30)
+	DistanceScore float64 `json:"distance_score"`
+
+	// HistoryScore 1 at the location of one of the user's past quests, 0 at the search radius from the
+	// nearest one; 0 for users without past quests (weight 0.20)
+	HistoryScore float64 `json:"history_score"`
+
+	// MatchedRequirements Required skills and equipment the user has
+	MatchedRequirements []string `json:"matched_requirements"`
+
+	// MissingRequirements Required skills and equipment the user lacks
+	MissingRequirements []string `json:"missing_requirements"`
+
+	// NearestPastQuestKm Distance to the nearest location of the user's past quests, absent without past quests
+	NearestPastQuestKm *float64 `json:"nearest_past_quest_km,omitempty"`
+
+	// RewardScore Reward scaled from 1..5 to 0..1 (weight 0.10)
+	RewardScore float64 `json:"reward_score"`
+
+	// SkillsScore Share of the quest's skills and equipment the user has, 1 if none are required (weight 0.25)
+	SkillsScore float64 `json:"skills_score"`
 }
 
-type RequiredParamError struct {
-	ParamName string
+// Recurrence Schedule of a quest template as a subset of the iCalendar RRULE: FREQ (DAILY, WEEKLY
+// or MONTHLY), INTERVAL, and either COUNT or UNTIL. The first occurrence is at `starts_at`,
+// the next ones are INTERVAL days, weeks or months apart. Monthly occurrences on a day
+// the month does not have, like February 30, are skipped.
+type Recurrence struct {
+	// Rule Recurrence rule; UNTIL is a UTC date-time like 20261231T090000Z or a date like 20261231
+	Rule string `json:"rule"`
+
+	// StartsAt Time of the first occurrence (default now)
+	StartsAt *time.Time `json:"starts_at,omitempty"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// RejectCompletionRequest defines model for RejectCompletionRequest.
+type RejectCompletionRequest struct {
+	// Reason Why the completion is rejected
+	Reason string `json:"reason"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// Reputation Reviews received by a user; in quest listings, those of the creator
+type Reputation struct {
+	// AverageRating Mean rating from 1 to 5 rounded to two decimals, 0 without reviews
+	AverageRating float64 `json:"average_rating"`
+	ReviewCount   int     `json:"review_count"`
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// ReviewApplicationResult defines model for ReviewApplicationResult.
+type ReviewApplicationResult struct {
+	Application Application `json:"application"`
+
+	// EligibilityWarnings Requirements the accepted applicant does not meet (quests with the warn eligibility policy only)
+	EligibilityWarnings *[]EligibilityViolation `json:"eligibility_warnings,omitempty"`
+
+	// QuestStatus Quest status
+	QuestStatus QuestStatus `json:"quest_status"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// SubmitEvidenceRequest defines model for SubmitEvidenceRequest.
+type SubmitEvidenceRequest struct {
+	// Latitude Latitude of the participant at completion in decimal degrees (-90 to 90); form fields are text
+	Latitude string `json:"latitude"`
+
+	// Longitude Longitude of the participant at completion in decimal degrees (-180 to 180); form fields are text
+	Longitude string `json:"longitude"`
+
+	// Note What was done
+	Note *string `json:"note,omitempty"`
+
+	// Photos JPEG, PNG or WebP images up to 5 MB each
+	Photos *[]openapi_types.File `json:"photos,omitempty"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// SubmitEvidenceResult defines model for SubmitEvidenceResult.
+type SubmitEvidenceResult struct {
+	Evidence CompletionEvidence `json:"evidence"`
+
+	// QuestStatus Quest status
+	QuestStatus QuestStatus `json:"quest_status"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// SubmitQuestReviewRequest defines model for SubmitQuestReviewRequest.
+type SubmitQuestReviewRequest struct {
+	Comment *string `json:"comment,omitempty"`
+	Rating  int     `json:"rating"`
+
+	// RevieweeId The reviewed user, one of the participants when the creator reviews, otherwise the creator
+	RevieweeId openapi_types.UUID `json:"reviewee_id"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// UpdateNotificationPreferencesRequest defines model for UpdateNotificationPreferencesRequest.
+type UpdateNotificationPreferencesRequest struct {
+	QuestAssigned      *bool `json:"quest_assigned,omitempty"`
+	QuestCommentAdded  *bool `json:"quest_comment_added,omitempty"`
+	QuestStatusChanged *bool `json:"quest_status_changed,omitempty"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// UpdateUserProfileRequest defines model for UpdateUserProfileRequest.
+type UpdateUserProfileRequest struct {
+	// DisplayName Name shown to other users
+	DisplayName string `json:"display_name"`
+
+	// Equipment Equipment the user owns (duplicates are ignored case-insensitively)
+	Equipment *[]string `json:"equipment,omitempty"`
+
+	// HomeLocation Geographic point
+	HomeLocation *GeoPoint `json:"home_location,omitempty"`
+
+	// MaxTravelRadiusKm How far from the home location the user is willing to travel
+	MaxTravelRadiusKm *float64 `json:"max_travel_radius_km,omitempty"`
+
+	// Skills Skills of the user (duplicates are ignored case-insensitively)
+	Skills *[]string `json:"skills,omitempty"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	CreatedAt   time.Time `json:"created_at"`
+	DisplayName string    `json:"display_name"`
+	Equipment   []string  `json:"equipment"`
+
+	// HomeLocation Geographic point
+	HomeLocation      *GeoPoint `json:"home_location,omitempty"`
+	MaxTravelRadiusKm *float64  `json:"max_travel_radius_km,omitempty"`
+
+	// Reputation Reviews received by a user; in quest listings, those of the creator
+	Reputation Reputation         `json:"reputation"`
+	Skills     []string           `json:"skills"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+	UserId     openapi_types.UUID `json:"user_id"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// Waypoint Intermediate stop of a quest route
+type Waypoint struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// GetLeaderboardParams defines parameters for GetLeaderboard.
+type GetLeaderboardParams struct {
+	// Period Period of the leaderboard (default week)
+	Period *LeaderboardPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Metric What users are ranked by (default completed)
+	Metric *LeaderboardMetric `form:"metric,omitempty" json:"metric,omitempty"`
+
+	// Lat Center latitude of the area (requires lon and radius_km)
+	Lat *float64 `form:"lat,omitempty" json:"lat,omitempty"`
+
+	// Lon Center longitude of the area (requires lat and radius_km)
+	Lon *float64 `form:"lon,omitempty" json:"lon,omitempty"`
+
+	// RadiusKm Radius of the area in kilometers (requires lat and lon)
+	RadiusKm *float64 `form:"radius_km,omitempty" json:"radius_km,omitempty"`
+
+	// Limit Maximum number of standings (1 to 100, default 10)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// AutocompleteLocationsParams defines parameters for AutocompleteLocations.
+type AutocompleteLocationsParams struct {
+	// Q Search text (at least 2 characters)
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of suggestions (1 to 50, default 10)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// ListMyLedgerParams defines parameters for ListMyLedger.
+type ListMyLedgerParams struct {
+	// Limit Maximum number of entries (1 to 100, default 20)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of entries to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// ListMyNotificationsParams defines parameters for ListMyNotifications.
+type ListMyNotificationsParams struct {
+	// UnreadOnly List unread notifications only
+	UnreadOnly *bool `form:"unread_only,omitempty" json:"unread_only,omitempty"`
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
-
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/quests", wrapper.ListQuests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/quests", wrapper.CreateQuest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/quests/assigned", wrapper.ListAssignedQuests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/quests/search-radius", wrapper.SearchQuestsByRadius)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/quests/{quest_id}", wrapper.GetQuestById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/quests/{quest_id}/assign", wrapper.AssignQuest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/quests/{quest_id}/status", wrapper.ChangeQuestStatus)
-	})
+	// Limit Maximum number of notifications (1 to 100, default 20)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	return r
+	// Offset Number of notifications to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-type ListQuestsRequestObject struct {
-	Params ListQuestsParams
-}
+// ListQuestsParams defines parameters for ListQuests.
+type ListQuestsParams struct {
+	// Status Filter quests by status
+	Status *ListQuestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-type ListQuestsResponseObject interface {
-	VisitListQuestsResponse(w http.ResponseWriter) error
+	// MaxRouteKm Only quests whose route (target location, waypoints, execution location) is at most this long, in kilometers
+	MaxRouteKm *float64 `form:"max_route_km,omitempty" json:"max_route_km,omitempty"`
+
+	// MinCreatorRating Only quests whose creator has at least this average rating; creators without reviews are excluded
+	MinCreatorRating *float64 `form:"min_creator_rating,omitempty" json:"min_creator_rating,omitempty"`
 }
 
-type ListQuests200JSONResponse []Quest
+// ListQuestsParamsStatus defines parameters for ListQuests.
+type ListQuestsParamsStatus string
 
-func (response ListQuests200JSONResponse) VisitListQuestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RecommendQuestsParams defines parameters for RecommendQuests.
+type RecommendQuestsParams struct {
+	// Lat Latitude of the user's position (-90 to 90), defaults to the home location; given together with lon
+	Lat *float32 `form:"lat,omitempty" json:"lat,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Lon Longitude of the user's position (-180 to 180), defaults to the home location; given together with lat
+	Lon *float32 `form:"lon,omitempty" json:"lon,omitempty"`
 
-type ListQuests401Response struct {
-}
+	// RadiusKm Search radius in kilometers (up to 200 km), defaults to the travel radius of the profile
+	// (capped at 200 km) or 10 km
+	RadiusKm *float32 `form:"radius_km,omitempty" json:"radius_km,omitempty"`
 
-func (response ListQuests401Response) VisitListQuestsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// Skills Skills of the user, comma-separated (case-insensitive), default to the profile's skills
+	Skills *[]string `form:"skills,omitempty" json:"skills,omitempty"`
 
-type ListQuests500Response struct {
-}
+	// Equipment Equipment the user has, comma-separated (case-insensitive), defaults to the profile's equipment
+	Equipment *[]string `form:"equipment,omitempty" json:"equipment,omitempty"`
 
-func (response ListQuests500Response) VisitListQuestsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+	// Limit Maximum number of recommendations
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type CreateQuestRequestObject struct {
-	Body *CreateQuestJSONRequestBody
-}
+// SearchQuestsByAreaParams defines parameters for SearchQuestsByArea.
+type SearchQuestsByAreaParams struct {
+	// Polygon GeoJSON Polygon geometry as a JSON string, coordinates in `[longitude, latitude]` order, e.g.
+	// `{"type":"Polygon","coordinates":[[[37.5,55.7],[37.7,55.7],[37.7,55.8],[37.5,55.8],[37.5,55.7]]]}`.
+	// Additional rings are holes. At most 1000 vertices; the polygon must not cross the antimeridian.
+	Polygon *string `form:"polygon,omitempty" json:"polygon,omitempty"`
 
-type CreateQuestResponseObject interface {
-	VisitCreateQuestResponse(w http.ResponseWriter) error
+	// Bbox Bounding box `minLon,minLat,maxLon,maxLat`. A box with `minLon > maxLon` crosses the antimeridian.
+	Bbox *string `form:"bbox,omitempty" json:"bbox,omitempty"`
+
+	// Match Which quest location must lie in the area: `target`, `execution` or `any` (either of them).
+	Match *SearchQuestsByAreaParamsMatch `form:"match,omitempty" json:"match,omitempty"`
 }
 
-type CreateQuest201JSONResponse Quest
+// SearchQuestsByAreaParamsMatch defines parameters for SearchQuestsByArea.
+type SearchQuestsByAreaParamsMatch string
 
-func (response CreateQuest201JSONResponse) VisitCreateQuestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// SearchQuestsByRadiusParams defines parameters for SearchQuestsByRadius.
+type SearchQuestsByRadiusParams struct {
+	// Lat Center latitude (-90 to 90)
+	Lat float32 `form:"lat" json:"lat"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Lon Center longitude (-180 to 180)
+	Lon float32 `form:"lon" json:"lon"`
 
-type CreateQuest400Response struct {
-}
+	// RadiusKm Search radius in kilometers (0.1 to 20000 km)
+	RadiusKm float32 `form:"radius_km" json:"radius_km"`
 
-func (response CreateQuest400Response) VisitCreateQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	// Sort Result order. `distance` - closest first (by the nearer of target and execution location),
+	// `created_at` - newest first, `reward` - highest reward first. Ties are broken by distance.
+	Sort *SearchQuestsByRadiusParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-type CreateQuest401Response struct {
+	// Limit Maximum number of results (all matches when omitted)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-func (response CreateQuest401Response) VisitCreateQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// SearchQuestsByRadiusParamsSort defines parameters for SearchQuestsByRadius.
+type SearchQuestsByRadiusParamsSort string
 
-type CreateQuest500Response struct {
-}
+// GetQuestTileParams defines parameters for GetQuestTile.
+type GetQuestTileParams struct {
+	// Resolution Grid resolution - the tile is split into 2^resolution x 2^resolution cells
+	Resolution *int `form:"resolution,omitempty" json:"resolution,omitempty"`
 
-func (response CreateQuest500Response) VisitCreateQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+	// Format Response format, overrides the Accept header
+	Format *GetQuestTileParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-type ListAssignedQuestsRequestObject struct {
+// GetQuestTileParamsFormat defines parameters for GetQuestTile.
+type GetQuestTileParamsFormat string
+
+// ListQuestCommentsParams defines parameters for ListQuestComments.
+type ListQuestCommentsParams struct {
+	// Limit Maximum number of comments (1 to 100, default 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of comments to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-type ListAssignedQuestsResponseObject interface {
-	VisitListAssignedQuestsResponse(w http.ResponseWriter) error
+// UpdateMyNotificationPreferencesJSONRequestBody defines body for UpdateMyNotificationPreferences for application/json ContentType.
+type UpdateMyNotificationPreferencesJSONRequestBody = UpdateNotificationPreferencesRequest
+
+// UpdateMyProfileJSONRequestBody defines body for UpdateMyProfile for application/json ContentType.
+type UpdateMyProfileJSONRequestBody = UpdateUserProfileRequest
+
+// CreateQuestTemplateJSONRequestBody defines body for CreateQuestTemplate for application/json ContentType.
+type CreateQuestTemplateJSONRequestBody = QuestTemplateRequest
+
+// UpdateQuestTemplateJSONRequestBody defines body for UpdateQuestTemplate for application/json ContentType.
+type UpdateQuestTemplateJSONRequestBody = QuestTemplateRequest
+
+// CreateQuestFromTemplateJSONRequestBody defines body for CreateQuestFromTemplate for application/json ContentType.
+type CreateQuestFromTemplateJSONRequestBody = QuestOverrides
+
+// CreateQuestJSONRequestBody defines body for CreateQuest for application/json ContentType.
+type CreateQuestJSONRequestBody = CreateQuestRequest
+
+// ApplyToQuestJSONRequestBody defines body for ApplyToQuest for application/json ContentType.
+type ApplyToQuestJSONRequestBody = ApplyToQuestRequest
+
+// AddQuestCommentJSONRequestBody defines body for AddQuestComment for application/json ContentType.
+type AddQuestCommentJSONRequestBody = AddQuestCommentRequest
+
+// EditQuestCommentJSONRequestBody defines body for EditQuestComment for application/json ContentType.
+type EditQuestCommentJSONRequestBody = EditQuestCommentRequest
+
+// RejectCompletionJSONRequestBody defines body for RejectCompletion for application/json ContentType.
+type RejectCompletionJSONRequestBody = RejectCompletionRequest
+
+// SubmitCompletionEvidenceMultipartRequestBody defines body for SubmitCompletionEvidence for multipart/form-data ContentType.
+type SubmitCompletionEvidenceMultipartRequestBody = SubmitEvidenceRequest
+
+// AddQuestPrerequisiteJSONRequestBody defines body for AddQuestPrerequisite for application/json ContentType.
+type AddQuestPrerequisiteJSONRequestBody = AddQuestPrerequisiteRequest
+
+// SubmitQuestReviewJSONRequestBody defines body for SubmitQuestReview for application/json ContentType.
+type SubmitQuestReviewJSONRequestBody = SubmitQuestReviewRequest
+
+// ChangeQuestStatusJSONRequestBody defines body for ChangeQuestStatus for application/json ContentType.
+type ChangeQuestStatusJSONRequestBody = ChangeStatusRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get a leaderboard
+	// (GET /leaderboards)
+	GetLeaderboard(w http.ResponseWriter, r *http.Request, params GetLeaderboardParams)
+	// Autocomplete locations by name or address
+	// (GET /locations/autocomplete)
+	AutocompleteLocations(w http.ResponseWriter, r *http.Request, params AutocompleteLocationsParams)
+	// List the achievements of the authenticated user
+	// (GET /me/achievements)
+	ListMyAchievements(w http.ResponseWriter, r *http.Request)
+	// Get the points balance of the authenticated user
+	// (GET /me/balance)
+	GetMyBalance(w http.ResponseWriter, r *http.Request)
+	// List the points ledger of the authenticated user
+	// (GET /me/ledger)
+	ListMyLedger(w http.ResponseWriter, r *http.Request, params ListMyLedgerParams)
+	// Get the notification preferences of the authenticated user
+	// (GET /me/notification-preferences)
+	GetMyNotificationPreferences(w http.ResponseWriter, r *http.Request)
+	// Update the notification preferences of the authenticated user
+	// (PUT /me/notification-preferences)
+	UpdateMyNotificationPreferences(w http.ResponseWriter, r *http.Request)
+	// List the notifications of the authenticated user
+	// (GET /me/notifications)
+	ListMyNotifications(w http.ResponseWriter, r *http.Request, params ListMyNotificationsParams)
+	// Mark every notification read
+	// (POST /me/notifications/read-all)
+	MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request)
+	// Mark a notification read
+	// (POST /me/notifications/{notification_id}/read)
+	MarkNotificationRead(w http.ResponseWriter, r *http.Request, notificationId openapi_types.UUID)
+	// Get the profile of the authenticated user
+	// (GET /me/profile)
+	GetMyProfile(w http.ResponseWriter, r *http.Request)
+	// Create or replace the profile of the authenticated user
+	// (PUT /me/profile)
+	UpdateMyProfile(w http.ResponseWriter, r *http.Request)
+	// List the quest templates of the authenticated user
+	// (GET /quest-templates)
+	ListQuestTemplates(w http.ResponseWriter, r *http.Request)
+	// Create a quest template
+	// (POST /quest-templates)
+	CreateQuestTemplate(w http.ResponseWriter, r *http.Request)
+	// Delete a quest template
+	// (DELETE /quest-templates/{template_id})
+	DeleteQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// Get a quest template
+	// (GET /quest-templates/{template_id})
+	GetQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// Replace a quest template
+	// (PUT /quest-templates/{template_id})
+	UpdateQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// Create a quest from a template
+	// (POST /quest-templates/{template_id}/quests)
+	CreateQuestFromTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// Get a list of all quests
+	// (GET /quests)
+	ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams)
+	// Create a new quest
+	// (POST /quests)
+	CreateQuest(w http.ResponseWriter, r *http.Request)
+	// Get quests assigned to the authenticated user
+	// (GET /quests/assigned)
+	ListAssignedQuests(w http.ResponseWriter, r *http.Request)
+	// Recommend posted quests for the authenticated user
+	// (GET /quests/recommended)
+	RecommendQuests(w http.ResponseWriter, r *http.Request, params RecommendQuestsParams)
+	// Search quests inside a polygon or bounding box
+	// (GET /quests/search-area)
+	SearchQuestsByArea(w http.ResponseWriter, r *http.Request, params SearchQuestsByAreaParams)
+	// Search quests within a radius
+	// (GET /quests/search-radius)
+	SearchQuestsByRadius(w http.ResponseWriter, r *http.Request, params SearchQuestsByRadiusParams)
+	// Get clustered quest counts for a map tile
+	// (GET /quests/tiles/{z}/{x}/{y})
+	GetQuestTile(w http.ResponseWriter, r *http.Request, z int, x int, y int, params GetQuestTileParams)
+	// Get quest details by ID
+	// (GET /quests/{quest_id})
+	GetQuestById(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// List applications to a quest
+	// (GET /quests/{quest_id}/applications)
+	ListQuestApplications(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Apply to a quest
+	// (POST /quests/{quest_id}/applications)
+	ApplyToQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Accept an application
+	// (POST /quests/{quest_id}/applications/{application_id}/accept)
+	AcceptApplication(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, applicationId openapi_types.UUID)
+	// Reject an application
+	// (POST /quests/{quest_id}/applications/{application_id}/reject)
+	RejectApplication(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, applicationId openapi_types.UUID)
+	// Assign quest to the authenticated user
+	// (POST /quests/{quest_id}/assign)
+	AssignQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// List the comment thread of a quest
+	// (GET /quests/{quest_id}/comments)
+	ListQuestComments(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, params ListQuestCommentsParams)
+	// Add a comment to the thread of a quest
+	// (POST /quests/{quest_id}/comments)
+	AddQuestComment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Edit a comment
+	// (PATCH /quests/{quest_id}/comments/{comment_id})
+	EditQuestComment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, commentId openapi_types.UUID)
+	// Approve quest completion
+	// (POST /quests/{quest_id}/completion/approve)
+	ApproveCompletion(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Reject quest completion
+	// (POST /quests/{quest_id}/completion/reject)
+	RejectCompletion(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Get the dependency graph of a quest
+	// (GET /quests/{quest_id}/dependencies)
+	GetQuestDependencies(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// List completion evidence
+	// (GET /quests/{quest_id}/evidence)
+	ListCompletionEvidence(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Submit completion evidence
+	// (POST /quests/{quest_id}/evidence)
+	SubmitCompletionEvidence(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Download an evidence photo
+	// (GET /quests/{quest_id}/evidence/{evidence_id}/attachments/{attachment_id})
+	GetEvidenceAttachment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, evidenceId openapi_types.UUID, attachmentId openapi_types.UUID)
+	// Add a prerequisite to a quest
+	// (POST /quests/{quest_id}/prerequisites)
+	AddQuestPrerequisite(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Remove a prerequisite from a quest
+	// (DELETE /quests/{quest_id}/prerequisites/{prerequisite_id})
+	RemoveQuestPrerequisite(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, prerequisiteId openapi_types.UUID)
+	// List reviews of a quest
+	// (GET /quests/{quest_id}/reviews)
+	ListQuestReviews(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Review the creator or a participant of a completed quest
+	// (POST /quests/{quest_id}/reviews)
+	SubmitQuestReview(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
+	// Change quest status
+	// (PATCH /quests/{quest_id}/status)
+	ChangeQuestStatus(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID)
 }
 
-type ListAssignedQuests200JSONResponse []Quest
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
-func (response ListAssignedQuests200JSONResponse) VisitListAssignedQuestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type Unimplemented struct{}
 
-	return json.NewEncoder(w).Encode(response)
+// Get a leaderboard
+// (GET /leaderboards)
+func (_ Unimplemented) GetLeaderboard(w http.ResponseWriter, r *http.Request, params GetLeaderboardParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListAssignedQuests401Response struct {
+// Autocomplete locations by name or address
+// (GET /locations/autocomplete)
+func (_ Unimplemented) AutocompleteLocations(w http.ResponseWriter, r *http.Request, params AutocompleteLocationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response ListAssignedQuests401Response) VisitListAssignedQuestsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// List the achievements of the authenticated user
+// (GET /me/achievements)
+func (_ Unimplemented) ListMyAchievements(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListAssignedQuests500Response struct {
+// Get the points balance of the authenticated user
+// (GET /me/balance)
+func (_ Unimplemented) GetMyBalance(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response ListAssignedQuests500Response) VisitListAssignedQuestsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+// List the points ledger of the authenticated user
+// (GET /me/ledger)
+func (_ Unimplemented) ListMyLedger(w http.ResponseWriter, r *http.Request, params ListMyLedgerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchQuestsByRadiusRequestObject struct {
-	Params SearchQuestsByRadiusParams
+// Get the notification preferences of the authenticated user
+// (GET /me/notification-preferences)
+func (_ Unimplemented) GetMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchQuestsByRadiusResponseObject interface {
-	VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error
+// Update the notification preferences of the authenticated user
+// (PUT /me/notification-preferences)
+func (_ Unimplemented) UpdateMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchQuestsByRadius200JSONResponse []Quest
+// List the notifications of the authenticated user
+// (GET /me/notifications)
+func (_ Unimplemented) ListMyNotifications(w http.ResponseWriter, r *http.Request, params ListMyNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response SearchQuestsByRadius200JSONResponse) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Mark every notification read
+// (POST /me/notifications/read-all)
+func (_ Unimplemented) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Mark a notification read
+// (POST /me/notifications/{notification_id}/read)
+func (_ Unimplemented) MarkNotificationRead(w http.ResponseWriter, r *http.Request, notificationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchQuestsByRadius400Response struct {
+// Get the profile of the authenticated user
+// (GET /me/profile)
+func (_ Unimplemented) GetMyProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response SearchQuestsByRadius400Response) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+// Create or replace the profile of the authenticated user
+// (PUT /me/profile)
+func (_ Unimplemented) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchQuestsByRadius401Response struct {
+// List the quest templates of the authenticated user
+// (GET /quest-templates)
+func (_ Unimplemented) ListQuestTemplates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response SearchQuestsByRadius401Response) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Create a quest template
+// (POST /quest-templates)
+func (_ Unimplemented) CreateQuestTemplate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type SearchQuestsByRadius500Response struct {
+// Delete a quest template
+// (DELETE /quest-templates/{template_id})
+func (_ Unimplemented) DeleteQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response SearchQuestsByRadius500Response) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+// Get a quest template
+// (GET /quest-templates/{template_id})
+func (_ Unimplemented) GetQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetQuestByIdRequestObject struct {
-	QuestId openapi_types.UUID `json:"quest_id"`
+// Replace a quest template
+// (PUT /quest-templates/{template_id})
+func (_ Unimplemented) UpdateQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetQuestByIdResponseObject interface {
-	VisitGetQuestByIdResponse(w http.ResponseWriter) error
+// Create a quest from a template
+// (POST /quest-templates/{template_id}/quests)
+func (_ Unimplemented) CreateQuestFromTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetQuestById200JSONResponse Quest
+// Get a list of all quests
+// (GET /quests)
+func (_ Unimplemented) ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response GetQuestById200JSONResponse) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Create a new quest
+// (POST /quests)
+func (_ Unimplemented) CreateQuest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Get quests assigned to the authenticated user
+// (GET /quests/assigned)
+func (_ Unimplemented) ListAssignedQuests(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetQuestById401Response struct {
+// Recommend posted quests for the authenticated user
+// (GET /quests/recommended)
+func (_ Unimplemented) RecommendQuests(w http.ResponseWriter, r *http.Request, params RecommendQuestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response GetQuestById401Response) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// Search quests inside a polygon or bounding box
+// (GET /quests/search-area)
+func (_ Unimplemented) SearchQuestsByArea(w http.ResponseWriter, r *http.Request, params SearchQuestsByAreaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetQuestById404Response struct {
+// Search quests within a radius
+// (GET /quests/search-radius)
+func (_ Unimplemented) SearchQuestsByRadius(w http.ResponseWriter, r *http.Request, params SearchQuestsByRadiusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response GetQuestById404Response) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+// Get clustered quest counts for a map tile
+// (GET /quests/tiles/{z}/{x}/{y})
+func (_ Unimplemented) GetQuestTile(w http.ResponseWriter, r *http.Request, z int, x int, y int, params GetQuestTileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetQuestById500Response struct {
+// Get quest details by ID
+// (GET /quests/{quest_id})
+func (_ Unimplemented) GetQuestById(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response GetQuestById500Response) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+// List applications to a quest
+// (GET /quests/{quest_id}/applications)
+func (_ Unimplemented) ListQuestApplications(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type AssignQuestRequestObject struct {
-	QuestId openapi_types.UUID `json:"quest_id"`
+// Apply to a quest
+// (POST /quests/{quest_id}/applications)
+func (_ Unimplemented) ApplyToQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type AssignQuestResponseObject interface {
-	VisitAssignQuestResponse(w http.ResponseWriter) error
+// Accept an application
+// (POST /quests/{quest_id}/applications/{application_id}/accept)
+func (_ Unimplemented) AcceptApplication(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, applicationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type AssignQuest200JSONResponse AssignQuestResult
+// Reject an application
+// (POST /quests/{quest_id}/applications/{application_id}/reject)
+func (_ Unimplemented) RejectApplication(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, applicationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Assign quest to the authenticated user
+// (POST /quests/{quest_id}/assign)
+func (_ Unimplemented) AssignQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the comment thread of a quest
+// (GET /quests/{quest_id}/comments)
+func (_ Unimplemented) ListQuestComments(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, params ListQuestCommentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a comment to the thread of a quest
+// (POST /quests/{quest_id}/comments)
+func (_ Unimplemented) AddQuestComment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Edit a comment
+// (PATCH /quests/{quest_id}/comments/{comment_id})
+func (_ Unimplemented) EditQuestComment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, commentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve quest completion
+// (POST /quests/{quest_id}/completion/approve)
+func (_ Unimplemented) ApproveCompletion(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reject quest completion
+// (POST /quests/{quest_id}/completion/reject)
+func (_ Unimplemented) RejectCompletion(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the dependency graph of a quest
+// (GET /quests/{quest_id}/dependencies)
+func (_ Unimplemented) GetQuestDependencies(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List completion evidence
+// (GET /quests/{quest_id}/evidence)
+func (_ Unimplemented) ListCompletionEvidence(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Submit completion evidence
+// (POST /quests/{quest_id}/evidence)
+func (_ Unimplemented) SubmitCompletionEvidence(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download an evidence photo
+// (GET /quests/{quest_id}/evidence/{evidence_id}/attachments/{attachment_id})
+func (_ Unimplemented) GetEvidenceAttachment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, evidenceId openapi_types.UUID, attachmentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a prerequisite to a quest
+// (POST /quests/{quest_id}/prerequisites)
+func (_ Unimplemented) AddQuestPrerequisite(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a prerequisite from a quest
+// (DELETE /quests/{quest_id}/prerequisites/{prerequisite_id})
+func (_ Unimplemented) RemoveQuestPrerequisite(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, prerequisiteId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List reviews of a quest
+// (GET /quests/{quest_id}/reviews)
+func (_ Unimplemented) ListQuestReviews(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Review the creator or a participant of a completed quest
+// (POST /quests/{quest_id}/reviews)
+func (_ Unimplemented) SubmitQuestReview(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change quest status
+// (PATCH /quests/{quest_id}/status)
+func (_ Unimplemented) ChangeQuestStatus(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaderboardParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "radius_km" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius_km", r.URL.Query(), &params.RadiusKm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius_km", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLeaderboard(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutocompleteLocations operation middleware
+func (siw *ServerInterfaceWrapper) AutocompleteLocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AutocompleteLocationsParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutocompleteLocations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyAchievements operation middleware
+func (siw *ServerInterfaceWrapper) ListMyAchievements(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyAchievements(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetMyBalance(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyBalance(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyLedger operation middleware
+func (siw *ServerInterfaceWrapper) ListMyLedger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMyLedgerParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyLedger(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyNotificationPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMyNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMyNotificationPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListMyNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMyNotificationsParams
+
+	// ------------- Optional query parameter "unread_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread_only", r.URL.Query(), &params.UnreadOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unread_only", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkAllNotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkAllNotificationsRead(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkNotificationRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "notification_id" -------------
+	var notificationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notification_id", chi.URLParam(r, "notification_id"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "notification_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkNotificationRead(w, r, notificationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetMyProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMyProfile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMyProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuestTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListQuestTemplates(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuestTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateQuestTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuestTemplate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateQuestTemplate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteQuestTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteQuestTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteQuestTemplate(w, r, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuestTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetQuestTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuestTemplate(w, r, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateQuestTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQuestTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateQuestTemplate(w, r, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateQuestFromTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuestFromTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", chi.URLParam(r, "template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateQuestFromTemplate(w, r, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuests operation middleware
+func (siw *ServerInterfaceWrapper) ListQuests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_route_km" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_route_km", r.URL.Query(), &params.MaxRouteKm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_route_km", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_creator_rating" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_creator_rating", r.URL.Query(), &params.MinCreatorRating)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_creator_rating", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateQuest operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateQuest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAssignedQuests operation middleware
+func (siw *ServerInterfaceWrapper) ListAssignedQuests(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAssignedQuests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RecommendQuests operation middleware
+func (siw *ServerInterfaceWrapper) RecommendQuests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RecommendQuestsParams
+
+	// ------------- Optional query parameter "lat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "radius_km" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius_km", r.URL.Query(), &params.RadiusKm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius_km", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "skills" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "skills", r.URL.Query(), &params.Skills)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "skills", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "equipment" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "equipment", r.URL.Query(), &params.Equipment)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "equipment", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecommendQuests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchQuestsByArea operation middleware
+func (siw *ServerInterfaceWrapper) SearchQuestsByArea(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchQuestsByAreaParams
+
+	// ------------- Optional query parameter "polygon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "polygon", r.URL.Query(), &params.Polygon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "polygon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bbox", r.URL.Query(), &params.Bbox)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bbox", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "match", r.URL.Query(), &params.Match)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "match", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchQuestsByArea(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchQuestsByRadius operation middleware
+func (siw *ServerInterfaceWrapper) SearchQuestsByRadius(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchQuestsByRadiusParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lat"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "lon" -------------
+
+	if paramValue := r.URL.Query().Get("lon"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lon"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "radius_km" -------------
+
+	if paramValue := r.URL.Query().Get("radius_km"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "radius_km"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "radius_km", r.URL.Query(), &params.RadiusKm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius_km", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchQuestsByRadius(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuestTile operation middleware
+func (siw *ServerInterfaceWrapper) GetQuestTile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "z" -------------
+	var z int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "z", chi.URLParam(r, "z"), &z, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "z", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "x" -------------
+	var x int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "x", chi.URLParam(r, "x"), &x, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "y" -------------
+	var y int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "y", chi.URLParam(r, "y"), &y, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "y", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQuestTileParams
+
+	// ------------- Optional query parameter "resolution" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resolution", r.URL.Query(), &params.Resolution)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resolution", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuestTile(w, r, z, x, y, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuestById operation middleware
+func (siw *ServerInterfaceWrapper) GetQuestById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuestById(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuestApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListQuestApplications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuestApplications(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyToQuest operation middleware
+func (siw *ServerInterfaceWrapper) ApplyToQuest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyToQuest(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptApplication operation middleware
+func (siw *ServerInterfaceWrapper) AcceptApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "application_id" -------------
+	var applicationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "application_id", chi.URLParam(r, "application_id"), &applicationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "application_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptApplication(w, r, questId, applicationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectApplication operation middleware
+func (siw *ServerInterfaceWrapper) RejectApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "application_id" -------------
+	var applicationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "application_id", chi.URLParam(r, "application_id"), &applicationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "application_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectApplication(w, r, questId, applicationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AssignQuest operation middleware
+func (siw *ServerInterfaceWrapper) AssignQuest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignQuest(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuestComments operation middleware
+func (siw *ServerInterfaceWrapper) ListQuestComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuestCommentsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuestComments(w, r, questId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddQuestComment operation middleware
+func (siw *ServerInterfaceWrapper) AddQuestComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddQuestComment(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditQuestComment operation middleware
+func (siw *ServerInterfaceWrapper) EditQuestComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", chi.URLParam(r, "comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "comment_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditQuestComment(w, r, questId, commentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveCompletion operation middleware
+func (siw *ServerInterfaceWrapper) ApproveCompletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveCompletion(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectCompletion operation middleware
+func (siw *ServerInterfaceWrapper) RejectCompletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectCompletion(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuestDependencies operation middleware
+func (siw *ServerInterfaceWrapper) GetQuestDependencies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuestDependencies(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCompletionEvidence operation middleware
+func (siw *ServerInterfaceWrapper) ListCompletionEvidence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCompletionEvidence(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitCompletionEvidence operation middleware
+func (siw *ServerInterfaceWrapper) SubmitCompletionEvidence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitCompletionEvidence(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvidenceAttachment operation middleware
+func (siw *ServerInterfaceWrapper) GetEvidenceAttachment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "evidence_id" -------------
+	var evidenceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "evidence_id", chi.URLParam(r, "evidence_id"), &evidenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "evidence_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", chi.URLParam(r, "attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachment_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEvidenceAttachment(w, r, questId, evidenceId, attachmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddQuestPrerequisite operation middleware
+func (siw *ServerInterfaceWrapper) AddQuestPrerequisite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddQuestPrerequisite(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveQuestPrerequisite operation middleware
+func (siw *ServerInterfaceWrapper) RemoveQuestPrerequisite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "prerequisite_id" -------------
+	var prerequisiteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "prerequisite_id", chi.URLParam(r, "prerequisite_id"), &prerequisiteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prerequisite_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveQuestPrerequisite(w, r, questId, prerequisiteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuestReviews operation middleware
+func (siw *ServerInterfaceWrapper) ListQuestReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuestReviews(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitQuestReview operation middleware
+func (siw *ServerInterfaceWrapper) SubmitQuestReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitQuestReview(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangeQuestStatus operation middleware
+func (siw *ServerInterfaceWrapper) ChangeQuestStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "quest_id" -------------
+	var questId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quest_id", chi.URLParam(r, "quest_id"), &questId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeQuestStatus(w, r, questId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/leaderboards", wrapper.GetLeaderboard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/locations/autocomplete", wrapper.AutocompleteLocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/achievements", wrapper.ListMyAchievements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/balance", wrapper.GetMyBalance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/ledger", wrapper.ListMyLedger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/notification-preferences", wrapper.GetMyNotificationPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/me/notification-preferences", wrapper.UpdateMyNotificationPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/notifications", wrapper.ListMyNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/notifications/read-all", wrapper.MarkAllNotificationsRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/notifications/{notification_id}/read", wrapper.MarkNotificationRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/profile", wrapper.GetMyProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/me/profile", wrapper.UpdateMyProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quest-templates", wrapper.ListQuestTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quest-templates", wrapper.CreateQuestTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/quest-templates/{template_id}", wrapper.DeleteQuestTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quest-templates/{template_id}", wrapper.GetQuestTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/quest-templates/{template_id}", wrapper.UpdateQuestTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quest-templates/{template_id}/quests", wrapper.CreateQuestFromTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests", wrapper.ListQuests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests", wrapper.CreateQuest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/assigned", wrapper.ListAssignedQuests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/recommended", wrapper.RecommendQuests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/search-area", wrapper.SearchQuestsByArea)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/search-radius", wrapper.SearchQuestsByRadius)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/tiles/{z}/{x}/{y}", wrapper.GetQuestTile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}", wrapper.GetQuestById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}/applications", wrapper.ListQuestApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/applications", wrapper.ApplyToQuest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/applications/{application_id}/accept", wrapper.AcceptApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/applications/{application_id}/reject", wrapper.RejectApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/assign", wrapper.AssignQuest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}/comments", wrapper.ListQuestComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/comments", wrapper.AddQuestComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/quests/{quest_id}/comments/{comment_id}", wrapper.EditQuestComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/completion/approve", wrapper.ApproveCompletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/completion/reject", wrapper.RejectCompletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}/dependencies", wrapper.GetQuestDependencies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}/evidence", wrapper.ListCompletionEvidence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/evidence", wrapper.SubmitCompletionEvidence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}/evidence/{evidence_id}/attachments/{attachment_id}", wrapper.GetEvidenceAttachment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/prerequisites", wrapper.AddQuestPrerequisite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/quests/{quest_id}/prerequisites/{prerequisite_id}", wrapper.RemoveQuestPrerequisite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quests/{quest_id}/reviews", wrapper.ListQuestReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quests/{quest_id}/reviews", wrapper.SubmitQuestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/quests/{quest_id}/status", wrapper.ChangeQuestStatus)
+	})
+
+	return r
+}
+
+type GetLeaderboardRequestObject struct {
+	Params GetLeaderboardParams
+}
+
+type GetLeaderboardResponseObject interface {
+	VisitGetLeaderboardResponse(w http.ResponseWriter) error
+}
+
+type GetLeaderboard200JSONResponse Leaderboard
+
+func (response GetLeaderboard200JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboard400Response struct {
+}
+
+func (response GetLeaderboard400Response) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetLeaderboard401Response struct {
+}
+
+func (response GetLeaderboard401Response) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetLeaderboard500Response struct {
+}
+
+func (response GetLeaderboard500Response) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AutocompleteLocationsRequestObject struct {
+	Params AutocompleteLocationsParams
+}
+
+type AutocompleteLocationsResponseObject interface {
+	VisitAutocompleteLocationsResponse(w http.ResponseWriter) error
+}
+
+type AutocompleteLocations200JSONResponse []LocationSuggestion
+
+func (response AutocompleteLocations200JSONResponse) VisitAutocompleteLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AutocompleteLocations400Response struct {
+}
+
+func (response AutocompleteLocations400Response) VisitAutocompleteLocationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AutocompleteLocations401Response struct {
+}
+
+func (response AutocompleteLocations401Response) VisitAutocompleteLocationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AutocompleteLocations500Response struct {
+}
+
+func (response AutocompleteLocations500Response) VisitAutocompleteLocationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListMyAchievementsRequestObject struct {
+}
+
+type ListMyAchievementsResponseObject interface {
+	VisitListMyAchievementsResponse(w http.ResponseWriter) error
+}
+
+type ListMyAchievements200JSONResponse []Achievement
+
+func (response ListMyAchievements200JSONResponse) VisitListMyAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMyAchievements401Response struct {
+}
+
+func (response ListMyAchievements401Response) VisitListMyAchievementsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListMyAchievements500Response struct {
+}
+
+func (response ListMyAchievements500Response) VisitListMyAchievementsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetMyBalanceRequestObject struct {
+}
+
+type GetMyBalanceResponseObject interface {
+	VisitGetMyBalanceResponse(w http.ResponseWriter) error
+}
+
+type GetMyBalance200JSONResponse Balance
+
+func (response GetMyBalance200JSONResponse) VisitGetMyBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyBalance401Response struct {
+}
+
+func (response GetMyBalance401Response) VisitGetMyBalanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMyBalance500Response struct {
+}
+
+func (response GetMyBalance500Response) VisitGetMyBalanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListMyLedgerRequestObject struct {
+	Params ListMyLedgerParams
+}
+
+type ListMyLedgerResponseObject interface {
+	VisitListMyLedgerResponse(w http.ResponseWriter) error
+}
+
+type ListMyLedger200JSONResponse LedgerPage
+
+func (response ListMyLedger200JSONResponse) VisitListMyLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMyLedger400Response struct {
+}
+
+func (response ListMyLedger400Response) VisitListMyLedgerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListMyLedger401Response struct {
+}
+
+func (response ListMyLedger401Response) VisitListMyLedgerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListMyLedger500Response struct {
+}
+
+func (response ListMyLedger500Response) VisitListMyLedgerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetMyNotificationPreferencesRequestObject struct {
+}
+
+type GetMyNotificationPreferencesResponseObject interface {
+	VisitGetMyNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetMyNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response GetMyNotificationPreferences200JSONResponse) VisitGetMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyNotificationPreferences401Response struct {
+}
+
+func (response GetMyNotificationPreferences401Response) VisitGetMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMyNotificationPreferences500Response struct {
+}
+
+func (response GetMyNotificationPreferences500Response) VisitGetMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type UpdateMyNotificationPreferencesRequestObject struct {
+	Body *UpdateMyNotificationPreferencesJSONRequestBody
+}
+
+type UpdateMyNotificationPreferencesResponseObject interface {
+	VisitUpdateMyNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type UpdateMyNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response UpdateMyNotificationPreferences200JSONResponse) VisitUpdateMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyNotificationPreferences400Response struct {
+}
+
+func (response UpdateMyNotificationPreferences400Response) VisitUpdateMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateMyNotificationPreferences401Response struct {
+}
+
+func (response UpdateMyNotificationPreferences401Response) VisitUpdateMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateMyNotificationPreferences500Response struct {
+}
+
+func (response UpdateMyNotificationPreferences500Response) VisitUpdateMyNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListMyNotificationsRequestObject struct {
+	Params ListMyNotificationsParams
+}
+
+type ListMyNotificationsResponseObject interface {
+	VisitListMyNotificationsResponse(w http.ResponseWriter) error
+}
+
+type ListMyNotifications200JSONResponse NotificationPage
+
+func (response ListMyNotifications200JSONResponse) VisitListMyNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMyNotifications400Response struct {
+}
+
+func (response ListMyNotifications400Response) VisitListMyNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListMyNotifications401Response struct {
+}
+
+func (response ListMyNotifications401Response) VisitListMyNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListMyNotifications500Response struct {
+}
+
+func (response ListMyNotifications500Response) VisitListMyNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type MarkAllNotificationsReadRequestObject struct {
+}
+
+type MarkAllNotificationsReadResponseObject interface {
+	VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error
+}
+
+type MarkAllNotificationsRead200JSONResponse MarkAllNotificationsReadResponse
+
+func (response MarkAllNotificationsRead200JSONResponse) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkAllNotificationsRead401Response struct {
+}
+
+func (response MarkAllNotificationsRead401Response) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MarkAllNotificationsRead500Response struct {
+}
+
+func (response MarkAllNotificationsRead500Response) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type MarkNotificationReadRequestObject struct {
+	NotificationId openapi_types.UUID `json:"notification_id"`
+}
+
+type MarkNotificationReadResponseObject interface {
+	VisitMarkNotificationReadResponse(w http.ResponseWriter) error
+}
+
+type MarkNotificationRead200JSONResponse Notification
+
+func (response MarkNotificationRead200JSONResponse) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkNotificationRead401Response struct {
+}
+
+func (response MarkNotificationRead401Response) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MarkNotificationRead404Response struct {
+}
+
+func (response MarkNotificationRead404Response) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type MarkNotificationRead500Response struct {
+}
+
+func (response MarkNotificationRead500Response) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetMyProfileRequestObject struct {
+}
+
+type GetMyProfileResponseObject interface {
+	VisitGetMyProfileResponse(w http.ResponseWriter) error
+}
+
+type GetMyProfile200JSONResponse UserProfile
+
+func (response GetMyProfile200JSONResponse) VisitGetMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyProfile401Response struct {
+}
+
+func (response GetMyProfile401Response) VisitGetMyProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMyProfile404Response struct {
+}
+
+func (response GetMyProfile404Response) VisitGetMyProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetMyProfile500Response struct {
+}
+
+func (response GetMyProfile500Response) VisitGetMyProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type UpdateMyProfileRequestObject struct {
+	Body *UpdateMyProfileJSONRequestBody
+}
+
+type UpdateMyProfileResponseObject interface {
+	VisitUpdateMyProfileResponse(w http.ResponseWriter) error
+}
+
+type UpdateMyProfile200JSONResponse UserProfile
+
+func (response UpdateMyProfile200JSONResponse) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyProfile400Response struct {
+}
+
+func (response UpdateMyProfile400Response) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateMyProfile401Response struct {
+}
+
+func (response UpdateMyProfile401Response) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateMyProfile500Response struct {
+}
+
+func (response UpdateMyProfile500Response) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListQuestTemplatesRequestObject struct {
+}
+
+type ListQuestTemplatesResponseObject interface {
+	VisitListQuestTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListQuestTemplates200JSONResponse []QuestTemplate
+
+func (response ListQuestTemplates200JSONResponse) VisitListQuestTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuestTemplates401Response struct {
+}
+
+func (response ListQuestTemplates401Response) VisitListQuestTemplatesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListQuestTemplates500Response struct {
+}
+
+func (response ListQuestTemplates500Response) VisitListQuestTemplatesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type CreateQuestTemplateRequestObject struct {
+	Body *CreateQuestTemplateJSONRequestBody
+}
+
+type CreateQuestTemplateResponseObject interface {
+	VisitCreateQuestTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateQuestTemplate201JSONResponse QuestTemplate
+
+func (response CreateQuestTemplate201JSONResponse) VisitCreateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuestTemplate400Response struct {
+}
+
+func (response CreateQuestTemplate400Response) VisitCreateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateQuestTemplate401Response struct {
+}
+
+func (response CreateQuestTemplate401Response) VisitCreateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateQuestTemplate500Response struct {
+}
+
+func (response CreateQuestTemplate500Response) VisitCreateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type DeleteQuestTemplateRequestObject struct {
+	TemplateId openapi_types.UUID `json:"template_id"`
+}
+
+type DeleteQuestTemplateResponseObject interface {
+	VisitDeleteQuestTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteQuestTemplate204Response struct {
+}
+
+func (response DeleteQuestTemplate204Response) VisitDeleteQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteQuestTemplate401Response struct {
+}
+
+func (response DeleteQuestTemplate401Response) VisitDeleteQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteQuestTemplate404Response struct {
+}
+
+func (response DeleteQuestTemplate404Response) VisitDeleteQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteQuestTemplate500Response struct {
+}
+
+func (response DeleteQuestTemplate500Response) VisitDeleteQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetQuestTemplateRequestObject struct {
+	TemplateId openapi_types.UUID `json:"template_id"`
+}
+
+type GetQuestTemplateResponseObject interface {
+	VisitGetQuestTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetQuestTemplate200JSONResponse QuestTemplate
+
+func (response GetQuestTemplate200JSONResponse) VisitGetQuestTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuestTemplate401Response struct {
+}
+
+func (response GetQuestTemplate401Response) VisitGetQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetQuestTemplate404Response struct {
+}
+
+func (response GetQuestTemplate404Response) VisitGetQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetQuestTemplate500Response struct {
+}
+
+func (response GetQuestTemplate500Response) VisitGetQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type UpdateQuestTemplateRequestObject struct {
+	TemplateId openapi_types.UUID `json:"template_id"`
+	Body       *UpdateQuestTemplateJSONRequestBody
+}
+
+type UpdateQuestTemplateResponseObject interface {
+	VisitUpdateQuestTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateQuestTemplate200JSONResponse QuestTemplate
+
+func (response UpdateQuestTemplate200JSONResponse) VisitUpdateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQuestTemplate400Response struct {
+}
+
+func (response UpdateQuestTemplate400Response) VisitUpdateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateQuestTemplate401Response struct {
+}
+
+func (response UpdateQuestTemplate401Response) VisitUpdateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateQuestTemplate404Response struct {
+}
+
+func (response UpdateQuestTemplate404Response) VisitUpdateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateQuestTemplate500Response struct {
+}
+
+func (response UpdateQuestTemplate500Response) VisitUpdateQuestTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type CreateQuestFromTemplateRequestObject struct {
+	TemplateId openapi_types.UUID `json:"template_id"`
+	Body       *CreateQuestFromTemplateJSONRequestBody
+}
+
+type CreateQuestFromTemplateResponseObject interface {
+	VisitCreateQuestFromTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateQuestFromTemplate201JSONResponse Quest
+
+func (response CreateQuestFromTemplate201JSONResponse) VisitCreateQuestFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuestFromTemplate400Response struct {
+}
+
+func (response CreateQuestFromTemplate400Response) VisitCreateQuestFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateQuestFromTemplate401Response struct {
+}
+
+func (response CreateQuestFromTemplate401Response) VisitCreateQuestFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateQuestFromTemplate404Response struct {
+}
+
+func (response CreateQuestFromTemplate404Response) VisitCreateQuestFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type CreateQuestFromTemplate500Response struct {
+}
+
+func (response CreateQuestFromTemplate500Response) VisitCreateQuestFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListQuestsRequestObject struct {
+	Params ListQuestsParams
+}
+
+type ListQuestsResponseObject interface {
+	VisitListQuestsResponse(w http.ResponseWriter) error
+}
+
+type ListQuests200ApplicationGeoPlusJSONResponse QuestFeatureCollection
+
+func (response ListQuests200ApplicationGeoPlusJSONResponse) VisitListQuestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuests200JSONResponse []Quest
+
+func (response ListQuests200JSONResponse) VisitListQuestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuests400Response struct {
+}
+
+func (response ListQuests400Response) VisitListQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListQuests401Response struct {
+}
+
+func (response ListQuests401Response) VisitListQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListQuests500Response struct {
+}
+
+func (response ListQuests500Response) VisitListQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type CreateQuestRequestObject struct {
+	Body *CreateQuestJSONRequestBody
+}
+
+type CreateQuestResponseObject interface {
+	VisitCreateQuestResponse(w http.ResponseWriter) error
+}
+
+type CreateQuest201JSONResponse Quest
+
+func (response CreateQuest201JSONResponse) VisitCreateQuestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuest400Response struct {
+}
+
+func (response CreateQuest400Response) VisitCreateQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateQuest401Response struct {
+}
+
+func (response CreateQuest401Response) VisitCreateQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateQuest500Response struct {
+}
+
+func (response CreateQuest500Response) VisitCreateQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListAssignedQuestsRequestObject struct {
+}
+
+type ListAssignedQuestsResponseObject interface {
+	VisitListAssignedQuestsResponse(w http.ResponseWriter) error
+}
+
+type ListAssignedQuests200JSONResponse []Quest
+
+func (response ListAssignedQuests200JSONResponse) VisitListAssignedQuestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssignedQuests401Response struct {
+}
+
+func (response ListAssignedQuests401Response) VisitListAssignedQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAssignedQuests500Response struct {
+}
+
+func (response ListAssignedQuests500Response) VisitListAssignedQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RecommendQuestsRequestObject struct {
+	Params RecommendQuestsParams
+}
+
+type RecommendQuestsResponseObject interface {
+	VisitRecommendQuestsResponse(w http.ResponseWriter) error
+}
+
+type RecommendQuests200JSONResponse []QuestRecommendation
+
+func (response RecommendQuests200JSONResponse) VisitRecommendQuestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecommendQuests400Response struct {
+}
+
+func (response RecommendQuests400Response) VisitRecommendQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RecommendQuests401Response struct {
+}
+
+func (response RecommendQuests401Response) VisitRecommendQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RecommendQuests500Response struct {
+}
+
+func (response RecommendQuests500Response) VisitRecommendQuestsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SearchQuestsByAreaRequestObject struct {
+	Params SearchQuestsByAreaParams
+}
+
+type SearchQuestsByAreaResponseObject interface {
+	VisitSearchQuestsByAreaResponse(w http.ResponseWriter) error
+}
+
+type SearchQuestsByArea200JSONResponse []Quest
+
+func (response SearchQuestsByArea200JSONResponse) VisitSearchQuestsByAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchQuestsByArea400Response struct {
+}
+
+func (response SearchQuestsByArea400Response) VisitSearchQuestsByAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SearchQuestsByArea401Response struct {
+}
+
+func (response SearchQuestsByArea401Response) VisitSearchQuestsByAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SearchQuestsByArea500Response struct {
+}
+
+func (response SearchQuestsByArea500Response) VisitSearchQuestsByAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SearchQuestsByRadiusRequestObject struct {
+	Params SearchQuestsByRadiusParams
+}
+
+type SearchQuestsByRadiusResponseObject interface {
+	VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error
+}
+
+type SearchQuestsByRadius200ApplicationGeoPlusJSONResponse QuestFeatureCollection
+
+func (response SearchQuestsByRadius200ApplicationGeoPlusJSONResponse) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchQuestsByRadius200JSONResponse []QuestWithDistance
+
+func (response SearchQuestsByRadius200JSONResponse) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchQuestsByRadius400Response struct {
+}
+
+func (response SearchQuestsByRadius400Response) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SearchQuestsByRadius401Response struct {
+}
+
+func (response SearchQuestsByRadius401Response) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SearchQuestsByRadius500Response struct {
+}
+
+func (response SearchQuestsByRadius500Response) VisitSearchQuestsByRadiusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetQuestTileRequestObject struct {
+	Z      int `json:"z"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Params GetQuestTileParams
+}
+
+type GetQuestTileResponseObject interface {
+	VisitGetQuestTileResponse(w http.ResponseWriter) error
+}
+
+type GetQuestTile200JSONResponse QuestTile
+
+func (response GetQuestTile200JSONResponse) VisitGetQuestTileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuestTile200ApplicationvndMapboxVectorTileResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetQuestTile200ApplicationvndMapboxVectorTileResponse) VisitGetQuestTileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.mapbox-vector-tile")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetQuestTile400Response struct {
+}
+
+func (response GetQuestTile400Response) VisitGetQuestTileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetQuestTile401Response struct {
+}
+
+func (response GetQuestTile401Response) VisitGetQuestTileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetQuestTile500Response struct {
+}
+
+func (response GetQuestTile500Response) VisitGetQuestTileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetQuestByIdRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type GetQuestByIdResponseObject interface {
+	VisitGetQuestByIdResponse(w http.ResponseWriter) error
+}
+
+type GetQuestById200JSONResponse Quest
+
+func (response GetQuestById200JSONResponse) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuestById401Response struct {
+}
+
+func (response GetQuestById401Response) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetQuestById404Response struct {
+}
+
+func (response GetQuestById404Response) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetQuestById500Response struct {
+}
+
+func (response GetQuestById500Response) VisitGetQuestByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListQuestApplicationsRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type ListQuestApplicationsResponseObject interface {
+	VisitListQuestApplicationsResponse(w http.ResponseWriter) error
+}
+
+type ListQuestApplications200JSONResponse []Application
+
+func (response ListQuestApplications200JSONResponse) VisitListQuestApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuestApplications401Response struct {
+}
+
+func (response ListQuestApplications401Response) VisitListQuestApplicationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListQuestApplications403Response struct {
+}
+
+func (response ListQuestApplications403Response) VisitListQuestApplicationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ListQuestApplications404Response struct {
+}
+
+func (response ListQuestApplications404Response) VisitListQuestApplicationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListQuestApplications500Response struct {
+}
+
+func (response ListQuestApplications500Response) VisitListQuestApplicationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ApplyToQuestRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *ApplyToQuestJSONRequestBody
+}
+
+type ApplyToQuestResponseObject interface {
+	VisitApplyToQuestResponse(w http.ResponseWriter) error
+}
+
+type ApplyToQuest201JSONResponse Application
+
+func (response ApplyToQuest201JSONResponse) VisitApplyToQuestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyToQuest400Response struct {
+}
+
+func (response ApplyToQuest400Response) VisitApplyToQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ApplyToQuest401Response struct {
+}
+
+func (response ApplyToQuest401Response) VisitApplyToQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ApplyToQuest404Response struct {
+}
+
+func (response ApplyToQuest404Response) VisitApplyToQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ApplyToQuest500Response struct {
+}
+
+func (response ApplyToQuest500Response) VisitApplyToQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AcceptApplicationRequestObject struct {
+	QuestId       openapi_types.UUID `json:"quest_id"`
+	ApplicationId openapi_types.UUID `json:"application_id"`
+}
+
+type AcceptApplicationResponseObject interface {
+	VisitAcceptApplicationResponse(w http.ResponseWriter) error
+}
+
+type AcceptApplication200JSONResponse ReviewApplicationResult
+
+func (response AcceptApplication200JSONResponse) VisitAcceptApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptApplication400Response struct {
+}
+
+func (response AcceptApplication400Response) VisitAcceptApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AcceptApplication401Response struct {
+}
+
+func (response AcceptApplication401Response) VisitAcceptApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AcceptApplication403Response struct {
+}
+
+func (response AcceptApplication403Response) VisitAcceptApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AcceptApplication404Response struct {
+}
+
+func (response AcceptApplication404Response) VisitAcceptApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AcceptApplication500Response struct {
+}
+
+func (response AcceptApplication500Response) VisitAcceptApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RejectApplicationRequestObject struct {
+	QuestId       openapi_types.UUID `json:"quest_id"`
+	ApplicationId openapi_types.UUID `json:"application_id"`
+}
+
+type RejectApplicationResponseObject interface {
+	VisitRejectApplicationResponse(w http.ResponseWriter) error
+}
+
+type RejectApplication200JSONResponse ReviewApplicationResult
+
+func (response RejectApplication200JSONResponse) VisitRejectApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectApplication400Response struct {
+}
+
+func (response RejectApplication400Response) VisitRejectApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RejectApplication401Response struct {
+}
+
+func (response RejectApplication401Response) VisitRejectApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RejectApplication403Response struct {
+}
+
+func (response RejectApplication403Response) VisitRejectApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type RejectApplication404Response struct {
+}
+
+func (response RejectApplication404Response) VisitRejectApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RejectApplication500Response struct {
+}
+
+func (response RejectApplication500Response) VisitRejectApplicationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AssignQuestRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type AssignQuestResponseObject interface {
+	VisitAssignQuestResponse(w http.ResponseWriter) error
+}
+
+type AssignQuest200JSONResponse AssignQuestResult
+
+func (response AssignQuest200JSONResponse) VisitAssignQuestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignQuest400Response struct {
+}
+
+func (response AssignQuest400Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssignQuest401Response struct {
+}
+
+func (response AssignQuest401Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssignQuest404Response struct {
+}
+
+func (response AssignQuest404Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssignQuest500Response struct {
+}
+
+func (response AssignQuest500Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListQuestCommentsRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Params  ListQuestCommentsParams
+}
+
+type ListQuestCommentsResponseObject interface {
+	VisitListQuestCommentsResponse(w http.ResponseWriter) error
+}
+
+type ListQuestComments200JSONResponse QuestCommentPage
+
+func (response ListQuestComments200JSONResponse) VisitListQuestCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuestComments400Response struct {
+}
+
+func (response ListQuestComments400Response) VisitListQuestCommentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListQuestComments401Response struct {
+}
+
+func (response ListQuestComments401Response) VisitListQuestCommentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListQuestComments404Response struct {
+}
+
+func (response ListQuestComments404Response) VisitListQuestCommentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListQuestComments500Response struct {
+}
+
+func (response ListQuestComments500Response) VisitListQuestCommentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AddQuestCommentRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *AddQuestCommentJSONRequestBody
+}
+
+type AddQuestCommentResponseObject interface {
+	VisitAddQuestCommentResponse(w http.ResponseWriter) error
+}
+
+type AddQuestComment201JSONResponse QuestComment
+
+func (response AddQuestComment201JSONResponse) VisitAddQuestCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddQuestComment400Response struct {
+}
+
+func (response AddQuestComment400Response) VisitAddQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AddQuestComment401Response struct {
+}
+
+func (response AddQuestComment401Response) VisitAddQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddQuestComment403Response struct {
+}
+
+func (response AddQuestComment403Response) VisitAddQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AddQuestComment404Response struct {
+}
+
+func (response AddQuestComment404Response) VisitAddQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AddQuestComment500Response struct {
+}
+
+func (response AddQuestComment500Response) VisitAddQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type EditQuestCommentRequestObject struct {
+	QuestId   openapi_types.UUID `json:"quest_id"`
+	CommentId openapi_types.UUID `json:"comment_id"`
+	Body      *EditQuestCommentJSONRequestBody
+}
+
+type EditQuestCommentResponseObject interface {
+	VisitEditQuestCommentResponse(w http.ResponseWriter) error
+}
+
+type EditQuestComment200JSONResponse QuestComment
+
+func (response EditQuestComment200JSONResponse) VisitEditQuestCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditQuestComment400Response struct {
+}
+
+func (response EditQuestComment400Response) VisitEditQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type EditQuestComment401Response struct {
+}
+
+func (response EditQuestComment401Response) VisitEditQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type EditQuestComment403Response struct {
+}
+
+func (response EditQuestComment403Response) VisitEditQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type EditQuestComment404Response struct {
+}
+
+func (response EditQuestComment404Response) VisitEditQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type EditQuestComment500Response struct {
+}
+
+func (response EditQuestComment500Response) VisitEditQuestCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ApproveCompletionRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type ApproveCompletionResponseObject interface {
+	VisitApproveCompletionResponse(w http.ResponseWriter) error
+}
+
+type ApproveCompletion200JSONResponse ChangeQuestStatusResult
+
+func (response ApproveCompletion200JSONResponse) VisitApproveCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCompletion400Response struct {
+}
+
+func (response ApproveCompletion400Response) VisitApproveCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ApproveCompletion401Response struct {
+}
+
+func (response ApproveCompletion401Response) VisitApproveCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ApproveCompletion403Response struct {
+}
+
+func (response ApproveCompletion403Response) VisitApproveCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ApproveCompletion404Response struct {
+}
+
+func (response ApproveCompletion404Response) VisitApproveCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ApproveCompletion500Response struct {
+}
+
+func (response ApproveCompletion500Response) VisitApproveCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RejectCompletionRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *RejectCompletionJSONRequestBody
+}
+
+type RejectCompletionResponseObject interface {
+	VisitRejectCompletionResponse(w http.ResponseWriter) error
+}
+
+type RejectCompletion200JSONResponse ChangeQuestStatusResult
+
+func (response RejectCompletion200JSONResponse) VisitRejectCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectCompletion400Response struct {
+}
+
+func (response RejectCompletion400Response) VisitRejectCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RejectCompletion401Response struct {
+}
+
+func (response RejectCompletion401Response) VisitRejectCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RejectCompletion403Response struct {
+}
+
+func (response RejectCompletion403Response) VisitRejectCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type RejectCompletion404Response struct {
+}
+
+func (response RejectCompletion404Response) VisitRejectCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RejectCompletion500Response struct {
+}
+
+func (response RejectCompletion500Response) VisitRejectCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetQuestDependenciesRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type GetQuestDependenciesResponseObject interface {
+	VisitGetQuestDependenciesResponse(w http.ResponseWriter) error
+}
+
+type GetQuestDependencies200JSONResponse QuestDependencyGraph
+
+func (response GetQuestDependencies200JSONResponse) VisitGetQuestDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuestDependencies400Response struct {
+}
+
+func (response GetQuestDependencies400Response) VisitGetQuestDependenciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetQuestDependencies401Response struct {
+}
+
+func (response GetQuestDependencies401Response) VisitGetQuestDependenciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetQuestDependencies404Response struct {
+}
+
+func (response GetQuestDependencies404Response) VisitGetQuestDependenciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetQuestDependencies500Response struct {
+}
+
+func (response GetQuestDependencies500Response) VisitGetQuestDependenciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListCompletionEvidenceRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type ListCompletionEvidenceResponseObject interface {
+	VisitListCompletionEvidenceResponse(w http.ResponseWriter) error
+}
+
+type ListCompletionEvidence200JSONResponse []CompletionEvidence
+
+func (response ListCompletionEvidence200JSONResponse) VisitListCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCompletionEvidence401Response struct {
+}
+
+func (response ListCompletionEvidence401Response) VisitListCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListCompletionEvidence403Response struct {
+}
+
+func (response ListCompletionEvidence403Response) VisitListCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ListCompletionEvidence404Response struct {
+}
+
+func (response ListCompletionEvidence404Response) VisitListCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListCompletionEvidence500Response struct {
+}
+
+func (response ListCompletionEvidence500Response) VisitListCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SubmitCompletionEvidenceRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *multipart.Reader
+}
+
+type SubmitCompletionEvidenceResponseObject interface {
+	VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error
+}
+
+type SubmitCompletionEvidence201JSONResponse SubmitEvidenceResult
+
+func (response SubmitCompletionEvidence201JSONResponse) VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitCompletionEvidence400Response struct {
+}
+
+func (response SubmitCompletionEvidence400Response) VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SubmitCompletionEvidence401Response struct {
+}
+
+func (response SubmitCompletionEvidence401Response) VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SubmitCompletionEvidence403Response struct {
+}
+
+func (response SubmitCompletionEvidence403Response) VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type SubmitCompletionEvidence404Response struct {
+}
+
+func (response SubmitCompletionEvidence404Response) VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SubmitCompletionEvidence500Response struct {
+}
+
+func (response SubmitCompletionEvidence500Response) VisitSubmitCompletionEvidenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetEvidenceAttachmentRequestObject struct {
+	QuestId      openapi_types.UUID `json:"quest_id"`
+	EvidenceId   openapi_types.UUID `json:"evidence_id"`
+	AttachmentId openapi_types.UUID `json:"attachment_id"`
+}
+
+type GetEvidenceAttachmentResponseObject interface {
+	VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error
+}
+
+type GetEvidenceAttachment200ImagejpegResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEvidenceAttachment200ImagejpegResponse) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/jpeg")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEvidenceAttachment200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEvidenceAttachment200ImagepngResponse) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEvidenceAttachment200ImagewebpResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEvidenceAttachment200ImagewebpResponse) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/webp")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEvidenceAttachment401Response struct {
+}
+
+func (response GetEvidenceAttachment401Response) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetEvidenceAttachment403Response struct {
+}
+
+func (response GetEvidenceAttachment403Response) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetEvidenceAttachment404Response struct {
+}
+
+func (response GetEvidenceAttachment404Response) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetEvidenceAttachment500Response struct {
+}
+
+func (response GetEvidenceAttachment500Response) VisitGetEvidenceAttachmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AddQuestPrerequisiteRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *AddQuestPrerequisiteJSONRequestBody
+}
+
+type AddQuestPrerequisiteResponseObject interface {
+	VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error
+}
+
+type AddQuestPrerequisite200JSONResponse Quest
+
+func (response AddQuestPrerequisite200JSONResponse) VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddQuestPrerequisite400Response struct {
+}
+
+func (response AddQuestPrerequisite400Response) VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AddQuestPrerequisite401Response struct {
+}
+
+func (response AddQuestPrerequisite401Response) VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddQuestPrerequisite403Response struct {
+}
+
+func (response AddQuestPrerequisite403Response) VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AddQuestPrerequisite404Response struct {
+}
+
+func (response AddQuestPrerequisite404Response) VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AddQuestPrerequisite500Response struct {
+}
+
+func (response AddQuestPrerequisite500Response) VisitAddQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RemoveQuestPrerequisiteRequestObject struct {
+	QuestId        openapi_types.UUID `json:"quest_id"`
+	PrerequisiteId openapi_types.UUID `json:"prerequisite_id"`
+}
+
+type RemoveQuestPrerequisiteResponseObject interface {
+	VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error
+}
+
+type RemoveQuestPrerequisite204Response struct {
+}
+
+func (response RemoveQuestPrerequisite204Response) VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveQuestPrerequisite400Response struct {
+}
+
+func (response RemoveQuestPrerequisite400Response) VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RemoveQuestPrerequisite401Response struct {
+}
+
+func (response RemoveQuestPrerequisite401Response) VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RemoveQuestPrerequisite403Response struct {
+}
+
+func (response RemoveQuestPrerequisite403Response) VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type RemoveQuestPrerequisite404Response struct {
+}
+
+func (response RemoveQuestPrerequisite404Response) VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RemoveQuestPrerequisite500Response struct {
+}
+
+func (response RemoveQuestPrerequisite500Response) VisitRemoveQuestPrerequisiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListQuestReviewsRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+}
+
+type ListQuestReviewsResponseObject interface {
+	VisitListQuestReviewsResponse(w http.ResponseWriter) error
+}
+
+type ListQuestReviews200JSONResponse []QuestReview
+
+func (response ListQuestReviews200JSONResponse) VisitListQuestReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuestReviews401Response struct {
+}
+
+func (response ListQuestReviews401Response) VisitListQuestReviewsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListQuestReviews404Response struct {
+}
+
+func (response ListQuestReviews404Response) VisitListQuestReviewsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListQuestReviews500Response struct {
+}
+
+func (response ListQuestReviews500Response) VisitListQuestReviewsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SubmitQuestReviewRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *SubmitQuestReviewJSONRequestBody
+}
+
+type SubmitQuestReviewResponseObject interface {
+	VisitSubmitQuestReviewResponse(w http.ResponseWriter) error
+}
+
+type SubmitQuestReview201JSONResponse QuestReview
+
+func (response SubmitQuestReview201JSONResponse) VisitSubmitQuestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitQuestReview400Response struct {
+}
+
+func (response SubmitQuestReview400Response) VisitSubmitQuestReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SubmitQuestReview401Response struct {
+}
+
+func (response SubmitQuestReview401Response) VisitSubmitQuestReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SubmitQuestReview403Response struct {
+}
+
+func (response SubmitQuestReview403Response) VisitSubmitQuestReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type SubmitQuestReview404Response struct {
+}
+
+func (response SubmitQuestReview404Response) VisitSubmitQuestReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SubmitQuestReview500Response struct {
+}
+
+func (response SubmitQuestReview500Response) VisitSubmitQuestReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ChangeQuestStatusRequestObject struct {
+	QuestId openapi_types.UUID `json:"quest_id"`
+	Body    *ChangeQuestStatusJSONRequestBody
+}
+
+type ChangeQuestStatusResponseObject interface {
+	VisitChangeQuestStatusResponse(w http.ResponseWriter) error
+}
+
+type ChangeQuestStatus200JSONResponse ChangeQuestStatusResult
+
+func (response ChangeQuestStatus200JSONResponse) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeQuestStatus400Response struct {
+}
+
+func (response ChangeQuestStatus400Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ChangeQuestStatus401Response struct {
+}
+
+func (response ChangeQuestStatus401Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangeQuestStatus403Response struct {
+}
+
+func (response ChangeQuestStatus403Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ChangeQuestStatus404Response struct {
+}
+
+func (response ChangeQuestStatus404Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ChangeQuestStatus500Response struct {
+}
+
+func (response ChangeQuestStatus500Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get a leaderboard
+	// (GET /leaderboards)
+	GetLeaderboard(ctx context.Context, request GetLeaderboardRequestObject) (GetLeaderboardResponseObject, error)
+	// Autocomplete locations by name or address
+	// (GET /locations/autocomplete)
+	AutocompleteLocations(ctx context.Context, request AutocompleteLocationsRequestObject) (AutocompleteLocationsResponseObject, error)
+	// List the achievements of the authenticated user
+	// (GET /me/achievements)
+	ListMyAchievements(ctx context.Context, request ListMyAchievementsRequestObject) (ListMyAchievementsResponseObject, error)
+	// Get the points balance of the authenticated user
+	// (GET /me/balance)
+	GetMyBalance(ctx context.Context, request GetMyBalanceRequestObject) (GetMyBalanceResponseObject, error)
+	// List the points ledger of the authenticated user
+	// (GET /me/ledger)
+	ListMyLedger(ctx context.Context, request ListMyLedgerRequestObject) (ListMyLedgerResponseObject, error)
+	// Get the notification preferences of the authenticated user
+	// (GET /me/notification-preferences)
+	GetMyNotificationPreferences(ctx context.Context, request GetMyNotificationPreferencesRequestObject) (GetMyNotificationPreferencesResponseObject, error)
+	// Update the notification preferences of the authenticated user
+	// (PUT /me/notification-preferences)
+	UpdateMyNotificationPreferences(ctx context.Context, request UpdateMyNotificationPreferencesRequestObject) (UpdateMyNotificationPreferencesResponseObject, error)
+	// List the notifications of the authenticated user
+	// (GET /me/notifications)
+	ListMyNotifications(ctx context.Context, request ListMyNotificationsRequestObject) (ListMyNotificationsResponseObject, error)
+	// Mark every notification read
+	// (POST /me/notifications/read-all)
+	MarkAllNotificationsRead(ctx context.Context, request MarkAllNotificationsReadRequestObject) (MarkAllNotificationsReadResponseObject, error)
+	// Mark a notification read
+	// (POST /me/notifications/{notification_id}/read)
+	MarkNotificationRead(ctx context.Context, request MarkNotificationReadRequestObject) (MarkNotificationReadResponseObject, error)
+	// Get the profile of the authenticated user
+	// (GET /me/profile)
+	GetMyProfile(ctx context.Context, request GetMyProfileRequestObject) (GetMyProfileResponseObject, error)
+	// Create or replace the profile of the authenticated user
+	// (PUT /me/profile)
+	UpdateMyProfile(ctx context.Context, request UpdateMyProfileRequestObject) (UpdateMyProfileResponseObject, error)
+	// List the quest templates of the authenticated user
+	// (GET /quest-templates)
+	ListQuestTemplates(ctx context.Context, request ListQuestTemplatesRequestObject) (ListQuestTemplatesResponseObject, error)
+	// Create a quest template
+	// (POST /quest-templates)
+	CreateQuestTemplate(ctx context.Context, request CreateQuestTemplateRequestObject) (CreateQuestTemplateResponseObject, error)
+	// Delete a quest template
+	// (DELETE /quest-templates/{template_id})
+	DeleteQuestTemplate(ctx context.Context, request DeleteQuestTemplateRequestObject) (DeleteQuestTemplateResponseObject, error)
+	// Get a quest template
+	// (GET /quest-templates/{template_id})
+	GetQuestTemplate(ctx context.Context, request GetQuestTemplateRequestObject) (GetQuestTemplateResponseObject, error)
+	// Replace a quest template
+	// (PUT /quest-templates/{template_id})
+	UpdateQuestTemplate(ctx context.Context, request UpdateQuestTemplateRequestObject) (UpdateQuestTemplateResponseObject, error)
+	// Create a quest from a template
+	// (POST /quest-templates/{template_id}/quests)
+	CreateQuestFromTemplate(ctx context.Context, request CreateQuestFromTemplateRequestObject) (CreateQuestFromTemplateResponseObject, error)
+	// Get a list of all quests
+	// (GET /quests)
+	ListQuests(ctx context.Context, request ListQuestsRequestObject) (ListQuestsResponseObject, error)
+	// Create a new quest
+	// (POST /quests)
+	CreateQuest(ctx context.Context, request CreateQuestRequestObject) (CreateQuestResponseObject, error)
+	// Get quests assigned to the authenticated user
+	// (GET /quests/assigned)
+	ListAssignedQuests(ctx context.Context, request ListAssignedQuestsRequestObject) (ListAssignedQuestsResponseObject, error)
+	// Recommend posted quests for the authenticated user
+	// (GET /quests/recommended)
+	RecommendQuests(ctx context.Context, request RecommendQuestsRequestObject) (RecommendQuestsResponseObject, error)
+	// Search quests inside a polygon or bounding box
+	// (GET /quests/search-area)
+	SearchQuestsByArea(ctx context.Context, request SearchQuestsByAreaRequestObject) (SearchQuestsByAreaResponseObject, error)
+	// Search quests within a radius
+	// (GET /quests/search-radius)
+	SearchQuestsByRadius(ctx context.Context, request SearchQuestsByRadiusRequestObject) (SearchQuestsByRadiusResponseObject, error)
+	// Get clustered quest counts for a map tile
+	// (GET /quests/tiles/{z}/{x}/{y})
+	GetQuestTile(ctx context.Context, request GetQuestTileRequestObject) (GetQuestTileResponseObject, error)
+	// Get quest details by ID
+	// (GET /quests/{quest_id})
+	GetQuestById(ctx context.Context, request GetQuestByIdRequestObject) (GetQuestByIdResponseObject, error)
+	// List applications to a quest
+	// (GET /quests/{quest_id}/applications)
+	ListQuestApplications(ctx context.Context, request ListQuestApplicationsRequestObject) (ListQuestApplicationsResponseObject, error)
+	// Apply to a quest
+	// (POST /quests/{quest_id}/applications)
+	ApplyToQuest(ctx context.Context, request ApplyToQuestRequestObject) (ApplyToQuestResponseObject, error)
+	// Accept an application
+	// (POST /quests/{quest_id}/applications/{application_id}/accept)
+	AcceptApplication(ctx context.Context, request AcceptApplicationRequestObject) (AcceptApplicationResponseObject, error)
+	// Reject an application
+	// (POST /quests/{quest_id}/applications/{application_id}/reject)
+	RejectApplication(ctx context.Context, request RejectApplicationRequestObject) (RejectApplicationResponseObject, error)
+	// Assign quest to the authenticated user
+	// (POST /quests/{quest_id}/assign)
+	AssignQuest(ctx context.Context, request AssignQuestRequestObject) (AssignQuestResponseObject, error)
+	// List the comment thread of a quest
+	// (GET /quests/{quest_id}/comments)
+	ListQuestComments(ctx context.Context, request ListQuestCommentsRequestObject) (ListQuestCommentsResponseObject, error)
+	// Add a comment to the thread of a quest
+	// (POST /quests/{quest_id}/comments)
+	AddQuestComment(ctx context.Context, request AddQuestCommentRequestObject) (AddQuestCommentResponseObject, error)
+	// Edit a comment
+	// (PATCH /quests/{quest_id}/comments/{comment_id})
+	EditQuestComment(ctx context.Context, request EditQuestCommentRequestObject) (EditQuestCommentResponseObject, error)
+	// Approve quest completion
+	// (POST /quests/{quest_id}/completion/approve)
+	ApproveCompletion(ctx context.Context, request ApproveCompletionRequestObject) (ApproveCompletionResponseObject, error)
+	// Reject quest completion
+	// (POST /quests/{quest_id}/completion/reject)
+	RejectCompletion(ctx context.Context, request RejectCompletionRequestObject) (RejectCompletionResponseObject, error)
+	// Get the dependency graph of a quest
+	// (GET /quests/{quest_id}/dependencies)
+	GetQuestDependencies(ctx context.Context, request GetQuestDependenciesRequestObject) (GetQuestDependenciesResponseObject, error)
+	// List completion evidence
+	// (GET /quests/{quest_id}/evidence)
+	ListCompletionEvidence(ctx context.Context, request ListCompletionEvidenceRequestObject) (ListCompletionEvidenceResponseObject, error)
+	// Submit completion evidence
+	// (POST /quests/{quest_id}/evidence)
+	SubmitCompletionEvidence(ctx context.Context, request SubmitCompletionEvidenceRequestObject) (SubmitCompletionEvidenceResponseObject, error)
+	// Download an evidence photo
+	// (GET /quests/{quest_id}/evidence/{evidence_id}/attachments/{attachment_id})
+	GetEvidenceAttachment(ctx context.Context, request GetEvidenceAttachmentRequestObject) (GetEvidenceAttachmentResponseObject, error)
+	// Add a prerequisite to a quest
+	// (POST /quests/{quest_id}/prerequisites)
+	AddQuestPrerequisite(ctx context.Context, request AddQuestPrerequisiteRequestObject) (AddQuestPrerequisiteResponseObject, error)
+	// Remove a prerequisite from a quest
+	// (DELETE /quests/{quest_id}/prerequisites/{prerequisite_id})
+	RemoveQuestPrerequisite(ctx context.Context, request RemoveQuestPrerequisiteRequestObject) (RemoveQuestPrerequisiteResponseObject, error)
+	// List reviews of a quest
+	// (GET /quests/{quest_id}/reviews)
+	ListQuestReviews(ctx context.Context, request ListQuestReviewsRequestObject) (ListQuestReviewsResponseObject, error)
+	// Review the creator or a participant of a completed quest
+	// (POST /quests/{quest_id}/reviews)
+	SubmitQuestReview(ctx context.Context, request SubmitQuestReviewRequestObject) (SubmitQuestReviewResponseObject, error)
+	// Change quest status
+	// (PATCH /quests/{quest_id}/status)
+	ChangeQuestStatus(ctx context.Context, request ChangeQuestStatusRequestObject) (ChangeQuestStatusResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetLeaderboard operation middleware
+func (sh *strictHandler) GetLeaderboard(w http.ResponseWriter, r *http.Request, params GetLeaderboardParams) {
+	var request GetLeaderboardRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLeaderboard(ctx, request.(GetLeaderboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLeaderboard")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLeaderboardResponseObject); ok {
+		if err := validResponse.VisitGetLeaderboardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AutocompleteLocations operation middleware
+func (sh *strictHandler) AutocompleteLocations(w http.ResponseWriter, r *http.Request, params AutocompleteLocationsParams) {
+	var request AutocompleteLocationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AutocompleteLocations(ctx, request.(AutocompleteLocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AutocompleteLocations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AutocompleteLocationsResponseObject); ok {
+		if err := validResponse.VisitAutocompleteLocationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMyAchievements operation middleware
+func (sh *strictHandler) ListMyAchievements(w http.ResponseWriter, r *http.Request) {
+	var request ListMyAchievementsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMyAchievements(ctx, request.(ListMyAchievementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMyAchievements")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMyAchievementsResponseObject); ok {
+		if err := validResponse.VisitListMyAchievementsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyBalance operation middleware
+func (sh *strictHandler) GetMyBalance(w http.ResponseWriter, r *http.Request) {
+	var request GetMyBalanceRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyBalance(ctx, request.(GetMyBalanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyBalance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMyBalanceResponseObject); ok {
+		if err := validResponse.VisitGetMyBalanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMyLedger operation middleware
+func (sh *strictHandler) ListMyLedger(w http.ResponseWriter, r *http.Request, params ListMyLedgerParams) {
+	var request ListMyLedgerRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMyLedger(ctx, request.(ListMyLedgerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMyLedger")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMyLedgerResponseObject); ok {
+		if err := validResponse.VisitListMyLedgerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyNotificationPreferences operation middleware
+func (sh *strictHandler) GetMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var request GetMyNotificationPreferencesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyNotificationPreferences(ctx, request.(GetMyNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyNotificationPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMyNotificationPreferencesResponseObject); ok {
+		if err := validResponse.VisitGetMyNotificationPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateMyNotificationPreferences operation middleware
+func (sh *strictHandler) UpdateMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var request UpdateMyNotificationPreferencesRequestObject
+
+	var body UpdateMyNotificationPreferencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMyNotificationPreferences(ctx, request.(UpdateMyNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMyNotificationPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateMyNotificationPreferencesResponseObject); ok {
+		if err := validResponse.VisitUpdateMyNotificationPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMyNotifications operation middleware
+func (sh *strictHandler) ListMyNotifications(w http.ResponseWriter, r *http.Request, params ListMyNotificationsParams) {
+	var request ListMyNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMyNotifications(ctx, request.(ListMyNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMyNotifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMyNotificationsResponseObject); ok {
+		if err := validResponse.VisitListMyNotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MarkAllNotificationsRead operation middleware
+func (sh *strictHandler) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	var request MarkAllNotificationsReadRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkAllNotificationsRead(ctx, request.(MarkAllNotificationsReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkAllNotificationsRead")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MarkAllNotificationsReadResponseObject); ok {
+		if err := validResponse.VisitMarkAllNotificationsReadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MarkNotificationRead operation middleware
+func (sh *strictHandler) MarkNotificationRead(w http.ResponseWriter, r *http.Request, notificationId openapi_types.UUID) {
+	var request MarkNotificationReadRequestObject
+
+	request.NotificationId = notificationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkNotificationRead(ctx, request.(MarkNotificationReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkNotificationRead")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MarkNotificationReadResponseObject); ok {
+		if err := validResponse.VisitMarkNotificationReadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyProfile operation middleware
+func (sh *strictHandler) GetMyProfile(w http.ResponseWriter, r *http.Request) {
+	var request GetMyProfileRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyProfile(ctx, request.(GetMyProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMyProfileResponseObject); ok {
+		if err := validResponse.VisitGetMyProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateMyProfile operation middleware
+func (sh *strictHandler) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
+	var request UpdateMyProfileRequestObject
+
+	var body UpdateMyProfileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMyProfile(ctx, request.(UpdateMyProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMyProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateMyProfileResponseObject); ok {
+		if err := validResponse.VisitUpdateMyProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListQuestTemplates operation middleware
+func (sh *strictHandler) ListQuestTemplates(w http.ResponseWriter, r *http.Request) {
+	var request ListQuestTemplatesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQuestTemplates(ctx, request.(ListQuestTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQuestTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQuestTemplatesResponseObject); ok {
+		if err := validResponse.VisitListQuestTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateQuestTemplate operation middleware
+func (sh *strictHandler) CreateQuestTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateQuestTemplateRequestObject
+
+	var body CreateQuestTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateQuestTemplate(ctx, request.(CreateQuestTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateQuestTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateQuestTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateQuestTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteQuestTemplate operation middleware
+func (sh *strictHandler) DeleteQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	var request DeleteQuestTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteQuestTemplate(ctx, request.(DeleteQuestTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteQuestTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteQuestTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteQuestTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetQuestTemplate operation middleware
+func (sh *strictHandler) GetQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	var request GetQuestTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQuestTemplate(ctx, request.(GetQuestTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQuestTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQuestTemplateResponseObject); ok {
+		if err := validResponse.VisitGetQuestTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateQuestTemplate operation middleware
+func (sh *strictHandler) UpdateQuestTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	var request UpdateQuestTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	var body UpdateQuestTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateQuestTemplate(ctx, request.(UpdateQuestTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateQuestTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateQuestTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateQuestTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateQuestFromTemplate operation middleware
+func (sh *strictHandler) CreateQuestFromTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	var request CreateQuestFromTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	var body CreateQuestFromTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateQuestFromTemplate(ctx, request.(CreateQuestFromTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateQuestFromTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateQuestFromTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateQuestFromTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListQuests operation middleware
+func (sh *strictHandler) ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams) {
+	var request ListQuestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQuests(ctx, request.(ListQuestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQuests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQuestsResponseObject); ok {
+		if err := validResponse.VisitListQuestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateQuest operation middleware
+func (sh *strictHandler) CreateQuest(w http.ResponseWriter, r *http.Request) {
+	var request CreateQuestRequestObject
+
+	var body CreateQuestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateQuest(ctx, request.(CreateQuestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateQuest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateQuestResponseObject); ok {
+		if err := validResponse.VisitCreateQuestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAssignedQuests operation middleware
+func (sh *strictHandler) ListAssignedQuests(w http.ResponseWriter, r *http.Request) {
+	var request ListAssignedQuestsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssignedQuests(ctx, request.(ListAssignedQuestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssignedQuests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssignedQuestsResponseObject); ok {
+		if err := validResponse.VisitListAssignedQuestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RecommendQuests operation middleware
+func (sh *strictHandler) RecommendQuests(w http.ResponseWriter, r *http.Request, params RecommendQuestsParams) {
+	var request RecommendQuestsRequestObject
 
-func (response AssignQuest200JSONResponse) VisitAssignQuestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RecommendQuests(ctx, request.(RecommendQuestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecommendQuests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RecommendQuestsResponseObject); ok {
+		if err := validResponse.VisitRecommendQuestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchQuestsByArea operation middleware
+func (sh *strictHandler) SearchQuestsByArea(w http.ResponseWriter, r *http.Request, params SearchQuestsByAreaParams) {
+	var request SearchQuestsByAreaRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchQuestsByArea(ctx, request.(SearchQuestsByAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchQuestsByArea")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchQuestsByAreaResponseObject); ok {
+		if err := validResponse.VisitSearchQuestsByAreaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchQuestsByRadius operation middleware
+func (sh *strictHandler) SearchQuestsByRadius(w http.ResponseWriter, r *http.Request, params SearchQuestsByRadiusParams) {
+	var request SearchQuestsByRadiusRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchQuestsByRadius(ctx, request.(SearchQuestsByRadiusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchQuestsByRadius")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchQuestsByRadiusResponseObject); ok {
+		if err := validResponse.VisitSearchQuestsByRadiusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetQuestTile operation middleware
+func (sh *strictHandler) GetQuestTile(w http.ResponseWriter, r *http.Request, z int, x int, y int, params GetQuestTileParams) {
+	var request GetQuestTileRequestObject
+
+	request.Z = z
+	request.X = x
+	request.Y = y
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQuestTile(ctx, request.(GetQuestTileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQuestTile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQuestTileResponseObject); ok {
+		if err := validResponse.VisitGetQuestTileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetQuestById operation middleware
+func (sh *strictHandler) GetQuestById(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request GetQuestByIdRequestObject
+
+	request.QuestId = questId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQuestById(ctx, request.(GetQuestByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQuestById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQuestByIdResponseObject); ok {
+		if err := validResponse.VisitGetQuestByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListQuestApplications operation middleware
+func (sh *strictHandler) ListQuestApplications(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request ListQuestApplicationsRequestObject
+
+	request.QuestId = questId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQuestApplications(ctx, request.(ListQuestApplicationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQuestApplications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQuestApplicationsResponseObject); ok {
+		if err := validResponse.VisitListQuestApplicationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyToQuest operation middleware
+func (sh *strictHandler) ApplyToQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request ApplyToQuestRequestObject
+
+	request.QuestId = questId
+
+	var body ApplyToQuestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyToQuest(ctx, request.(ApplyToQuestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyToQuest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyToQuestResponseObject); ok {
+		if err := validResponse.VisitApplyToQuestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AcceptApplication operation middleware
+func (sh *strictHandler) AcceptApplication(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, applicationId openapi_types.UUID) {
+	var request AcceptApplicationRequestObject
+
+	request.QuestId = questId
+	request.ApplicationId = applicationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptApplication(ctx, request.(AcceptApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptApplicationResponseObject); ok {
+		if err := validResponse.VisitAcceptApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RejectApplication operation middleware
+func (sh *strictHandler) RejectApplication(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, applicationId openapi_types.UUID) {
+	var request RejectApplicationRequestObject
+
+	request.QuestId = questId
+	request.ApplicationId = applicationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectApplication(ctx, request.(RejectApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectApplicationResponseObject); ok {
+		if err := validResponse.VisitRejectApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AssignQuest operation middleware
+func (sh *strictHandler) AssignQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request AssignQuestRequestObject
+
+	request.QuestId = questId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AssignQuest(ctx, request.(AssignQuestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssignQuest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AssignQuestResponseObject); ok {
+		if err := validResponse.VisitAssignQuestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListQuestComments operation middleware
+func (sh *strictHandler) ListQuestComments(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, params ListQuestCommentsParams) {
+	var request ListQuestCommentsRequestObject
+
+	request.QuestId = questId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQuestComments(ctx, request.(ListQuestCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQuestComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQuestCommentsResponseObject); ok {
+		if err := validResponse.VisitListQuestCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddQuestComment operation middleware
+func (sh *strictHandler) AddQuestComment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request AddQuestCommentRequestObject
+
+	request.QuestId = questId
+
+	var body AddQuestCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddQuestComment(ctx, request.(AddQuestCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddQuestComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddQuestCommentResponseObject); ok {
+		if err := validResponse.VisitAddQuestCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AssignQuest400Response struct {
-}
+// EditQuestComment operation middleware
+func (sh *strictHandler) EditQuestComment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, commentId openapi_types.UUID) {
+	var request EditQuestCommentRequestObject
 
-func (response AssignQuest400Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	request.QuestId = questId
+	request.CommentId = commentId
 
-type AssignQuest401Response struct {
-}
+	var body EditQuestCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response AssignQuest401Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EditQuestComment(ctx, request.(EditQuestCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditQuestComment")
+	}
 
-type AssignQuest404Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response AssignQuest404Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EditQuestCommentResponseObject); ok {
+		if err := validResponse.VisitEditQuestCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AssignQuest500Response struct {
-}
+// ApproveCompletion operation middleware
+func (sh *strictHandler) ApproveCompletion(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request ApproveCompletionRequestObject
 
-func (response AssignQuest500Response) VisitAssignQuestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	request.QuestId = questId
 
-type ChangeQuestStatusRequestObject struct {
-	QuestId openapi_types.UUID `json:"quest_id"`
-	Body    *ChangeQuestStatusJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveCompletion(ctx, request.(ApproveCompletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveCompletion")
+	}
 
-type ChangeQuestStatusResponseObject interface {
-	VisitChangeQuestStatusResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveCompletionResponseObject); ok {
+		if err := validResponse.VisitApproveCompletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ChangeQuestStatus200JSONResponse ChangeQuestStatusResult
+// RejectCompletion operation middleware
+func (sh *strictHandler) RejectCompletion(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request RejectCompletionRequestObject
 
-func (response ChangeQuestStatus200JSONResponse) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.QuestId = questId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body RejectCompletionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ChangeQuestStatus400Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectCompletion(ctx, request.(RejectCompletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectCompletion")
+	}
 
-func (response ChangeQuestStatus400Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ChangeQuestStatus401Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectCompletionResponseObject); ok {
+		if err := validResponse.VisitRejectCompletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ChangeQuestStatus401Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// GetQuestDependencies operation middleware
+func (sh *strictHandler) GetQuestDependencies(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request GetQuestDependenciesRequestObject
 
-type ChangeQuestStatus404Response struct {
-}
+	request.QuestId = questId
 
-func (response ChangeQuestStatus404Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQuestDependencies(ctx, request.(GetQuestDependenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQuestDependencies")
+	}
 
-type ChangeQuestStatus500Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response ChangeQuestStatus500Response) VisitChangeQuestStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQuestDependenciesResponseObject); ok {
+		if err := validResponse.VisitGetQuestDependenciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get a list of all quests
-	// (GET /quests)
-	ListQuests(ctx context.Context, request ListQuestsRequestObject) (ListQuestsResponseObject, error)
-	// Create a new quest
-	// (POST /quests)
-	CreateQuest(ctx context.Context, request CreateQuestRequestObject) (CreateQuestResponseObject, error)
-	// Get quests assigned to the authenticated user
-	// (GET /quests/assigned)
-	ListAssignedQuests(ctx context.Context, request ListAssignedQuestsRequestObject) (ListAssignedQuestsResponseObject, error)
-	// Search quests within a radius
-	// (GET /quests/search-radius)
-	SearchQuestsByRadius(ctx context.Context, request SearchQuestsByRadiusRequestObject) (SearchQuestsByRadiusResponseObject, error)
-	// Get quest details by ID
-	// (GET /quests/{quest_id})
-	GetQuestById(ctx context.Context, request GetQuestByIdRequestObject) (GetQuestByIdResponseObject, error)
-	// Assign quest to the authenticated user
-	// (POST /quests/{quest_id}/assign)
-	AssignQuest(ctx context.Context, request AssignQuestRequestObject) (AssignQuestResponseObject, error)
-	// Change quest status
-	// (PATCH /quests/{quest_id}/status)
-	ChangeQuestStatus(ctx context.Context, request ChangeQuestStatusRequestObject) (ChangeQuestStatusResponseObject, error)
-}
+// ListCompletionEvidence operation middleware
+func (sh *strictHandler) ListCompletionEvidence(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request ListCompletionEvidenceRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.QuestId = questId
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCompletionEvidence(ctx, request.(ListCompletionEvidenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCompletionEvidence")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCompletionEvidenceResponseObject); ok {
+		if err := validResponse.VisitListCompletionEvidenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// SubmitCompletionEvidence operation middleware
+func (sh *strictHandler) SubmitCompletionEvidence(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request SubmitCompletionEvidenceRequestObject
 
-// ListQuests operation middleware
-func (sh *strictHandler) ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams) {
-	var request ListQuestsRequestObject
+	request.QuestId = questId
 
-	request.Params = params
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListQuests(ctx, request.(ListQuestsRequestObject))
+		return sh.ssi.SubmitCompletionEvidence(ctx, request.(SubmitCompletionEvidenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListQuests")
+		handler = middleware(handler, "SubmitCompletionEvidence")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListQuestsResponseObject); ok {
-		if err := validResponse.VisitListQuestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(SubmitCompletionEvidenceResponseObject); ok {
+		if err := validResponse.VisitSubmitCompletionEvidenceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1015,30 +6966,27 @@ func (sh *strictHandler) ListQuests(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// CreateQuest operation middleware
-func (sh *strictHandler) CreateQuest(w http.ResponseWriter, r *http.Request) {
-	var request CreateQuestRequestObject
+// GetEvidenceAttachment operation middleware
+func (sh *strictHandler) GetEvidenceAttachment(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, evidenceId openapi_types.UUID, attachmentId openapi_types.UUID) {
+	var request GetEvidenceAttachmentRequestObject
 
-	var body CreateQuestJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.QuestId = questId
+	request.EvidenceId = evidenceId
+	request.AttachmentId = attachmentId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateQuest(ctx, request.(CreateQuestRequestObject))
+		return sh.ssi.GetEvidenceAttachment(ctx, request.(GetEvidenceAttachmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateQuest")
+		handler = middleware(handler, "GetEvidenceAttachment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateQuestResponseObject); ok {
-		if err := validResponse.VisitCreateQuestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEvidenceAttachmentResponseObject); ok {
+		if err := validResponse.VisitGetEvidenceAttachmentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1046,23 +6994,32 @@ func (sh *strictHandler) CreateQuest(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListAssignedQuests operation middleware
-func (sh *strictHandler) ListAssignedQuests(w http.ResponseWriter, r *http.Request) {
-	var request ListAssignedQuestsRequestObject
+// AddQuestPrerequisite operation middleware
+func (sh *strictHandler) AddQuestPrerequisite(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request AddQuestPrerequisiteRequestObject
+
+	request.QuestId = questId
+
+	var body AddQuestPrerequisiteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAssignedQuests(ctx, request.(ListAssignedQuestsRequestObject))
+		return sh.ssi.AddQuestPrerequisite(ctx, request.(AddQuestPrerequisiteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAssignedQuests")
+		handler = middleware(handler, "AddQuestPrerequisite")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAssignedQuestsResponseObject); ok {
-		if err := validResponse.VisitListAssignedQuestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddQuestPrerequisiteResponseObject); ok {
+		if err := validResponse.VisitAddQuestPrerequisiteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1070,25 +7027,26 @@ func (sh *strictHandler) ListAssignedQuests(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// SearchQuestsByRadius operation middleware
-func (sh *strictHandler) SearchQuestsByRadius(w http.ResponseWriter, r *http.Request, params SearchQuestsByRadiusParams) {
-	var request SearchQuestsByRadiusRequestObject
+// RemoveQuestPrerequisite operation middleware
+func (sh *strictHandler) RemoveQuestPrerequisite(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID, prerequisiteId openapi_types.UUID) {
+	var request RemoveQuestPrerequisiteRequestObject
 
-	request.Params = params
+	request.QuestId = questId
+	request.PrerequisiteId = prerequisiteId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SearchQuestsByRadius(ctx, request.(SearchQuestsByRadiusRequestObject))
+		return sh.ssi.RemoveQuestPrerequisite(ctx, request.(RemoveQuestPrerequisiteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SearchQuestsByRadius")
+		handler = middleware(handler, "RemoveQuestPrerequisite")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SearchQuestsByRadiusResponseObject); ok {
-		if err := validResponse.VisitSearchQuestsByRadiusResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveQuestPrerequisiteResponseObject); ok {
+		if err := validResponse.VisitRemoveQuestPrerequisiteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1096,25 +7054,25 @@ func (sh *strictHandler) SearchQuestsByRadius(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetQuestById operation middleware
-func (sh *strictHandler) GetQuestById(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
-	var request GetQuestByIdRequestObject
+// ListQuestReviews operation middleware
+func (sh *strictHandler) ListQuestReviews(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request ListQuestReviewsRequestObject
 
 	request.QuestId = questId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetQuestById(ctx, request.(GetQuestByIdRequestObject))
+		return sh.ssi.ListQuestReviews(ctx, request.(ListQuestReviewsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetQuestById")
+		handler = middleware(handler, "ListQuestReviews")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetQuestByIdResponseObject); ok {
-		if err := validResponse.VisitGetQuestByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListQuestReviewsResponseObject); ok {
+		if err := validResponse.VisitListQuestReviewsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1122,25 +7080,32 @@ func (sh *strictHandler) GetQuestById(w http.ResponseWriter, r *http.Request, qu
 	}
 }
 
-// AssignQuest operation middleware
-func (sh *strictHandler) AssignQuest(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
-	var request AssignQuestRequestObject
+// SubmitQuestReview operation middleware
+func (sh *strictHandler) SubmitQuestReview(w http.ResponseWriter, r *http.Request, questId openapi_types.UUID) {
+	var request SubmitQuestReviewRequestObject
 
 	request.QuestId = questId
 
+	var body SubmitQuestReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AssignQuest(ctx, request.(AssignQuestRequestObject))
+		return sh.ssi.SubmitQuestReview(ctx, request.(SubmitQuestReviewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AssignQuest")
+		handler = middleware(handler, "SubmitQuestReview")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AssignQuestResponseObject); ok {
-		if err := validResponse.VisitAssignQuestResponse(w); err != nil {
+	} else if validResponse, ok := response.(SubmitQuestReviewResponseObject); ok {
+		if err := validResponse.VisitSubmitQuestReviewResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1184,36 +7149,210 @@ func (sh *strictHandler) ChangeQuestStatus(w http.ResponseWriter, r *http.Reques
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZ23LjuBH9lS5kHjQpXaiZcZVHb5517ZZSm0o8jisPtuOCyZaINQjQuNhWJvr3FABe",
-	"RWokW95dz5MgEpfG6dOnG+A3EssslwKF0WT2jeg4xYz65onWbCnOLGrzFbXlxj3MlcxRGYa+C/VdEF07",
-	"QR0rlhsmBZmRC40K5qfwmEp4pBqKngkYCSZFuHfTkiFZSJVRQ2bEWpaQITGrHMmMaKOYWJL1kLCkO7m3",
-	"Cean+4zXhhrrjX2ncEFm5C+TeseTYrsTP+N56LpeD4nCe8sUJmR2Sfy81U6rGa+rxeTtbxgbt9hPKRVL",
-	"bEx2GHBsC24wEJZzYAsQ0lRd3vfA4frRW45kZpTFtwvvTkxLOANvOngebsb3LJBSJUxQgz2OTBKFWndB",
-	"/IdvUA5FD1hIBSZlGriMqXsHg+noKIogTqnSznkZffoVxdKkZHYURUOSMVH+n/ZAz6lhxiY9HPq1eANx",
-	"bXnDlwsuqQnrscxmZPY5LBb+jNy/YjFhs1tUfjEplttWK1/tu9z0uLWe/7ux4IZzqq02Del1lUJqsNCs",
-	"LVxpWd/P/cYz56VpVLppCDEVLuhuEaTgK3hMmUGd0xg3POjGbLowp8agcsv85+rqfPzXq6vzd/9zzXd9",
-	"oZWwxYLFlpuVMxOFA+uSINUrtxImzGZkSFKqkgYUjeFWeZrdZExY07P1cq9FP2ACiq4wmBZNJztTeES8",
-	"e9/yYBS1fFjTkwmDy0AZ5788Q2F6KMO0AbmA0sdQ9YVBRp/gKAJmMPNR4Rtuija4O8Mjo0/zMPSo5hdV",
-	"iq68cU8YWw9PGY67xKOhAp6djw74zs6++ufA8QE5LJTMYOowPGqid7QLOX3HONd7wBY6/lGYGaqWaF4I",
-	"mGGG4zYO+pcu0j48P9A+HBZnG0oTzBy2rGyFYuX6ngjrQtRLtD7hOuvXqmad8OzcHnsxTG6oaQ1PqMGR",
-	"YRmSbWOkcgO6itIWzjckWORZWlRFR8eE11aJ7vibvoJrfuqi2pV31YC6SmB1W4NxDocBWwAVq/f7F3g7",
-	"67g/SM/2h/4FFd2hCtUevMNToferuamSx84bmyfPjOK+AvtAXSu8safA1TLSEqHWXraK4Hnl+L7grwwp",
-	"5aWYnwxJLnVolAcilwjFTa7k0pfobvMxZ+GFYwNH1/+6Dz+NsVXMrM4dU4IU3SJVqE6syzHlv59Lb/zt",
-	"3//aANc/A2pNisKwgiRG3qEYQxgGI7giX/w8cGWj6GPsX/smXhGHul+dzIrVak+nxuRk7QxlYiG7WJ38",
-	"c+7PGx4cJpZDUGgUwwffpiKBjAq6ZGIZDpR6DCecA4okl0wYXVYY0N3DuCJT6ZK/u6nQF2/nqB5Y7Hj2",
-	"gEoHW6bjT+PIeVfmKGjOyIx8HEfjj8Tn6dRjOwlWuOYSPc9dEvTrzZOi8DkLXdwoRTM0qDSZXW7u/GfG",
-	"DapiV3C7qgnD3Ot7i8pxXdAseLx4GRShmbZ+D15duyDTuRQ6UOpDFLmfWApTpCaa57wAevKbDgpW21ap",
-	"505J7CrqejOBV9Vkgf16SD5F054LCeEIIBX7LyYwAiYeKGcJSAUZ09pRyJHEU9fNcRT2tCGbwtVjlING",
-	"9YAKUCkZTnnaZhlVKzIjv6ABCrwwinLeMMy5oMuKxmGPBMlDbb7IZPUsUL+bILrHyXVbXp2crztunb6a",
-	"BWf1or1yaOMYtV5YzldQUtZ7stcLwXVM5NZAQg39050eAAYKAh+hBHhY6sGkirhaGDbLE2OV0A26dG7M",
-	"rEYFLHEKtmCYOE1wj2v7hz1ic1JMUonOm43bzn4bco2J3/2bCO1nWNsggEaq4nSkaMLs9vRw7nsFX31Z",
-	"fQ2ddySKn9AZDuXNEgxGnyNn1Ofo/ZZkwWkpM3XoNzPHAfdr6+E286q7tcFoeuwNnB5vt9DXXi+xcK8r",
-	"uU0bA+wQnOMK4DvGZQAcBtHYHxQ+RFEUwV22zeQw+OYue6Hhfv6G6dF42rX8LeddeGQmZcIHQ0HzXfrd",
-	"4PWfHdkFBdpbodVGGpH8zf/esGS9NYx/wVDkfVnNk13heyHYvS0/h1xc+I8Vnl+upqzpVS66H7v6D8cH",
-	"0+eA/J6goYy/mp8/RZ+2HayENLCQViSvo/Sl5S7dzk+3MKHI7/7aq6jwNs4x/r1ufPl6aVJvfMzcRa2z",
-	"H51T3Q+3+9WP+1YS35Gm+8Yh3Z9Aw5z+5u1H4XCAr823HWVKg9L1vVVOTZz2nFo2vxG/HT7+Dgeono+3",
-	"e52golc2oftNfntMBPYWd1U7Ka+r28cfgt0BjlagBijKKy/PwOZl1+W1Y0eYMvDTKl5cQs0mEy5jylOp",
-	"zew4Oo7I+nr9/wAAAP//I7HLzVQiAAA=",
+	"H4sIAAAAAAAC/+x9/XIbufHgq6B4qYp0GX3uKtm1K/Urra11nNhexfZmL1k6EjjTJBENAS4GlET79O89",
+	"wD3iPclV42swMxjOUKIseff3l0RyBmg0uhuN/vw0SMVsLjhwVQyefBoU6RRmVP97nE4ZXMIMuMKPcynm",
+	"IBUD/WMqMsC/cE1n8xwGTwZTKrOzKyEvQA6SgVrO8ctCScYng5tkkEGRSjZXTPDqe88E/qOAHOwTHIP8",
+	"soBCFbEx5lJMJBQagMp4g+9pqgqSigVXkBElrnAgNQVCy0UkhCoyE4UiisoJqHIGxhVMQOIU9qfGBM9w",
+	"6PqIhANkxVOyT9iYMEVYQbggueATkCSDMeOQxWdhKq+h7y8I8U+t6FvwXKQXkOFL9seREDlQHv56RjXo",
+	"YyFn+N8gowp2FJvBIBnwRZ7TEc6l5AIaU9wkAwm/LJjEOX42++sAre5esA8eXwGAH/zQYvQfSBXCd5xl",
+	"f8ddfSZmiLe3oPe4SVUjkS3x74xevwI+UdPBk8P9/f1kMGPcfXEQwc4lK9iI5Uzpt38nYTx4MvgfeyVp",
+	"71m63rMQ/KN8ob5yDcOqRZxK0M8XTEHrSubBQ2csaxKUHoqoKVVkSguiBBkBSS0vZGTMZIFo9Vu5WLBs",
+	"0LVr9Vmjy5jPc5ZSx4hVsKn5kSsLc8f0ySCVQNVqwmu803PoGRQFnUBA8eVvGut9YSwUVYuiizICvLwz",
+	"LyBjzbM1l1fbEQ2RhzapIrhcoweygtHK/B17+c4vEvhipokBeIYgJQOapjBXWhZJwHcrbFoiCodbvhea",
+	"NFspO9iWKkW/EQqQjlFI6neJXoqQgyRk6APN0E2sNRdXFGzCLTDFIo/AQvUjEAHmxwIkefmcXE0FuaIF",
+	"sU9mFQC7+SsZQM4mVlScXVHJGZ9Ezp+3ZstRtBR6/AXOnwnAE0GRGYAiW+ZgI1dMTfUzOBwJxidzkbN0",
+	"SQTPl9uDZMAUzDrJ9qR8/x9M5IavS3RSKemyZLqYDHr5vA8e5lQqlrI5tYpCdaTjPCfhE0SMSzQnhHHy",
+	"H8EQd0TIDGTfxZ2WQ8bW1I+x9SodS8fY01NRwIWV5X5opU7c8NdWFapi5C/iKuCECaiCMOXpEJ4YCX+W",
+	"ihnYLw3h6K8N+SDtKnoB+GYy5BIuGVyRHIfC3wuCwmRJKDeqjuU2YridCA5uF2gpJoohHyReRJQwaNGA",
+	"40cFw3c0pzyFyDEnWJQe3i1mbnLzCEKXMVUyIK4gqhvhD/0ke20v3YuJgyq2bc+mlE8gIIm7iRbWIlnI",
+	"FqpbqBYi+7tHtiOM1qGWJQOrEzDBz35ZCLmYNSF6s5iNQCLCA7IlqeBjJmdm242mkTpdOxSBzS34b1nR",
+	"KiviAiK2S+3U5wivTXUUBXPa2WpVVsiMcapgM+uzI0ThbmjNjV2dL0Y5S5EhtDKea1UALkEuNasnZC7Z",
+	"JW3RELQMq9IEz5eBoDKj66uHHiUqpZ75PTi5ZBlEBRZViqbTmbvu9jti7WjH/t32A7aTN7hQcZX2dtu+",
+	"liJslD+kUQm0ELy5i2/192TCLoGT0bJyrlxNgZsvPJ61auVVyuiMeKbc6WLal7TdLpXKe7EYzZha93ZS",
+	"vjVarn8I1fX9ymh294O9TioEGYiXCuxxlvRk0CTyLIubSX7Q/9Cc2CfIWEiipqwguTD6Adk62Dna3yfp",
+	"lMpiu6q5H/W4iaMCqhYxheiV/YWkJeTBWTLOhb7xzOg1myHTf2smMx92vi3vDFyfdnoywSdts7mf+k53",
+	"8E1lvoNvYhNyOoMVWJ0uZpTvSKAZ0jPBp+MYPmzB8GEnhmvk5tEdIiNKLvpWufpmR71KezazOu3KC3NV",
+	"A0ZVhc5pGj0dohpKERwERs/dymBMF7kiB9uVvdkP9+YgprLcVksqtBo3pZf6YLIqUyDhAqMhSj67wBJQ",
+	"Smb0P0Lid2JceWbdFdQMpDHtK/gOqehg35FRQlLKUdMcgT45ydWUKSjmNIXt2O27SmJzqhRInObfw+G7",
+	"3f85HL773f/Gf38XNeSy8Zili9xsszuggRYo32aQscVskGhrcPSQzhZSs8HZjPGFgqJ1rfY51Afto2Tr",
+	"wP6Le3VArgAu6lj+phPP4YXeXLjXuGKfmhdwmF8WbO5s4zXZwwqF1OAYlfhnydaMXpOjfaL1jsodv7pH",
+	"nXJ2Rq9fmleP9pu6CFxDutBYdlKna42v7HMv+XyhBcYExBh4CmeSZmwRM3no7/X2gNK3USkW9ipqrMKl",
+	"yEMhWCjkOj7x91UPZPU5x3t8El6nHL/tJ2hid8DVd7/CZfux3ZeAroHIavT3JIdLyMlYihk5QCI7Cic4",
+	"6iKt4oLledGDIMyDn4sazG7cnhS8tyJqwcYfK2daf2F0eFdZdEWXbRaIl1yBRHFEFZBCiXlBRqCuwOqw",
+	"lkLbaVFfRJWkSA/6Hmp26/Cot2XuJwtcdYMOj+obVDvS4y6XQOp6Io4I0+ZmR4VBTEM4yZi6RydNXy9L",
+	"U9hGTWulroKCB0g6BfQ+ETqhjNvTWsP/+8JyWxKIYdz25pY/GXKENlX2SuPMbKghZKI05KopzBJjvXWW",
+	"OxxQwlxIrVBQ7QqcsaJgfJIQMR4jDHOj72hIK1Y4M+kAyVni92I8jh6dUVNv0zzFZ6Cs2JSlWXqQ1LZx",
+	"zCCPCMImsoQkERJa02FzSfNF7LB/bZDkJKKQ5cQhnzXGW8lCZmklQFE6a17qI05urlAbNi9HoBizHM7c",
+	"peC2fq6CfYxI13fsI6AIGi0NX/thGFd//DpitotdREv4kupi7KyrELPSoTSfS3HZ7VB6AeKv7354cypY",
+	"HL3uZhYhjFN7RSa0IOc/+8tNQtyV58N5SCDl3V4sRjkMIpe3QAZrWVV+aByYdr/dwg38H7pkmsVsuKwY",
+	"gl+A8AiprvkFiImk8ylLjdW8wbPh7XpzN+c7XYpveyF9BTQDORJWG6v7GFEkdioq5RCvzQtoRgPJRLbG",
+	"q6fmBc2I1mRY40RFpfLeDPN00teYpKjmmf7GxgCyd/blTnnngbJ4c0sJ5+/Yg9ce49XFlxEJkvKLAm2C",
+	"5VfGoZk4F89oWXp5zFfBIeffivlnLMKSwfUOPr9zSSVKrQJfbAD5LBip8eOpHbq6ulNPFLWoHpoDz6i0",
+	"24ry9sf3z57qm2VhbixEcPJa8IyG5mjzCZ9CpAuuplHxF9vLBq0jXleIP2H01bwcKiHFlErIEN/wy4Lm",
+	"pEiFhCLqytE/RRZe20M8eO0uMl4l9A166PRSk8BRZ6CLk2Y2AXnClVxGDo77Czq5YLyH8PCw/Q0fv0kC",
+	"P2gTXaGJPh4D5J2kVyCh5KGxDp1Y02EYUwP0ojyQlRCTDtz/jfEI3D9Nl61QB1xiFm4vK3H+wIlOrc5Y",
+	"3WPgSjJYR26WBBPxziihaN5cyXv8mnBvGbSzOmnf4qSu4diB6maJ4rRyqY6Yq83PODF1MQPOCRMoFAl+",
+	"tsb7BJl2JNR0d8iP3W3D/YgXkDHLc8iMEkkk+uIKQNNJKjJnXQmGJlsjnBXGYyHV9tMhdyOGz1AJREIh",
+	"8ksjf6qj2bl3h7yhubS6JI77eSISOxVkxg9VB0oYb8k9OyzIljfhKDEBNQVpwnm8rkMWPMflBNug93H7",
+	"M/s62iGl6g6AfjleklYOfLeYTKBoCX9so1LPnw5dW2xMKF9udwvkeDiFH7BfRMVKxX9N7b7nfnkI9Qat",
+	"s94WneMt5HBJeQpGX0FrPjMmES14ZlSl0w7qi1p3W24iGpHR68gqveM1lRfHef5GKDZ2IVNvgWZvoZgL",
+	"XkQOqhmVNi67zd3Ew8GIeZ4g/XcfLXbwGKQhiI9ORQqBczrSxuJ5EXV2YXW9BLg/tzWG9acQ/wlxgVkL",
+	"brdgA9HyoZYVOP/L6N5KUK+Dv2tX48qXGd9Hne2QQsxAcCBKiAuvPAQKzNMhN++Y4IKzVAch4ZvuYY8y",
+	"CyYqFsYhO8cbEOPEvWOG8COmxlR8RrPMDsjhithv8epSLNKpm6Zi86yuwqOtCqL/ujJPVJUM8RZXKCtc",
+	"2FutDMe9g15ZFQE5K1RLZoilyhXSxDxRG3EtfbXyqtda/dxdZHkqYQwSeApFE8u1fY1mq8T2dMWDNZqI",
+	"PFlb32Zoq1x8GFkYMWLam/RqiRQJzIQsiDO4pRxKBhgruW6Ek6JyLYDtC/HA+bWg3UBwb7ng2F79fVVo",
+	"Syya93sdbx2uVozjC+0bsPvgYTSDTQbI3CGM+DY6iEsZefKp9bczCfOF6uXKfls+2YyweUSRLYOHCFrp",
+	"61xLBlAoNtNbaRzi7Ss8cU8S3GAd66vf0JQixUIBoYpc0fwCbQZzmgLZOiIXs73pdkJ0/AhkZDGPktP6",
+	"QS3VUNnm+1Gj3MvnTgJEAlRY+X9BlL6/rnMzWj+oBu/sjGMIRToN+JwVXiij69kfQonJRVVTkGDTUd2c",
+	"KyL97xjQ/6P2kitq99RojPcf0R8mO7YxW+EyLC+hkWI5grGQYMwOqD+nlOMDTm9I3Co0zPjf0lg6jaLQ",
+	"cw2NjNHYSu41KElz3VnG0BWUwtlFRPC/kEDVTspkmgPJtVXF8YDh2Xo81//7P/+X+Ngb/SnOKxcsF4aQ",
+	"B0kfF20ZQNVfON0i8WHtkKiqIKm93CFF6ri7owjxwViNX9bPVf28AVS3iZta6fY0supu4VI+4L5X3FSp",
+	"n7Tn6YZIjXHfiuM0es43FcpAQ4zpdY3cpPqp06o3P8sXhQIZCfkaieuIExHynIzw0C5MlAbaZHHj8S9V",
+	"CUGbrf5Mr19RdYeAja/DgI2vmzJAF35YpdDWU28jTIlfp5Dn0XOy3UvwGigvjerh3aGojdpjxSuM/GYe",
+	"9/tdJzJkD+uITV0BQwvP67ifcxn/+mNzLf8SYmZPNTH2kJMtxXIgH/HHPxgvk2a/7W6LxscBQoUgOFpo",
+	"Nf1qUg4Q0M4Mxi4QuUsu1FTIvsZKFy25kcoJxsW6+uJuwCM5LRRxKbcmacsUQfGJqegQvPVFvufq17Lr",
+	"brKKR6Pugt80uyWV6WrSvERzF3XETY0W1/2tjOGQd7AyunkDgy7lpADo5iAP8iontgbzOcyBZ8DT5UnW",
+	"sngbnhOjUe2IRPBC7b281JQvJxF74GcqqtIdspGBjYV04TkhZHeu2lIh3BIjPXbkBUYPNrcEsgmsSYy1",
+	"XY7QJBfZimtXzviFljxSLCbTCn4wcCHPEI1uP24D1huRRcHqE22jgyxt7qp+vjXYZvXGBdtksJFYVPfY",
+	"qjfWNFjdqY1WtKnfeVpuDqu06hU54Xr074GqRczZayN/iX1AO/jxkl36960KFkaXbwfhL40IkgmIGdhw",
+	"sFWrrsQct3jfHVQvnzvVCVFOzj+5Hb158slf76TI4ea8pR5ZAGDnVthZT8u3IrHG9qHe0cZ6uzxuKjB1",
+	"7dozkecmH7xz/8pHydbb75+RP3379R+3vWJdXmibWQbm/TWlj0PCTXdsdnMtfTHnQevC02mtslebY2H9",
+	"0h4bNpDft4F7lQ3puf3R2KmQpQqgMp2SFLgCWbUGkS1zFXXPuIpHPW4uMSt7xFZ8O0N3T5POpmw5FRET",
+	"U5fQ6FvlMCOsrPiSMJdQQDXQ2lfk82I1uplrRlw4A+WqpMf7ttlt0PLVfpBX9+TO9qUwm8liql7rbaU1",
+	"qVU2/XAJUrKoCvY9gzwrkDpymrrYTAWzeU4VEJOJ5Q9kvfSntlKLTpxCPVzYYL1BEpV3G3Ju3jnB/w75",
+	"9Y8mJx6THiZix37ZrNPwvBy7Rfw9fP77l5TFftt88dukgT/yfO57S8XedH50XARW3Gqf0w6xxtnZftD0",
+	"uNi/BWOWyVqCPNdUyFzVIVeCiwOVxnAUOHOajpx+ehlcz3PKe0ZlhMs6CV50uO2lGawINTaGMf2jd1rt",
+	"6+UdkK0rYJOpgowUZZHCAHrzWtFLHY3t7qCqKDsYqxhaseW6CGObSXFjluT7sN/iyWQy3GJyFNcF0Hss",
+	"8/ztgsXqNrRwsCooHujEY7gzPSpUS1uMTF7B89mPZkhT+sv8E8QnMn4WlNS2psUzA6dWPdPclhKPCY0S",
+	"aU1vTbdLbA6yBDd6t23R+yvytTX4K/6jX1H01xAd0QdqGIo/Y/Ac+a1u+b7HrakRzXurg3/WcP3W8gQc",
+	"rtWZSNOF1MG8Ua/Sex3Fpc+KaxUcjy5OHKXlFLJFDk99Blot/mi8kPiRlDP1zp4WV9xLgEgN1sq1ZgTo",
+	"5UObf+IrFladpA5ofR6y/n6ATmdUQ2U3Aswvt/s4dE9urOK4x5zd/8SfTWtfNB3RttaDiacPvaEz76X2",
+	"m3SnpK4H2I4aaiuobEcYy2McbmIrYiJZ8B2YzdVSe8GLhHAh1RSVtEIs1FSrLVfadSEI0DUdJi6kIxp1",
+	"5jzssYgwlgUueLIojIuE2GWYI7MpdW8RGrCGaz+AOCkR2roRPzE1dVqwPs/y/Ifx4MnP/XTL+gaWt9E7",
+	"2UGt7r2ZeDl7E9wEQJGImPWgqe1bBLSkBYfNDcTyDe23hGaxBCoV83UQyNa+rg+47YPTxro7jI7gpHgj",
+	"oIU+uxoKT5jLcVa16XRoUaXD3Q1RxhoVKAkrBsOIQuR/Pmur2JCLAjgU1XLZwbhuI2dAefh1kBr0+6JR",
+	"vOPpkNsiV0xNxUI1HtAY07mZbk1o1nI/2ssU2d89ONoe9rwq+r1vWeoBoapyYcWoZvsVZBN/rFg6tq6E",
+	"EpSv9nv6EqasUEIuO+HIg+z8oKy+RemcFsqXQvGAVoFzvDfk+tJd6PL82DgIZWp1A4LhgjUd7vdGr92s",
+	"s6D4V3vHCl+LUN/9fc0vT89TWqxRhysZWCVwM5PnNL1Yb3qL3TNE4pm5Aa6UiaElJPSyrNhhOioQyMh2",
+	"9dseY1E8a02Sxl9JkdLcqaoHu7tHCOj+7u5ByHB9qdzguG3Cd1NkcDGOVMxbQRMJOTDNDTjYmhB2QwOK",
+	"PbqNCaUmG2rQRwRlDaF1pm5hhxZCTVqPgJiS8baiU9bQaq9FYUEPrwbTglBSLEYF+Gwz5ksRvX3746uT",
+	"J+T7tyd/J1vPj1+++mdCfjo5+durfw65kOT1D2/e/+XVP7cT8vLN+5O3/zh+lZh9YvqW9eyHH9+8J0KS",
+	"H9+8f/lql7z3LUXK+5fuWqHIuc4gKc6oOk+G3F/yBLclNdz4JKPLIrE1kYQkut5RQeicSrWLtZHUNF8G",
+	"w2M2BaH4lhlVP1/2wsFcjITk7ALI9zCSCyqX5Kv9RE+JVRLnkMUKiMhFHmUXvyipL6F62XqBWMmJ+GuT",
+	"mfBw//CPB4dfHbzf/xaN///C5VD9UPX3QRJ0ZsOd+LPZgacavX8+2F//7uKR3XLJtnTQ2Ctf+ZaLq+2e",
+	"N+caT2nUxQkY/yvbJrRe8draBbhCQEFHAFZpCNDhZeuA28wahzxMAazTBBpnEIwUmK1TQ00mPGq11p/O",
+	"CrT7FQlRU1F49JcO2Zop7BIknaAbyZk4I9HZ5scwUcentOE5cyVIBimb0VzrCe4AMbak3ocHPnzm4907",
+	"Lk81sGvvxxGLDwR9xVr741TbyPXsrHaHjlquiRnxLdQeVX+tMMf8Ll1YQrzWRo3t1zvdJ8LV7Gxl4B5V",
+	"jiwHhKm/VFU4mzv6JRlMJOBNa+dbfdf6dn/7qQmhG5vYAxTmCq7VoOJG3Pmvn/d3vv3wh63hcNf8t/1f",
+	"UWdir2JHtwP44BtzO/xmwyC7vi51AanTXQuSCQ6NQ2M/Ms58KpSI8MNfT09eJOT0zQs8s36C0SlhMzqB",
+	"gizmRtS8/o4ATafRHJsR41QuB6s9zR3ZVr0re9ZJMi4/IGjR0xHqX2/qs0Fu81D0ZrXAS9fKbYGzrqvx",
+	"Yeg1WyOns+pNq6kTU7CHCmS295LgMXYpgpY+1mJu3sMIbTUFecUKqB2LaxZ8jLnaYrj9URuiW6qMtOL5",
+	"oYqNtICPTolTKcYsbxfEGSvmOV2erTCaF1NxpX30eg+MdaChSnXqmyv6U5w0r3TiimP3lYU5e+wFgE24",
+	"kJCRlBaww3gBvGCKXULt9NxobMtUzKB3ZIuvpGyGdemUxuYSvfhj6fgxlaUtFOerBldqfDBUI/JcB8+5",
+	"Ggb6UoBWZ3YJrx17msjOpuZWiTJaWbasvW3EO/19aIt4BFvUvK+X9Bzl7JIpNuP2rHPQasrvbzraOOX1",
+	"UudvV8nkNjG3t0kP30C5nsp2BTGwYWBsgIb1HJQ+hKw7ez00xOik7F9vcXUkEEgXkqklmqFmNocbqAR5",
+	"vFDT8tP3Dri//vS+FuOsv9PJpMAVc/JRXADfJeY1skOGg+/0OGS42N//KtU/639hOMDN1rPj4amfKqlm",
+	"qtR8cHOjYz3GIlIU9vSl8TYiLegeGhKUZHCp/0dz14xyOkHxbC59uwS7igLPbEkKizXSXMMucf1b0Ui0",
+	"UGJG8ac8XxK4VpKmPkQA3zUL9mHgLr7nNc6u76bkHchLphVIrK9rHQe7h9/s7usIhjlwOmeDJ4Ovdvd3",
+	"vzJ3i6nej72gpLf+YgIx25CYW/+As1Bow5BC85qxyZEtDa2pUb7tDXSJLWaexEq2a0tirVb7LkF/KTnP",
+	"0R5IznPBzzWmz71QOzftfNw1W9tNmn7MIWe+bA0CbF4n2uRgkG9+rtQuNyXNCdVV5o35D1nTxK1mOh9J",
+	"hR0DEI+SWufkk5/rWDP13n26RvliaU5zzcOYramor0fmTCnr6Rvpe4tmAjdJHSR9EzQ7iSvFdRrTlIfI",
+	"b1IbWGV1/3XBcu0RmmA9M07gegEDKoH6EsJYlZdrWvCk0AZirkVcCd8KxahTkLYDWzcB1KGlqie0gveF",
+	"tpewbqnmFEJZT4Oqw5wL3gatX04F5luopIf7XTppcymvzctBsrlvLYGFfNGisr+fEN+/cb8V52zGqjRi",
+	"30HVdJ2OiTcfdECIrsqr5efh/n7QtKdmo9z7jzVgr8075pyqGaHCn5PB12bmugZySXOW2UYKCTHci56P",
+	"GdNpr0gP5u2DWBcnU7GAfdSFVZkdDKW7DbPzxxOOcRSHQIHEitsFyEuQBKQU0qgHi9mMyqWRq4SGElL/",
+	"vuez2vbwiHSSqfWU+n7x8eOSbCnJJpLOtp3zm6ZSFEFNbyQB41W0ZbShSAJJKF2B6Ib0Pw6AeBXmmq46",
+	"BN4ZINCmR7aowkUWihzq6DM85mXRRqO/DEK9y/BTSTcrHD+HEb24Byf5iuSOl442ykpH981J/Ro0NOuv",
+	"Nws8NTnN0U6IJF1Oqk4znXxY0spDM11IzkEC6WipOUTLBsMfhhlnsEfTKYPLMpwiyoUnOocvgzHjkJHg",
+	"ldIl4mKZQ6NCQsYiz8WVQeiC5yK9qL5vq+hRCRjQi8cvSDdPg1ex2eXr5XEI8Ocgr2DCPnRVge+h6QFR",
+	"Zn1cAc6d2lBeX6w911PFiOYutjFKEO/KBBd7Lwp7y0QaOe37oI5qJ5olqJhG/nr5nYXgHo9iN0VkE02z",
+	"J+LQ8BjO0gDXFqwe+5jr3jXtfG2b0pjtQHjtDDZ6yTAxh6uwpkqMKU2PnK5zs3lAua44EUXv8Dan0+F6",
+	"il7SHn7pIFNCx460gCLG4wJaYOnIAL1nLdP3P4pRNzWhjoY63FI7zzmvX9pVPxrhZonWLqebK8Iy9Tvz",
+	"atH5KJ+YegnYhqFo1twP2kLosJDC5bzj8zrlnZMFVywnaiHx/MQurFGZ11YL/x4JpW3KCNWEj5IQa49F",
+	"OvIWAFdRRDKIds36m95qB6b36diiTuQCYI5fMEkKUDYCprqhxnG3ek/1YN/ZMn4b2c5e3s6bm5v6LeTm",
+	"cZMYsdb6ThHlNkgX4ntoujSbcWvSjAirdgn1FlC4YHDinJZx7K2tPKqHelKq8XxlN5CW0/9N7ZmVSgC+",
+	"0tJqhOfLlnPWPH9mn4gctmOaF5BEXOrdOkgViMenidROmy9SH2k00VmhlVQp7otVSqK815fP95Dcd2iu",
+	"S3POhYn3qLJeWz+x+9QWOnuYxWR6j25lD71puC6rtFUktQEuukGfwo9Y12/PtVdy21WXO1J3MaCkIfkI",
+	"nVC03gPYRv4mVFs/Z/3nza0P98Bu+0qxGz5Pfvzx5XMnQdBtWAqQ2qpWmiu7HPefS6B0ahL3QGpf738d",
+	"y7cNJuVCkTEGaRM6E7ZUVut5fHfqpSsod15GyVjlIXL5cKE097hpYcRO7AAwP61W1+9t894HSUh68wqK",
+	"If6UWOxpU9VGjDh9lhm9lbzVddegIDTP/TAmyPip61PrC6yF0cdpDlRGLKrukhLu/X1dSiIxjJ/5ItJB",
+	"fu/0fjte6TT6h8894NFlihIQHWGrqaMvjaFs0Nuw47LHum8XYcmF2n2iVni5eU2olHz4PJb7ypR9bPd/",
+	"r2TTPSLzfTXNr8uiEVVA3ikdDuMi1bSTxRftMqFxLgBH2ztwImlDdyg5L8tcnPsxWDHkldonIX2g2cuo",
+	"VGXO2VP9hCvsYhpNBWk1mniGvHzejMrFFRE8FrsTVOTw23w/MixasKSX/Dq4Hxi6ydduarfRpEEQWphU",
+	"0h8fi5irJ7xGxdjeJ/cv6uVmPhdk0OAJq3IXQVZtSMVPHU/QXALNlvVaP/p4vYB5U+Y913PWaXOljl7b",
+	"v3YtPVjfhjX0r1ur2zuoDC7vV5GuTRlTpaPH0J1IzGxYhMSSVq35S9vd/YeSRb8+ajHhTU1SWa2449S4",
+	"y0lT6Oq4Qmg5KSXYs9IdiC2q/BdAkI/pbH4wfnDK+qM6nB8Pc1l+uc1xb34t2g1xRpMo6v3sm6p0U6Xd",
+	"JbYA+oRdgmvtNuTO6RRewGJF0XU/UDspz5dPCfUhWkQGEmLI/dv+d13Y42oqcujQg7+XYvabFABl2fqb",
+	"m5smq29YDW9n8WKRplAU40Wee22xlcvfh6Ti70HCLcUUusSDJhAGvz52ryn3mu9ojOmLVhOmtzB0+h+/",
+	"Z7kC6dT60bKsURtzqPkfS9r4nJV3m37BHxqJMqaR7latzF5SttFNIrk027ZUz0xoCwPTmRiTpFGcL4YT",
+	"TEvUk94hY2CdDIHmkl1SOZpqffy1XoWtS2Irpjx1Txb1oij63qbhtS2XY+tk/Mx3hnd1TnrldMTT7P0K",
+	"11PMJyD+cAsJ1WxfhHy0KatarwhrZo5Xl0hnssCOdbWVJyS2xPOwqq6p/mVjpvT5Z1tHDXljbUZ2UhMJ",
+	"5rvYmEO3R0dhhBKoa4mDJ2yXWmbkQkJCXjCStkEzjyQRw24Gug6sLA1Mha3qxD2Z02KldB/AmLahU9wR",
+	"BePzhSIZVfTx2Ms4XDm1oTxG98LKFqvjiTy5+Pb1YXAwYRlwdDuaEH/8uoQ/5gU4toP4s/ozeQHWl1eN",
+	"9d6fP/JOrL0GtAEBSFcDdxUNUH5RkHOj3Zy7iThQqSWtbfnhhGwXOewO+fsp2NYZuoxevV3GmF3aKjJl",
+	"9m6tx8aTIXd1HMmWrseaxEtKogqd0zk+dHi0nYRla5FEqPSYGvKWArb47gG+a8pA6o/7pvRv6kvmWmyX",
+	"qTZiXBm0UnNVF1vdJX+vVo23iNL4C7USi7CyVK1J02xZsAtWC5jz94XzAw751nkQCHC+/dQdtHIZpC/5",
+	"iwf+OotdNH3p5H66dr0EmIPK0U5Q5cuH23mcVgqoPLV3biUmpsOOPvBNmu1a6cLrl124STorhTXXFRQD",
+	"u93KqGpbmeA9V3arzOJ3lerCtaRiUxEMC+1fzCLrMrVK3LsWNyUJpnQ+R1VOuRFQIB7gf0O+2czkBioO",
+	"105LbpbISXQXbLpTANI8cu5WvThOiROHErt6X/nWduvRDe5szGr02ukejpx1G62KVKhljl8g6gY3SY+C",
+	"TrpG7xqYKJqoCCu09MFG+PwDI6QZPiwr1eSLe48U/vDZdKZak7AeGtTbUqvwmX8jKJQp826DQ/qn0yYo",
+	"ILgo5aqRlHjwcVErsMV4SGQPrpl5TBCjPvnQCqstdahnJs19Ryfyd6nodmSNCcBLSGFPcIcuCXSXnFzT",
+	"VOVLV7DvfC7y5cRXYhmNxPU5mS0wdUIj8ZIZDcQmQtoKZjgUGaE9kErT8YBxr6w0lAVzlBhN4bvlMa6l",
+	"Q19wvaFPDXDEdZ8213/9k+FjlEBCZoxrOyTj5PxnX7sj8TVHPpybhO6EwO5kd8jPPw01CQ8HT4YDO8dw",
+	"kAwHwWDDwZOff/75qz/tHiVHR7t/+pDg/3+q///Nh8Q/E/7/pw8fPtyc7w75cZYxG4cndSkLRBaa77Gg",
+	"kDW7YQE3cglSsRSTxkwmm1m43gk0vpo6Bxr3XLEZSJYxamNhogVmzAAVSdNpU/wOdxRpfySuyTmKT8ET",
+	"/ENVgsIVP9HrV1Sd75Jj/ZDWUeyTpkQTEPPkuQEZ1gEaqW89iKMNjDXScgaEldT6hJwb6w9WHvKGH6QL",
+	"ck758pxs2fLm5qCfbbdDqWVYXIQPKF+ubpSc6Ec+bN5Jf69X4ECWlDVFvoxKCFaPrS6EegYT0sgxS/Ux",
+	"4Vs2do16HKrSzRTk6ZJv9ZpIwf1n5T2mh8dtE/eaRhWkyj1m5X3kNhBu/n6yv3tgLyjmgtHjVnEbwBtl",
+	"jnYP+tRs0vWKzYm0S86dHeOc7Bh7goueJVvWItCzi+p2MuTnZW1DHK+a3HduDBj4w5RNpqBd1fiNeWCX",
+	"vGe26OdIIt+hRcJB1y4OCyFbFFrfaiMQicFXlTKMBpBenq+Y6l3oC8YWWim1eAZbedgGw/fP2wtK2mxW",
+	"AX+U3ptK/7L1j4VmDT78xtNM2ZTIdgIzrp8h7+H7cVva6vwhDcwM+RbjZT8szV/bVkMJmoGdE90UhRtH",
+	"j3UR9XH1PNpDzW4DtZtQOcMUyzEy5uPN3qfrm71Py5v2Ui7znNlGCEXO5vMlmdE5wffJ1k8wIq9BplQJ",
+	"mZD/9c9/6Yi0GWwTxpUglEwk08UQdX9BG8JWpgp4Th3yoCcsr3QldJ4741AjIwn0IhNX3JtJTf6K7sjn",
+	"jaVM1rvK7Q65uw9pKhktnf0hMa1YXtM5Kq7/gFQJSbCToiWRGE1e8mx3pl/YudQv7CA+znVxy3NzLvx5",
+	"dqnOW+pJls0aO/SBfwkMtAc0mpnWcoeH2/EInI9dhcPsyXTYkeSbNFvE5EBSkS9m3MHw749khxy0AHK9",
+	"GpD155biqjpxvU1lCyDLDQJSb0q5Y8yZCB4rSIEcYij+8N/BU9fVj5oH2jSOsL9k5Mj8KtAu/rhuqrjL",
+	"viWGMpMgfgiXYQicTIFmIFvgM29Gw1z0OZMMZpfqPq4v3TGVJlsrGXTzZ3XkuvLQYP+ty8NtJ33OjXw6",
+	"JzldYjcfwaEWS4CSy4oh/RLqXGLBlTGgzEHuWAmmvyVlAehqc722fhltfmg7ZWk4Un2S05Rh6tJCIuRj",
+	"OsZegF+Ys4wZtJllUn8CVY60T67v+s2qRFaNtu+WLzsTon/k7JeFy6xqD32sNHt/tJH47QSUgaIsLz5D",
+	"0KCPFdyMS9tBjkf5y+ctlLAX4K1f+mL4gvNM6OGqKYy7REed+V99yNmMLk0sjXaRtmc6HoeA9YrDfeQ0",
+	"2K9WYrUhV2etxLbN2By5fhUPuo2Ee9tY28aWfxay1zesOm3SEhktOZ26cRAq3uG7K0Lay0HRdGJiIW3o",
+	"iPbyzUQWqZE7n+fL98LFgz0SUt58UFq4zgeKSqvwz0p+wS6fxsjRqg2YvbBkXW437nGivxNzMPE7IeHp",
+	"+1Lo7fWpj/qpz5L5txme0vtZ4aPuM2TvU/DJ/KrV5/Z8EhPRVoRp2iI8afjqs8QMX9mB5qliVPjjSgO/",
+	"h2fDxkUkJM/2uasIfrR6VVu/yg62LJtJcqfaNkLzHGG0cm5lQMt9rvGaSx1MKQ9qJo/AT+MZuKWdpemd",
+	"G3YnDjvYlM3llWSpinS5HPLNSYB7P52rwm1TgsXybOXUvZ10MR1t26XLCslhXu2QHKYR739Lji9Acvjm",
+	"xreRCr91lnxrmaEfS2pJeYsjfe2QdzPSI9Oc74nsg7W2E3wku6JvnH1nkrQ1wGmF1l9pkq7rDtmiWk9k",
+	"vFBAs+2K/jvk1U7Qdzs5yZYNURvlMNNWBE1jsyFn3HHmmSaSYnuTp+x969kaoTXld3V8W8CMtoFqu/Xm",
+	"mZh5XLdaa04lu9Q1b9zDVILGMGSmt5izn+q3bdkiIX1OWNi8NubV8XYdB83jPEebPnKPkEhh26PbFLY9",
+	"2lhhWw/al1nTNiSHrpq2nsgfqJzt57FfaY+/WSlRU12/tJSP7Vas08UoZ6nHkYnWNC8ZgwTysrZVKGFL",
+	"iumD+GrKcggOaVbm1Ahp4253h/wHntaeKm9KYYfqmCjAZBzdsJnMawJmd8h/sinF55essIL+vIIDVpC5",
+	"WVsEVL0gnNONTMcKJEbDRAXQcZaFBPertsNVl/qQCaIO2xHWtj8R0/i7ja9PdKADnj3C3NV1aXqvY5SU",
+	"40s92IZJNqTFNeUMNZx71fVrBymquNSxm9BA2oDZ5qWUa99dwD6fR/vIMkJLsWMzkZrSZ7X2sffJ/uf8",
+	"i3Md7dt6FSrrbZprAu6qmc5BYqNzDo7IjPGFrjiDDK7lEm4Fa5aMO8mYenRMnrToYyvmLVH5GMVLHcsP",
+	"WTGqh3wxjbJuK2DwbXLFeCautC1/Toviwa0FloFc32GHgy6rgeOszcgNJIOSXVfIh3kOenvpHPNiYEVl",
+	"KfNsUFuKcVKt/GJaVJqBCsJU4X4ngBk3GHC70llg3tyNuejwh2ce2l+9teGZVsv0Mt7pU7HL5lCW2enr",
+	"L6tu3W/OJW1pivwSom+FVS1glS6r9jvg2SouGdH0Ao/yoIiSDS7FceOMU9pfJNBCR4Sehpr8lF4Cjmn8",
+	"prouRi+eM3PGk+Hxl8fIc5s/NOtrfaBDc22u15SEBGbJ6JbM789TQ1v4nGPu4Oj9zYkIa3XvKyEyQKwC",
+	"T1nPWvNmYOR8c+03n3Om+zqjfMCFS7GYTMlcgibDgikodB2zkdA5CdJkCRTJkOvGDhmUbX5DG2JSGgCC",
+	"ofRkZRkStNYSWhDIJlCsiv5+Hi71134WV1e8fCHpfBpjyfIRMjHP9LLrv3xuHvxCoheRhLLaSnvcRN1h",
+	"1Isx3MNlFFDVwN0azBgauyqGLh/XyFQ8qrGU/ScO0t9MWGNk7T2iG8u3yv0KfRgPdVwEBpyHNd1oo3Ha",
+	"xFK7qfg4hFFbpPBSq2tjQxYcGRLmQmpFEaV1JrhVD3WXJEiIKWRzROZToUThJf+L03dD7qtN0BC4XfLe",
+	"jz4RpjFwTWsVztDsd1sC1TmJ9prr1vnLQshFtL6SCeh8zKzWplrOFrliuDl7OMyOLoLX+wAxy3aLfSCD",
+	"bx2INsXypCF7+yuVVgtN6o6GsumUJWXjBAqjL/1snrySIXeiwdOskEQsVFkBBMRYP4qDg1dUE/3fjPKl",
+	"5QCbJKc/lEWH/3p68iIhp29e4K8/weiUsBk6tBz3vP7uQWPBPr/AMiQSF1mrT/W9T+4//TVViqZTa3ku",
+	"P9SSW1pCwVae4Zg7mQtaUond4d2YqupI+dhD8DhNzp7l2icO0Lvh0DSPmxWzV7bwnvUZzYN7/5nDpCrb",
+	"unPMEvvunN/61SsYzdd9t+kV12LGLsl7uuxHfJug0ZoWZDFHUoZMH95frrqUBPqfJCWtbEgqPXcsT3mN",
+	"69ukUuWy3G6mex8seUYvILyUmxsO0Y1LhEaQ/j5Uk1LKcYFBaLJOoM5BW+KmQFzVinMXumXa5Ju7fgij",
+	"du07++3TUtHKKcbyVJ50jxVJaF9szMQKV5iLLpSYUdz1PF+i0TDEDa4BF2CCALK+IQet3vtw8N+CCz9c",
+	"70P62aJSKCSb1V78QI9zAfeNbU8aFBuQiSogH2vmd7k0OF+ohlXNV/Yuj8YnciUWeWbnIzQ0LaTLNIeH",
+	"Eou3i/yt4OiXjYcBVIbvzvypYH3vU/ixq3VcKBszKeZFffY+oiLiUpiJS3icoiL5tIqBuvKoa7i9/7Z1",
+	"FeikxuvdGPxLtO6T0ChYpgFN3Qkc7sodPQGI4DoP2E4uK3nQNuToZfa0z66IBG4P3H1r5/nNGC2DVfer",
+	"3drE7ZcVfhpQR2fM6fuuwE8XyxWWhCeSKjD1o7TK+yS8FAy5m78yTuOSbp4K30wIB70wP/DukJ/gB/Ms",
+	"SL80CL4FowfPner9VFdpBTozH4s4cFa5LiEiroqz1n5bLJIhLf2K1dbGYh8y9tSxbhurrmt/9MScBLIU",
+	"vKbqaQ3vt33uvOhQDT97InUXwvBZMwU1kY8FEQtdT07irSqpx0yXVsq2THQLa1ZZyMPZIh9ZROzbhoQh",
+	"dTii4q3thLZRx2FQbK1fUD0q49csI8xiXfTJlxEF48wrukds1ru5VcAfoYOh/D/iabhXHtQm8MppivYZ",
+	"7TOpKIR1Szk+5YidsM/DhWaTaoH7+hFIF5KppeaLEVAJ8nihpoMnP39AmjVDGq5ZyHzwZDBVav5kbw9L",
+	"HeZTUagn3+x/sz+4+XDz/wcAN+JBgmMlAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"gopkg.in/yaml.v3"
+)
+
+// achievementsFile is the layout of the achievement definitions file.
+type achievementsFile struct {
+	Achievements []achievementDefinition `yaml:"achievements"`
+}
+
+type achievementDefinition struct {
+	Code        string `yaml:"code"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	Criterion   string `yaml:"criterion"`
+	Count       int    `yaml:"count"`
+	Difficulty  string `yaml:"difficulty"`
+}
+
+// AchievementDefinitions returns the achievements loaded from AchievementsFile,
+// or the default achievements if no file is configured.
+// Returns an error if the file cannot be read or defines an invalid achievement.
+func (c Config) AchievementDefinitions() ([]achievement.Definition, error) {
+	if c.AchievementsFile == "" {
+		return achievement.DefaultDefinitions(), nil
+	}
+	return loadAchievementDefinitions(c.AchievementsFile)
+}
+
+// loadAchievementDefinitions reads achievement definitions from a YAML file.
+func loadAchievementDefinitions(path string) ([]achievement.Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read achievements file: %w", err)
+	}
+
+	var file achievementsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse achievements file %s: %w", path, err)
+	}
+
+	definitions := make([]achievement.Definition, 0, len(file.Achievements))
+	for _, d := range file.Achievements {
+		definitions = append(definitions, achievement.Definition{
+			Code:        d.Code,
+			Title:       d.Title,
+			Description: d.Description,
+			Criterion:   achievement.Criterion(d.Criterion),
+			Count:       d.Count,
+			Difficulty:  quest.Difficulty(d.Difficulty),
+		})
+	}
+	if err := achievement.ValidateDefinitions(definitions); err != nil {
+		return nil, fmt.Errorf("invalid achievements file %s: %w", path, err)
+	}
+	return definitions, nil
+}
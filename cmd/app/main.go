@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 
@@ -36,6 +37,14 @@ func main() {
 	gormDb := cmd.MustGormOpen(connectionString)
 	cmd.MustAutoMigrate(gormDb)
 
+	usePostGIS, err := configs.UsePostGIS()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if usePostGIS {
+		cmd.MustMigratePostGIS(gormDb)
+	}
+
 	// Create container
 	container, err := cmd.NewContainer(configs, gormDb)
 	if err != nil {
@@ -44,6 +53,10 @@ func main() {
 
 	defer container.CloseAll()
 
+	if err := container.StartQuestTemplateScheduler(); err != nil {
+		log.Fatalf("failed to start quest template scheduler: %v", err)
+	}
+
 	// Create router
 	router := cmd.NewRouter(container)
 
@@ -67,6 +80,24 @@ func getConfigs() cmd.Config {
 		DbSslMode:           getEnv("DB_SSLMODE"),
 		EventGoroutineLimit: getEnvInt("EVENT_GOROUTINE_LIMIT"),
 		AuthGRPC:            getEnv("AUTH_GRPC"),
+		GeoBackend:          getEnvWithDefault("GEO_BACKEND", cmd.GeoBackendSQL),
+
+		Geocoder: cmd.GeocoderConfig{
+			Provider:  getEnvWithDefault("GEOCODER", cmd.GeocoderNone),
+			URL:       getEnvWithDefault("GEOCODER_URL", cmd.DefaultGeocoderURL),
+			UserAgent: getEnvWithDefault("GEOCODER_USER_AGENT", cmd.DefaultGeocoderUserAgent),
+			File:      os.Getenv("GEOCODER_FILE"),
+		},
+		BlobStorageDir: getEnvWithDefault("BLOB_STORAGE_DIR", cmd.DefaultBlobStorageDir),
+		Rewards: cmd.RewardsConfig{
+			PointsPerReward:  getEnvIntWithDefault("REWARD_POINTS_PER_REWARD", 0),
+			EasyMultiplier:   getEnvFloat("REWARD_MULTIPLIER_EASY"),
+			MediumMultiplier: getEnvFloat("REWARD_MULTIPLIER_MEDIUM"),
+			HardMultiplier:   getEnvFloat("REWARD_MULTIPLIER_HARD"),
+		},
+		AchievementsFile: os.Getenv("ACHIEVEMENTS_FILE"),
+
+		QuestTemplateSchedulerInterval: getEnvDurationWithDefault("QUEST_TEMPLATE_SCHEDULER_INTERVAL", cmd.DefaultQuestTemplateSchedulerInterval),
 
 		// Middleware configuration
 		Middleware: cmd.MiddlewareConfig{
@@ -107,6 +138,44 @@ func getEnvInt(key string) int {
 	return intVal
 }
 
+func getEnvIntWithDefault(key string, defaultValue int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	intVal, err := strconv.Atoi(val)
+	if err != nil {
+		log.Fatalf("Invalid integer value for env var %s: %s", key, val)
+	}
+	return intVal
+}
+
+// getEnvFloat returns 0 when the variable is unset
+func getEnvFloat(key string) float64 {
+	val := os.Getenv(key)
+	if val == "" {
+		return 0
+	}
+	floatVal, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		log.Fatalf("Invalid float value for env var %s: %s", key, val)
+	}
+	return floatVal
+}
+
+// getEnvDurationWithDefault parses values like "30s" or "5m"; "0" is a zero duration
+func getEnvDurationWithDefault(key string, defaultValue time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	durationVal, err := time.ParseDuration(val)
+	if err != nil {
+		log.Fatalf("Invalid duration value for env var %s: %s", key, val)
+	}
+	return durationVal
+}
+
 func getEnvBool(key string, defaultValue bool) bool {
 	val := os.Getenv(key)
 	if val == "" {
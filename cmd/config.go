@@ -1,11 +1,46 @@
 package cmd
 
+import (
+	"fmt"
+	"time"
+
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
 const (
 	// DefaultDevAuthHeaderName is the default header name for dev auth
 	DefaultDevAuthHeaderName = "X-Dev-User-ID"
 
 	// DefaultDevAuthStaticUserID is the default static user ID for dev auth
 	DefaultDevAuthStaticUserID = "00000000-0000-0000-0000-000000000001"
+
+	// GeoBackendSQL runs geospatial queries with plain SQL and in-process distance filtering
+	GeoBackendSQL = "sql"
+
+	// GeoBackendPostGIS runs geospatial queries in PostGIS (requires the postgis extension)
+	GeoBackendPostGIS = "postgis"
+
+	// GeocoderNone disables the geocoding provider; only stored locations are used
+	GeocoderNone = "none"
+
+	// GeocoderNominatim uses a Nominatim-compatible HTTP API
+	GeocoderNominatim = "nominatim"
+
+	// GeocoderFile uses an offline list of places loaded from a JSON file
+	GeocoderFile = "file"
+
+	// DefaultGeocoderURL is the public Nominatim instance
+	DefaultGeocoderURL = "https://nominatim.openstreetmap.org"
+
+	// DefaultGeocoderUserAgent identifies the service to the geocoding provider
+	DefaultGeocoderUserAgent = "quest-manager"
+
+	// DefaultBlobStorageDir is where evidence photos are stored by default
+	DefaultBlobStorageDir = "data/blobs"
+
+	// DefaultQuestTemplateSchedulerInterval is how often due quest template occurrences are checked by default
+	DefaultQuestTemplateSchedulerInterval = time.Minute
 )
 
 type Config struct {
@@ -19,15 +54,64 @@ type Config struct {
 	EventGoroutineLimit int
 	AuthGRPC            string
 
+	// GeoBackend selects the geospatial query implementation: "sql" (default) or "postgis"
+	GeoBackend string
+
+	// Geocoder configures address <-> coordinate lookups for quest locations
+	Geocoder GeocoderConfig
+
+	// BlobStorageDir is the local directory for binary content such as evidence photos
+	BlobStorageDir string
+
+	// Rewards configures how many points a completed quest credits to the ledger
+	Rewards RewardsConfig
+
+	// AchievementsFile is the path to a YAML file with achievement definitions;
+	// empty uses the default achievements
+	AchievementsFile string
+
+	// QuestTemplateSchedulerInterval is how often quests are created for due
+	// quest template occurrences; zero or less disables the scheduler
+	QuestTemplateSchedulerInterval time.Duration
+
 	// Middleware configuration
 	Middleware MiddlewareConfig
 }
 
+// RewardsConfig contains the reward formula settings; zero values fall back to the defaults
+type RewardsConfig struct {
+	// PointsPerReward is the number of points per unit of quest reward
+	PointsPerReward int
+
+	// EasyMultiplier, MediumMultiplier and HardMultiplier scale points by quest difficulty
+	EasyMultiplier   float64
+	MediumMultiplier float64
+	HardMultiplier   float64
+}
+
 // MiddlewareConfig contains configuration for HTTP middlewares
 type MiddlewareConfig struct {
 	DevAuth DevAuthConfig
 }
 
+// GeocoderConfig contains configuration for the geocoding provider
+type GeocoderConfig struct {
+	// Provider selects the implementation: "none" (default), "nominatim" or "file"
+	Provider string
+
+	// URL is the base URL of the Nominatim-compatible API
+	// Only used when Provider="nominatim"
+	URL string
+
+	// UserAgent is sent with every request, as required by the Nominatim usage policy
+	// Only used when Provider="nominatim"
+	UserAgent string
+
+	// File is the path to a JSON array of places ({"address", "latitude", "longitude"})
+	// Only used when Provider="file"
+	File string
+}
+
 // DevAuthConfig contains configuration for development/testing authentication
 type DevAuthConfig struct {
 	// Enabled enables development authentication mode (mock auth without gRPC)
@@ -45,3 +129,39 @@ type DevAuthConfig struct {
 	// Default: "00000000-0000-0000-0000-000000000001"
 	StaticUserID string
 }
+
+// UsePostGIS reports whether PostGIS-backed repositories are configured.
+// Returns an error for unknown GeoBackend values.
+func (c Config) UsePostGIS() (bool, error) {
+	switch c.GeoBackend {
+	case "", GeoBackendSQL:
+		return false, nil
+	case GeoBackendPostGIS:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown geo backend %q, expected %q or %q", c.GeoBackend, GeoBackendSQL, GeoBackendPostGIS)
+	}
+}
+
+// RewardFormula builds the ledger reward formula, filling unset values with defaults.
+// Returns an error if the resulting formula would not grant positive points.
+func (c Config) RewardFormula() (ledger.RewardFormula, error) {
+	formula := ledger.DefaultRewardFormula()
+	if c.Rewards.PointsPerReward != 0 {
+		formula.PointsPerReward = c.Rewards.PointsPerReward
+	}
+	multipliers := map[quest.Difficulty]float64{
+		quest.DifficultyEasy:   c.Rewards.EasyMultiplier,
+		quest.DifficultyMedium: c.Rewards.MediumMultiplier,
+		quest.DifficultyHard:   c.Rewards.HardMultiplier,
+	}
+	for difficulty, multiplier := range multipliers {
+		if multiplier != 0 {
+			formula.DifficultyMultipliers[difficulty] = multiplier
+		}
+	}
+	if err := formula.Validate(); err != nil {
+		return ledger.RewardFormula{}, fmt.Errorf("invalid reward formula: %w", err)
+	}
+	return formula, nil
+}
@@ -11,11 +11,18 @@ import (
 
 	v1 "quest-manager/api/http/quests/v1"
 	httphandlers "quest-manager/internal/adapters/in/http"
+	"quest-manager/internal/adapters/in/scheduler"
+	"quest-manager/internal/adapters/out/blobstorage"
 	authclient "quest-manager/internal/adapters/out/client/auth"
+	"quest-manager/internal/adapters/out/client/geocoder"
+	"quest-manager/internal/adapters/out/notifier"
 	"quest-manager/internal/adapters/out/postgres"
 	"quest-manager/internal/adapters/out/postgres/eventrepo"
+	"quest-manager/internal/core/application/eventhandlers"
 	"quest-manager/internal/core/application/usecases/commands"
 	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/ledger"
 	"quest-manager/internal/core/ports"
 )
 
@@ -26,23 +33,49 @@ type Container struct {
 	unitOfWork     ports.UnitOfWork
 	eventPublisher ports.EventPublisher
 	authClient     ports.AuthClient
+	geocoder       ports.Geocoder
+	blobStorage    ports.BlobStorage
+	rewardFormula  ledger.RewardFormula
+	achievements   []achievement.Definition
 	closers        []Closer
 }
 
 // NewContainer creates a new dependency injection container.
 // Initializes all dependencies including auth client (eager initialization).
 func NewContainer(configs Config, db *gorm.DB) (*Container, error) {
-	unitOfWork, err := postgres.NewUnitOfWork(db)
+	usePostGIS, err := configs.UsePostGIS()
+	if err != nil {
+		return nil, err
+	}
+
+	unitOfWork, err := postgres.NewUnitOfWork(db, postgres.WithPostGIS(usePostGIS))
 	if err != nil {
 		return nil, fmt.Errorf("create unit of work: %w", err)
 	}
 
-	eventPublisher, err := eventrepo.NewRepository(
-		unitOfWork.(ports.Tracker),
-		configs.EventGoroutineLimit,
-	)
+	rewardFormula, err := configs.RewardFormula()
 	if err != nil {
-		return nil, fmt.Errorf("create event publisher: %w", err)
+		return nil, err
+	}
+
+	achievements, err := configs.AchievementDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	eventPublisher, err := newEventPublisher(unitOfWork, configs.EventGoroutineLimit, rewardFormula, achievements)
+	if err != nil {
+		return nil, err
+	}
+
+	geocoderClient, err := createGeocoder(configs.Geocoder)
+	if err != nil {
+		return nil, fmt.Errorf("create geocoder: %w", err)
+	}
+
+	blobStorage, err := blobstorage.NewLocalStorage(configs.BlobStorageDir)
+	if err != nil {
+		return nil, fmt.Errorf("create blob storage: %w", err)
 	}
 
 	container := &Container{
@@ -50,6 +83,10 @@ func NewContainer(configs Config, db *gorm.DB) (*Container, error) {
 		db:             db,
 		unitOfWork:     unitOfWork,
 		eventPublisher: eventPublisher,
+		geocoder:       geocoderClient,
+		blobStorage:    blobStorage,
+		rewardFormula:  rewardFormula,
+		achievements:   achievements,
 	}
 
 	if !configs.Middleware.DevAuth.Enabled {
@@ -60,6 +97,37 @@ func NewContainer(configs Config, db *gorm.DB) (*Container, error) {
 	return container, nil
 }
 
+// newEventPublisher creates the event dispatcher of a UnitOfWork.
+// Events are stored by the event repository and then handled in the same transaction.
+func newEventPublisher(
+	unitOfWork ports.UnitOfWork,
+	goroutineLimit int,
+	rewardFormula ledger.RewardFormula,
+	achievements []achievement.Definition,
+) (ports.EventPublisher, error) {
+	eventRepo, err := eventrepo.NewRepository(unitOfWork.(ports.Tracker), goroutineLimit)
+	if err != nil {
+		return nil, fmt.Errorf("create event publisher: %w", err)
+	}
+
+	eventPublisher, err := eventhandlers.NewDispatcher(eventRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create event dispatcher: %w", err)
+	}
+	eventPublisher.Subscribe("quest.status_changed",
+		eventhandlers.NewQuestRewardHandler(unitOfWork, eventPublisher, rewardFormula))
+	eventPublisher.Subscribe("ledger.points_credited", eventhandlers.NewLeaderboardHandler(unitOfWork))
+	achievementHandler := eventhandlers.NewAchievementHandler(unitOfWork, eventPublisher, achievements)
+	eventPublisher.Subscribe("quest.assigned", achievementHandler)
+	eventPublisher.Subscribe("quest.status_changed", achievementHandler)
+	notificationHandler := eventhandlers.NewNotificationHandler(unitOfWork, notifier.NewLogChannel())
+	eventPublisher.Subscribe("quest.assigned", notificationHandler)
+	eventPublisher.Subscribe("quest.status_changed", notificationHandler)
+	eventPublisher.Subscribe("quest.comment_added", notificationHandler)
+	eventPublisher.Subscribe("quest.status_changed", eventhandlers.NewPrerequisiteHandler(unitOfWork, eventPublisher))
+	return eventPublisher, nil
+}
+
 // Cfg returns configuration.
 func (c *Container) Cfg() Config { return c.configs }
 
@@ -82,6 +150,83 @@ func (c *Container) SetAuthClient(client ports.AuthClient) {
 	c.authClient = client
 }
 
+// Geocoder returns the configured geocoder.
+func (c *Container) Geocoder() ports.Geocoder { return c.geocoder }
+
+// SetGeocoder allows injecting a custom geocoder (for testing).
+func (c *Container) SetGeocoder(g ports.Geocoder) {
+	c.geocoder = g
+}
+
+// BlobStorage returns the storage for evidence photos.
+func (c *Container) BlobStorage() ports.BlobStorage { return c.blobStorage }
+
+// SetBlobStorage allows injecting a custom blob storage (for testing).
+func (c *Container) SetBlobStorage(s ports.BlobStorage) {
+	c.blobStorage = s
+}
+
+// StartQuestTemplateScheduler starts creating the quests of due quest template occurrences
+// in the background, unless the scheduler is disabled. It is stopped by CloseAll.
+func (c *Container) StartQuestTemplateScheduler() error {
+	if c.configs.QuestTemplateSchedulerInterval <= 0 {
+		return nil
+	}
+
+	handler, err := c.newCreateScheduledQuestsHandler()
+	if err != nil {
+		return err
+	}
+
+	s, err := scheduler.NewQuestTemplateScheduler(handler, c.configs.QuestTemplateSchedulerInterval)
+	if err != nil {
+		return fmt.Errorf("create quest template scheduler: %w", err)
+	}
+	s.Start()
+	c.RegisterCloser(s)
+	return nil
+}
+
+// newCreateScheduledQuestsHandler creates the handler run by the scheduler.
+// The scheduler runs alongside HTTP requests, so it gets its own UnitOfWork,
+// repositories and event dispatcher instead of sharing the request transaction.
+func (c *Container) newCreateScheduledQuestsHandler() (commands.CreateScheduledQuestsCommandHandler, error) {
+	usePostGIS, err := c.configs.UsePostGIS()
+	if err != nil {
+		return nil, err
+	}
+
+	unitOfWork, err := postgres.NewUnitOfWork(c.db, postgres.WithPostGIS(usePostGIS))
+	if err != nil {
+		return nil, fmt.Errorf("create scheduler unit of work: %w", err)
+	}
+
+	eventPublisher, err := newEventPublisher(unitOfWork, c.configs.EventGoroutineLimit, c.rewardFormula, c.achievements)
+	if err != nil {
+		return nil, err
+	}
+
+	return commands.NewCreateScheduledQuestsCommandHandler(unitOfWork, eventPublisher, c.geocoder), nil
+}
+
+// createGeocoder selects the geocoding provider.
+// Returns an error for unknown providers or a missing places file.
+func createGeocoder(cfg GeocoderConfig) (ports.Geocoder, error) {
+	switch cfg.Provider {
+	case "", GeocoderNone:
+		return &ports.NullGeocoder{}, nil
+	case GeocoderNominatim:
+		return geocoder.NewNominatimGeocoder(cfg.URL, cfg.UserAgent, nil), nil
+	case GeocoderFile:
+		if cfg.File == "" {
+			return nil, fmt.Errorf("geocoder %q requires a places file", GeocoderFile)
+		}
+		return geocoder.NewFileGeocoder(cfg.File)
+	default:
+		return nil, fmt.Errorf("unknown geocoder %q, expected %q, %q or %q", cfg.Provider, GeocoderNone, GeocoderNominatim, GeocoderFile)
+	}
+}
+
 // createAuthClient creates and initializes auth gRPC client (internal helper).
 func (c *Container) createAuthClient() (ports.AuthClient, error) {
 	// Create gRPC connection
@@ -110,6 +255,56 @@ func (c *Container) LocationRepository() ports.LocationRepository {
 	return c.unitOfWork.LocationRepository()
 }
 
+// UserRepository returns repository from the single UoW.
+func (c *Container) UserRepository() ports.UserRepository {
+	return c.unitOfWork.UserRepository()
+}
+
+// ApplicationRepository returns repository from the single UoW.
+func (c *Container) ApplicationRepository() ports.ApplicationRepository {
+	return c.unitOfWork.ApplicationRepository()
+}
+
+// EvidenceRepository returns repository from the single UoW.
+func (c *Container) EvidenceRepository() ports.EvidenceRepository {
+	return c.unitOfWork.EvidenceRepository()
+}
+
+// LedgerRepository returns repository from the single UoW.
+func (c *Container) LedgerRepository() ports.LedgerRepository {
+	return c.unitOfWork.LedgerRepository()
+}
+
+// LeaderboardRepository returns repository from the single UoW.
+func (c *Container) LeaderboardRepository() ports.LeaderboardRepository {
+	return c.unitOfWork.LeaderboardRepository()
+}
+
+// AchievementRepository returns repository from the single UoW.
+func (c *Container) AchievementRepository() ports.AchievementRepository {
+	return c.unitOfWork.AchievementRepository()
+}
+
+// ReviewRepository returns repository from the single UoW.
+func (c *Container) ReviewRepository() ports.ReviewRepository {
+	return c.unitOfWork.ReviewRepository()
+}
+
+// CommentRepository returns repository from the single UoW.
+func (c *Container) CommentRepository() ports.CommentRepository {
+	return c.unitOfWork.CommentRepository()
+}
+
+// NotificationRepository returns repository from the single UoW.
+func (c *Container) NotificationRepository() ports.NotificationRepository {
+	return c.unitOfWork.NotificationRepository()
+}
+
+// QuestTemplateRepository returns repository from the single UoW.
+func (c *Container) QuestTemplateRepository() ports.QuestTemplateRepository {
+	return c.unitOfWork.QuestTemplateRepository()
+}
+
 // Handlers groups all command/query handlers for API wiring.
 type Handlers struct {
 	CreateQuest       commands.CreateQuestCommandHandler
@@ -118,19 +313,102 @@ type Handlers struct {
 	ChangeQuestStatus commands.ChangeQuestStatusCommandHandler
 	AssignQuest       commands.AssignQuestCommandHandler
 	SearchByRadius    queries.SearchQuestsByRadiusQueryHandler
+	SearchByArea      queries.SearchQuestsByAreaQueryHandler
+	GetQuestTile      queries.GetQuestTileQueryHandler
 	ListAssigned      queries.ListAssignedQuestsQueryHandler
+	Recommend         queries.RecommendQuestsQueryHandler
+	GetUserProfile    queries.GetUserProfileQueryHandler
+	UpdateUserProfile commands.UpdateUserProfileCommandHandler
+	ApplyToQuest      commands.ApplyToQuestCommandHandler
+	ListApplications  queries.ListQuestApplicationsQueryHandler
+	ReviewApplication commands.ReviewApplicationCommandHandler
+	SubmitEvidence    commands.SubmitCompletionEvidenceCommandHandler
+	ListEvidence      queries.ListCompletionEvidenceQueryHandler
+	GetAttachment     queries.GetEvidenceAttachmentQueryHandler
+	ReviewCompletion  commands.ReviewCompletionCommandHandler
+	GetBalance        queries.GetBalanceQueryHandler
+	ListLedger        queries.ListLedgerEntriesQueryHandler
+	GetLeaderboard    queries.GetLeaderboardQueryHandler
+	ListAchievements  queries.ListAchievementsQueryHandler
+	SubmitReview      commands.SubmitReviewCommandHandler
+	ListReviews       queries.ListQuestReviewsQueryHandler
+	GetReputations    queries.GetReputationsQueryHandler
+	AddComment        commands.AddQuestCommentCommandHandler
+	EditComment       commands.EditQuestCommentCommandHandler
+	ListComments      queries.ListQuestCommentsQueryHandler
+
+	ListNotifications             queries.ListNotificationsQueryHandler
+	MarkNotificationRead          commands.MarkNotificationReadCommandHandler
+	MarkAllNotificationsRead      commands.MarkAllNotificationsReadCommandHandler
+	GetNotificationPreferences    queries.GetNotificationPreferencesQueryHandler
+	UpdateNotificationPreferences commands.UpdateNotificationPreferencesCommandHandler
+
+	CreateQuestTemplate     commands.CreateQuestTemplateCommandHandler
+	ListQuestTemplates      queries.ListQuestTemplatesQueryHandler
+	GetQuestTemplate        queries.GetQuestTemplateQueryHandler
+	UpdateQuestTemplate     commands.UpdateQuestTemplateCommandHandler
+	DeleteQuestTemplate     commands.DeleteQuestTemplateCommandHandler
+	CreateQuestFromTemplate commands.CreateQuestFromTemplateCommandHandler
+
+	AddPrerequisite    commands.AddQuestPrerequisiteCommandHandler
+	RemovePrerequisite commands.RemoveQuestPrerequisiteCommandHandler
+	GetDependencies    queries.GetQuestDependenciesQueryHandler
+
+	AutocompleteLocations queries.AutocompleteLocationsQueryHandler
 }
 
 // Handlers initializes all application handlers.
 func (c *Container) Handlers() Handlers {
+	createQuest := commands.NewCreateQuestCommandHandler(c.unitOfWork, c.eventPublisher, c.geocoder)
 	return Handlers{
-		CreateQuest:       commands.NewCreateQuestCommandHandler(c.unitOfWork, c.eventPublisher),
+		CreateQuest:       createQuest,
 		ListQuests:        queries.NewListQuestsQueryHandler(c.QuestRepository()),
 		GetQuestByID:      queries.NewGetQuestByIDQueryHandler(c.QuestRepository()),
 		ChangeQuestStatus: commands.NewChangeQuestStatusCommandHandler(c.unitOfWork, c.eventPublisher),
 		AssignQuest:       commands.NewAssignQuestCommandHandler(c.unitOfWork, c.eventPublisher),
 		SearchByRadius:    queries.NewSearchQuestsByRadiusQueryHandler(c.QuestRepository()),
+		SearchByArea:      queries.NewSearchQuestsByAreaQueryHandler(c.QuestRepository()),
+		GetQuestTile:      queries.NewGetQuestTileQueryHandler(c.QuestRepository()),
 		ListAssigned:      queries.NewListAssignedQuestsQueryHandler(c.QuestRepository()),
+		Recommend:         queries.NewRecommendQuestsQueryHandler(c.QuestRepository(), c.UserRepository()),
+		GetUserProfile:    queries.NewGetUserProfileQueryHandler(c.UserRepository()),
+		UpdateUserProfile: commands.NewUpdateUserProfileCommandHandler(c.unitOfWork, c.eventPublisher),
+		ApplyToQuest:      commands.NewApplyToQuestCommandHandler(c.unitOfWork, c.eventPublisher),
+		ListApplications:  queries.NewListQuestApplicationsQueryHandler(c.QuestRepository(), c.ApplicationRepository()),
+		ReviewApplication: commands.NewReviewApplicationCommandHandler(c.unitOfWork, c.eventPublisher),
+		SubmitEvidence:    commands.NewSubmitCompletionEvidenceCommandHandler(c.unitOfWork, c.eventPublisher, c.blobStorage),
+		ListEvidence:      queries.NewListCompletionEvidenceQueryHandler(c.QuestRepository(), c.EvidenceRepository()),
+		GetAttachment:     queries.NewGetEvidenceAttachmentQueryHandler(c.QuestRepository(), c.EvidenceRepository(), c.blobStorage),
+		ReviewCompletion:  commands.NewReviewCompletionCommandHandler(c.unitOfWork, c.eventPublisher),
+		GetBalance:        queries.NewGetBalanceQueryHandler(c.LedgerRepository()),
+		ListLedger:        queries.NewListLedgerEntriesQueryHandler(c.LedgerRepository()),
+		GetLeaderboard:    queries.NewGetLeaderboardQueryHandler(c.LeaderboardRepository()),
+		ListAchievements:  queries.NewListAchievementsQueryHandler(c.AchievementRepository(), c.achievements),
+		SubmitReview:      commands.NewSubmitReviewCommandHandler(c.unitOfWork, c.eventPublisher),
+		ListReviews:       queries.NewListQuestReviewsQueryHandler(c.QuestRepository(), c.ReviewRepository()),
+		GetReputations:    queries.NewGetReputationsQueryHandler(c.ReviewRepository()),
+		AddComment:        commands.NewAddQuestCommentCommandHandler(c.unitOfWork, c.eventPublisher),
+		EditComment:       commands.NewEditQuestCommentCommandHandler(c.unitOfWork),
+		ListComments:      queries.NewListQuestCommentsQueryHandler(c.QuestRepository(), c.CommentRepository()),
+
+		ListNotifications:             queries.NewListNotificationsQueryHandler(c.NotificationRepository()),
+		MarkNotificationRead:          commands.NewMarkNotificationReadCommandHandler(c.unitOfWork),
+		MarkAllNotificationsRead:      commands.NewMarkAllNotificationsReadCommandHandler(c.unitOfWork),
+		GetNotificationPreferences:    queries.NewGetNotificationPreferencesQueryHandler(c.NotificationRepository()),
+		UpdateNotificationPreferences: commands.NewUpdateNotificationPreferencesCommandHandler(c.unitOfWork),
+
+		CreateQuestTemplate:     commands.NewCreateQuestTemplateCommandHandler(c.unitOfWork),
+		ListQuestTemplates:      queries.NewListQuestTemplatesQueryHandler(c.QuestTemplateRepository()),
+		GetQuestTemplate:        queries.NewGetQuestTemplateQueryHandler(c.QuestTemplateRepository()),
+		UpdateQuestTemplate:     commands.NewUpdateQuestTemplateCommandHandler(c.unitOfWork),
+		DeleteQuestTemplate:     commands.NewDeleteQuestTemplateCommandHandler(c.unitOfWork),
+		CreateQuestFromTemplate: commands.NewCreateQuestFromTemplateCommandHandler(c.unitOfWork, createQuest),
+
+		AddPrerequisite:    commands.NewAddQuestPrerequisiteCommandHandler(c.unitOfWork),
+		RemovePrerequisite: commands.NewRemoveQuestPrerequisiteCommandHandler(c.unitOfWork),
+		GetDependencies:    queries.NewGetQuestDependenciesQueryHandler(c.QuestRepository()),
+
+		AutocompleteLocations: queries.NewAutocompleteLocationsQueryHandler(c.LocationRepository()),
 	}
 }
 
@@ -145,6 +423,43 @@ func (c *Container) NewApiHandler() (v1.StrictServerInterface, error) {
 		h.SearchByRadius,
 		h.ListAssigned,
 		h.AssignQuest,
+		h.AutocompleteLocations,
+		h.SearchByArea,
+		h.GetQuestTile,
+		h.Recommend,
+		h.GetUserProfile,
+		h.UpdateUserProfile,
+		h.ApplyToQuest,
+		h.ListApplications,
+		h.ReviewApplication,
+		h.SubmitEvidence,
+		h.ListEvidence,
+		h.GetAttachment,
+		h.ReviewCompletion,
+		h.GetBalance,
+		h.ListLedger,
+		h.GetLeaderboard,
+		h.ListAchievements,
+		h.SubmitReview,
+		h.ListReviews,
+		h.GetReputations,
+		h.AddComment,
+		h.EditComment,
+		h.ListComments,
+		h.ListNotifications,
+		h.MarkNotificationRead,
+		h.MarkAllNotificationsRead,
+		h.GetNotificationPreferences,
+		h.UpdateNotificationPreferences,
+		h.CreateQuestTemplate,
+		h.ListQuestTemplates,
+		h.GetQuestTemplate,
+		h.UpdateQuestTemplate,
+		h.DeleteQuestTemplate,
+		h.CreateQuestFromTemplate,
+		h.AddPrerequisite,
+		h.RemovePrerequisite,
+		h.GetDependencies,
 	)
 }
 
@@ -8,9 +8,19 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"quest-manager/internal/adapters/out/postgres/achievementrepo"
+	"quest-manager/internal/adapters/out/postgres/applicationrepo"
+	"quest-manager/internal/adapters/out/postgres/commentrepo"
 	"quest-manager/internal/adapters/out/postgres/eventrepo"
+	"quest-manager/internal/adapters/out/postgres/evidencerepo"
+	"quest-manager/internal/adapters/out/postgres/leaderboardrepo"
+	"quest-manager/internal/adapters/out/postgres/ledgerrepo"
 	"quest-manager/internal/adapters/out/postgres/locationrepo"
+	"quest-manager/internal/adapters/out/postgres/notificationrepo"
 	"quest-manager/internal/adapters/out/postgres/questrepo"
+	"quest-manager/internal/adapters/out/postgres/reviewrepo"
+	"quest-manager/internal/adapters/out/postgres/templaterepo"
+	"quest-manager/internal/adapters/out/postgres/userrepo"
 	"quest-manager/internal/pkg/errs"
 
 	_ "github.com/lib/pq"
@@ -108,12 +118,97 @@ func MustAutoMigrate(db *gorm.DB) {
 	if err != nil {
 		log.Fatalf("Ошибка миграции QuestDTO: %v", err)
 	}
+	err = questrepo.MigrateWaypoints(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции точек маршрута квестов: %v", err)
+	}
+	err = questrepo.MigrateParticipants(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции участников квестов: %v", err)
+	}
+	err = questrepo.MigratePrerequisites(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции предварительных условий квестов: %v", err)
+	}
+	err = questrepo.MigrateGeohash(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции геохешей квестов: %v", err)
+	}
+	err = questrepo.MigrateRouteLength(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции длины маршрутов квестов: %v", err)
+	}
 	err = db.AutoMigrate(&locationrepo.LocationDTO{})
 	if err != nil {
 		log.Fatalf("Ошибка миграции LocationDTO: %v", err)
 	}
+	err = locationrepo.MigrateGeohash(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции геохешей локаций: %v", err)
+	}
+	err = locationrepo.MigrateSearchIndexes(db)
+	if err != nil {
+		log.Fatalf("Ошибка создания индексов поиска локаций: %v", err)
+	}
+	err = db.AutoMigrate(&userrepo.UserDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции UserDTO: %v", err)
+	}
+	err = db.AutoMigrate(&applicationrepo.ApplicationDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции ApplicationDTO: %v", err)
+	}
+	err = evidencerepo.MigrateEvidence(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции доказательств выполнения квестов: %v", err)
+	}
+	err = db.AutoMigrate(&ledgerrepo.EntryDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции журнала начислений: %v", err)
+	}
+	err = db.AutoMigrate(&leaderboardrepo.ScoreDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции таблицы лидербордов: %v", err)
+	}
+	err = db.AutoMigrate(&achievementrepo.FactDTO{}, &achievementrepo.UnlockedDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции таблиц достижений: %v", err)
+	}
+	err = db.AutoMigrate(&reviewrepo.ReviewDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции отзывов: %v", err)
+	}
+	err = db.AutoMigrate(&commentrepo.CommentDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции комментариев: %v", err)
+	}
+	err = db.AutoMigrate(&notificationrepo.NotificationDTO{}, &notificationrepo.PreferenceDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции уведомлений: %v", err)
+	}
+	err = db.AutoMigrate(&templaterepo.QuestTemplateDTO{}, &templaterepo.OccurrenceDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции шаблонов квестов: %v", err)
+	}
 	err = db.AutoMigrate(&eventrepo.EventDTO{})
 	if err != nil {
 		log.Fatalf("Ошибка миграции EventDTO: %v", err)
 	}
 }
+
+// MustMigratePostGIS enables PostGIS and creates the geography columns and indexes.
+// Must be called after MustAutoMigrate when GeoBackend is "postgis".
+func MustMigratePostGIS(db *gorm.DB) {
+	err := questrepo.MigratePostGIS(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции PostGIS для квестов: %v", err)
+	}
+	err = locationrepo.MigratePostGIS(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции PostGIS для локаций: %v", err)
+	}
+	err = leaderboardrepo.MigratePostGIS(db)
+	if err != nil {
+		log.Fatalf("Ошибка миграции PostGIS для лидербордов: %v", err)
+	}
+}
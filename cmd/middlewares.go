@@ -42,6 +42,10 @@ func (c *Container) Middlewares(swagger *openapi3.T) []func(http.Handler) http.H
 			middlewares = append(middlewares, validationMW.Validate)
 		}
 	}
+
+	// 3. Content negotiation (Accept header) - always enabled
+	middlewares = append(middlewares, httpmiddleware.ContentNegotiation)
+
 	return middlewares
 }
 
@@ -108,6 +108,15 @@ func NewRouter(root *Container) http.Handler {
 				return
 			}
 
+			// Check if it's a forbidden error from application layer
+			var forbiddenErr *errs.ForbiddenError
+			if errors.As(err, &forbiddenErr) {
+				// Convert to 403 Forbidden
+				problem := httperrors.NewForbiddenProblem(forbiddenErr)
+				problem.WriteResponse(w)
+				return
+			}
+
 			// Handle other response errors
 			problem := httperrors.NewBadRequest("Response error: " + err.Error())
 			problem.WriteResponse(w)
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+)
+
+// ListMyAchievements implements GET /api/v1/me/achievements from OpenAPI.
+func (a *ApiHandler) ListMyAchievements(ctx context.Context, request v1.ListMyAchievementsRequestObject) (v1.ListMyAchievementsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	achievements, err := a.listAchievementsHandler.Handle(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListMyAchievements200JSONResponse, 0, len(achievements))
+	for _, item := range achievements {
+		response = append(response, AchievementToAPI(item))
+	}
+	return response, nil
+}
@@ -14,6 +14,51 @@ type ApiHandler struct {
 	searchQuestsByRadius      queries.SearchQuestsByRadiusQueryHandler
 	listAssignedQuestsHandler queries.ListAssignedQuestsQueryHandler
 	assignQuestHandler        commands.AssignQuestCommandHandler
+
+	autocompleteLocationsHandler queries.AutocompleteLocationsQueryHandler
+	searchQuestsByAreaHandler    queries.SearchQuestsByAreaQueryHandler
+	getQuestTileHandler          queries.GetQuestTileQueryHandler
+	recommendQuestsHandler       queries.RecommendQuestsQueryHandler
+	getUserProfileHandler        queries.GetUserProfileQueryHandler
+	updateUserProfileHandler     commands.UpdateUserProfileCommandHandler
+	applyToQuestHandler          commands.ApplyToQuestCommandHandler
+	listQuestApplicationsHandler queries.ListQuestApplicationsQueryHandler
+	reviewApplicationHandler     commands.ReviewApplicationCommandHandler
+
+	submitCompletionEvidenceHandler commands.SubmitCompletionEvidenceCommandHandler
+	listCompletionEvidenceHandler   queries.ListCompletionEvidenceQueryHandler
+	getEvidenceAttachmentHandler    queries.GetEvidenceAttachmentQueryHandler
+	reviewCompletionHandler         commands.ReviewCompletionCommandHandler
+
+	getBalanceHandler        queries.GetBalanceQueryHandler
+	listLedgerEntriesHandler queries.ListLedgerEntriesQueryHandler
+	getLeaderboardHandler    queries.GetLeaderboardQueryHandler
+	listAchievementsHandler  queries.ListAchievementsQueryHandler
+
+	submitReviewHandler     commands.SubmitReviewCommandHandler
+	listQuestReviewsHandler queries.ListQuestReviewsQueryHandler
+	getReputationsHandler   queries.GetReputationsQueryHandler
+
+	addQuestCommentHandler   commands.AddQuestCommentCommandHandler
+	editQuestCommentHandler  commands.EditQuestCommentCommandHandler
+	listQuestCommentsHandler queries.ListQuestCommentsQueryHandler
+
+	listNotificationsHandler             queries.ListNotificationsQueryHandler
+	markNotificationReadHandler          commands.MarkNotificationReadCommandHandler
+	markAllNotificationsReadHandler      commands.MarkAllNotificationsReadCommandHandler
+	getNotificationPreferencesHandler    queries.GetNotificationPreferencesQueryHandler
+	updateNotificationPreferencesHandler commands.UpdateNotificationPreferencesCommandHandler
+
+	createQuestTemplateHandler     commands.CreateQuestTemplateCommandHandler
+	listQuestTemplatesHandler      queries.ListQuestTemplatesQueryHandler
+	getQuestTemplateHandler        queries.GetQuestTemplateQueryHandler
+	updateQuestTemplateHandler     commands.UpdateQuestTemplateCommandHandler
+	deleteQuestTemplateHandler     commands.DeleteQuestTemplateCommandHandler
+	createQuestFromTemplateHandler commands.CreateQuestFromTemplateCommandHandler
+
+	addQuestPrerequisiteHandler    commands.AddQuestPrerequisiteCommandHandler
+	removeQuestPrerequisiteHandler commands.RemoveQuestPrerequisiteCommandHandler
+	getQuestDependenciesHandler    queries.GetQuestDependenciesQueryHandler
 }
 
 func NewApiHandler(
@@ -24,6 +69,43 @@ func NewApiHandler(
 	searchQuestsByRadius queries.SearchQuestsByRadiusQueryHandler,
 	listAssignedQuestsHandler queries.ListAssignedQuestsQueryHandler,
 	assignQuestHandler commands.AssignQuestCommandHandler,
+	autocompleteLocationsHandler queries.AutocompleteLocationsQueryHandler,
+	searchQuestsByAreaHandler queries.SearchQuestsByAreaQueryHandler,
+	getQuestTileHandler queries.GetQuestTileQueryHandler,
+	recommendQuestsHandler queries.RecommendQuestsQueryHandler,
+	getUserProfileHandler queries.GetUserProfileQueryHandler,
+	updateUserProfileHandler commands.UpdateUserProfileCommandHandler,
+	applyToQuestHandler commands.ApplyToQuestCommandHandler,
+	listQuestApplicationsHandler queries.ListQuestApplicationsQueryHandler,
+	reviewApplicationHandler commands.ReviewApplicationCommandHandler,
+	submitCompletionEvidenceHandler commands.SubmitCompletionEvidenceCommandHandler,
+	listCompletionEvidenceHandler queries.ListCompletionEvidenceQueryHandler,
+	getEvidenceAttachmentHandler queries.GetEvidenceAttachmentQueryHandler,
+	reviewCompletionHandler commands.ReviewCompletionCommandHandler,
+	getBalanceHandler queries.GetBalanceQueryHandler,
+	listLedgerEntriesHandler queries.ListLedgerEntriesQueryHandler,
+	getLeaderboardHandler queries.GetLeaderboardQueryHandler,
+	listAchievementsHandler queries.ListAchievementsQueryHandler,
+	submitReviewHandler commands.SubmitReviewCommandHandler,
+	listQuestReviewsHandler queries.ListQuestReviewsQueryHandler,
+	getReputationsHandler queries.GetReputationsQueryHandler,
+	addQuestCommentHandler commands.AddQuestCommentCommandHandler,
+	editQuestCommentHandler commands.EditQuestCommentCommandHandler,
+	listQuestCommentsHandler queries.ListQuestCommentsQueryHandler,
+	listNotificationsHandler queries.ListNotificationsQueryHandler,
+	markNotificationReadHandler commands.MarkNotificationReadCommandHandler,
+	markAllNotificationsReadHandler commands.MarkAllNotificationsReadCommandHandler,
+	getNotificationPreferencesHandler queries.GetNotificationPreferencesQueryHandler,
+	updateNotificationPreferencesHandler commands.UpdateNotificationPreferencesCommandHandler,
+	createQuestTemplateHandler commands.CreateQuestTemplateCommandHandler,
+	listQuestTemplatesHandler queries.ListQuestTemplatesQueryHandler,
+	getQuestTemplateHandler queries.GetQuestTemplateQueryHandler,
+	updateQuestTemplateHandler commands.UpdateQuestTemplateCommandHandler,
+	deleteQuestTemplateHandler commands.DeleteQuestTemplateCommandHandler,
+	createQuestFromTemplateHandler commands.CreateQuestFromTemplateCommandHandler,
+	addQuestPrerequisiteHandler commands.AddQuestPrerequisiteCommandHandler,
+	removeQuestPrerequisiteHandler commands.RemoveQuestPrerequisiteCommandHandler,
+	getQuestDependenciesHandler queries.GetQuestDependenciesQueryHandler,
 ) (*ApiHandler, error) {
 	if createQuestHandler == nil {
 		return nil, errs.NewValueIsRequiredError("createQuestHandler")
@@ -46,6 +128,117 @@ func NewApiHandler(
 	if assignQuestHandler == nil {
 		return nil, errs.NewValueIsRequiredError("assignQuestHandler")
 	}
+	if autocompleteLocationsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("autocompleteLocationsHandler")
+	}
+	if searchQuestsByAreaHandler == nil {
+		return nil, errs.NewValueIsRequiredError("searchQuestsByAreaHandler")
+	}
+	if getQuestTileHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getQuestTileHandler")
+	}
+	if recommendQuestsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("recommendQuestsHandler")
+	}
+	if getUserProfileHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getUserProfileHandler")
+	}
+	if updateUserProfileHandler == nil {
+		return nil, errs.NewValueIsRequiredError("updateUserProfileHandler")
+	}
+	if applyToQuestHandler == nil {
+		return nil, errs.NewValueIsRequiredError("applyToQuestHandler")
+	}
+	if listQuestApplicationsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listQuestApplicationsHandler")
+	}
+	if reviewApplicationHandler == nil {
+		return nil, errs.NewValueIsRequiredError("reviewApplicationHandler")
+	}
+	if submitCompletionEvidenceHandler == nil {
+		return nil, errs.NewValueIsRequiredError("submitCompletionEvidenceHandler")
+	}
+	if listCompletionEvidenceHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listCompletionEvidenceHandler")
+	}
+	if getEvidenceAttachmentHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getEvidenceAttachmentHandler")
+	}
+	if reviewCompletionHandler == nil {
+		return nil, errs.NewValueIsRequiredError("reviewCompletionHandler")
+	}
+	if getBalanceHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getBalanceHandler")
+	}
+	if listLedgerEntriesHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listLedgerEntriesHandler")
+	}
+	if getLeaderboardHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getLeaderboardHandler")
+	}
+	if listAchievementsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listAchievementsHandler")
+	}
+	if submitReviewHandler == nil {
+		return nil, errs.NewValueIsRequiredError("submitReviewHandler")
+	}
+	if listQuestReviewsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listQuestReviewsHandler")
+	}
+	if getReputationsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getReputationsHandler")
+	}
+	if addQuestCommentHandler == nil {
+		return nil, errs.NewValueIsRequiredError("addQuestCommentHandler")
+	}
+	if editQuestCommentHandler == nil {
+		return nil, errs.NewValueIsRequiredError("editQuestCommentHandler")
+	}
+	if listQuestCommentsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listQuestCommentsHandler")
+	}
+	if listNotificationsHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listNotificationsHandler")
+	}
+	if markNotificationReadHandler == nil {
+		return nil, errs.NewValueIsRequiredError("markNotificationReadHandler")
+	}
+	if markAllNotificationsReadHandler == nil {
+		return nil, errs.NewValueIsRequiredError("markAllNotificationsReadHandler")
+	}
+	if getNotificationPreferencesHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getNotificationPreferencesHandler")
+	}
+	if updateNotificationPreferencesHandler == nil {
+		return nil, errs.NewValueIsRequiredError("updateNotificationPreferencesHandler")
+	}
+	if createQuestTemplateHandler == nil {
+		return nil, errs.NewValueIsRequiredError("createQuestTemplateHandler")
+	}
+	if listQuestTemplatesHandler == nil {
+		return nil, errs.NewValueIsRequiredError("listQuestTemplatesHandler")
+	}
+	if getQuestTemplateHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getQuestTemplateHandler")
+	}
+	if updateQuestTemplateHandler == nil {
+		return nil, errs.NewValueIsRequiredError("updateQuestTemplateHandler")
+	}
+	if deleteQuestTemplateHandler == nil {
+		return nil, errs.NewValueIsRequiredError("deleteQuestTemplateHandler")
+	}
+	if createQuestFromTemplateHandler == nil {
+		return nil, errs.NewValueIsRequiredError("createQuestFromTemplateHandler")
+	}
+	if addQuestPrerequisiteHandler == nil {
+		return nil, errs.NewValueIsRequiredError("addQuestPrerequisiteHandler")
+	}
+	if removeQuestPrerequisiteHandler == nil {
+		return nil, errs.NewValueIsRequiredError("removeQuestPrerequisiteHandler")
+	}
+	if getQuestDependenciesHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getQuestDependenciesHandler")
+	}
 
 	return &ApiHandler{
 		createQuestHandler:        createQuestHandler,
@@ -55,5 +248,50 @@ func NewApiHandler(
 		searchQuestsByRadius:      searchQuestsByRadius,
 		listAssignedQuestsHandler: listAssignedQuestsHandler,
 		assignQuestHandler:        assignQuestHandler,
+
+		autocompleteLocationsHandler: autocompleteLocationsHandler,
+		searchQuestsByAreaHandler:    searchQuestsByAreaHandler,
+		getQuestTileHandler:          getQuestTileHandler,
+		recommendQuestsHandler:       recommendQuestsHandler,
+		getUserProfileHandler:        getUserProfileHandler,
+		updateUserProfileHandler:     updateUserProfileHandler,
+		applyToQuestHandler:          applyToQuestHandler,
+		listQuestApplicationsHandler: listQuestApplicationsHandler,
+		reviewApplicationHandler:     reviewApplicationHandler,
+
+		submitCompletionEvidenceHandler: submitCompletionEvidenceHandler,
+		listCompletionEvidenceHandler:   listCompletionEvidenceHandler,
+		getEvidenceAttachmentHandler:    getEvidenceAttachmentHandler,
+		reviewCompletionHandler:         reviewCompletionHandler,
+
+		getBalanceHandler:        getBalanceHandler,
+		listLedgerEntriesHandler: listLedgerEntriesHandler,
+		getLeaderboardHandler:    getLeaderboardHandler,
+		listAchievementsHandler:  listAchievementsHandler,
+
+		submitReviewHandler:     submitReviewHandler,
+		listQuestReviewsHandler: listQuestReviewsHandler,
+		getReputationsHandler:   getReputationsHandler,
+
+		addQuestCommentHandler:   addQuestCommentHandler,
+		editQuestCommentHandler:  editQuestCommentHandler,
+		listQuestCommentsHandler: listQuestCommentsHandler,
+
+		listNotificationsHandler:             listNotificationsHandler,
+		markNotificationReadHandler:          markNotificationReadHandler,
+		markAllNotificationsReadHandler:      markAllNotificationsReadHandler,
+		getNotificationPreferencesHandler:    getNotificationPreferencesHandler,
+		updateNotificationPreferencesHandler: updateNotificationPreferencesHandler,
+
+		createQuestTemplateHandler:     createQuestTemplateHandler,
+		listQuestTemplatesHandler:      listQuestTemplatesHandler,
+		getQuestTemplateHandler:        getQuestTemplateHandler,
+		updateQuestTemplateHandler:     updateQuestTemplateHandler,
+		deleteQuestTemplateHandler:     deleteQuestTemplateHandler,
+		createQuestFromTemplateHandler: createQuestFromTemplateHandler,
+
+		addQuestPrerequisiteHandler:    addQuestPrerequisiteHandler,
+		removeQuestPrerequisiteHandler: removeQuestPrerequisiteHandler,
+		getQuestDependenciesHandler:    getQuestDependenciesHandler,
 	}, nil
 }
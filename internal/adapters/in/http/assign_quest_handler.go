@@ -34,6 +34,12 @@ func (a *ApiHandler) AssignQuest(ctx context.Context, request v1.AssignQuestRequ
 		Id:       result.ID,
 		Assignee: result.Assignee,
 		Status:   v1.QuestStatus(result.Status),
+
+		Participants: ParticipantsToAPI(result.Participants),
+	}
+	if len(result.EligibilityWarnings) > 0 {
+		warnings := EligibilityViolationsToAPI(result.EligibilityWarnings)
+		apiResult.EligibilityWarnings = &warnings
 	}
 	return v1.AssignQuest200JSONResponse(apiResult), nil
 }
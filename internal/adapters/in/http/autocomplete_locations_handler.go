@@ -0,0 +1,28 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+)
+
+// AutocompleteLocations implements GET /api/v1/locations/autocomplete from OpenAPI.
+func (a *ApiHandler) AutocompleteLocations(ctx context.Context, request v1.AutocompleteLocationsRequestObject) (v1.AutocompleteLocationsResponseObject, error) {
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	matches, err := a.autocompleteLocationsHandler.Handle(ctx, request.Params.Q, limit)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation, 500 for infrastructure)
+		return nil, err
+	}
+
+	suggestions := make([]v1.LocationSuggestion, 0, len(matches))
+	for _, m := range matches {
+		suggestions = append(suggestions, LocationMatchToAPI(m))
+	}
+
+	return v1.AutocompleteLocations200JSONResponse(suggestions), nil
+}
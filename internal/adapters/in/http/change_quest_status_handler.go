@@ -5,6 +5,7 @@ import (
 
 	v1 "quest-manager/api/http/quests/v1"
 	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
 	"quest-manager/internal/core/application/usecases/commands"
 	"quest-manager/internal/core/domain/model/quest"
 )
@@ -15,13 +16,27 @@ func (a *ApiHandler) ChangeQuestStatus(ctx context.Context, request v1.ChangeQue
 		return nil, errors.NewBadRequest("request body is required")
 	}
 
+	// Get authenticated user ID from context (set by auth middleware)
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
 	cmd := commands.ChangeQuestStatusCommand{
 		QuestID: request.QuestId,
+		UserID:  userID,
 		Status:  quest.Status(request.Body.Status),
 	}
+	if request.Body.Position != nil {
+		position, err := convertAPICoordinateToKernel(*request.Body.Position)
+		if err != nil {
+			return nil, errors.NewBadRequest("Request validation failed: position invalid coordinate values (" + err.Error() + ")")
+		}
+		cmd.Position = &position
+	}
 	result, err := a.changeQuestStatusHandler.Handle(ctx, cmd)
 	if err != nil {
-		// Pass error to middleware for proper handling (400, 404, 500)
+		// Pass error to middleware for proper handling (400, 403, 404, 500)
 		return nil, err
 	}
 
@@ -30,6 +45,9 @@ func (a *ApiHandler) ChangeQuestStatus(ctx context.Context, request v1.ChangeQue
 		Id:       result.ID,
 		Assignee: result.Assignee,
 		Status:   v1.QuestStatus(result.Status),
+
+		Participants:     ParticipantsToAPI(result.Participants),
+		CompletionQuorum: result.CompletionQuorum,
 	}
 	return v1.ChangeQuestStatus200JSONResponse(apiResult), nil
 }
@@ -0,0 +1,229 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// SubmitCompletionEvidence implements POST /api/v1/quests/{quest_id}/evidence from OpenAPI.
+func (a *ApiHandler) SubmitCompletionEvidence(ctx context.Context, request v1.SubmitCompletionEvidenceRequestObject) (v1.SubmitCompletionEvidenceResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	cmd, err := readEvidenceForm(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	cmd.QuestID = request.QuestId
+	cmd.UserID = userID
+
+	result, err := a.submitCompletionEvidenceHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400, 403, 404)
+		return nil, err
+	}
+
+	return v1.SubmitCompletionEvidence201JSONResponse(v1.SubmitEvidenceResult{
+		Evidence:    EvidenceToAPI(result.Evidence),
+		QuestStatus: v1.QuestStatus(result.QuestStatus),
+	}), nil
+}
+
+// ListCompletionEvidence implements GET /api/v1/quests/{quest_id}/evidence from OpenAPI.
+func (a *ApiHandler) ListCompletionEvidence(ctx context.Context, request v1.ListCompletionEvidenceRequestObject) (v1.ListCompletionEvidenceResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	evidence, err := a.listCompletionEvidenceHandler.Handle(ctx, queries.ListCompletionEvidenceQuery{
+		QuestID: request.QuestId,
+		UserID:  userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (403 for outsiders, 404 for not found)
+		return nil, err
+	}
+
+	result := make([]v1.CompletionEvidence, 0, len(evidence))
+	for _, e := range evidence {
+		result = append(result, EvidenceToAPI(e))
+	}
+	return v1.ListCompletionEvidence200JSONResponse(result), nil
+}
+
+// GetEvidenceAttachment implements GET /api/v1/quests/{quest_id}/evidence/{evidence_id}/attachments/{attachment_id} from OpenAPI.
+func (a *ApiHandler) GetEvidenceAttachment(ctx context.Context, request v1.GetEvidenceAttachmentRequestObject) (v1.GetEvidenceAttachmentResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	result, err := a.getEvidenceAttachmentHandler.Handle(ctx, queries.GetEvidenceAttachmentQuery{
+		QuestID:      request.QuestId,
+		EvidenceID:   request.EvidenceId,
+		AttachmentID: request.AttachmentId,
+		UserID:       userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (403 for outsiders, 404 for not found)
+		return nil, err
+	}
+
+	// The response closes the content once it is written
+	switch result.Attachment.ContentType {
+	case "image/png":
+		return v1.GetEvidenceAttachment200ImagepngResponse{Body: result.Content, ContentLength: result.Attachment.Size}, nil
+	case "image/webp":
+		return v1.GetEvidenceAttachment200ImagewebpResponse{Body: result.Content, ContentLength: result.Attachment.Size}, nil
+	default:
+		return v1.GetEvidenceAttachment200ImagejpegResponse{Body: result.Content, ContentLength: result.Attachment.Size}, nil
+	}
+}
+
+// ApproveCompletion implements POST /api/v1/quests/{quest_id}/completion/approve from OpenAPI.
+func (a *ApiHandler) ApproveCompletion(ctx context.Context, request v1.ApproveCompletionRequestObject) (v1.ApproveCompletionResponseObject, error) {
+	result, err := a.reviewCompletion(ctx, request.QuestId, commands.CompletionDecisionApprove, "")
+	if err != nil {
+		return nil, err
+	}
+	return v1.ApproveCompletion200JSONResponse(result), nil
+}
+
+// RejectCompletion implements POST /api/v1/quests/{quest_id}/completion/reject from OpenAPI.
+func (a *ApiHandler) RejectCompletion(ctx context.Context, request v1.RejectCompletionRequestObject) (v1.RejectCompletionResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	result, err := a.reviewCompletion(ctx, request.QuestId, commands.CompletionDecisionReject, request.Body.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return v1.RejectCompletion200JSONResponse(result), nil
+}
+
+// reviewCompletion runs the completion review command on behalf of the authenticated user
+func (a *ApiHandler) reviewCompletion(ctx context.Context, questID uuid.UUID, decision commands.CompletionDecision, reason string) (v1.ChangeQuestStatusResult, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return v1.ChangeQuestStatusResult{}, errors.NewBadRequest("user ID not found in context")
+	}
+
+	result, err := a.reviewCompletionHandler.Handle(ctx, commands.ReviewCompletionCommand{
+		QuestID:  questID,
+		UserID:   userID,
+		Decision: decision,
+		Reason:   reason,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (400, 403, 404)
+		return v1.ChangeQuestStatusResult{}, err
+	}
+
+	return v1.ChangeQuestStatusResult{
+		Id:       result.ID,
+		Assignee: result.Assignee,
+		Status:   v1.QuestStatus(result.Status),
+
+		Participants:     ParticipantsToAPI(result.Participants),
+		CompletionQuorum: result.CompletionQuorum,
+	}, nil
+}
+
+// readEvidenceForm reads the multipart evidence form. Photos are read up to the size limit,
+// their content type is detected from the content rather than trusted from the client.
+func readEvidenceForm(form *multipart.Reader) (commands.SubmitCompletionEvidenceCommand, error) {
+	var cmd commands.SubmitCompletionEvidenceCommand
+	var latitude, longitude *float64
+
+	for {
+		part, err := form.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return cmd, errors.NewBadRequest(fmt.Sprintf("invalid multipart body: %v", err))
+		}
+
+		switch part.FormName() {
+		case "note":
+			value, err := readFormValue(part, quest.MaxEvidenceNoteLength*4)
+			if err != nil {
+				return cmd, err
+			}
+			cmd.Note = value
+		case "latitude", "longitude":
+			value, err := readFormValue(part, 64)
+			if err != nil {
+				return cmd, err
+			}
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return cmd, errors.NewBadRequest(fmt.Sprintf("%s must be a number", part.FormName()))
+			}
+			if part.FormName() == "latitude" {
+				latitude = &parsed
+			} else {
+				longitude = &parsed
+			}
+		case "photos":
+			if len(cmd.Photos) == quest.MaxEvidenceAttachments {
+				return cmd, errors.NewBadRequest(fmt.Sprintf("too many photos, maximum is %d", quest.MaxEvidenceAttachments))
+			}
+			content, err := io.ReadAll(io.LimitReader(part, quest.MaxAttachmentSize+1))
+			if err != nil {
+				return cmd, errors.NewBadRequest(fmt.Sprintf("failed to read photo %q", part.FileName()))
+			}
+			if len(content) > quest.MaxAttachmentSize {
+				return cmd, errors.NewBadRequest(fmt.Sprintf("photo %q exceeds 5 MB", part.FileName()))
+			}
+			cmd.Photos = append(cmd.Photos, commands.EvidencePhoto{
+				FileName:    part.FileName(),
+				ContentType: http.DetectContentType(content),
+				Content:     content,
+			})
+		}
+		_ = part.Close()
+	}
+
+	if latitude == nil || longitude == nil {
+		return cmd, errors.NewBadRequest("latitude and longitude are required")
+	}
+	cmd.Position = kernel.GeoCoordinate{Lat: *latitude, Lon: *longitude}
+	return cmd, nil
+}
+
+// readFormValue reads a text field of the multipart form up to limit bytes
+func readFormValue(part *multipart.Part, limit int64) (string, error) {
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(part, limit+1))
+	if err != nil {
+		return "", errors.NewBadRequest(fmt.Sprintf("failed to read %s", part.FormName()))
+	}
+	if n > limit {
+		return "", errors.NewBadRequest(fmt.Sprintf("%s is too long", part.FormName()))
+	}
+	return buf.String(), nil
+}
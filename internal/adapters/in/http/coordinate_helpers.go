@@ -1,6 +1,8 @@
 package http
 
 import (
+	"errors"
+
 	v1 "quest-manager/api/http/quests/v1"
 	"quest-manager/internal/core/domain/model/kernel"
 )
@@ -9,6 +11,26 @@ func convertAPICoordinateToKernel(coord v1.Coordinate) (kernel.GeoCoordinate, er
 	return kernel.NewGeoCoordinate(float64(coord.Latitude), float64(coord.Longitude))
 }
 
+// convertAPILocationInputToKernel returns the coordinate of a location input,
+// or nil when the location is given by address only.
+func convertAPILocationInputToKernel(loc v1.LocationInput) (*kernel.GeoCoordinate, error) {
+	if loc.Latitude == nil && loc.Longitude == nil {
+		if loc.Address == nil {
+			return nil, errors.New("coordinates or address is required")
+		}
+		return nil, nil
+	}
+	if loc.Latitude == nil || loc.Longitude == nil {
+		return nil, errors.New("latitude and longitude must be given together")
+	}
+
+	coord, err := kernel.NewGeoCoordinate(float64(*loc.Latitude), float64(*loc.Longitude))
+	if err != nil {
+		return nil, err
+	}
+	return &coord, nil
+}
+
 func convertKernelCoordinateToAPI(coord kernel.GeoCoordinate, address *string) v1.Coordinate {
 	return v1.Coordinate{
 		Latitude:  float32(coord.Latitude()),
@@ -16,3 +38,18 @@ func convertKernelCoordinateToAPI(coord kernel.GeoCoordinate, address *string) v
 		Address:   address,
 	}
 }
+
+func convertAPIWaypointToKernel(waypoint v1.Waypoint) (kernel.GeoCoordinate, error) {
+	return kernel.NewGeoCoordinate(float64(waypoint.Latitude), float64(waypoint.Longitude))
+}
+
+func convertKernelWaypointsToAPI(waypoints []kernel.GeoCoordinate) []v1.Waypoint {
+	result := make([]v1.Waypoint, len(waypoints))
+	for i, w := range waypoints {
+		result[i] = v1.Waypoint{
+			Latitude:  float32(w.Latitude()),
+			Longitude: float32(w.Longitude()),
+		}
+	}
+	return result
+}
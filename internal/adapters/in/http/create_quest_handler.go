@@ -16,14 +16,19 @@ func (a *ApiHandler) CreateQuest(ctx context.Context, request v1.CreateQuestRequ
 		return nil, errors.NewBadRequest("request body is required")
 	}
 
-	targetLocation, err := convertAPICoordinateToKernel(request.Body.TargetLocation)
+	targetLocation, err := convertAPILocationInputToKernel(request.Body.TargetLocation)
 	if err != nil {
-		return nil, errors.NewBadRequest("Request validation failed: target_location invalid coordinate values (" + err.Error() + ")")
+		return nil, errors.NewBadRequest("Request validation failed: target_location invalid location (" + err.Error() + ")")
 	}
 
-	executionLocation, err := convertAPICoordinateToKernel(request.Body.ExecutionLocation)
+	executionLocation, err := convertAPILocationInputToKernel(request.Body.ExecutionLocation)
 	if err != nil {
-		return nil, errors.NewBadRequest("Request validation failed: execution_location invalid coordinate values (" + err.Error() + ")")
+		return nil, errors.NewBadRequest("Request validation failed: execution_location invalid location (" + err.Error() + ")")
+	}
+
+	waypoints, err := convertAPIWaypointsToKernel(request.Body.Waypoints, "waypoints")
+	if err != nil {
+		return nil, err
 	}
 
 	equipment := []string{}
@@ -49,13 +54,31 @@ func (a *ApiHandler) CreateQuest(ctx context.Context, request v1.CreateQuestRequ
 		Reward:            request.Body.Reward,
 		DurationMinutes:   request.Body.DurationMinutes,
 		TargetLocation:    targetLocation,
+		TargetName:        request.Body.TargetLocation.Name,
 		TargetAddress:     request.Body.TargetLocation.Address,
 		ExecutionLocation: executionLocation,
+		ExecutionName:     request.Body.ExecutionLocation.Name,
 		ExecutionAddress:  request.Body.ExecutionLocation.Address,
+		Waypoints:         waypoints,
 		Equipment:         equipment,
 		Skills:            skills,
 		Creator:           creator,
 	}
+	if request.Body.EligibilityPolicy != nil {
+		cmd.EligibilityPolicy = string(*request.Body.EligibilityPolicy)
+	}
+	if request.Body.AssignmentMode != nil {
+		cmd.AssignmentMode = string(*request.Body.AssignmentMode)
+	}
+	if request.Body.Capacity != nil {
+		cmd.Capacity = *request.Body.Capacity
+	}
+	if request.Body.CompletionQuorum != nil {
+		cmd.CompletionQuorum = *request.Body.CompletionQuorum
+	}
+	if request.Body.GeofenceRadius != nil {
+		cmd.GeofenceRadius = *request.Body.GeofenceRadius
+	}
 
 	result, err := a.createQuestHandler.Handle(ctx, cmd)
 	if err != nil {
@@ -7,7 +7,15 @@ func NewDomainValidationProblem(err *errs.DomainValidationError) *BadRequest {
 	if err.Cause != nil {
 		detail += " (cause: " + err.Cause.Error() + ")"
 	}
-	return NewBadRequest(detail)
+	problem := NewBadRequest(detail)
+	for _, v := range err.Violations {
+		problem.InvalidParams = append(problem.InvalidParams, InvalidParam{
+			Name:   v.Field,
+			Reason: v.Message,
+			Values: v.Values,
+		})
+	}
+	return problem
 }
 
 func NewNotFoundProblem(err *errs.NotFoundError) *ProblemDetails {
@@ -23,3 +31,12 @@ func NewNotFoundProblem(err *errs.NotFoundError) *ProblemDetails {
 		Detail: detail,
 	}
 }
+
+func NewForbiddenProblem(err *errs.ForbiddenError) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "forbidden",
+		Title:  "Forbidden",
+		Status: 403,
+		Detail: "not allowed to " + err.Action + ": " + err.Reason,
+	}
+}
@@ -8,10 +8,18 @@ import (
 
 // ProblemDetails RFC 7807
 type ProblemDetails struct {
-	Type   string `json:"type"`
-	Title  string `json:"title"`
-	Status int    `json:"status"`
-	Detail string `json:"detail"`
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+// InvalidParam is the RFC 7807 "invalid-params" extension member
+type InvalidParam struct {
+	Name   string   `json:"name"`
+	Reason string   `json:"reason"`
+	Values []string `json:"values,omitempty"`
 }
 
 func (p *ProblemDetails) Error() string {
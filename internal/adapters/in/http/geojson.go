@@ -0,0 +1,81 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// geoJSONPolygon is a GeoJSON Polygon geometry (RFC 7946), positions are [lon, lat].
+type geoJSONPolygon struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+// parseGeoJSONPolygon converts a GeoJSON Polygon geometry into a kernel polygon.
+// The first ring is the exterior, the rest are holes.
+func parseGeoJSONPolygon(raw string) (kernel.Polygon, error) {
+	var geometry geoJSONPolygon
+	if err := json.Unmarshal([]byte(raw), &geometry); err != nil {
+		return kernel.Polygon{}, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	if geometry.Type != "Polygon" {
+		return kernel.Polygon{}, fmt.Errorf("geometry type must be Polygon, got %q", geometry.Type)
+	}
+	if len(geometry.Coordinates) == 0 {
+		return kernel.Polygon{}, fmt.Errorf("polygon has no rings")
+	}
+
+	rings := make([][]kernel.GeoCoordinate, len(geometry.Coordinates))
+	for i, ring := range geometry.Coordinates {
+		rings[i] = make([]kernel.GeoCoordinate, len(ring))
+		for j, position := range ring {
+			if len(position) < 2 {
+				return kernel.Polygon{}, fmt.Errorf("ring %d position %d must have longitude and latitude", i, j)
+			}
+			rings[i][j] = kernel.GeoCoordinate{Lon: position[0], Lat: position[1]}
+		}
+	}
+
+	return kernel.NewPolygon(rings[0], rings[1:]...)
+}
+
+// parseBoundingBox parses "minLon,minLat,maxLon,maxLat". minLon > maxLon means the box
+// crosses the antimeridian.
+func parseBoundingBox(raw string) (kernel.BoundingBox, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 4 {
+		return kernel.BoundingBox{}, fmt.Errorf("bbox must be minLon,minLat,maxLon,maxLat")
+	}
+
+	values := make([]float64, 4)
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return kernel.BoundingBox{}, fmt.Errorf("bbox value %q is not a number", part)
+		}
+		values[i] = v
+	}
+
+	minCorner, err := kernel.NewGeoCoordinate(values[1], values[0])
+	if err != nil {
+		return kernel.BoundingBox{}, err
+	}
+	maxCorner, err := kernel.NewGeoCoordinate(values[3], values[2])
+	if err != nil {
+		return kernel.BoundingBox{}, err
+	}
+	if minCorner.Lat > maxCorner.Lat {
+		return kernel.BoundingBox{}, fmt.Errorf("bbox minLat must not exceed maxLat")
+	}
+
+	return kernel.BoundingBox{
+		MinLat: minCorner.Lat,
+		MaxLat: maxCorner.Lat,
+		MinLon: minCorner.Lon,
+		MaxLon: maxCorner.Lon,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"bytes"
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// GetQuestTile implements GET /api/v1/quests/tiles/{z}/{x}/{y} from OpenAPI.
+func (a *ApiHandler) GetQuestTile(ctx context.Context, request v1.GetQuestTileRequestObject) (v1.GetQuestTileResponseObject, error) {
+	tile, err := kernel.NewTile(request.Z, request.X, request.Y)
+	if err != nil {
+		return nil, errors.NewBadRequest("Request validation failed: tile invalid (" + err.Error() + ")")
+	}
+
+	query := queries.GetQuestTileQuery{Tile: tile}
+	if request.Params.Resolution != nil {
+		query.Resolution = *request.Params.Resolution
+	}
+
+	result, err := a.getQuestTileHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling
+		return nil, err
+	}
+
+	// Explicit format wins over the Accept header: map clients usually can't set headers per tile URL
+	mvt := middleware.MediaTypeFromContext(ctx) == middleware.MediaTypeMVT
+	if request.Params.Format != nil {
+		mvt = *request.Params.Format == v1.Mvt
+	}
+	if mvt {
+		body := QuestTileToMVT(result)
+		return v1.GetQuestTile200ApplicationvndMapboxVectorTileResponse{
+			Body:          bytes.NewReader(body),
+			ContentLength: int64(len(body)),
+		}, nil
+	}
+
+	return v1.GetQuestTile200JSONResponse(QuestTileToAPI(result)), nil
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+)
+
+// GetLeaderboard implements GET /api/v1/leaderboards from OpenAPI.
+func (a *ApiHandler) GetLeaderboard(ctx context.Context, request v1.GetLeaderboardRequestObject) (v1.GetLeaderboardResponseObject, error) {
+	params := request.Params
+
+	query := queries.GetLeaderboardQuery{}
+	if params.Period != nil {
+		query.Period = leaderboard.Period(*params.Period)
+	}
+	if params.Metric != nil {
+		query.Metric = leaderboard.Metric(*params.Metric)
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	// An area needs all of lat, lon and radius_km
+	if params.Lat != nil || params.Lon != nil || params.RadiusKm != nil {
+		if params.Lat == nil || params.Lon == nil || params.RadiusKm == nil {
+			return nil, errors.NewBadRequest("Request validation failed: lat, lon and radius_km must be given together")
+		}
+		center, err := kernel.NewGeoCoordinate(*params.Lat, *params.Lon)
+		if err != nil {
+			return nil, errors.NewBadRequest("Request validation failed: coordinates invalid (" + err.Error() + ")")
+		}
+		query.Center = &center
+		query.RadiusKm = *params.RadiusKm
+	}
+
+	board, err := a.getLeaderboardHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for invalid parameters)
+		return nil, err
+	}
+
+	return v1.GetLeaderboard200JSONResponse(LeaderboardToAPI(board)), nil
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/queries"
+)
+
+// GetMyBalance implements GET /api/v1/me/balance from OpenAPI.
+func (a *ApiHandler) GetMyBalance(ctx context.Context, request v1.GetMyBalanceRequestObject) (v1.GetMyBalanceResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	balance, err := a.getBalanceHandler.Handle(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.GetMyBalance200JSONResponse(v1.Balance{
+		UserId: balance.UserID,
+		Points: balance.Points,
+	}), nil
+}
+
+// ListMyLedger implements GET /api/v1/me/ledger from OpenAPI.
+func (a *ApiHandler) ListMyLedger(ctx context.Context, request v1.ListMyLedgerRequestObject) (v1.ListMyLedgerResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	query := queries.ListLedgerEntriesQuery{UserID: userID}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		query.Offset = *request.Params.Offset
+	}
+
+	page, err := a.listLedgerEntriesHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for an invalid page)
+		return nil, err
+	}
+
+	entries := make([]v1.LedgerEntry, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		entries = append(entries, LedgerEntryToAPI(entry))
+	}
+	return v1.ListMyLedger200JSONResponse(v1.LedgerPage{
+		Entries: entries,
+		Total:   page.Total,
+	}), nil
+}
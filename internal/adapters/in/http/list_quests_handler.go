@@ -4,7 +4,11 @@ import (
 	"context"
 
 	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/queries"
 	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
 )
 
 // ListQuests implements GET /api/v1/quests from OpenAPI.
@@ -17,16 +21,35 @@ func (a *ApiHandler) ListQuests(ctx context.Context, request v1.ListQuestsReques
 		status = &questStatus
 	}
 
-	// Get quest list directly with optional filter
-	quests, err := a.listQuestsHandler.Handle(ctx, status)
+	// Get quest list directly with optional filters
+	quests, err := a.listQuestsHandler.Handle(ctx, queries.ListQuestsQuery{
+		Status:           status,
+		MaxRouteKm:       request.Params.MaxRouteKm,
+		MinCreatorRating: request.Params.MinCreatorRating,
+	})
 	if err != nil {
 		// Pass error to middleware for proper handling (e.g., 400 for invalid status)
 		return nil, err
 	}
 
+	if middleware.MediaTypeFromContext(ctx) == middleware.MediaTypeGeoJSON {
+		return v1.ListQuests200ApplicationGeoPlusJSONResponse(QuestsToGeoJSON(quests)), nil
+	}
+
+	// Creator info: the reputation of every creator with a user ID
+	reputations, err := a.getReputationsHandler.Handle(ctx, queries.CreatorIDs(quests))
+	if err != nil {
+		return nil, err
+	}
+
 	var apiQuests []v1.Quest
 	for _, q := range quests {
-		apiQuests = append(apiQuests, QuestToAPI(q))
+		apiQuest := QuestToAPI(q)
+		if creatorID, err := uuid.Parse(q.Creator); err == nil {
+			reputation := ReputationToAPI(reputations[creatorID])
+			apiQuest.CreatorReputation = &reputation
+		}
+		apiQuests = append(apiQuests, apiQuest)
 	}
 
 	return v1.ListQuests200JSONResponse(apiQuests), nil
@@ -2,7 +2,19 @@ package http
 
 import (
 	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/domain/model/location"
+	"quest-manager/internal/core/domain/model/notification"
 	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
 // QuestToAPI converts domain quest to API format
@@ -31,22 +43,516 @@ func QuestToAPI(q quest.Quest) v1.Quest {
 	}
 
 	return v1.Quest{
-		Id:                  q.ID(),
-		Title:               q.Title,
-		Description:         q.Description,
-		Difficulty:          v1.QuestDifficulty(q.Difficulty),
-		Reward:              q.Reward,
-		DurationMinutes:     q.DurationMinutes,
-		TargetLocation:      targetLocation,
-		ExecutionLocation:   executionLocation,
-		Equipment:           equipment,
-		Skills:              skills,
-		Status:              v1.QuestStatus(q.Status),
-		Creator:             q.Creator,
-		Assignee:            q.Assignee,
-		CreatedAt:           q.CreatedAt,
-		UpdatedAt:           q.UpdatedAt,
-		TargetLocationId:    targetLocationId,
-		ExecutionLocationId: executionLocationId,
+		Id:                     q.ID(),
+		Title:                  q.Title,
+		Description:            q.Description,
+		Difficulty:             v1.QuestDifficulty(q.Difficulty),
+		Reward:                 q.Reward,
+		DurationMinutes:        q.DurationMinutes,
+		TargetLocation:         targetLocation,
+		ExecutionLocation:      executionLocation,
+		Equipment:              equipment,
+		Skills:                 skills,
+		EligibilityPolicy:      eligibilityPolicyToAPI(q.EligibilityPolicy),
+		AssignmentMode:         assignmentModeToAPI(q.AssignmentMode),
+		Status:                 v1.QuestStatus(q.Status),
+		Creator:                q.Creator,
+		Assignee:               q.Assignee,
+		Capacity:               q.Capacity,
+		CompletionQuorum:       q.CompletionQuorum,
+		Participants:           ParticipantsToAPI(q.Participants),
+		Prerequisites:          prerequisitesToAPI(q.Prerequisites),
+		GeofenceRadius:         q.GeofenceRadius,
+		CreatedAt:              q.CreatedAt,
+		UpdatedAt:              q.UpdatedAt,
+		TargetLocationId:       targetLocationId,
+		ExecutionLocationId:    executionLocationId,
+		Waypoints:              convertKernelWaypointsToAPI(q.Waypoints),
+		RouteDistanceKm:        q.RouteDistanceKm(),
+		EstimatedTravelMinutes: q.EstimatedTravelMinutes(),
+	}
+}
+
+// eligibilityPolicyToAPI converts the policy; quests without one are not checked
+func eligibilityPolicyToAPI(p quest.EligibilityPolicy) v1.EligibilityPolicy {
+	if p == "" {
+		return v1.Off
+	}
+	return v1.EligibilityPolicy(p)
+}
+
+// assignmentModeToAPI converts the mode; quests without one are assigned first-come
+func assignmentModeToAPI(m quest.AssignmentMode) v1.AssignmentMode {
+	if m == "" {
+		return v1.FirstCome
+	}
+	return v1.AssignmentMode(m)
+}
+
+// ParticipantsToAPI converts quest participants to API format, always returning a non-nil slice
+func ParticipantsToAPI(participants []quest.Participant) []v1.Participant {
+	result := make([]v1.Participant, 0, len(participants))
+	for _, p := range participants {
+		result = append(result, v1.Participant{
+			UserId:      p.UserID,
+			JoinedAt:    p.JoinedAt,
+			StartedAt:   p.StartedAt,
+			CompletedAt: p.CompletedAt,
+		})
+	}
+	return result
+}
+
+// prerequisitesToAPI converts quest prerequisites to API format, always returning a non-nil slice
+func prerequisitesToAPI(prerequisites []quest.Prerequisite) *[]v1.QuestPrerequisite {
+	result := make([]v1.QuestPrerequisite, 0, len(prerequisites))
+	for _, p := range prerequisites {
+		result = append(result, v1.QuestPrerequisite{QuestId: p.QuestID, Completed: p.Completed})
+	}
+	return &result
+}
+
+// DependencyGraphToAPI converts the quests linked through prerequisites to a graph,
+// one node per quest and one edge per prerequisite link
+func DependencyGraphToAPI(questID uuid.UUID, quests []quest.Quest) v1.QuestDependencyGraph {
+	nodes := make([]v1.QuestDependencyNode, 0, len(quests))
+	edges := []v1.QuestDependencyEdge{}
+	for _, q := range quests {
+		nodes = append(nodes, v1.QuestDependencyNode{
+			Id:     q.ID(),
+			Title:  q.Title,
+			Status: v1.QuestStatus(q.Status),
+		})
+		for _, p := range q.Prerequisites {
+			edges = append(edges, v1.QuestDependencyEdge{
+				PrerequisiteId: p.QuestID,
+				QuestId:        q.ID(),
+				Completed:      p.Completed,
+			})
+		}
+	}
+	return v1.QuestDependencyGraph{QuestId: questID, Nodes: nodes, Edges: edges}
+}
+
+// ApplicationToAPI converts a quest application to API format
+func ApplicationToAPI(a quest.Application) v1.Application {
+	return v1.Application{
+		Id:          a.ID,
+		QuestId:     a.QuestID,
+		ApplicantId: a.ApplicantID,
+		Message:     a.Message,
+		Status:      v1.ApplicationStatus(a.Status),
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+// EligibilityViolationsToAPI converts unmet requirements to API format
+func EligibilityViolationsToAPI(violations []errs.Violation) []v1.EligibilityViolation {
+	result := make([]v1.EligibilityViolation, 0, len(violations))
+	for _, v := range violations {
+		apiViolation := v1.EligibilityViolation{Field: v.Field, Message: v.Message}
+		if len(v.Values) > 0 {
+			values := v.Values
+			apiViolation.Values = &values
+		}
+		result = append(result, apiViolation)
+	}
+	return result
+}
+
+// QuestWithDistanceToAPI converts a radius search result to API format
+func QuestWithDistanceToAPI(r queries.QuestWithDistance) v1.QuestWithDistance {
+	q := QuestToAPI(r.Quest)
+	return v1.QuestWithDistance{
+		Id:                     q.Id,
+		Title:                  q.Title,
+		Description:            q.Description,
+		Difficulty:             v1.QuestWithDistanceDifficulty(q.Difficulty),
+		Reward:                 q.Reward,
+		DurationMinutes:        q.DurationMinutes,
+		TargetLocation:         q.TargetLocation,
+		ExecutionLocation:      q.ExecutionLocation,
+		Equipment:              q.Equipment,
+		Skills:                 q.Skills,
+		EligibilityPolicy:      q.EligibilityPolicy,
+		AssignmentMode:         q.AssignmentMode,
+		Status:                 q.Status,
+		Creator:                q.Creator,
+		Assignee:               q.Assignee,
+		Capacity:               q.Capacity,
+		CompletionQuorum:       q.CompletionQuorum,
+		Participants:           q.Participants,
+		Prerequisites:          q.Prerequisites,
+		GeofenceRadius:         q.GeofenceRadius,
+		CreatedAt:              q.CreatedAt,
+		UpdatedAt:              q.UpdatedAt,
+		TargetLocationId:       q.TargetLocationId,
+		ExecutionLocationId:    q.ExecutionLocationId,
+		Waypoints:              q.Waypoints,
+		RouteDistanceKm:        q.RouteDistanceKm,
+		EstimatedTravelMinutes: q.EstimatedTravelMinutes,
+		TargetDistanceKm:       r.TargetDistanceKm,
+		ExecutionDistanceKm:    r.ExecutionDistanceKm,
+	}
+}
+
+// QuestsToGeoJSON converts quests to a GeoJSON FeatureCollection with a point feature
+// for the target and the execution location of each quest
+func QuestsToGeoJSON(quests []quest.Quest) v1.QuestFeatureCollection {
+	features := make([]v1.QuestFeature, 0, 2*len(quests))
+	for _, q := range quests {
+		features = append(features,
+			questFeature(q, v1.QuestFeaturePropertiesLocationRoleTarget, q.TargetLocation, q.TargetLocationID, nil),
+			questFeature(q, v1.QuestFeaturePropertiesLocationRoleExecution, q.ExecutionLocation, q.ExecutionLocationID, nil),
+		)
+	}
+	return v1.QuestFeatureCollection{Type: v1.FeatureCollection, Features: features}
+}
+
+// QuestsWithDistanceToGeoJSON converts radius search results to a GeoJSON FeatureCollection,
+// keeping the result order and setting the distance from the search center on each feature
+func QuestsWithDistanceToGeoJSON(results []queries.QuestWithDistance) v1.QuestFeatureCollection {
+	features := make([]v1.QuestFeature, 0, 2*len(results))
+	for _, r := range results {
+		targetDistance, executionDistance := r.TargetDistanceKm, r.ExecutionDistanceKm
+		features = append(features,
+			questFeature(r.Quest, v1.QuestFeaturePropertiesLocationRoleTarget, r.Quest.TargetLocation, r.Quest.TargetLocationID, &targetDistance),
+			questFeature(r.Quest, v1.QuestFeaturePropertiesLocationRoleExecution, r.Quest.ExecutionLocation, r.Quest.ExecutionLocationID, &executionDistance),
+		)
+	}
+	return v1.QuestFeatureCollection{Type: v1.FeatureCollection, Features: features}
+}
+
+// questFeature builds the GeoJSON point feature for one quest location
+func questFeature(
+	q quest.Quest,
+	role v1.QuestFeaturePropertiesLocationRole,
+	coord kernel.GeoCoordinate,
+	locationID *uuid.UUID,
+	distanceKm *float64,
+) v1.QuestFeature {
+	var locationId *string
+	if locationID != nil {
+		id := locationID.String()
+		locationId = &id
+	}
+
+	// GeoJSON requires arrays, never null
+	equipment := append([]string{}, q.Equipment...)
+	skills := append([]string{}, q.Skills...)
+
+	return v1.QuestFeature{
+		Type: v1.Feature,
+		Id:   q.ID().String() + ":" + string(role),
+		Geometry: v1.GeoJSONPoint{
+			Type:        v1.Point,
+			Coordinates: []float64{coord.Longitude(), coord.Latitude()},
+		},
+		Properties: v1.QuestFeatureProperties{
+			QuestId:         q.ID(),
+			LocationRole:    role,
+			LocationId:      locationId,
+			DistanceKm:      distanceKm,
+			Title:           q.Title,
+			Description:     q.Description,
+			Difficulty:      v1.QuestFeaturePropertiesDifficulty(q.Difficulty),
+			Reward:          q.Reward,
+			DurationMinutes: q.DurationMinutes,
+			Equipment:       equipment,
+			Skills:          skills,
+			Status:          v1.QuestStatus(q.Status),
+			Creator:         q.Creator,
+			Assignee:        q.Assignee,
+			CreatedAt:       q.CreatedAt,
+			UpdatedAt:       q.UpdatedAt,
+		},
+	}
+}
+
+// QuestTileToAPI converts quest clusters of a map tile to API format
+func QuestTileToAPI(tile queries.QuestTile) v1.QuestTile {
+	clusters := make([]v1.QuestCluster, 0, len(tile.Clusters))
+	for _, c := range tile.Clusters {
+		bbox := c.Cell.BoundingBox()
+		clusters = append(clusters, v1.QuestCluster{
+			Z:         c.Cell.Z,
+			X:         c.Cell.X,
+			Y:         c.Cell.Y,
+			Count:     c.Count,
+			Latitude:  c.Center.Latitude(),
+			Longitude: c.Center.Longitude(),
+			Bbox:      []float64{bbox.MinLon, bbox.MinLat, bbox.MaxLon, bbox.MaxLat},
+			Statuses: v1.QuestStatusCounts{
+				Created:       c.StatusCounts[quest.StatusCreated],
+				Posted:        c.StatusCounts[quest.StatusPosted],
+				Assigned:      c.StatusCounts[quest.StatusAssigned],
+				InProgress:    c.StatusCounts[quest.StatusInProgress],
+				PendingReview: c.StatusCounts[quest.StatusPendingReview],
+				Declined:      c.StatusCounts[quest.StatusDeclined],
+				Completed:     c.StatusCounts[quest.StatusCompleted],
+			},
+		})
+	}
+
+	return v1.QuestTile{
+		Z:          tile.Tile.Z,
+		X:          tile.Tile.X,
+		Y:          tile.Tile.Y,
+		Resolution: tile.Resolution,
+		Clusters:   clusters,
+	}
+}
+
+// LocationMatchToAPI converts a location search match to API suggestion format
+func LocationMatchToAPI(m location.SearchMatch) v1.LocationSuggestion {
+	return v1.LocationSuggestion{
+		Id:        m.Location.ID(),
+		Name:      m.Location.Name,
+		Address:   m.Location.Address,
+		Latitude:  float32(m.Location.Coordinate.Latitude()),
+		Longitude: float32(m.Location.Coordinate.Longitude()),
+		Score:     float32(m.Score),
+	}
+}
+
+// QuestRecommendationToAPI converts a recommendation with its score explanation to API format
+func QuestRecommendationToAPI(r queries.QuestRecommendation) v1.QuestRecommendation {
+	return v1.QuestRecommendation{
+		Quest:      QuestToAPI(r.Quest),
+		DistanceKm: r.DistanceKm,
+		Score:      r.Score,
+		Explanation: v1.RecommendationExplanation{
+			DistanceScore:         r.Scores.Distance,
+			SkillsScore:           r.Scores.Skills,
+			DifficultyScore:       r.Scores.Difficulty,
+			RewardScore:           r.Scores.Reward,
+			HistoryScore:          r.Scores.History,
+			NearestPastQuestKm:    r.NearestPastQuestKm,
+			MatchedRequirements:   r.MatchedRequirements,
+			MissingRequirements:   r.MissingRequirements,
+			CompletedAtDifficulty: r.CompletedAtDifficulty,
+		},
+	}
+}
+
+// UserProfileToAPI converts a domain user and the reputation of the user to its API profile
+func UserProfileToAPI(u *user.User, reputation review.Reputation) v1.UserProfile {
+	profile := v1.UserProfile{
+		UserId:            u.ID(),
+		DisplayName:       u.DisplayName,
+		Skills:            u.Skills,
+		Equipment:         u.Equipment,
+		MaxTravelRadiusKm: u.MaxTravelRadiusKm,
+		Reputation:        ReputationToAPI(reputation),
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+	}
+	if profile.Skills == nil {
+		profile.Skills = []string{}
+	}
+	if profile.Equipment == nil {
+		profile.Equipment = []string{}
+	}
+	if u.HomeLocation != nil {
+		profile.HomeLocation = &v1.GeoPoint{
+			Latitude:  float32(u.HomeLocation.Latitude()),
+			Longitude: float32(u.HomeLocation.Longitude()),
+		}
+	}
+	return profile
+}
+
+// EvidenceToAPI converts completion evidence to API format
+func EvidenceToAPI(e quest.Evidence) v1.CompletionEvidence {
+	attachments := make([]v1.EvidenceAttachment, 0, len(e.Attachments))
+	for _, a := range e.Attachments {
+		attachments = append(attachments, v1.EvidenceAttachment{
+			Id:          a.ID,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		})
+	}
+
+	result := v1.CompletionEvidence{
+		Id:          e.ID,
+		QuestId:     e.QuestID,
+		SubmittedBy: e.SubmittedBy,
+		Note:        e.Note,
+		Position:    convertKernelCoordinateToAPI(e.Position, nil),
+		Attachments: attachments,
+		Status:      v1.EvidenceStatus(e.Status),
+		SubmittedAt: e.SubmittedAt,
+		ReviewedAt:  e.ReviewedAt,
+	}
+	if e.RejectionReason != "" {
+		reason := e.RejectionReason
+		result.RejectionReason = &reason
+	}
+	return result
+}
+
+// LedgerEntryToAPI converts a ledger entry to API format
+func LedgerEntryToAPI(e ledger.Entry) v1.LedgerEntry {
+	return v1.LedgerEntry{
+		Id:        e.ID,
+		Kind:      v1.LedgerEntryKind(e.Kind),
+		Points:    e.Points,
+		QuestId:   e.QuestID,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// LeaderboardToAPI converts a leaderboard to API format
+func LeaderboardToAPI(board queries.Leaderboard) v1.Leaderboard {
+	standings := make([]v1.LeaderboardStanding, 0, len(board.Standings))
+	for _, st := range board.Standings {
+		standings = append(standings, LeaderboardStandingToAPI(st))
+	}
+	return v1.Leaderboard{
+		Period:    v1.LeaderboardPeriod(board.Period),
+		Metric:    v1.LeaderboardMetric(board.Metric),
+		Since:     board.Since,
+		Standings: standings,
+	}
+}
+
+// LeaderboardStandingToAPI converts a leaderboard standing to API format
+func LeaderboardStandingToAPI(st leaderboard.Standing) v1.LeaderboardStanding {
+	return v1.LeaderboardStanding{
+		Rank:   st.Rank,
+		UserId: st.UserID,
+		Score:  st.Score,
+	}
+}
+
+// AchievementToAPI converts an achievement with the progress of a user to API format
+func AchievementToAPI(a queries.Achievement) v1.Achievement {
+	return v1.Achievement{
+		Code:        a.Code,
+		Title:       a.Title,
+		Description: a.Description,
+		Progress:    a.Progress,
+		Target:      a.Target,
+		Unlocked:    a.UnlockedAt != nil,
+		UnlockedAt:  a.UnlockedAt,
+	}
+}
+
+// ReputationToAPI converts a reputation to API format
+func ReputationToAPI(r review.Reputation) v1.Reputation {
+	return v1.Reputation{
+		AverageRating: r.AverageRating,
+		ReviewCount:   r.ReviewCount,
+	}
+}
+
+// ReviewToAPI converts a review to API format
+func ReviewToAPI(r review.Review) v1.QuestReview {
+	return v1.QuestReview{
+		Id:         r.ID(),
+		QuestId:    r.QuestID,
+		ReviewerId: r.ReviewerID,
+		RevieweeId: r.RevieweeID,
+		Rating:     r.Rating,
+		Comment:    r.Comment,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+// CommentToAPI converts a quest comment to API format
+func CommentToAPI(c quest.Comment) v1.QuestComment {
+	return v1.QuestComment{
+		Id:         c.ID,
+		QuestId:    c.QuestID,
+		AuthorId:   c.AuthorID,
+		Body:       c.Body,
+		Visibility: v1.CommentVisibility(c.Visibility),
+		CreatedAt:  c.CreatedAt,
+		EditedAt:   c.EditedAt,
+	}
+}
+
+// NotificationToAPI converts a notification to API format
+func NotificationToAPI(n notification.Notification) v1.Notification {
+	return v1.Notification{
+		Id:        n.ID,
+		Kind:      v1.NotificationKind(n.Kind),
+		QuestId:   n.QuestID,
+		Message:   n.Message,
+		CreatedAt: n.CreatedAt,
+		ReadAt:    n.ReadAt,
+	}
+}
+
+// NotificationPreferencesToAPI converts notification preferences to API format, one flag per kind
+func NotificationPreferencesToAPI(p notification.Preferences) v1.NotificationPreferences {
+	return v1.NotificationPreferences{
+		QuestAssigned:      p.Receives(notification.KindQuestAssigned),
+		QuestStatusChanged: p.Receives(notification.KindQuestStatusChanged),
+		QuestCommentAdded:  p.Receives(notification.KindQuestCommentAdded),
+	}
+}
+
+// QuestTemplateToAPI converts a quest template to API format
+func QuestTemplateToAPI(t *template.QuestTemplate) v1.QuestTemplate {
+	spec := t.Quest
+	quest := v1.CreateQuestRequest{
+		Title:             spec.Title,
+		Description:       spec.Description,
+		Difficulty:        v1.CreateQuestRequestDifficulty(spec.Difficulty),
+		Reward:            spec.Reward,
+		DurationMinutes:   spec.DurationMinutes,
+		TargetLocation:    locationInputToAPI(spec.TargetLocation, spec.TargetName, spec.TargetAddress),
+		ExecutionLocation: locationInputToAPI(spec.ExecutionLocation, spec.ExecutionName, spec.ExecutionAddress),
+		GeofenceRadius:    &spec.GeofenceRadius,
+	}
+	// Zero capacity and quorum mean the defaults of quest creation
+	if spec.Capacity > 0 {
+		quest.Capacity = &spec.Capacity
+	}
+	if spec.CompletionQuorum > 0 {
+		quest.CompletionQuorum = &spec.CompletionQuorum
+	}
+	waypoints := convertKernelWaypointsToAPI(spec.Waypoints)
+	quest.Waypoints = &waypoints
+	equipment := append([]string{}, spec.Equipment...)
+	quest.Equipment = &equipment
+	skills := append([]string{}, spec.Skills...)
+	quest.Skills = &skills
+	if spec.EligibilityPolicy != "" {
+		policy := v1.EligibilityPolicy(spec.EligibilityPolicy)
+		quest.EligibilityPolicy = &policy
+	}
+	if spec.AssignmentMode != "" {
+		mode := v1.AssignmentMode(spec.AssignmentMode)
+		quest.AssignmentMode = &mode
+	}
+
+	result := v1.QuestTemplate{
+		Id:               t.ID(),
+		OwnerId:          t.OwnerID,
+		Name:             t.Name,
+		Quest:            quest,
+		NextOccurrenceAt: t.NextOccurrence,
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        t.UpdatedAt,
+	}
+	if t.Recurrence != nil {
+		start := t.Recurrence.Start
+		result.Recurrence = &v1.Recurrence{Rule: t.Recurrence.String(), StartsAt: &start}
+	}
+	return result
+}
+
+func locationInputToAPI(coordinate *kernel.GeoCoordinate, name, address *string) v1.LocationInput {
+	location := v1.LocationInput{Name: name, Address: address}
+	if coordinate != nil {
+		latitude := float32(coordinate.Latitude())
+		longitude := float32(coordinate.Longitude())
+		location.Latitude = &latitude
+		location.Longitude = &longitude
 	}
+	return location
 }
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Response media types supported by the API.
+const (
+	MediaTypeJSON    = "application/json"
+	MediaTypeGeoJSON = "application/geo+json"
+	MediaTypeMVT     = "application/vnd.mapbox-vector-tile"
+)
+
+var (
+	contextKeyMediaType = contextKey("media_type")
+
+	// supportedMediaTypes in order of preference when the client rates them equally
+	supportedMediaTypes = []string{MediaTypeJSON, MediaTypeGeoJSON, MediaTypeMVT}
+)
+
+// MediaTypeToContext adds the negotiated response media type to context (exported for testing)
+func MediaTypeToContext(ctx context.Context, mediaType string) context.Context {
+	return context.WithValue(ctx, contextKeyMediaType, mediaType)
+}
+
+// MediaTypeFromContext returns the negotiated response media type, MediaTypeJSON by default
+func MediaTypeFromContext(ctx context.Context) string {
+	if mediaType, ok := ctx.Value(contextKeyMediaType).(string); ok {
+		return mediaType
+	}
+	return MediaTypeJSON
+}
+
+// ContentNegotiation picks the response media type from the Accept header and stores it in context.
+// Handlers that support several representations read it with MediaTypeFromContext.
+func ContentNegotiation(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediaType := negotiateMediaType(r.Header.Values("Accept"))
+		h.ServeHTTP(w, r.WithContext(MediaTypeToContext(r.Context(), mediaType)))
+	})
+}
+
+// negotiateMediaType returns the supported media type with the highest quality in the Accept header.
+// Missing or unsupported Accept headers fall back to MediaTypeJSON.
+func negotiateMediaType(acceptHeaders []string) string {
+	best, bestQuality := MediaTypeJSON, 0.0
+	for _, supported := range supportedMediaTypes {
+		if q := acceptQuality(acceptHeaders, supported); q > bestQuality {
+			best, bestQuality = supported, q
+		}
+	}
+	return best
+}
+
+// acceptQuality returns the q-value the Accept header assigns to mediaType.
+// The most specific matching range wins (type/subtype over type/* over */*).
+func acceptQuality(acceptHeaders []string, mediaType string) float64 {
+	mainType := strings.SplitN(mediaType, "/", 2)[0]
+	quality, specificity := 0.0, -1
+
+	for _, header := range acceptHeaders {
+		for _, accepted := range strings.Split(header, ",") {
+			rangeType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+			if err != nil {
+				continue
+			}
+
+			var s int
+			switch rangeType {
+			case mediaType:
+				s = 2
+			case mainType + "/*":
+				s = 1
+			case "*/*":
+				s = 0
+			default:
+				continue
+			}
+			if s <= specificity {
+				continue
+			}
+
+			q := 1.0
+			if raw, ok := params["q"]; ok {
+				if parsed, err := strconv.ParseFloat(raw, 64); err == nil {
+					q = parsed
+				}
+			}
+			quality, specificity = q, s
+		}
+	}
+	return quality
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+// Mapbox Vector Tile v2 encoding (https://github.com/mapbox/vector-tile-spec/tree/master/2.1).
+const (
+	mvtLayerName = "quests"
+	mvtExtent    = 4096
+	mvtVersion   = 2
+
+	// Field numbers from vector_tile.proto
+	mvtFieldTileLayers      = 3
+	mvtFieldLayerVersion    = 15
+	mvtFieldLayerName       = 1
+	mvtFieldLayerFeatures   = 2
+	mvtFieldLayerKeys       = 3
+	mvtFieldLayerValues     = 4
+	mvtFieldLayerExtent     = 5
+	mvtFieldFeatureID       = 1
+	mvtFieldFeatureTags     = 2
+	mvtFieldFeatureType     = 3
+	mvtFieldFeatureGeometry = 4
+	mvtFieldValueUint       = 5
+	mvtGeometryPoint        = 1
+	mvtCommandMoveTo        = 1
+)
+
+// mvtStatuses are the per-status feature properties, in key order after "count"
+var mvtStatuses = []quest.Status{
+	quest.StatusCreated,
+	quest.StatusPosted,
+	quest.StatusAssigned,
+	quest.StatusInProgress,
+	quest.StatusPendingReview,
+	quest.StatusDeclined,
+	quest.StatusCompleted,
+}
+
+// QuestTileToMVT encodes quest clusters as a Mapbox Vector Tile with a single "quests" layer.
+// Each cluster is a point feature at the cluster center with "count" and per-status count properties.
+func QuestTileToMVT(tile queries.QuestTile) []byte {
+	keys := []string{"count"}
+	for _, status := range mvtStatuses {
+		keys = append(keys, string(status))
+	}
+
+	// Values are shared between features; index them by count
+	var values []uint64
+	valueIndex := make(map[uint64]uint32)
+	indexOf := func(v uint64) uint32 {
+		if i, ok := valueIndex[v]; ok {
+			return i
+		}
+		i := uint32(len(values))
+		values = append(values, v)
+		valueIndex[v] = i
+		return i
+	}
+
+	var layer []byte
+	layer = protowire.AppendTag(layer, mvtFieldLayerVersion, protowire.VarintType)
+	layer = protowire.AppendVarint(layer, mvtVersion)
+	layer = protowire.AppendTag(layer, mvtFieldLayerName, protowire.BytesType)
+	layer = protowire.AppendString(layer, mvtLayerName)
+
+	for i, cluster := range tile.Clusters {
+		var tags []byte
+		tags = protowire.AppendVarint(tags, 0)
+		tags = protowire.AppendVarint(tags, uint64(indexOf(uint64(cluster.Count))))
+		for k, status := range mvtStatuses {
+			tags = protowire.AppendVarint(tags, uint64(k+1))
+			tags = protowire.AppendVarint(tags, uint64(indexOf(uint64(cluster.StatusCounts[status]))))
+		}
+
+		x, y := tile.Tile.Project(cluster.Center, mvtExtent)
+		var geometry []byte
+		geometry = protowire.AppendVarint(geometry, mvtCommand(mvtCommandMoveTo, 1))
+		geometry = protowire.AppendVarint(geometry, protowire.EncodeZigZag(int64(x)))
+		geometry = protowire.AppendVarint(geometry, protowire.EncodeZigZag(int64(y)))
+
+		var feature []byte
+		feature = protowire.AppendTag(feature, mvtFieldFeatureID, protowire.VarintType)
+		feature = protowire.AppendVarint(feature, uint64(i+1))
+		feature = protowire.AppendTag(feature, mvtFieldFeatureTags, protowire.BytesType)
+		feature = protowire.AppendBytes(feature, tags)
+		feature = protowire.AppendTag(feature, mvtFieldFeatureType, protowire.VarintType)
+		feature = protowire.AppendVarint(feature, mvtGeometryPoint)
+		feature = protowire.AppendTag(feature, mvtFieldFeatureGeometry, protowire.BytesType)
+		feature = protowire.AppendBytes(feature, geometry)
+
+		layer = protowire.AppendTag(layer, mvtFieldLayerFeatures, protowire.BytesType)
+		layer = protowire.AppendBytes(layer, feature)
+	}
+
+	for _, key := range keys {
+		layer = protowire.AppendTag(layer, mvtFieldLayerKeys, protowire.BytesType)
+		layer = protowire.AppendString(layer, key)
+	}
+	for _, v := range values {
+		var value []byte
+		value = protowire.AppendTag(value, mvtFieldValueUint, protowire.VarintType)
+		value = protowire.AppendVarint(value, v)
+
+		layer = protowire.AppendTag(layer, mvtFieldLayerValues, protowire.BytesType)
+		layer = protowire.AppendBytes(layer, value)
+	}
+	layer = protowire.AppendTag(layer, mvtFieldLayerExtent, protowire.VarintType)
+	layer = protowire.AppendVarint(layer, mvtExtent)
+
+	var out []byte
+	out = protowire.AppendTag(out, mvtFieldTileLayers, protowire.BytesType)
+	out = protowire.AppendBytes(out, layer)
+	return out
+}
+
+// mvtCommand encodes a geometry command integer (command id and repeat count)
+func mvtCommand(id, count uint64) uint64 {
+	return (id & 0x7) | (count << 3)
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/notification"
+)
+
+// ListMyNotifications implements GET /api/v1/me/notifications from OpenAPI.
+func (a *ApiHandler) ListMyNotifications(ctx context.Context, request v1.ListMyNotificationsRequestObject) (v1.ListMyNotificationsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	query := queries.ListNotificationsQuery{UserID: userID}
+	if request.Params.UnreadOnly != nil {
+		query.UnreadOnly = *request.Params.UnreadOnly
+	}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		query.Offset = *request.Params.Offset
+	}
+
+	page, err := a.listNotificationsHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for an invalid page)
+		return nil, err
+	}
+
+	notifications := make([]v1.Notification, 0, len(page.Notifications))
+	for _, n := range page.Notifications {
+		notifications = append(notifications, NotificationToAPI(n))
+	}
+	return v1.ListMyNotifications200JSONResponse(v1.NotificationPage{
+		Notifications: notifications,
+		Total:         page.Total,
+		Unread:        page.Unread,
+	}), nil
+}
+
+// MarkNotificationRead implements POST /api/v1/me/notifications/{notification_id}/read from OpenAPI.
+func (a *ApiHandler) MarkNotificationRead(ctx context.Context, request v1.MarkNotificationReadRequestObject) (v1.MarkNotificationReadResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	n, err := a.markNotificationReadHandler.Handle(ctx, commands.MarkNotificationReadCommand{
+		NotificationID: request.NotificationId,
+		UserID:         userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (404 for notifications of other users)
+		return nil, err
+	}
+
+	return v1.MarkNotificationRead200JSONResponse(NotificationToAPI(n)), nil
+}
+
+// MarkAllNotificationsRead implements POST /api/v1/me/notifications/read-all from OpenAPI.
+func (a *ApiHandler) MarkAllNotificationsRead(ctx context.Context, request v1.MarkAllNotificationsReadRequestObject) (v1.MarkAllNotificationsReadResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	marked, err := a.markAllNotificationsReadHandler.Handle(ctx, commands.MarkAllNotificationsReadCommand{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.MarkAllNotificationsRead200JSONResponse(v1.MarkAllNotificationsReadResponse{Marked: marked}), nil
+}
+
+// GetMyNotificationPreferences implements GET /api/v1/me/notification-preferences from OpenAPI.
+func (a *ApiHandler) GetMyNotificationPreferences(ctx context.Context, request v1.GetMyNotificationPreferencesRequestObject) (v1.GetMyNotificationPreferencesResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	preferences, err := a.getNotificationPreferencesHandler.Handle(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.GetMyNotificationPreferences200JSONResponse(NotificationPreferencesToAPI(preferences)), nil
+}
+
+// UpdateMyNotificationPreferences implements PUT /api/v1/me/notification-preferences from OpenAPI.
+func (a *ApiHandler) UpdateMyNotificationPreferences(ctx context.Context, request v1.UpdateMyNotificationPreferencesRequestObject) (v1.UpdateMyNotificationPreferencesResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	enabled := make(map[string]bool)
+	for kind, on := range map[notification.Kind]*bool{
+		notification.KindQuestAssigned:      request.Body.QuestAssigned,
+		notification.KindQuestStatusChanged: request.Body.QuestStatusChanged,
+		notification.KindQuestCommentAdded:  request.Body.QuestCommentAdded,
+	} {
+		if on != nil {
+			enabled[string(kind)] = *on
+		}
+	}
+
+	preferences, err := a.updateNotificationPreferencesHandler.Handle(ctx, commands.UpdateNotificationPreferencesCommand{
+		UserID:  userID,
+		Enabled: enabled,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation)
+		return nil, err
+	}
+
+	return v1.UpdateMyNotificationPreferences200JSONResponse(NotificationPreferencesToAPI(preferences)), nil
+}
@@ -0,0 +1,111 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+
+	"github.com/google/uuid"
+)
+
+// ApplyToQuest implements POST /api/v1/quests/{quest_id}/applications from OpenAPI.
+func (a *ApiHandler) ApplyToQuest(ctx context.Context, request v1.ApplyToQuestRequestObject) (v1.ApplyToQuestResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	cmd := commands.ApplyToQuestCommand{
+		QuestID: request.QuestId,
+		UserID:  userID,
+	}
+	if request.Body.Message != nil {
+		cmd.Message = *request.Body.Message
+	}
+
+	application, err := a.applyToQuestHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation, 404 for not found)
+		return nil, err
+	}
+
+	return v1.ApplyToQuest201JSONResponse(ApplicationToAPI(application)), nil
+}
+
+// ListQuestApplications implements GET /api/v1/quests/{quest_id}/applications from OpenAPI.
+func (a *ApiHandler) ListQuestApplications(ctx context.Context, request v1.ListQuestApplicationsRequestObject) (v1.ListQuestApplicationsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	applications, err := a.listQuestApplicationsHandler.Handle(ctx, queries.ListQuestApplicationsQuery{
+		QuestID: request.QuestId,
+		UserID:  userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (403 for non-creators, 404 for not found)
+		return nil, err
+	}
+
+	result := make([]v1.Application, 0, len(applications))
+	for _, application := range applications {
+		result = append(result, ApplicationToAPI(application))
+	}
+	return v1.ListQuestApplications200JSONResponse(result), nil
+}
+
+// AcceptApplication implements POST /api/v1/quests/{quest_id}/applications/{application_id}/accept from OpenAPI.
+func (a *ApiHandler) AcceptApplication(ctx context.Context, request v1.AcceptApplicationRequestObject) (v1.AcceptApplicationResponseObject, error) {
+	result, err := a.reviewApplication(ctx, request.QuestId, request.ApplicationId, commands.ApplicationDecisionAccept)
+	if err != nil {
+		return nil, err
+	}
+	return v1.AcceptApplication200JSONResponse(result), nil
+}
+
+// RejectApplication implements POST /api/v1/quests/{quest_id}/applications/{application_id}/reject from OpenAPI.
+func (a *ApiHandler) RejectApplication(ctx context.Context, request v1.RejectApplicationRequestObject) (v1.RejectApplicationResponseObject, error) {
+	result, err := a.reviewApplication(ctx, request.QuestId, request.ApplicationId, commands.ApplicationDecisionReject)
+	if err != nil {
+		return nil, err
+	}
+	return v1.RejectApplication200JSONResponse(result), nil
+}
+
+// reviewApplication runs the review command on behalf of the authenticated user
+func (a *ApiHandler) reviewApplication(ctx context.Context, questID, applicationID uuid.UUID, decision commands.ApplicationDecision) (v1.ReviewApplicationResult, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return v1.ReviewApplicationResult{}, errors.NewBadRequest("user ID not found in context")
+	}
+
+	result, err := a.reviewApplicationHandler.Handle(ctx, commands.ReviewApplicationCommand{
+		QuestID:       questID,
+		ApplicationID: applicationID,
+		UserID:        userID,
+		Decision:      decision,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (400, 403, 404)
+		return v1.ReviewApplicationResult{}, err
+	}
+
+	apiResult := v1.ReviewApplicationResult{
+		Application: ApplicationToAPI(result.Application),
+		QuestStatus: v1.QuestStatus(result.QuestStatus),
+	}
+	if len(result.EligibilityWarnings) > 0 {
+		warnings := EligibilityViolationsToAPI(result.EligibilityWarnings)
+		apiResult.EligibilityWarnings = &warnings
+	}
+	return apiResult, nil
+}
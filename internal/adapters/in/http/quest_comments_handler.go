@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+)
+
+// AddQuestComment implements POST /api/v1/quests/{quest_id}/comments from OpenAPI.
+func (a *ApiHandler) AddQuestComment(ctx context.Context, request v1.AddQuestCommentRequestObject) (v1.AddQuestCommentResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	cmd := commands.AddQuestCommentCommand{
+		QuestID: request.QuestId,
+		UserID:  userID,
+		Body:    request.Body.Body,
+	}
+	if request.Body.Visibility != nil {
+		cmd.Visibility = string(*request.Body.Visibility)
+	}
+
+	comment, err := a.addQuestCommentHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation, 403 for private comments of other users, 404 for not found)
+		return nil, err
+	}
+
+	return v1.AddQuestComment201JSONResponse(CommentToAPI(comment)), nil
+}
+
+// ListQuestComments implements GET /api/v1/quests/{quest_id}/comments from OpenAPI.
+func (a *ApiHandler) ListQuestComments(ctx context.Context, request v1.ListQuestCommentsRequestObject) (v1.ListQuestCommentsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	query := queries.ListQuestCommentsQuery{QuestID: request.QuestId, UserID: userID}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		query.Offset = *request.Params.Offset
+	}
+
+	page, err := a.listQuestCommentsHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for an invalid page, 404 for not found)
+		return nil, err
+	}
+
+	comments := make([]v1.QuestComment, 0, len(page.Comments))
+	for _, comment := range page.Comments {
+		comments = append(comments, CommentToAPI(comment))
+	}
+	return v1.ListQuestComments200JSONResponse(v1.QuestCommentPage{
+		Comments: comments,
+		Total:    page.Total,
+	}), nil
+}
+
+// EditQuestComment implements PATCH /api/v1/quests/{quest_id}/comments/{comment_id} from OpenAPI.
+func (a *ApiHandler) EditQuestComment(ctx context.Context, request v1.EditQuestCommentRequestObject) (v1.EditQuestCommentResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	comment, err := a.editQuestCommentHandler.Handle(ctx, commands.EditQuestCommentCommand{
+		QuestID:   request.QuestId,
+		CommentID: request.CommentId,
+		UserID:    userID,
+		Body:      request.Body.Body,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation, 403 for other users, 404 for not found)
+		return nil, err
+	}
+
+	return v1.EditQuestComment200JSONResponse(CommentToAPI(comment)), nil
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+)
+
+// AddQuestPrerequisite implements POST /api/v1/quests/{quest_id}/prerequisites from OpenAPI.
+func (a *ApiHandler) AddQuestPrerequisite(ctx context.Context, request v1.AddQuestPrerequisiteRequestObject) (v1.AddQuestPrerequisiteResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	q, err := a.addQuestPrerequisiteHandler.Handle(ctx, commands.AddQuestPrerequisiteCommand{
+		QuestID:        request.QuestId,
+		PrerequisiteID: request.Body.PrerequisiteId,
+		UserID:         userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation and cycles, 403 for other users, 404 for not found)
+		return nil, err
+	}
+
+	return v1.AddQuestPrerequisite200JSONResponse(QuestToAPI(q)), nil
+}
+
+// RemoveQuestPrerequisite implements DELETE /api/v1/quests/{quest_id}/prerequisites/{prerequisite_id} from OpenAPI.
+func (a *ApiHandler) RemoveQuestPrerequisite(ctx context.Context, request v1.RemoveQuestPrerequisiteRequestObject) (v1.RemoveQuestPrerequisiteResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	_, err := a.removeQuestPrerequisiteHandler.Handle(ctx, commands.RemoveQuestPrerequisiteCommand{
+		QuestID:        request.QuestId,
+		PrerequisiteID: request.PrerequisiteId,
+		UserID:         userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation, 403 for other users, 404 for not found)
+		return nil, err
+	}
+
+	return v1.RemoveQuestPrerequisite204Response{}, nil
+}
+
+// GetQuestDependencies implements GET /api/v1/quests/{quest_id}/dependencies from OpenAPI.
+func (a *ApiHandler) GetQuestDependencies(ctx context.Context, request v1.GetQuestDependenciesRequestObject) (v1.GetQuestDependenciesResponseObject, error) {
+	quests, err := a.getQuestDependenciesHandler.Handle(ctx, request.QuestId)
+	if err != nil {
+		// Pass error to middleware for proper handling (404 for not found)
+		return nil, err
+	}
+
+	return v1.GetQuestDependencies200JSONResponse(DependencyGraphToAPI(request.QuestId, quests)), nil
+}
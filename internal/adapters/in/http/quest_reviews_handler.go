@@ -0,0 +1,55 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+)
+
+// SubmitQuestReview implements POST /api/v1/quests/{quest_id}/reviews from OpenAPI.
+func (a *ApiHandler) SubmitQuestReview(ctx context.Context, request v1.SubmitQuestReviewRequestObject) (v1.SubmitQuestReviewResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	cmd := commands.SubmitReviewCommand{
+		QuestID:    request.QuestId,
+		UserID:     userID,
+		RevieweeID: request.Body.RevieweeId,
+		Rating:     request.Body.Rating,
+	}
+	if request.Body.Comment != nil {
+		cmd.Comment = *request.Body.Comment
+	}
+
+	r, err := a.submitReviewHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for validation, 403 for other users, 404 for not found)
+		return nil, err
+	}
+
+	return v1.SubmitQuestReview201JSONResponse(ReviewToAPI(r)), nil
+}
+
+// ListQuestReviews implements GET /api/v1/quests/{quest_id}/reviews from OpenAPI.
+func (a *ApiHandler) ListQuestReviews(ctx context.Context, request v1.ListQuestReviewsRequestObject) (v1.ListQuestReviewsResponseObject, error) {
+	reviews, err := a.listQuestReviewsHandler.Handle(ctx, request.QuestId)
+	if err != nil {
+		// Pass error to middleware for proper handling (404 for not found)
+		return nil, err
+	}
+
+	result := make(v1.ListQuestReviews200JSONResponse, 0, len(reviews))
+	for _, r := range reviews {
+		result = append(result, ReviewToAPI(r))
+	}
+	return result, nil
+}
@@ -0,0 +1,293 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/template"
+)
+
+// CreateQuestTemplate implements POST /api/v1/quest-templates from OpenAPI.
+func (a *ApiHandler) CreateQuestTemplate(ctx context.Context, request v1.CreateQuestTemplateRequestObject) (v1.CreateQuestTemplateResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	spec, err := questSpecFromAPI(request.Body.Quest)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := commands.CreateQuestTemplateCommand{
+		OwnerID: userID,
+		Name:    request.Body.Name,
+		Quest:   spec,
+	}
+	if request.Body.Recurrence != nil {
+		cmd.RecurrenceRule = &request.Body.Recurrence.Rule
+		cmd.RecurrenceStart = request.Body.Recurrence.StartsAt
+	}
+
+	t, err := a.createQuestTemplateHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 for an invalid template or rule)
+		return nil, err
+	}
+
+	return v1.CreateQuestTemplate201JSONResponse(QuestTemplateToAPI(t)), nil
+}
+
+// ListQuestTemplates implements GET /api/v1/quest-templates from OpenAPI.
+func (a *ApiHandler) ListQuestTemplates(ctx context.Context, request v1.ListQuestTemplatesRequestObject) (v1.ListQuestTemplatesResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	templates, err := a.listQuestTemplatesHandler.Handle(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListQuestTemplates200JSONResponse, 0, len(templates))
+	for _, t := range templates {
+		response = append(response, QuestTemplateToAPI(t))
+	}
+	return response, nil
+}
+
+// GetQuestTemplate implements GET /api/v1/quest-templates/{template_id} from OpenAPI.
+func (a *ApiHandler) GetQuestTemplate(ctx context.Context, request v1.GetQuestTemplateRequestObject) (v1.GetQuestTemplateResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	t, err := a.getQuestTemplateHandler.Handle(ctx, queries.GetQuestTemplateQuery{
+		TemplateID: request.TemplateId,
+		UserID:     userID,
+	})
+	if err != nil {
+		// Pass error to middleware for proper handling (404 for templates of other users)
+		return nil, err
+	}
+
+	return v1.GetQuestTemplate200JSONResponse(QuestTemplateToAPI(t)), nil
+}
+
+// UpdateQuestTemplate implements PUT /api/v1/quest-templates/{template_id} from OpenAPI.
+func (a *ApiHandler) UpdateQuestTemplate(ctx context.Context, request v1.UpdateQuestTemplateRequestObject) (v1.UpdateQuestTemplateResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	spec, err := questSpecFromAPI(request.Body.Quest)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := commands.UpdateQuestTemplateCommand{
+		TemplateID: request.TemplateId,
+		UserID:     userID,
+		Name:       request.Body.Name,
+		Quest:      spec,
+	}
+	if request.Body.Recurrence != nil {
+		cmd.RecurrenceRule = &request.Body.Recurrence.Rule
+		cmd.RecurrenceStart = request.Body.Recurrence.StartsAt
+	}
+
+	t, err := a.updateQuestTemplateHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.UpdateQuestTemplate200JSONResponse(QuestTemplateToAPI(t)), nil
+}
+
+// DeleteQuestTemplate implements DELETE /api/v1/quest-templates/{template_id} from OpenAPI.
+func (a *ApiHandler) DeleteQuestTemplate(ctx context.Context, request v1.DeleteQuestTemplateRequestObject) (v1.DeleteQuestTemplateResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	if err := a.deleteQuestTemplateHandler.Handle(ctx, commands.DeleteQuestTemplateCommand{
+		TemplateID: request.TemplateId,
+		UserID:     userID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return v1.DeleteQuestTemplate204Response{}, nil
+}
+
+// CreateQuestFromTemplate implements POST /api/v1/quest-templates/{template_id}/quests from OpenAPI.
+func (a *ApiHandler) CreateQuestFromTemplate(ctx context.Context, request v1.CreateQuestFromTemplateRequestObject) (v1.CreateQuestFromTemplateResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	cmd := commands.CreateQuestFromTemplateCommand{
+		TemplateID: request.TemplateId,
+		UserID:     userID,
+	}
+	if request.Body != nil {
+		overrides, err := questOverridesFromAPI(*request.Body)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Overrides = overrides
+	}
+
+	q, err := a.createQuestFromTemplateHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400 if the result is not a valid quest)
+		return nil, err
+	}
+
+	return v1.CreateQuestFromTemplate201JSONResponse(QuestToAPI(q)), nil
+}
+
+// questSpecFromAPI converts the quest definition of a template request.
+func questSpecFromAPI(body v1.CreateQuestRequest) (template.Spec, error) {
+	targetLocation, err := convertAPILocationInputToKernel(body.TargetLocation)
+	if err != nil {
+		return template.Spec{}, errors.NewBadRequest("Request validation failed: quest.target_location invalid location (" + err.Error() + ")")
+	}
+	executionLocation, err := convertAPILocationInputToKernel(body.ExecutionLocation)
+	if err != nil {
+		return template.Spec{}, errors.NewBadRequest("Request validation failed: quest.execution_location invalid location (" + err.Error() + ")")
+	}
+	waypoints, err := convertAPIWaypointsToKernel(body.Waypoints, "quest.waypoints")
+	if err != nil {
+		return template.Spec{}, err
+	}
+
+	spec := template.Spec{
+		Title:             body.Title,
+		Description:       body.Description,
+		Difficulty:        string(body.Difficulty),
+		Reward:            body.Reward,
+		DurationMinutes:   body.DurationMinutes,
+		TargetLocation:    targetLocation,
+		TargetName:        body.TargetLocation.Name,
+		TargetAddress:     body.TargetLocation.Address,
+		ExecutionLocation: executionLocation,
+		ExecutionName:     body.ExecutionLocation.Name,
+		ExecutionAddress:  body.ExecutionLocation.Address,
+		Waypoints:         waypoints,
+		Equipment:         []string{},
+		Skills:            []string{},
+	}
+	if body.Equipment != nil {
+		spec.Equipment = *body.Equipment
+	}
+	if body.Skills != nil {
+		spec.Skills = *body.Skills
+	}
+	if body.EligibilityPolicy != nil {
+		spec.EligibilityPolicy = string(*body.EligibilityPolicy)
+	}
+	if body.AssignmentMode != nil {
+		spec.AssignmentMode = string(*body.AssignmentMode)
+	}
+	if body.Capacity != nil {
+		spec.Capacity = *body.Capacity
+	}
+	if body.CompletionQuorum != nil {
+		spec.CompletionQuorum = *body.CompletionQuorum
+	}
+	if body.GeofenceRadius != nil {
+		spec.GeofenceRadius = *body.GeofenceRadius
+	}
+	return spec, nil
+}
+
+// questOverridesFromAPI converts the fields replacing template values for one quest.
+func questOverridesFromAPI(body v1.QuestOverrides) (commands.QuestOverrides, error) {
+	overrides := commands.QuestOverrides{
+		Title:            body.Title,
+		Description:      body.Description,
+		Reward:           body.Reward,
+		DurationMinutes:  body.DurationMinutes,
+		Equipment:        body.Equipment,
+		Skills:           body.Skills,
+		Capacity:         body.Capacity,
+		CompletionQuorum: body.CompletionQuorum,
+		GeofenceRadius:   body.GeofenceRadius,
+	}
+	if body.Difficulty != nil {
+		difficulty := string(*body.Difficulty)
+		overrides.Difficulty = &difficulty
+	}
+	if body.EligibilityPolicy != nil {
+		policy := string(*body.EligibilityPolicy)
+		overrides.EligibilityPolicy = &policy
+	}
+	if body.AssignmentMode != nil {
+		mode := string(*body.AssignmentMode)
+		overrides.AssignmentMode = &mode
+	}
+	if body.TargetLocation != nil {
+		coordinate, err := convertAPILocationInputToKernel(*body.TargetLocation)
+		if err != nil {
+			return commands.QuestOverrides{}, errors.NewBadRequest("Request validation failed: target_location invalid location (" + err.Error() + ")")
+		}
+		overrides.TargetLocation = &commands.LocationOverride{
+			Coordinate: coordinate,
+			Name:       body.TargetLocation.Name,
+			Address:    body.TargetLocation.Address,
+		}
+	}
+	if body.ExecutionLocation != nil {
+		coordinate, err := convertAPILocationInputToKernel(*body.ExecutionLocation)
+		if err != nil {
+			return commands.QuestOverrides{}, errors.NewBadRequest("Request validation failed: execution_location invalid location (" + err.Error() + ")")
+		}
+		overrides.ExecutionLocation = &commands.LocationOverride{
+			Coordinate: coordinate,
+			Name:       body.ExecutionLocation.Name,
+			Address:    body.ExecutionLocation.Address,
+		}
+	}
+	if body.Waypoints != nil {
+		waypoints, err := convertAPIWaypointsToKernel(body.Waypoints, "waypoints")
+		if err != nil {
+			return commands.QuestOverrides{}, err
+		}
+		overrides.Waypoints = &waypoints
+	}
+	return overrides, nil
+}
+
+// convertAPIWaypointsToKernel converts optional route stops; field names the list in error messages.
+func convertAPIWaypointsToKernel(waypoints *[]v1.Waypoint, field string) ([]kernel.GeoCoordinate, error) {
+	result := []kernel.GeoCoordinate{}
+	if waypoints == nil {
+		return result, nil
+	}
+	for i, w := range *waypoints {
+		waypoint, err := convertAPIWaypointToKernel(w)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("Request validation failed: %s[%d] invalid coordinate values (%s)", field, i, err.Error()))
+		}
+		result = append(result, waypoint)
+	}
+	return result, nil
+}
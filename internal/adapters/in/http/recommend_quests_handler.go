@@ -0,0 +1,56 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// RecommendQuests implements GET /api/v1/quests/recommended from OpenAPI.
+func (a *ApiHandler) RecommendQuests(ctx context.Context, request v1.RecommendQuestsRequestObject) (v1.RecommendQuestsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	query := queries.RecommendQuestsQuery{UserID: userID}
+	if (request.Params.Lat == nil) != (request.Params.Lon == nil) {
+		return nil, errors.NewBadRequest("Request validation failed: lat and lon must be given together")
+	}
+	if request.Params.Lat != nil {
+		position, err := kernel.NewGeoCoordinate(float64(*request.Params.Lat), float64(*request.Params.Lon))
+		if err != nil {
+			return nil, errors.NewBadRequest("Request validation failed: coordinates invalid (" + err.Error() + ")")
+		}
+		query.Position = &position
+	}
+	if request.Params.RadiusKm != nil {
+		query.RadiusKm = float64(*request.Params.RadiusKm)
+	}
+	if request.Params.Skills != nil {
+		query.Skills = *request.Params.Skills
+	}
+	if request.Params.Equipment != nil {
+		query.Equipment = *request.Params.Equipment
+	}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+
+	results, err := a.recommendQuestsHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling
+		return nil, err
+	}
+
+	recommendations := make([]v1.QuestRecommendation, 0, len(results))
+	for _, r := range results {
+		recommendations = append(recommendations, QuestRecommendationToAPI(r))
+	}
+
+	return v1.RecommendQuests200JSONResponse(recommendations), nil
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+// SearchQuestsByArea implements GET /api/v1/quests/search-area from OpenAPI.
+func (a *ApiHandler) SearchQuestsByArea(ctx context.Context, request v1.SearchQuestsByAreaRequestObject) (v1.SearchQuestsByAreaResponseObject, error) {
+	var query queries.SearchQuestsByAreaQuery
+
+	if request.Params.Polygon != nil {
+		polygon, err := parseGeoJSONPolygon(*request.Params.Polygon)
+		if err != nil {
+			return nil, errors.NewBadRequest("Request validation failed: polygon invalid (" + err.Error() + ")")
+		}
+		query.Polygon = &polygon
+	}
+	if request.Params.Bbox != nil {
+		bbox, err := parseBoundingBox(*request.Params.Bbox)
+		if err != nil {
+			return nil, errors.NewBadRequest("Request validation failed: bbox invalid (" + err.Error() + ")")
+		}
+		query.BoundingBox = &bbox
+	}
+	if request.Params.Match != nil {
+		query.Match = quest.LocationMatch(*request.Params.Match)
+	}
+
+	quests, err := a.searchQuestsByAreaHandler.Handle(ctx, query)
+	if err != nil {
+		// Pass error to middleware for proper handling
+		return nil, err
+	}
+
+	apiQuests := make([]v1.Quest, 0, len(quests))
+	for _, q := range quests {
+		apiQuests = append(apiQuests, QuestToAPI(q))
+	}
+
+	return v1.SearchQuestsByArea200JSONResponse(apiQuests), nil
+}
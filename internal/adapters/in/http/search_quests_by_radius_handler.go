@@ -5,6 +5,8 @@ import (
 
 	v1 "quest-manager/api/http/quests/v1"
 	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/queries"
 	"quest-manager/internal/core/domain/model/kernel"
 )
 
@@ -15,15 +17,30 @@ func (a *ApiHandler) SearchQuestsByRadius(ctx context.Context, request v1.Search
 		return nil, errors.NewBadRequest("Request validation failed: coordinates invalid (" + err.Error() + ")")
 	}
 
-	quests, err := a.searchQuestsByRadius.Handle(ctx, center, float64(request.Params.RadiusKm))
+	query := queries.SearchQuestsByRadiusQuery{
+		Center:   center,
+		RadiusKm: float64(request.Params.RadiusKm),
+	}
+	if request.Params.Sort != nil {
+		query.SortBy = queries.RadiusSort(*request.Params.Sort)
+	}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+
+	results, err := a.searchQuestsByRadius.Handle(ctx, query)
 	if err != nil {
 		// Pass error to middleware for proper handling
 		return nil, err
 	}
 
-	var apiQuests []v1.Quest
-	for _, q := range quests {
-		apiQuests = append(apiQuests, QuestToAPI(q))
+	if middleware.MediaTypeFromContext(ctx) == middleware.MediaTypeGeoJSON {
+		return v1.SearchQuestsByRadius200ApplicationGeoPlusJSONResponse(QuestsWithDistanceToGeoJSON(results)), nil
+	}
+
+	apiQuests := make([]v1.QuestWithDistance, 0, len(results))
+	for _, r := range results {
+		apiQuests = append(apiQuests, QuestWithDistanceToAPI(r))
 	}
 
 	return v1.SearchQuestsByRadius200JSONResponse(apiQuests), nil
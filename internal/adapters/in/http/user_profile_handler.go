@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/adapters/in/http/errors"
+	"quest-manager/internal/adapters/in/http/middleware"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/review"
+
+	"github.com/google/uuid"
+)
+
+// GetMyProfile implements GET /api/v1/me/profile from OpenAPI.
+func (a *ApiHandler) GetMyProfile(ctx context.Context, request v1.GetMyProfileRequestObject) (v1.GetMyProfileResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	u, err := a.getUserProfileHandler.Handle(ctx, userID)
+	if err != nil {
+		// Pass error to middleware for proper handling (404 if no profile yet)
+		return nil, err
+	}
+
+	reputation, err := a.reputationOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.GetMyProfile200JSONResponse(UserProfileToAPI(u, reputation)), nil
+}
+
+// UpdateMyProfile implements PUT /api/v1/me/profile from OpenAPI.
+func (a *ApiHandler) UpdateMyProfile(ctx context.Context, request v1.UpdateMyProfileRequestObject) (v1.UpdateMyProfileResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.NewBadRequest("request body is required")
+	}
+
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("user ID not found in context")
+	}
+
+	cmd := commands.UpdateUserProfileCommand{
+		UserID:            userID,
+		DisplayName:       request.Body.DisplayName,
+		MaxTravelRadiusKm: request.Body.MaxTravelRadiusKm,
+	}
+	if request.Body.Skills != nil {
+		cmd.Skills = *request.Body.Skills
+	}
+	if request.Body.Equipment != nil {
+		cmd.Equipment = *request.Body.Equipment
+	}
+	if home := request.Body.HomeLocation; home != nil {
+		coordinate, err := kernel.NewGeoCoordinate(float64(home.Latitude), float64(home.Longitude))
+		if err != nil {
+			return nil, errors.NewBadRequest("Request validation failed: home_location invalid (" + err.Error() + ")")
+		}
+		cmd.HomeLocation = &coordinate
+	}
+
+	u, err := a.updateUserProfileHandler.Handle(ctx, cmd)
+	if err != nil {
+		// Pass error to middleware for proper handling (400, 500)
+		return nil, err
+	}
+
+	reputation, err := a.reputationOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.UpdateMyProfile200JSONResponse(UserProfileToAPI(u, reputation)), nil
+}
+
+// reputationOf returns the reputation shown on the profile of the user.
+func (a *ApiHandler) reputationOf(ctx context.Context, userID uuid.UUID) (review.Reputation, error) {
+	reputations, err := a.getReputationsHandler.Handle(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return review.Reputation{}, err
+	}
+	return reputations[userID], nil
+}
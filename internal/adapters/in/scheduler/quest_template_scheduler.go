@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/pkg/errs"
+)
+
+// DefaultInterval is the time between scheduler runs if none is given
+const DefaultInterval = time.Minute
+
+// QuestTemplateScheduler periodically creates the quests of due quest template occurrences.
+type QuestTemplateScheduler struct {
+	handler  commands.CreateScheduledQuestsCommandHandler
+	interval time.Duration
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewQuestTemplateScheduler creates a scheduler running the handler every interval.
+func NewQuestTemplateScheduler(handler commands.CreateScheduledQuestsCommandHandler, interval time.Duration) (*QuestTemplateScheduler, error) {
+	if handler == nil {
+		return nil, errs.NewValueIsRequiredError("handler")
+	}
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &QuestTemplateScheduler{handler: handler, interval: interval}, nil
+}
+
+// Start runs the scheduler in the background until Close is called.
+// The first run happens right away, catching up on occurrences missed while stopped.
+func (s *QuestTemplateScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.RunOnce(ctx, time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunOnce creates the quests of the occurrences due at now.
+func (s *QuestTemplateScheduler) RunOnce(ctx context.Context, now time.Time) {
+	created, err := s.handler.Handle(ctx, commands.CreateScheduledQuestsCommand{Now: now})
+	if err != nil {
+		slog.ErrorContext(ctx, "quest template scheduler run failed", slog.String("error", err.Error()))
+	}
+	if len(created) > 0 {
+		slog.InfoContext(ctx, "created scheduled quests", slog.Int("count", len(created)))
+	}
+}
+
+// Close stops the scheduler and waits for the current run to finish.
+func (s *QuestTemplateScheduler) Close() error {
+	s.stopOnce.Do(func() {
+		if s.cancel == nil {
+			return
+		}
+		s.cancel()
+		<-s.done
+	})
+	return nil
+}
@@ -0,0 +1,87 @@
+package geocoder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/ports"
+)
+
+var _ ports.Geocoder = &FileGeocoder{}
+
+// FileReverseRadiusKm is how far a coordinate may be from a known place to be reverse geocoded.
+const FileReverseRadiusKm = 0.1
+
+// Place is a known address with its coordinate.
+type Place struct {
+	Address   string  `json:"address"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// FileGeocoder is an offline geocoder backed by a fixed list of places.
+// Addresses match case-insensitively after trimming; reverse lookups return
+// the nearest place within FileReverseRadiusKm.
+type FileGeocoder struct {
+	places []ports.GeocodeResult
+}
+
+// NewFileGeocoder loads places from a JSON file containing an array of Place.
+func NewFileGeocoder(path string) (*FileGeocoder, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read geocoder file: %w", err)
+	}
+
+	var places []Place
+	if err := json.Unmarshal(data, &places); err != nil {
+		return nil, fmt.Errorf("parse geocoder file %s: %w", path, err)
+	}
+	return NewPlacesGeocoder(places)
+}
+
+// NewPlacesGeocoder creates an offline geocoder from places.
+func NewPlacesGeocoder(places []Place) (*FileGeocoder, error) {
+	g := &FileGeocoder{places: make([]ports.GeocodeResult, 0, len(places))}
+	for i, p := range places {
+		if strings.TrimSpace(p.Address) == "" {
+			return nil, fmt.Errorf("place %d: address is required", i)
+		}
+		coordinate, err := kernel.NewGeoCoordinate(p.Latitude, p.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("place %d: %w", i, err)
+		}
+		g.places = append(g.places, ports.GeocodeResult{Coordinate: coordinate, Address: p.Address})
+	}
+	return g, nil
+}
+
+// Geocode returns the place with the given address.
+func (g *FileGeocoder) Geocode(ctx context.Context, address string) (ports.GeocodeResult, error) {
+	address = strings.TrimSpace(address)
+	for _, p := range g.places {
+		if strings.EqualFold(p.Address, address) {
+			return p, nil
+		}
+	}
+	return ports.GeocodeResult{}, ports.ErrGeocodeNotFound
+}
+
+// ReverseGeocode returns the place nearest to coordinate within FileReverseRadiusKm.
+func (g *FileGeocoder) ReverseGeocode(ctx context.Context, coordinate kernel.GeoCoordinate) (ports.GeocodeResult, error) {
+	var nearest *ports.GeocodeResult
+	nearestKm := FileReverseRadiusKm
+	for i := range g.places {
+		if d := coordinate.DistanceTo(g.places[i].Coordinate); d <= nearestKm {
+			nearest, nearestKm = &g.places[i], d
+		}
+	}
+	if nearest == nil {
+		return ports.GeocodeResult{}, ports.ErrGeocodeNotFound
+	}
+	return *nearest, nil
+}
@@ -0,0 +1,125 @@
+package geocoder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/ports"
+)
+
+var _ ports.Geocoder = &NominatimGeocoder{}
+
+// DefaultNominatimTimeout bounds a single request to the geocoding service.
+const DefaultNominatimTimeout = 5 * time.Second
+
+// NominatimGeocoder talks to a Nominatim-compatible HTTP API
+// (/search and /reverse with format=jsonv2).
+type NominatimGeocoder struct {
+	baseURL    string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// nominatimPlace is the subset of a jsonv2 place used by the geocoder.
+// Nominatim returns coordinates as strings.
+type nominatimPlace struct {
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	DisplayName string `json:"display_name"`
+	Error       string `json:"error"`
+}
+
+// NewNominatimGeocoder creates a geocoder for the service at baseURL.
+// The public Nominatim instance requires an identifying User-Agent.
+func NewNominatimGeocoder(baseURL, userAgent string, httpClient *http.Client) *NominatimGeocoder {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultNominatimTimeout}
+	}
+	return &NominatimGeocoder{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		userAgent:  userAgent,
+		httpClient: httpClient,
+	}
+}
+
+// Geocode returns the best match for address.
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address string) (ports.GeocodeResult, error) {
+	params := url.Values{}
+	params.Set("q", address)
+	params.Set("format", "jsonv2")
+	params.Set("limit", "1")
+
+	var places []nominatimPlace
+	if err := g.get(ctx, "/search", params, &places); err != nil {
+		return ports.GeocodeResult{}, err
+	}
+	if len(places) == 0 {
+		return ports.GeocodeResult{}, ports.ErrGeocodeNotFound
+	}
+	return places[0].toResult()
+}
+
+// ReverseGeocode returns the address of the place closest to coordinate.
+func (g *NominatimGeocoder) ReverseGeocode(ctx context.Context, coordinate kernel.GeoCoordinate) (ports.GeocodeResult, error) {
+	params := url.Values{}
+	params.Set("lat", strconv.FormatFloat(coordinate.Lat, 'f', -1, 64))
+	params.Set("lon", strconv.FormatFloat(coordinate.Lon, 'f', -1, 64))
+	params.Set("format", "jsonv2")
+
+	var place nominatimPlace
+	if err := g.get(ctx, "/reverse", params, &place); err != nil {
+		return ports.GeocodeResult{}, err
+	}
+	if place.Error != "" {
+		return ports.GeocodeResult{}, ports.ErrGeocodeNotFound
+	}
+	return place.toResult()
+}
+
+func (g *NominatimGeocoder) get(ctx context.Context, path string, params url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("build geocoder request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if g.userAgent != "" {
+		req.Header.Set("User-Agent", g.userAgent)
+	}
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("geocoder request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("geocoder request %s: unexpected status %d", path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode geocoder response: %w", err)
+	}
+	return nil
+}
+
+func (p nominatimPlace) toResult() (ports.GeocodeResult, error) {
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return ports.GeocodeResult{}, fmt.Errorf("invalid latitude %q in geocoder response", p.Lat)
+	}
+	lon, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return ports.GeocodeResult{}, fmt.Errorf("invalid longitude %q in geocoder response", p.Lon)
+	}
+	coordinate, err := kernel.NewGeoCoordinate(lat, lon)
+	if err != nil {
+		return ports.GeocodeResult{}, fmt.Errorf("invalid coordinate in geocoder response: %w", err)
+	}
+	return ports.GeocodeResult{Coordinate: coordinate, Address: p.DisplayName}, nil
+}
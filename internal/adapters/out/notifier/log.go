@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+)
+
+var _ ports.NotificationChannel = &LogChannel{}
+
+// LogChannel is the default delivery channel: it only logs notifications.
+// Email or push channels implement ports.NotificationChannel the same way.
+type LogChannel struct{}
+
+// NewLogChannel returns the log-only delivery channel.
+func NewLogChannel() *LogChannel {
+	return &LogChannel{}
+}
+
+// Deliver logs the notification and never fails.
+func (c *LogChannel) Deliver(ctx context.Context, n notification.Notification) error {
+	slog.InfoContext(ctx, "notification delivered",
+		slog.String("notification_id", n.ID.String()),
+		slog.String("user_id", n.UserID.String()),
+		slog.String("kind", string(n.Kind)),
+		slog.String("message", n.Message),
+	)
+	return nil
+}
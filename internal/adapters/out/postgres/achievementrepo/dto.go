@@ -0,0 +1,29 @@
+package achievementrepo
+
+import "time"
+
+// FactDTO is the database model for something a user did that achievements count.
+type FactDTO struct {
+	UserID      string    `gorm:"primaryKey"`
+	QuestID     string    `gorm:"primaryKey"`
+	Kind        string    `gorm:"primaryKey;size:20"`
+	Difficulty  string    `gorm:"size:20;not null"`
+	LocationKey string    `gorm:"size:12;not null"`
+	OccurredAt  time.Time `gorm:"not null"`
+}
+
+func (FactDTO) TableName() string {
+	return "achievement_facts"
+}
+
+// UnlockedDTO is the database model for an achievement unlocked by a user.
+type UnlockedDTO struct {
+	UserID     string    `gorm:"primaryKey"`
+	Code       string    `gorm:"primaryKey;size:64"`
+	Title      string    `gorm:"size:200;not null"`
+	UnlockedAt time.Time `gorm:"not null"`
+}
+
+func (UnlockedDTO) TableName() string {
+	return "user_achievements"
+}
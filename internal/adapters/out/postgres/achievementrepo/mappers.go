@@ -0,0 +1,64 @@
+package achievementrepo
+
+import (
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// FactToDTO converts Fact domain model to FactDTO
+func FactToDTO(f achievement.Fact) FactDTO {
+	return FactDTO{
+		UserID:      f.UserID.String(),
+		QuestID:     f.QuestID.String(),
+		Kind:        string(f.Kind),
+		Difficulty:  string(f.Difficulty),
+		LocationKey: f.LocationKey,
+		OccurredAt:  f.OccurredAt,
+	}
+}
+
+// DtoToFact converts FactDTO to Fact domain model
+func DtoToFact(dto FactDTO) (achievement.Fact, error) {
+	userID, err := uuid.Parse(dto.UserID)
+	if err != nil {
+		return achievement.Fact{}, err
+	}
+	questID, err := uuid.Parse(dto.QuestID)
+	if err != nil {
+		return achievement.Fact{}, err
+	}
+	return achievement.Fact{
+		UserID:      userID,
+		QuestID:     questID,
+		Kind:        achievement.FactKind(dto.Kind),
+		Difficulty:  quest.Difficulty(dto.Difficulty),
+		LocationKey: dto.LocationKey,
+		OccurredAt:  dto.OccurredAt,
+	}, nil
+}
+
+// UnlockedToDTO converts Unlocked domain model to UnlockedDTO
+func UnlockedToDTO(u achievement.Unlocked) UnlockedDTO {
+	return UnlockedDTO{
+		UserID:     u.UserID.String(),
+		Code:       u.Code,
+		Title:      u.Title,
+		UnlockedAt: u.UnlockedAt,
+	}
+}
+
+// DtoToUnlocked converts UnlockedDTO to Unlocked domain model
+func DtoToUnlocked(dto UnlockedDTO) (achievement.Unlocked, error) {
+	userID, err := uuid.Parse(dto.UserID)
+	if err != nil {
+		return achievement.Unlocked{}, err
+	}
+	return achievement.Unlocked{
+		UserID:     userID,
+		Code:       dto.Code,
+		Title:      dto.Title,
+		UnlockedAt: dto.UnlockedAt,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package achievementrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.AchievementRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// RecordFact inserts the fact; a fact with the same user, quest and kind is left as it is.
+func (r *Repository) RecordFact(ctx context.Context, fact achievement.Fact) (bool, error) {
+	dto := FactToDTO(fact)
+	return r.insertIgnoringDuplicates(ctx, &dto, "failed to record achievement fact")
+}
+
+// FindFacts retrieves all facts of the user.
+func (r *Repository) FindFacts(ctx context.Context, userID uuid.UUID) ([]achievement.Fact, error) {
+	var dtos []FactDTO
+	if err := r.db().WithContext(ctx).
+		Where("user_id = ?", userID.String()).
+		Order("occurred_at ASC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find achievement facts", err)
+	}
+
+	facts := make([]achievement.Fact, 0, len(dtos))
+	for _, dto := range dtos {
+		fact, err := DtoToFact(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert achievement fact", err)
+		}
+		facts = append(facts, fact)
+	}
+	return facts, nil
+}
+
+// Unlock inserts the achievement; an achievement the user already unlocked is left as it is.
+func (r *Repository) Unlock(ctx context.Context, unlocked achievement.Unlocked) (bool, error) {
+	dto := UnlockedToDTO(unlocked)
+	return r.insertIgnoringDuplicates(ctx, &dto, "failed to unlock achievement")
+}
+
+// FindUnlocked retrieves the achievements of the user, oldest first.
+func (r *Repository) FindUnlocked(ctx context.Context, userID uuid.UUID) ([]achievement.Unlocked, error) {
+	var dtos []UnlockedDTO
+	if err := r.db().WithContext(ctx).
+		Where("user_id = ?", userID.String()).
+		Order("unlocked_at ASC, code ASC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find unlocked achievements", err)
+	}
+
+	result := make([]achievement.Unlocked, 0, len(dtos))
+	for _, dto := range dtos {
+		unlocked, err := DtoToUnlocked(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert unlocked achievement", err)
+		}
+		result = append(result, unlocked)
+	}
+	return result, nil
+}
+
+// insertIgnoringDuplicates inserts the row unless its primary key exists and reports whether it was inserted.
+func (r *Repository) insertIgnoringDuplicates(ctx context.Context, value interface{}, message string) (bool, error) {
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return false, errs.WrapInfrastructureError("failed to begin achievement transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	result := tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(value)
+	if result.Error != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return false, errs.WrapInfrastructureError(message, result.Error)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return false, errs.WrapInfrastructureError("failed to commit achievement transaction", err)
+		}
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// db reads inside the current transaction if there is one, so that facts recorded
+// by event handlers are counted before the commit.
+func (r *Repository) db() *gorm.DB {
+	if r.tracker.InTx() {
+		return r.tracker.Tx()
+	}
+	return r.tracker.Db()
+}
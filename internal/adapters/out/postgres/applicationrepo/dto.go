@@ -0,0 +1,18 @@
+package applicationrepo
+
+import "time"
+
+// ApplicationDTO is the database model for a quest application.
+type ApplicationDTO struct {
+	ID          string `gorm:"primaryKey"`
+	QuestID     string `gorm:"not null;uniqueIndex:idx_application_quest_applicant"`
+	ApplicantID string `gorm:"not null;uniqueIndex:idx_application_quest_applicant;index"`
+	Message     string
+	Status      string `gorm:"size:10;not null;index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (ApplicationDTO) TableName() string {
+	return "quest_applications"
+}
@@ -0,0 +1,46 @@
+package applicationrepo
+
+import (
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Application domain model to ApplicationDTO
+func DomainToDTO(a quest.Application) ApplicationDTO {
+	return ApplicationDTO{
+		ID:          a.ID.String(),
+		QuestID:     a.QuestID.String(),
+		ApplicantID: a.ApplicantID.String(),
+		Message:     a.Message,
+		Status:      string(a.Status),
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+// DtoToDomain converts ApplicationDTO to Application domain model
+func DtoToDomain(dto ApplicationDTO) (quest.Application, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return quest.Application{}, err
+	}
+	questID, err := uuid.Parse(dto.QuestID)
+	if err != nil {
+		return quest.Application{}, err
+	}
+	applicantID, err := uuid.Parse(dto.ApplicantID)
+	if err != nil {
+		return quest.Application{}, err
+	}
+
+	return quest.Application{
+		ID:          id,
+		QuestID:     questID,
+		ApplicantID: applicantID,
+		Message:     dto.Message,
+		Status:      quest.ApplicationStatus(dto.Status),
+		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
+	}, nil
+}
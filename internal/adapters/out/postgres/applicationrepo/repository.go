@@ -0,0 +1,92 @@
+package applicationrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var _ ports.ApplicationRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts or updates an application.
+func (r *Repository) Save(ctx context.Context, application quest.Application) error {
+	dto := DomainToDTO(application)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin application transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	if err := tx.WithContext(ctx).Save(&dto).Error; err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError("failed to save application", err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit application transaction", err)
+		}
+	}
+	return nil
+}
+
+// GetByID retrieves an application.
+// Returns an error wrapping ports.ErrApplicationNotFound if it does not exist.
+func (r *Repository) GetByID(ctx context.Context, applicationID uuid.UUID) (quest.Application, error) {
+	var dto ApplicationDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("id = ?", applicationID.String()).
+		First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return quest.Application{}, fmt.Errorf("application %s: %w", applicationID, ports.ErrApplicationNotFound)
+		}
+		return quest.Application{}, errs.WrapInfrastructureError("failed to get application by ID", err)
+	}
+	return DtoToDomain(dto)
+}
+
+// FindByQuest retrieves the applications to a quest, oldest first.
+func (r *Repository) FindByQuest(ctx context.Context, questID uuid.UUID) ([]quest.Application, error) {
+	var dtos []ApplicationDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("quest_id = ?", questID.String()).
+		Order("created_at ASC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find applications by quest", err)
+	}
+
+	applications := make([]quest.Application, 0, len(dtos))
+	for _, dto := range dtos {
+		a, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		applications = append(applications, a)
+	}
+	return applications, nil
+}
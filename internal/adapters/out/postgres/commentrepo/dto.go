@@ -0,0 +1,18 @@
+package commentrepo
+
+import "time"
+
+// CommentDTO is the database model for a comment in the thread of a quest.
+type CommentDTO struct {
+	ID         string    `gorm:"primaryKey"`
+	QuestID    string    `gorm:"not null;index:idx_comment_quest_created"`
+	AuthorID   string    `gorm:"not null;index"`
+	Body       string    `gorm:"not null"`
+	Visibility string    `gorm:"size:10;not null"`
+	CreatedAt  time.Time `gorm:"index:idx_comment_quest_created"`
+	EditedAt   *time.Time
+}
+
+func (CommentDTO) TableName() string {
+	return "quest_comments"
+}
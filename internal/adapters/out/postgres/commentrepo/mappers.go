@@ -0,0 +1,46 @@
+package commentrepo
+
+import (
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Comment domain model to CommentDTO
+func DomainToDTO(c quest.Comment) CommentDTO {
+	return CommentDTO{
+		ID:         c.ID.String(),
+		QuestID:    c.QuestID.String(),
+		AuthorID:   c.AuthorID.String(),
+		Body:       c.Body,
+		Visibility: string(c.Visibility),
+		CreatedAt:  c.CreatedAt,
+		EditedAt:   c.EditedAt,
+	}
+}
+
+// DtoToDomain converts CommentDTO to Comment domain model
+func DtoToDomain(dto CommentDTO) (quest.Comment, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return quest.Comment{}, err
+	}
+	questID, err := uuid.Parse(dto.QuestID)
+	if err != nil {
+		return quest.Comment{}, err
+	}
+	authorID, err := uuid.Parse(dto.AuthorID)
+	if err != nil {
+		return quest.Comment{}, err
+	}
+
+	return quest.Comment{
+		ID:         id,
+		QuestID:    questID,
+		AuthorID:   authorID,
+		Body:       dto.Body,
+		Visibility: quest.CommentVisibility(dto.Visibility),
+		CreatedAt:  dto.CreatedAt,
+		EditedAt:   dto.EditedAt,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package commentrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var _ ports.CommentRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts or updates a comment.
+func (r *Repository) Save(ctx context.Context, comment quest.Comment) error {
+	dto := DomainToDTO(comment)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin comment transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	if err := tx.WithContext(ctx).Save(&dto).Error; err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError("failed to save comment", err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit comment transaction", err)
+		}
+	}
+	return nil
+}
+
+// GetByID retrieves a comment.
+// Returns an error wrapping ports.ErrCommentNotFound if it does not exist.
+func (r *Repository) GetByID(ctx context.Context, commentID uuid.UUID) (quest.Comment, error) {
+	var dto CommentDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("id = ?", commentID.String()).
+		First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return quest.Comment{}, fmt.Errorf("comment %s: %w", commentID, ports.ErrCommentNotFound)
+		}
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to get comment by ID", err)
+	}
+	return DtoToDomain(dto)
+}
+
+// FindByQuest retrieves a page of the comments of a quest, oldest first.
+func (r *Repository) FindByQuest(ctx context.Context, questID uuid.UUID, includePrivate bool, limit, offset int) ([]quest.Comment, int, error) {
+	db := r.tracker.Db().WithContext(ctx)
+	visible := func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("quest_id = ?", questID.String())
+		if !includePrivate {
+			tx = tx.Where("visibility = ?", string(quest.CommentPublic))
+		}
+		return tx
+	}
+
+	var total int64
+	if err := db.Model(&CommentDTO{}).Scopes(visible).Count(&total).Error; err != nil {
+		return nil, 0, errs.WrapInfrastructureError("failed to count comments", err)
+	}
+
+	var dtos []CommentDTO
+	if err := db.Scopes(visible).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&dtos).Error; err != nil {
+		return nil, 0, errs.WrapInfrastructureError("failed to find comments by quest", err)
+	}
+
+	comments := make([]quest.Comment, 0, len(dtos))
+	for _, dto := range dtos {
+		c, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, 0, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		comments = append(comments, c)
+	}
+	return comments, int(total), nil
+}
@@ -0,0 +1,43 @@
+package evidencerepo
+
+import "time"
+
+// EvidenceDTO is the database model for quest completion evidence.
+type EvidenceDTO struct {
+	ID          string `gorm:"primaryKey"`
+	QuestID     string `gorm:"not null;index"`
+	SubmittedBy string `gorm:"not null;index"`
+	Note        string
+
+	// GPS position of the participant at completion
+	Latitude  float64
+	Longitude float64
+
+	// Photos, stored in quest_evidence_attachments
+	Attachments []AttachmentDTO `gorm:"foreignKey:EvidenceID;constraint:OnDelete:CASCADE"`
+
+	Status          string `gorm:"size:10;not null;index"`
+	RejectionReason string
+	SubmittedAt     time.Time
+	ReviewedAt      *time.Time
+}
+
+func (EvidenceDTO) TableName() string {
+	return "quest_evidence"
+}
+
+// AttachmentDTO is the database model for a photo of completion evidence.
+// The content is kept in blob storage under StorageKey.
+type AttachmentDTO struct {
+	ID          string `gorm:"primaryKey"`
+	EvidenceID  string `gorm:"not null;index"`
+	Position    int    // order of upload, starting at 0
+	FileName    string
+	ContentType string `gorm:"size:50"`
+	Size        int64
+	StorageKey  string
+}
+
+func (AttachmentDTO) TableName() string {
+	return "quest_evidence_attachments"
+}
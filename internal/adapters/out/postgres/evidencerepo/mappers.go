@@ -0,0 +1,88 @@
+package evidencerepo
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Evidence domain model to EvidenceDTO
+func DomainToDTO(e quest.Evidence) EvidenceDTO {
+	dto := EvidenceDTO{
+		ID:              e.ID.String(),
+		QuestID:         e.QuestID.String(),
+		SubmittedBy:     e.SubmittedBy.String(),
+		Note:            e.Note,
+		Latitude:        e.Position.Latitude(),
+		Longitude:       e.Position.Longitude(),
+		Status:          string(e.Status),
+		RejectionReason: e.RejectionReason,
+		SubmittedAt:     e.SubmittedAt,
+		ReviewedAt:      e.ReviewedAt,
+	}
+
+	dto.Attachments = make([]AttachmentDTO, len(e.Attachments))
+	for i, a := range e.Attachments {
+		dto.Attachments[i] = AttachmentDTO{
+			ID:          a.ID.String(),
+			EvidenceID:  dto.ID,
+			Position:    i,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			StorageKey:  a.StorageKey,
+		}
+	}
+
+	return dto
+}
+
+// DtoToDomain converts EvidenceDTO to Evidence domain model
+func DtoToDomain(dto EvidenceDTO) (quest.Evidence, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return quest.Evidence{}, err
+	}
+	questID, err := uuid.Parse(dto.QuestID)
+	if err != nil {
+		return quest.Evidence{}, err
+	}
+	submittedBy, err := uuid.Parse(dto.SubmittedBy)
+	if err != nil {
+		return quest.Evidence{}, err
+	}
+	position, err := kernel.NewGeoCoordinate(dto.Latitude, dto.Longitude)
+	if err != nil {
+		return quest.Evidence{}, err
+	}
+
+	// Attachments are loaded in upload order
+	attachments := make([]quest.Attachment, len(dto.Attachments))
+	for i, a := range dto.Attachments {
+		attachmentID, err := uuid.Parse(a.ID)
+		if err != nil {
+			return quest.Evidence{}, err
+		}
+		attachments[i] = quest.Attachment{
+			ID:          attachmentID,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			StorageKey:  a.StorageKey,
+		}
+	}
+
+	return quest.Evidence{
+		ID:              id,
+		QuestID:         questID,
+		SubmittedBy:     submittedBy,
+		Note:            dto.Note,
+		Position:        position,
+		Attachments:     attachments,
+		Status:          quest.EvidenceStatus(dto.Status),
+		RejectionReason: dto.RejectionReason,
+		SubmittedAt:     dto.SubmittedAt,
+		ReviewedAt:      dto.ReviewedAt,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package evidencerepo
+
+import "gorm.io/gorm"
+
+// MigrateEvidence creates the quest_evidence and quest_evidence_attachments tables
+// and the cascading foreign key between them.
+func MigrateEvidence(db *gorm.DB) error {
+	if err := db.AutoMigrate(&EvidenceDTO{}, &AttachmentDTO{}); err != nil {
+		return err
+	}
+	// The has-many constraint belongs to the parent relationship, so AutoMigrate of either model skips it
+	if !db.Migrator().HasConstraint(&EvidenceDTO{}, "Attachments") {
+		return db.Migrator().CreateConstraint(&EvidenceDTO{}, "Attachments")
+	}
+	return nil
+}
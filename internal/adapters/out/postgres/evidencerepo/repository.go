@@ -0,0 +1,99 @@
+package evidencerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var _ ports.EvidenceRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts or updates evidence together with its attachments.
+func (r *Repository) Save(ctx context.Context, evidence quest.Evidence) error {
+	dto := DomainToDTO(evidence)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin evidence transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	if err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto).Error; err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError("failed to save evidence", err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit evidence transaction", err)
+		}
+	}
+	return nil
+}
+
+// GetByID retrieves evidence.
+// Returns an error wrapping ports.ErrEvidenceNotFound if it does not exist.
+func (r *Repository) GetByID(ctx context.Context, evidenceID uuid.UUID) (quest.Evidence, error) {
+	var dto EvidenceDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).Scopes(preloadAttachments).
+		Where("id = ?", evidenceID.String()).
+		First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return quest.Evidence{}, fmt.Errorf("evidence %s: %w", evidenceID, ports.ErrEvidenceNotFound)
+		}
+		return quest.Evidence{}, errs.WrapInfrastructureError("failed to get evidence by ID", err)
+	}
+	return DtoToDomain(dto)
+}
+
+// FindByQuest retrieves the evidence submitted for a quest, oldest first.
+func (r *Repository) FindByQuest(ctx context.Context, questID uuid.UUID) ([]quest.Evidence, error) {
+	var dtos []EvidenceDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).Scopes(preloadAttachments).
+		Where("quest_id = ?", questID.String()).
+		Order("submitted_at ASC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find evidence by quest", err)
+	}
+
+	evidence := make([]quest.Evidence, 0, len(dtos))
+	for _, dto := range dtos {
+		e, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		evidence = append(evidence, e)
+	}
+	return evidence, nil
+}
+
+// preloadAttachments loads the attachments of the selected evidence in upload order
+func preloadAttachments(db *gorm.DB) *gorm.DB {
+	return db.Preload("Attachments", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	})
+}
@@ -0,0 +1,24 @@
+package geoquery
+
+import (
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// BoundingBoxCondition builds a WHERE fragment matching rows whose (latColumn, lonColumn)
+// lie inside bbox. Boxes crossing the antimeridian are queried as two longitude ranges.
+// Column names are trusted identifiers; values are returned as placeholder arguments.
+func BoundingBoxCondition(latColumn, lonColumn string, bbox kernel.BoundingBox) (string, []interface{}) {
+	ranges := bbox.LongitudeRanges()
+
+	lonConditions := make([]string, len(ranges))
+	args := make([]interface{}, 0, 2+2*len(ranges))
+	args = append(args, bbox.MinLat, bbox.MaxLat)
+	for i, r := range ranges {
+		lonConditions[i] = lonColumn + " BETWEEN ? AND ?"
+		args = append(args, r.Min, r.Max)
+	}
+
+	return "(" + latColumn + " BETWEEN ? AND ? AND (" + strings.Join(lonConditions, " OR ") + "))", args
+}
@@ -0,0 +1,33 @@
+package geoquery
+
+import (
+	"strconv"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// DistanceExpression builds a SQL expression for the distance in kilometers from center to
+// (latColumn, lonColumn) by the same Haversine formula as kernel.GeoCoordinate.DistanceTo.
+// Column names are trusted identifiers; values are returned as placeholder arguments.
+func DistanceExpression(latColumn, lonColumn string, center kernel.GeoCoordinate) (string, []interface{}) {
+	radius := strconv.FormatFloat(kernel.EarthRadiusKm, 'f', -1, 64)
+	// LEAST guards ASIN against rounding just above 1 for antipodal points
+	distance := "2 * " + radius + " * ASIN(LEAST(1, SQRT(" +
+		"POWER(SIN(RADIANS(" + latColumn + " - ?) / 2), 2) + " +
+		"COS(RADIANS(?)) * COS(RADIANS(" + latColumn + ")) * POWER(SIN(RADIANS(" + lonColumn + " - ?) / 2), 2))))"
+
+	return distance, []interface{}{center.Lat, center.Lat, center.Lon}
+}
+
+// WithinRadiusCondition builds a WHERE fragment matching rows whose (latColumn, lonColumn)
+// lie within radiusKm of center (see DistanceExpression).
+// The bounding box of the circle is checked first so the coordinate index can be used.
+func WithinRadiusCondition(latColumn, lonColumn string, center kernel.GeoCoordinate, radiusKm float64) (string, []interface{}) {
+	bboxCondition, args := BoundingBoxCondition(latColumn, lonColumn, center.BoundingBoxForRadius(radiusKm))
+
+	distance, distanceArgs := DistanceExpression(latColumn, lonColumn, center)
+	args = append(args, distanceArgs...)
+	args = append(args, radiusKm)
+
+	return "(" + bboxCondition + " AND " + distance + " <= ?)", args
+}
@@ -0,0 +1,43 @@
+package geoquery
+
+import (
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// MaxGeohashPrefixes limits the number of LIKE prefixes in a geohash condition.
+// Larger areas are covered with shorter (coarser) prefixes.
+const MaxGeohashPrefixes = 16
+
+// GeohashPrefixCondition builds a WHERE fragment matching rows whose geohashColumn starts
+// with one of the prefixes. The fragment can use a varchar_pattern_ops index on the column.
+// Returns an empty condition when there are no prefixes.
+func GeohashPrefixCondition(geohashColumn string, prefixes []string) (string, []interface{}) {
+	if len(prefixes) == 0 {
+		return "", nil
+	}
+
+	conditions := make([]string, len(prefixes))
+	args := make([]interface{}, len(prefixes))
+	for i, prefix := range prefixes {
+		conditions[i] = geohashColumn + " LIKE ?"
+		args[i] = prefix + "%"
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// IndexedBoundingBoxCondition combines BoundingBoxCondition with a geohash prefix pre-filter,
+// so vanilla Postgres can narrow candidates by a prefix index before comparing coordinates.
+// The prefixes must cover bbox; without prefixes it is a plain bounding box condition.
+func IndexedBoundingBoxCondition(latColumn, lonColumn, geohashColumn string, bbox kernel.BoundingBox, prefixes []string) (string, []interface{}) {
+	bboxCond, bboxArgs := BoundingBoxCondition(latColumn, lonColumn, bbox)
+
+	prefixCond, prefixArgs := GeohashPrefixCondition(geohashColumn, prefixes)
+	if prefixCond == "" {
+		return bboxCond, bboxArgs
+	}
+
+	return "(" + prefixCond + " AND " + bboxCond + ")", append(prefixArgs, bboxArgs...)
+}
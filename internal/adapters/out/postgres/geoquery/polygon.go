@@ -0,0 +1,39 @@
+package geoquery
+
+import (
+	"strconv"
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// PolygonWKT renders the polygon as Well-Known Text with (lon lat) axis order and closed rings,
+// e.g. POLYGON((30 10, 40 40, 20 40, 30 10)).
+func PolygonWKT(p kernel.Polygon) string {
+	var sb strings.Builder
+	sb.WriteString("POLYGON(")
+	writeRing(&sb, p.Exterior())
+	for _, hole := range p.Holes() {
+		sb.WriteString(", ")
+		writeRing(&sb, hole)
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// writeRing writes the ring and closes it by repeating its first point.
+func writeRing(sb *strings.Builder, ring []kernel.GeoCoordinate) {
+	sb.WriteString("(")
+	for _, c := range ring {
+		writeCoordinate(sb, c)
+		sb.WriteString(", ")
+	}
+	writeCoordinate(sb, ring[0])
+	sb.WriteString(")")
+}
+
+func writeCoordinate(sb *strings.Builder, c kernel.GeoCoordinate) {
+	sb.WriteString(strconv.FormatFloat(c.Lon, 'f', -1, 64))
+	sb.WriteString(" ")
+	sb.WriteString(strconv.FormatFloat(c.Lat, 'f', -1, 64))
+}
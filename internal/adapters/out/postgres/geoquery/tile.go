@@ -0,0 +1,24 @@
+package geoquery
+
+import (
+	"strconv"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// TileIndexExpressions builds SQL expressions for the x and y index of the Web Mercator tile
+// at zoom containing (latColumn, lonColumn), with the same clamping as kernel.TileForCoordinate.
+// Column names are trusted identifiers; zoom is rendered as a literal.
+func TileIndexExpressions(latColumn, lonColumn string, zoom int) (x, y string) {
+	n := strconv.Itoa(1 << zoom)
+	maxIndex := strconv.Itoa(1<<zoom - 1)
+	maxLat := strconv.FormatFloat(kernel.MaxMercatorLatitude, 'f', -1, 64)
+
+	latRad := "RADIANS(GREATEST(-" + maxLat + ", LEAST(" + maxLat + ", " + latColumn + ")))"
+	fx := "(" + lonColumn + " + 180) / 360"
+	fy := "(1 - LN(TAN(" + latRad + ") + 1 / COS(" + latRad + ")) / PI()) / 2"
+
+	x = "CAST(LEAST(GREATEST(FLOOR(" + fx + " * " + n + "), 0), " + maxIndex + ") AS INTEGER)"
+	y = "CAST(LEAST(GREATEST(FLOOR(" + fy + " * " + n + "), 0), " + maxIndex + ") AS INTEGER)"
+	return x, y
+}
@@ -0,0 +1,19 @@
+package leaderboardrepo
+
+import "time"
+
+// ScoreDTO is the database model for a row of the leaderboard projection.
+type ScoreDTO struct {
+	EntryID    string    `gorm:"primaryKey"`
+	UserID     string    `gorm:"not null;index"`
+	QuestID    *string   `gorm:"index"`
+	Points     int       `gorm:"not null"`
+	Completion bool      `gorm:"not null;default:false"`
+	Latitude   *float64  `gorm:"index:idx_leaderboard_scores_location"`
+	Longitude  *float64  `gorm:"index:idx_leaderboard_scores_location"`
+	OccurredAt time.Time `gorm:"not null;index"`
+}
+
+func (ScoreDTO) TableName() string {
+	return "leaderboard_scores"
+}
@@ -0,0 +1,60 @@
+package leaderboardrepo
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Score domain model to ScoreDTO
+func DomainToDTO(s leaderboard.Score) ScoreDTO {
+	dto := ScoreDTO{
+		EntryID:    s.EntryID.String(),
+		UserID:     s.UserID.String(),
+		Points:     s.Points,
+		Completion: s.Completion,
+		OccurredAt: s.OccurredAt,
+	}
+	if s.QuestID != nil {
+		questID := s.QuestID.String()
+		dto.QuestID = &questID
+	}
+	if s.Location != nil {
+		lat, lon := s.Location.Lat, s.Location.Lon
+		dto.Latitude = &lat
+		dto.Longitude = &lon
+	}
+	return dto
+}
+
+// DtoToDomain converts ScoreDTO to Score domain model
+func DtoToDomain(dto ScoreDTO) (leaderboard.Score, error) {
+	entryID, err := uuid.Parse(dto.EntryID)
+	if err != nil {
+		return leaderboard.Score{}, err
+	}
+	userID, err := uuid.Parse(dto.UserID)
+	if err != nil {
+		return leaderboard.Score{}, err
+	}
+
+	score := leaderboard.Score{
+		EntryID:    entryID,
+		UserID:     userID,
+		Points:     dto.Points,
+		Completion: dto.Completion,
+		OccurredAt: dto.OccurredAt,
+	}
+	if dto.QuestID != nil {
+		questID, err := uuid.Parse(*dto.QuestID)
+		if err != nil {
+			return leaderboard.Score{}, err
+		}
+		score.QuestID = &questID
+	}
+	if dto.Latitude != nil && dto.Longitude != nil {
+		score.Location = &kernel.GeoCoordinate{Lat: *dto.Latitude, Lon: *dto.Longitude}
+	}
+	return score, nil
+}
@@ -0,0 +1,24 @@
+package leaderboardrepo
+
+import "gorm.io/gorm"
+
+// postGISStatements enable PostGIS and add a geography column generated from the
+// plain coordinate columns, so writes keep going through ScoreDTO unchanged.
+// Scores not tied to a place get a NULL geography.
+var postGISStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS postgis`,
+	`ALTER TABLE leaderboard_scores ADD COLUMN IF NOT EXISTS location_geog geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_leaderboard_scores_location_geog ON leaderboard_scores USING gist (location_geog)`,
+}
+
+// MigratePostGIS creates the geography column and GiST index used by PostGISRepository.
+// Must be called after the leaderboard_scores table has been migrated.
+func MigratePostGIS(db *gorm.DB) error {
+	for _, stmt := range postGISStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package leaderboardrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/leaderboard"
+	"quest-manager/internal/core/ports"
+)
+
+var _ ports.LeaderboardRepository = &PostGISRepository{}
+
+// geographyPoint builds a geography point from (longitude, latitude) placeholders
+const geographyPoint = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+
+// PostGISRepository is a leaderboard repository that restricts area leaderboards in PostGIS
+// using the generated geography column and its GiST index (see MigratePostGIS).
+// All other operations are inherited from the plain SQL Repository.
+type PostGISRepository struct {
+	*Repository
+}
+
+func NewPostGISRepository(tracker ports.Tracker) (*PostGISRepository, error) {
+	repo, err := NewRepository(tracker)
+	if err != nil {
+		return nil, err
+	}
+	return &PostGISRepository{Repository: repo}, nil
+}
+
+// Top aggregates the scores of the period per user in SQL. With an area the scores
+// are restricted by ST_DWithin before aggregation.
+func (r *PostGISRepository) Top(ctx context.Context, filter leaderboard.Filter) ([]leaderboard.Standing, error) {
+	query := r.scoresSince(ctx, filter)
+	if filter.Center != nil {
+		query = query.Where("ST_DWithin(location_geog, "+geographyPoint+", ?)",
+			filter.Center.Lon, filter.Center.Lat, filter.RadiusKm*1000)
+	}
+	return r.aggregate(query, filter)
+}
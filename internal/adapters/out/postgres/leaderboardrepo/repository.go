@@ -0,0 +1,123 @@
+package leaderboardrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/adapters/out/postgres/geoquery"
+	"quest-manager/internal/core/domain/model/leaderboard"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.LeaderboardRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Record inserts the score; a score for the same ledger entry is left as it is.
+func (r *Repository) Record(ctx context.Context, score leaderboard.Score) error {
+	dto := DomainToDTO(score)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin leaderboard transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "entry_id"}}, DoNothing: true}).
+		Create(&dto).Error; err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError("failed to record leaderboard score", err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit leaderboard transaction", err)
+		}
+	}
+	return nil
+}
+
+// Top aggregates the scores of the period per user in SQL. With an area the scores
+// are restricted by bounding box and Haversine distance before aggregation.
+func (r *Repository) Top(ctx context.Context, filter leaderboard.Filter) ([]leaderboard.Standing, error) {
+	query := r.scoresSince(ctx, filter)
+	if filter.Center != nil {
+		cond, args := geoquery.WithinRadiusCondition("latitude", "longitude", *filter.Center, filter.RadiusKm)
+		query = query.Where(cond, args...)
+	}
+	return r.aggregate(query, filter)
+}
+
+type userTotal struct {
+	UserID string
+	Score  int
+}
+
+// aggregate sums the selected scores per user, highest first, up to the filter's limit.
+func (r *Repository) aggregate(query *gorm.DB, filter leaderboard.Filter) ([]leaderboard.Standing, error) {
+	aggregate := "SUM(points)"
+	if filter.Metric == leaderboard.MetricCompleted {
+		aggregate = "COUNT(*)"
+	}
+
+	query = query.
+		Select("user_id, " + aggregate + " AS score").
+		Group("user_id").
+		Order("score DESC, user_id ASC")
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var totals []userTotal
+	if err := query.Scan(&totals).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to aggregate leaderboard scores", err)
+	}
+
+	standings := make([]leaderboard.Standing, 0, len(totals))
+	for _, t := range totals {
+		userID, err := uuid.Parse(t.UserID)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to parse leaderboard user", err)
+		}
+		standings = append(standings, leaderboard.Standing{UserID: userID, Score: t.Score})
+	}
+	return standings, nil
+}
+
+// scoresSince selects the scores of the period that count for the metric
+// (see leaderboard.Filter).
+func (r *Repository) scoresSince(ctx context.Context, filter leaderboard.Filter) *gorm.DB {
+	query := r.db().WithContext(ctx).Model(&ScoreDTO{}).Where("occurred_at >= ?", filter.Since)
+	if filter.Metric == leaderboard.MetricCompleted {
+		query = query.Where("completion = ?", true)
+	} else {
+		query = query.Where("points <> 0")
+	}
+	return query
+}
+
+// db reads inside the current transaction if there is one.
+func (r *Repository) db() *gorm.DB {
+	if r.tracker.InTx() {
+		return r.tracker.Tx()
+	}
+	return r.tracker.Db()
+}
@@ -0,0 +1,18 @@
+package ledgerrepo
+
+import "time"
+
+// EntryDTO is the database model for a ledger entry.
+type EntryDTO struct {
+	ID             string    `gorm:"primaryKey"`
+	UserID         string    `gorm:"not null;index:idx_ledger_user_created"`
+	Kind           string    `gorm:"size:20;not null"`
+	Points         int       `gorm:"not null"`
+	QuestID        *string   `gorm:"index"`
+	IdempotencyKey string    `gorm:"size:200;not null;uniqueIndex"`
+	CreatedAt      time.Time `gorm:"index:idx_ledger_user_created"`
+}
+
+func (EntryDTO) TableName() string {
+	return "ledger_entries"
+}
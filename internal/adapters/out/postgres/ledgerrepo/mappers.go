@@ -0,0 +1,53 @@
+package ledgerrepo
+
+import (
+	"quest-manager/internal/core/domain/model/ledger"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Entry domain model to EntryDTO
+func DomainToDTO(e ledger.Entry) EntryDTO {
+	dto := EntryDTO{
+		ID:             e.ID.String(),
+		UserID:         e.UserID.String(),
+		Kind:           string(e.Kind),
+		Points:         e.Points,
+		IdempotencyKey: e.IdempotencyKey,
+		CreatedAt:      e.CreatedAt,
+	}
+	if e.QuestID != nil {
+		questID := e.QuestID.String()
+		dto.QuestID = &questID
+	}
+	return dto
+}
+
+// DtoToDomain converts EntryDTO to Entry domain model
+func DtoToDomain(dto EntryDTO) (ledger.Entry, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return ledger.Entry{}, err
+	}
+	userID, err := uuid.Parse(dto.UserID)
+	if err != nil {
+		return ledger.Entry{}, err
+	}
+
+	entry := ledger.Entry{
+		ID:             id,
+		UserID:         userID,
+		Kind:           ledger.EntryKind(dto.Kind),
+		Points:         dto.Points,
+		IdempotencyKey: dto.IdempotencyKey,
+		CreatedAt:      dto.CreatedAt,
+	}
+	if dto.QuestID != nil {
+		questID, err := uuid.Parse(*dto.QuestID)
+		if err != nil {
+			return ledger.Entry{}, err
+		}
+		entry.QuestID = &questID
+	}
+	return entry, nil
+}
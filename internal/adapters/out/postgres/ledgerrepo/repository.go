@@ -0,0 +1,107 @@
+package ledgerrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.LedgerRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Append inserts the entry; an entry with the same idempotency key is left as it is.
+func (r *Repository) Append(ctx context.Context, entry ledger.Entry) (bool, error) {
+	dto := DomainToDTO(entry)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return false, errs.WrapInfrastructureError("failed to begin ledger transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	result := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "idempotency_key"}}, DoNothing: true}).
+		Create(&dto)
+	if result.Error != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return false, errs.WrapInfrastructureError("failed to append ledger entry", result.Error)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return false, errs.WrapInfrastructureError("failed to commit ledger transaction", err)
+		}
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Balance sums the points of the user's entries.
+func (r *Repository) Balance(ctx context.Context, userID uuid.UUID) (int, error) {
+	var balance int
+	if err := r.db().WithContext(ctx).
+		Model(&EntryDTO{}).
+		Select("COALESCE(SUM(points), 0)").
+		Where("user_id = ?", userID.String()).
+		Scan(&balance).Error; err != nil {
+		return 0, errs.WrapInfrastructureError("failed to sum ledger entries", err)
+	}
+	return balance, nil
+}
+
+// FindByUser retrieves a page of the user's entries, newest first.
+func (r *Repository) FindByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]ledger.Entry, int, error) {
+	db := r.db().WithContext(ctx)
+
+	var total int64
+	if err := db.Model(&EntryDTO{}).Where("user_id = ?", userID.String()).Count(&total).Error; err != nil {
+		return nil, 0, errs.WrapInfrastructureError("failed to count ledger entries", err)
+	}
+
+	var dtos []EntryDTO
+	if err := db.Where("user_id = ?", userID.String()).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&dtos).Error; err != nil {
+		return nil, 0, errs.WrapInfrastructureError("failed to find ledger entries", err)
+	}
+
+	entries := make([]ledger.Entry, 0, len(dtos))
+	for _, dto := range dtos {
+		entry, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, 0, errs.WrapInfrastructureError("failed to convert ledger entry", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, int(total), nil
+}
+
+// db reads inside the current transaction if there is one, so that entries appended
+// by event handlers are visible before the commit.
+func (r *Repository) db() *gorm.DB {
+	if r.tracker.InTx() {
+		return r.tracker.Tx()
+	}
+	return r.tracker.Db()
+}
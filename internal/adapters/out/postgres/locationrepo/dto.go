@@ -7,6 +7,8 @@ type LocationDTO struct {
 	ID        string  `gorm:"primaryKey"`
 	Latitude  float64 `gorm:"not null;index:idx_location_coords"`
 	Longitude float64 `gorm:"not null;index:idx_location_coords"`
+	Geohash   string  `gorm:"size:12"` // full-precision geohash for prefix index lookups
+	Name      *string
 	Address   *string
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime"`
@@ -15,3 +17,9 @@ type LocationDTO struct {
 func (LocationDTO) TableName() string {
 	return "locations"
 }
+
+// LocationSearchDTO extends LocationDTO with the relevance score of a text search
+type LocationSearchDTO struct {
+	LocationDTO
+	Score float64 `gorm:"column:score"`
+}
@@ -14,6 +14,8 @@ func DomainToDTO(l *location.Location) LocationDTO {
 		ID:        l.ID().String(),
 		Latitude:  l.Coordinate.Latitude(),
 		Longitude: l.Coordinate.Longitude(),
+		Geohash:   l.Coordinate.Geohash(kernel.GeohashMaxPrecision),
+		Name:      l.Name,
 		Address:   l.Address,
 		CreatedAt: l.CreatedAt,
 		UpdatedAt: l.UpdatedAt,
@@ -35,6 +37,7 @@ func DtoToDomain(dto LocationDTO) (*location.Location, error) {
 	l := &location.Location{
 		BaseAggregate: ddd.NewBaseAggregate(id),
 		Coordinate:    coordinate,
+		Name:          dto.Name,
 		Address:       dto.Address,
 		CreatedAt:     dto.CreatedAt,
 		UpdatedAt:     dto.UpdatedAt,
@@ -0,0 +1,76 @@
+package locationrepo
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+
+	"gorm.io/gorm"
+)
+
+// searchIndexStatements enable pg_trgm and create trigram indexes used by text search
+var searchIndexStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+	`CREATE INDEX IF NOT EXISTS idx_locations_name_trgm ON locations USING gin (name gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS idx_locations_address_trgm ON locations USING gin (address gin_trgm_ops)`,
+}
+
+// MigrateSearchIndexes creates the trigram indexes for fuzzy location search.
+// Must be called after the locations table has been migrated.
+func MigrateSearchIndexes(db *gorm.DB) error {
+	for _, stmt := range searchIndexStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// geohashBackfillBatchSize is the number of rows updated per batch when backfilling geohashes
+const geohashBackfillBatchSize = 500
+
+// geohashStatements create a prefix index for LIKE 'prefix%' lookups on the geohash column
+var geohashStatements = []string{
+	`CREATE INDEX IF NOT EXISTS idx_locations_geohash ON locations (geohash varchar_pattern_ops)`,
+}
+
+// MigrateGeohash creates the geohash prefix index and fills in geohashes for locations
+// stored before the column existed. Must be called after the locations table has been migrated.
+func MigrateGeohash(db *gorm.DB) error {
+	for _, stmt := range geohashStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+
+	var dtos []LocationDTO
+	return db.Where("geohash IS NULL OR geohash = ''").
+		FindInBatches(&dtos, geohashBackfillBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, dto := range dtos {
+				coordinate := kernel.GeoCoordinate{Lat: dto.Latitude, Lon: dto.Longitude}
+				if err := tx.Model(&LocationDTO{}).Where("id = ?", dto.ID).
+					UpdateColumn("geohash", coordinate.Geohash(kernel.GeohashMaxPrecision)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// postGISStatements enable PostGIS and add a geography column generated from the
+// plain coordinate columns, so writes keep going through LocationDTO unchanged
+var postGISStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS postgis`,
+	`ALTER TABLE locations ADD COLUMN IF NOT EXISTS geog geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_locations_geog ON locations USING gist (geog)`,
+}
+
+// MigratePostGIS creates the geography column and GiST index used by PostGISRepository.
+// Must be called after the locations table has been migrated.
+func MigratePostGIS(db *gorm.DB) error {
+	for _, stmt := range postGISStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package locationrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/location"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+var _ ports.LocationRepository = &PostGISRepository{}
+
+// geographyPoint builds a geography point from (longitude, latitude) placeholders
+const geographyPoint = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+
+// PostGISRepository is a location repository that runs geospatial queries in PostGIS
+// using the generated geography column and its GiST index (see MigratePostGIS).
+// All other operations are inherited from the plain SQL Repository.
+type PostGISRepository struct {
+	*Repository
+}
+
+func NewPostGISRepository(tracker ports.Tracker) (*PostGISRepository, error) {
+	repo, err := NewRepository(tracker)
+	if err != nil {
+		return nil, err
+	}
+	return &PostGISRepository{Repository: repo}, nil
+}
+
+// FindWithinRadius retrieves locations within radiusKm of center using ST_DWithin,
+// ordered by ST_Distance (closest first).
+func (r *PostGISRepository) FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64) ([]*location.Location, error) {
+	var dtos []LocationDTO
+
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Table("locations").
+		Select("locations.*, ST_Distance(geog, "+geographyPoint+") AS distance_m", center.Lon, center.Lat).
+		Where("ST_DWithin(geog, "+geographyPoint+", ?)", center.Lon, center.Lat, radiusKm*1000).
+		Order("distance_m, locations.id").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get locations within radius", err)
+	}
+
+	locations := make([]*location.Location, len(dtos))
+	for i, dto := range dtos {
+		l, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		locations[i] = l
+	}
+
+	return locations, nil
+}
@@ -2,7 +2,10 @@ package locationrepo
 
 import (
 	"context"
+	"sort"
+	"strings"
 
+	"quest-manager/internal/adapters/out/postgres/geoquery"
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/location"
 	"quest-manager/internal/core/ports"
@@ -89,13 +92,21 @@ func (r *Repository) FindAll(ctx context.Context) ([]*location.Location, error)
 }
 
 // FindByBoundingBox retrieves locations within a bounding box area.
+// Boxes crossing the antimeridian are queried as two longitude ranges.
 func (r *Repository) FindByBoundingBox(ctx context.Context, bbox kernel.BoundingBox) ([]*location.Location, error) {
+	return r.findInBoundingBox(ctx, bbox, bbox.Geohashes(geoquery.MaxGeohashPrefixes))
+}
+
+// findInBoundingBox retrieves locations inside bbox.
+// Rows are pre-filtered by geohash prefixes that must cover bbox.
+func (r *Repository) findInBoundingBox(ctx context.Context, bbox kernel.BoundingBox, geohashes []string) ([]*location.Location, error) {
 	var dtos []LocationDTO
 
+	cond, args := geoquery.IndexedBoundingBoxCondition("latitude", "longitude", "geohash", bbox, geohashes)
+
 	db := r.tracker.Db()
 	if err := db.WithContext(ctx).
-		Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?",
-			bbox.MinLat, bbox.MaxLat, bbox.MinLon, bbox.MaxLon).
+		Where(cond, args...).
 		Find(&dtos).Error; err != nil {
 		return nil, errs.WrapInfrastructureError("failed to get locations by bounding box", err)
 	}
@@ -112,12 +123,44 @@ func (r *Repository) FindByBoundingBox(ctx context.Context, bbox kernel.Bounding
 	return locations, nil
 }
 
-// FindByName searches locations by name (partial match).
+// FindWithinRadius retrieves locations within radiusKm of center, closest first.
+// Candidates are pre-selected by the geohash cells around center and the bounding box,
+// then filtered and ordered by Haversine distance in Go. Used when PostGIS is not available.
+func (r *Repository) FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64) ([]*location.Location, error) {
+	bbox := center.BoundingBoxForRadius(radiusKm)
+	geohashes := center.GeohashesForRadius(radiusKm)
+	if geohashes == nil {
+		geohashes = bbox.Geohashes(geoquery.MaxGeohashPrefixes)
+	}
+
+	candidates, err := r.findInBoundingBox(ctx, bbox, geohashes)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]*location.Location, 0, len(candidates))
+	for _, l := range candidates {
+		if center.DistanceTo(l.Coordinate) <= radiusKm {
+			locations = append(locations, l)
+		}
+	}
+
+	sort.SliceStable(locations, func(i, j int) bool {
+		return center.DistanceTo(locations[i].Coordinate) < center.DistanceTo(locations[j].Coordinate)
+	})
+
+	return locations, nil
+}
+
+// FindByName searches locations by name or address (partial, case-insensitive match).
 func (r *Repository) FindByName(ctx context.Context, namePattern string) ([]*location.Location, error) {
 	var dtos []LocationDTO
+	pattern := "%" + escapeLikePattern(namePattern) + "%"
+
 	db := r.tracker.Db()
 	if err := db.WithContext(ctx).
-		Where("name ILIKE ?", "%"+namePattern+"%").
+		Where("name ILIKE ? OR address ILIKE ?", pattern, pattern).
+		Order("name, address").
 		Find(&dtos).Error; err != nil {
 		return nil, errs.WrapInfrastructureError("failed to get locations by name", err)
 	}
@@ -133,3 +176,87 @@ func (r *Repository) FindByName(ctx context.Context, namePattern string) ([]*loc
 
 	return locations, nil
 }
+
+// FindByAddress retrieves locations whose address equals address (case-insensitive), newest first.
+func (r *Repository) FindByAddress(ctx context.Context, address string) ([]*location.Location, error) {
+	var dtos []LocationDTO
+
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("LOWER(address) = LOWER(?)", strings.TrimSpace(address)).
+		Order("created_at DESC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get locations by address", err)
+	}
+
+	locations := make([]*location.Location, len(dtos))
+	for i, dto := range dtos {
+		l, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		locations[i] = l
+	}
+
+	return locations, nil
+}
+
+// FindByCoordinate retrieves locations stored at exactly the given coordinate, newest first.
+func (r *Repository) FindByCoordinate(ctx context.Context, coordinate kernel.GeoCoordinate) ([]*location.Location, error) {
+	var dtos []LocationDTO
+
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("latitude = ? AND longitude = ?", coordinate.Lat, coordinate.Lon).
+		Order("created_at DESC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get locations by coordinate", err)
+	}
+
+	locations := make([]*location.Location, len(dtos))
+	for i, dto := range dtos {
+		l, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		locations[i] = l
+	}
+
+	return locations, nil
+}
+
+// SearchByText performs trigram fuzzy search across location name and address.
+// Results are ranked by word similarity (best match first) and limited to limit rows.
+func (r *Repository) SearchByText(ctx context.Context, query string, limit int) ([]location.SearchMatch, error) {
+	var dtos []LocationSearchDTO
+	pattern := "%" + escapeLikePattern(query) + "%"
+
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Table("locations").
+		Select("locations.*, COALESCE(GREATEST(word_similarity(?, name), word_similarity(?, address)), 0) AS score", query, query).
+		Where("? <% name OR ? <% address OR name ILIKE ? OR address ILIKE ?", query, query, pattern, pattern).
+		Order("score DESC, name").
+		Limit(limit).
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to search locations", err)
+	}
+
+	matches := make([]location.SearchMatch, len(dtos))
+	for i, dto := range dtos {
+		l, err := DtoToDomain(dto.LocationDTO)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		matches[i] = location.SearchMatch{Location: l, Score: dto.Score}
+	}
+
+	return matches, nil
+}
+
+// escapeLikePattern escapes LIKE wildcards so user input is matched literally
+func escapeLikePattern(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
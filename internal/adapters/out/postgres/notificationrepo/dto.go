@@ -0,0 +1,29 @@
+package notificationrepo
+
+import "time"
+
+// NotificationDTO is the database model for a notification in the inbox of a user.
+type NotificationDTO struct {
+	ID        string    `gorm:"primaryKey"`
+	UserID    string    `gorm:"not null;index:idx_notification_user_created"`
+	Kind      string    `gorm:"size:30;not null"`
+	QuestID   string    `gorm:"not null"`
+	Message   string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"index:idx_notification_user_created"`
+	ReadAt    *time.Time
+}
+
+func (NotificationDTO) TableName() string {
+	return "notifications"
+}
+
+// PreferenceDTO is the database model for whether a user receives notifications of a kind.
+type PreferenceDTO struct {
+	UserID  string `gorm:"primaryKey"`
+	Kind    string `gorm:"primaryKey;size:30"`
+	Enabled bool   `gorm:"not null"`
+}
+
+func (PreferenceDTO) TableName() string {
+	return "notification_preferences"
+}
@@ -0,0 +1,71 @@
+package notificationrepo
+
+import (
+	"quest-manager/internal/core/domain/model/notification"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Notification domain model to NotificationDTO
+func DomainToDTO(n notification.Notification) NotificationDTO {
+	return NotificationDTO{
+		ID:        n.ID.String(),
+		UserID:    n.UserID.String(),
+		Kind:      string(n.Kind),
+		QuestID:   n.QuestID.String(),
+		Message:   n.Message,
+		CreatedAt: n.CreatedAt,
+		ReadAt:    n.ReadAt,
+	}
+}
+
+// DtoToDomain converts NotificationDTO to Notification domain model
+func DtoToDomain(dto NotificationDTO) (notification.Notification, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return notification.Notification{}, err
+	}
+	userID, err := uuid.Parse(dto.UserID)
+	if err != nil {
+		return notification.Notification{}, err
+	}
+	questID, err := uuid.Parse(dto.QuestID)
+	if err != nil {
+		return notification.Notification{}, err
+	}
+
+	return notification.Notification{
+		ID:        id,
+		UserID:    userID,
+		Kind:      notification.Kind(dto.Kind),
+		QuestID:   questID,
+		Message:   dto.Message,
+		CreatedAt: dto.CreatedAt,
+		ReadAt:    dto.ReadAt,
+	}, nil
+}
+
+// PreferencesToDTOs converts Preferences domain model to one PreferenceDTO per kind set
+func PreferencesToDTOs(p notification.Preferences) []PreferenceDTO {
+	dtos := make([]PreferenceDTO, 0, len(p.Enabled))
+	for _, kind := range notification.Kinds() {
+		enabled, ok := p.Enabled[kind]
+		if !ok {
+			continue
+		}
+		dtos = append(dtos, PreferenceDTO{UserID: p.UserID.String(), Kind: string(kind), Enabled: enabled})
+	}
+	return dtos
+}
+
+// DTOsToPreferences converts the PreferenceDTOs of a user to Preferences domain model.
+// Rows of kinds that no longer exist are skipped.
+func DTOsToPreferences(userID uuid.UUID, dtos []PreferenceDTO) notification.Preferences {
+	p := notification.DefaultPreferences(userID)
+	for _, dto := range dtos {
+		if notification.IsValidKind(dto.Kind) {
+			p.Enabled[notification.Kind(dto.Kind)] = dto.Enabled
+		}
+	}
+	return p
+}
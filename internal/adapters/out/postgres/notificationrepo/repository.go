@@ -0,0 +1,169 @@
+package notificationrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.NotificationRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts or updates a notification.
+func (r *Repository) Save(ctx context.Context, n notification.Notification) error {
+	dto := DomainToDTO(n)
+	return r.write(ctx, "failed to save notification", func(tx *gorm.DB) error {
+		return tx.Save(&dto).Error
+	})
+}
+
+// GetByID retrieves a notification.
+// Returns an error wrapping ports.ErrNotificationNotFound if it does not exist.
+func (r *Repository) GetByID(ctx context.Context, notificationID uuid.UUID) (notification.Notification, error) {
+	var dto NotificationDTO
+	if err := r.db().WithContext(ctx).
+		Where("id = ?", notificationID.String()).
+		First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return notification.Notification{}, fmt.Errorf("notification %s: %w", notificationID, ports.ErrNotificationNotFound)
+		}
+		return notification.Notification{}, errs.WrapInfrastructureError("failed to get notification by ID", err)
+	}
+	return DtoToDomain(dto)
+}
+
+// FindByUser retrieves a page of the notifications of a user, newest first.
+func (r *Repository) FindByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]notification.Notification, int, error) {
+	db := r.db().WithContext(ctx)
+	inbox := func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("user_id = ?", userID.String())
+		if unreadOnly {
+			tx = tx.Where("read_at IS NULL")
+		}
+		return tx
+	}
+
+	var total int64
+	if err := db.Model(&NotificationDTO{}).Scopes(inbox).Count(&total).Error; err != nil {
+		return nil, 0, errs.WrapInfrastructureError("failed to count notifications", err)
+	}
+
+	var dtos []NotificationDTO
+	if err := db.Scopes(inbox).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&dtos).Error; err != nil {
+		return nil, 0, errs.WrapInfrastructureError("failed to find notifications by user", err)
+	}
+
+	notifications := make([]notification.Notification, 0, len(dtos))
+	for _, dto := range dtos {
+		n, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, 0, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, int(total), nil
+}
+
+// CountUnread counts the unread notifications of a user.
+func (r *Repository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int64
+	if err := r.db().WithContext(ctx).Model(&NotificationDTO{}).
+		Where("user_id = ? AND read_at IS NULL", userID.String()).
+		Count(&count).Error; err != nil {
+		return 0, errs.WrapInfrastructureError("failed to count unread notifications", err)
+	}
+	return int(count), nil
+}
+
+// MarkAllRead sets the read time of every unread notification of a user in one statement.
+func (r *Repository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	var marked int64
+	err := r.write(ctx, "failed to mark notifications read", func(tx *gorm.DB) error {
+		result := tx.Model(&NotificationDTO{}).
+			Where("user_id = ? AND read_at IS NULL", userID.String()).
+			Update("read_at", at)
+		marked = result.RowsAffected
+		return result.Error
+	})
+	return int(marked), err
+}
+
+// Preferences retrieves the notification preferences of a user.
+func (r *Repository) Preferences(ctx context.Context, userID uuid.UUID) (notification.Preferences, error) {
+	var dtos []PreferenceDTO
+	if err := r.db().WithContext(ctx).
+		Where("user_id = ?", userID.String()).
+		Find(&dtos).Error; err != nil {
+		return notification.Preferences{}, errs.WrapInfrastructureError("failed to get notification preferences", err)
+	}
+	return DTOsToPreferences(userID, dtos), nil
+}
+
+// SavePreferences upserts a row per kind set in the preferences.
+func (r *Repository) SavePreferences(ctx context.Context, p notification.Preferences) error {
+	dtos := PreferencesToDTOs(p)
+	if len(dtos) == 0 {
+		return nil
+	}
+	return r.write(ctx, "failed to save notification preferences", func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "kind"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled"}),
+		}).Create(&dtos).Error
+	})
+}
+
+// write runs fn in the current transaction, or in its own one if there is none.
+func (r *Repository) write(ctx context.Context, message string, fn func(tx *gorm.DB) error) error {
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin notification transaction", err)
+		}
+	}
+
+	if err := fn(r.tracker.Tx().WithContext(ctx)); err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError(message, err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit notification transaction", err)
+		}
+	}
+	return nil
+}
+
+// db reads inside the current transaction if there is one.
+func (r *Repository) db() *gorm.DB {
+	if r.tracker.InTx() {
+		return r.tracker.Tx()
+	}
+	return r.tracker.Db()
+}
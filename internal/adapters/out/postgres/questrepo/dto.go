@@ -17,12 +17,38 @@ type QuestDTO struct {
 	ExecutionLatitude  float64 `gorm:"index:idx_execution_location"`
 	ExecutionLongitude float64 `gorm:"index:idx_execution_location"`
 
+	// Geohashes of the coordinates at full precision, for prefix index lookups
+	TargetGeohash    string `gorm:"size:12"`
+	ExecutionGeohash string `gorm:"size:12"`
+
+	// Route length (target, waypoints, execution) in kilometers, for route length filters
+	RouteKm *float64 `gorm:"index"`
+
+	// Intermediate route stops, stored in quest_waypoints
+	Waypoints []WaypointDTO `gorm:"foreignKey:QuestID;constraint:OnDelete:CASCADE"`
+
 	// Optional references to location directory
 	TargetLocationID    *string `gorm:"index"` // FK to quest_locations
 	ExecutionLocationID *string `gorm:"index"` // FK to quest_locations
 
-	Equipment string  // stored as comma-separated string
-	Skills    string  // stored as comma-separated string
+	Equipment string // stored as comma-separated string
+	Skills    string // stored as comma-separated string
+
+	EligibilityPolicy string `gorm:"size:10;not null;default:off"`
+	AssignmentMode    string `gorm:"size:20;not null;default:first_come"`
+
+	Capacity         int `gorm:"not null;default:1"`
+	CompletionQuorum int `gorm:"not null;default:1"`
+
+	// Geofence radius in meters, 0 means none
+	GeofenceRadius int `gorm:"not null;default:0"`
+
+	// Users taking part in the quest, stored in quest_participants
+	Participants []ParticipantDTO `gorm:"foreignKey:QuestID;constraint:OnDelete:CASCADE"`
+
+	// Quests to complete first, stored in quest_prerequisites
+	Prerequisites []PrerequisiteDTO `gorm:"foreignKey:QuestID;constraint:OnDelete:CASCADE"`
+
 	Status    string  `gorm:"index"`
 	Creator   string  `gorm:"index"`
 	Assignee  *string `gorm:"index"`
@@ -34,6 +60,43 @@ func (QuestDTO) TableName() string {
 	return "quests"
 }
 
+// WaypointDTO is the database model for an intermediate stop of a quest route.
+type WaypointDTO struct {
+	QuestID   string `gorm:"primaryKey"`
+	Position  int    `gorm:"primaryKey;autoIncrement:false"` // order in the route, starting at 0
+	Latitude  float64
+	Longitude float64
+}
+
+func (WaypointDTO) TableName() string {
+	return "quest_waypoints"
+}
+
+// ParticipantDTO is the database model for a user taking part in a quest.
+type ParticipantDTO struct {
+	QuestID     string `gorm:"primaryKey"`
+	UserID      string `gorm:"primaryKey;index"`
+	JoinedAt    time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+func (ParticipantDTO) TableName() string {
+	return "quest_participants"
+}
+
+// PrerequisiteDTO is the database model for a quest that has to be completed before another one.
+type PrerequisiteDTO struct {
+	QuestID        string `gorm:"primaryKey"`
+	PrerequisiteID string `gorm:"primaryKey;index"`
+	Position       int    // order in which the prerequisite was added, starting at 0
+	Completed      bool   `gorm:"not null;default:false"`
+}
+
+func (PrerequisiteDTO) TableName() string {
+	return "quest_prerequisites"
+}
+
 // QuestWithAddressDTO extends QuestDTO for JOIN queries with addresses
 type QuestWithAddressDTO struct {
 	QuestDTO
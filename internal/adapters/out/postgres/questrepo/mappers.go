@@ -12,6 +12,7 @@ import (
 
 // DomainToDTO converts Quest domain model to QuestDTO for DB.
 func DomainToDTO(q quest.Quest) QuestDTO {
+	routeKm := q.RouteDistanceKm()
 	dto := QuestDTO{
 		ID:                 q.ID().String(),
 		Title:              q.Title,
@@ -23,8 +24,16 @@ func DomainToDTO(q quest.Quest) QuestDTO {
 		TargetLongitude:    q.TargetLocation.Longitude(),
 		ExecutionLatitude:  q.ExecutionLocation.Latitude(),
 		ExecutionLongitude: q.ExecutionLocation.Longitude(),
+		TargetGeohash:      q.TargetLocation.Geohash(kernel.GeohashMaxPrecision),
+		ExecutionGeohash:   q.ExecutionLocation.Geohash(kernel.GeohashMaxPrecision),
+		RouteKm:            &routeKm,
 		Equipment:          strings.Join(q.Equipment, ","),
 		Skills:             strings.Join(q.Skills, ","),
+		EligibilityPolicy:  string(q.EligibilityPolicy),
+		AssignmentMode:     string(q.AssignmentMode),
+		Capacity:           q.Capacity,
+		CompletionQuorum:   q.CompletionQuorum,
+		GeofenceRadius:     q.GeofenceRadius,
 		Status:             string(q.Status),
 		Creator:            q.Creator,
 		Assignee:           convertUUIDPtrToStringPtr(q.Assignee),
@@ -32,6 +41,37 @@ func DomainToDTO(q quest.Quest) QuestDTO {
 		UpdatedAt:          q.UpdatedAt,
 	}
 
+	dto.Waypoints = make([]WaypointDTO, len(q.Waypoints))
+	for i, w := range q.Waypoints {
+		dto.Waypoints[i] = WaypointDTO{
+			QuestID:   dto.ID,
+			Position:  i,
+			Latitude:  w.Latitude(),
+			Longitude: w.Longitude(),
+		}
+	}
+
+	dto.Participants = make([]ParticipantDTO, len(q.Participants))
+	for i, p := range q.Participants {
+		dto.Participants[i] = ParticipantDTO{
+			QuestID:     dto.ID,
+			UserID:      p.UserID.String(),
+			JoinedAt:    p.JoinedAt,
+			StartedAt:   p.StartedAt,
+			CompletedAt: p.CompletedAt,
+		}
+	}
+
+	dto.Prerequisites = make([]PrerequisiteDTO, len(q.Prerequisites))
+	for i, p := range q.Prerequisites {
+		dto.Prerequisites[i] = PrerequisiteDTO{
+			QuestID:        dto.ID,
+			PrerequisiteID: p.QuestID.String(),
+			Position:       i,
+			Completed:      p.Completed,
+		}
+	}
+
 	// Опциональные ссылки на локации
 	if q.TargetLocationID != nil {
 		targetLocationIDStr := q.TargetLocationID.String()
@@ -110,6 +150,16 @@ func dtoToDomainCommon(dto QuestDTO, id uuid.UUID, targetCoord, execCoord kernel
 		skills = []string{} // Нормализация: всегда возвращаем [], а не nil
 	}
 
+	eligibilityPolicy := quest.EligibilityPolicy(dto.EligibilityPolicy)
+	if eligibilityPolicy == "" {
+		eligibilityPolicy = quest.EligibilityOff
+	}
+
+	assignmentMode := quest.AssignmentMode(dto.AssignmentMode)
+	if assignmentMode == "" {
+		assignmentMode = quest.AssignmentFirstCome
+	}
+
 	q := quest.Quest{
 		BaseAggregate:     ddd.NewBaseAggregate(id),
 		Title:             dto.Title,
@@ -119,8 +169,16 @@ func dtoToDomainCommon(dto QuestDTO, id uuid.UUID, targetCoord, execCoord kernel
 		DurationMinutes:   dto.DurationMinutes,
 		TargetLocation:    targetCoord,
 		ExecutionLocation: execCoord,
+		Waypoints:         make([]kernel.GeoCoordinate, len(dto.Waypoints)),
 		Equipment:         equipment,
 		Skills:            skills,
+		EligibilityPolicy: eligibilityPolicy,
+		AssignmentMode:    assignmentMode,
+		Capacity:          max(dto.Capacity, 1),
+		CompletionQuorum:  max(dto.CompletionQuorum, 1),
+		GeofenceRadius:    dto.GeofenceRadius,
+		Participants:      make([]quest.Participant, 0, len(dto.Participants)),
+		Prerequisites:     make([]quest.Prerequisite, 0, len(dto.Prerequisites)),
 		Status:            quest.Status(dto.Status),
 		Creator:           dto.Creator,
 		Assignee:          convertStringPtrToUUIDPtr(dto.Assignee),
@@ -128,6 +186,38 @@ func dtoToDomainCommon(dto QuestDTO, id uuid.UUID, targetCoord, execCoord kernel
 		UpdatedAt:         dto.UpdatedAt,
 	}
 
+	// Waypoints are loaded ordered by position
+	for i, w := range dto.Waypoints {
+		coord, err := kernel.NewGeoCoordinate(w.Latitude, w.Longitude)
+		if err != nil {
+			return quest.Quest{}, err
+		}
+		q.Waypoints[i] = coord
+	}
+
+	// Participants are loaded in joining order
+	for _, p := range dto.Participants {
+		userID, err := uuid.Parse(p.UserID)
+		if err != nil {
+			return quest.Quest{}, err
+		}
+		q.Participants = append(q.Participants, quest.Participant{
+			UserID:      userID,
+			JoinedAt:    p.JoinedAt,
+			StartedAt:   p.StartedAt,
+			CompletedAt: p.CompletedAt,
+		})
+	}
+
+	// Prerequisites are loaded in the order they were added
+	for _, p := range dto.Prerequisites {
+		prerequisiteID, err := uuid.Parse(p.PrerequisiteID)
+		if err != nil {
+			return quest.Quest{}, err
+		}
+		q.Prerequisites = append(q.Prerequisites, quest.Prerequisite{QuestID: prerequisiteID, Completed: p.Completed})
+	}
+
 	// Опциональные ссылки на локации
 	if dto.TargetLocationID != nil {
 		targetLocationID, err := uuid.Parse(*dto.TargetLocationID)
@@ -0,0 +1,131 @@
+package questrepo
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+
+	"gorm.io/gorm"
+)
+
+// MigrateWaypoints creates the quest_waypoints table and its cascading foreign key to quests.
+// Must be called after the quests table has been migrated.
+func MigrateWaypoints(db *gorm.DB) error {
+	if err := db.AutoMigrate(&WaypointDTO{}); err != nil {
+		return err
+	}
+	// The has-many constraint belongs to the parent relationship, so AutoMigrate of either model skips it
+	if !db.Migrator().HasConstraint(&QuestDTO{}, "Waypoints") {
+		return db.Migrator().CreateConstraint(&QuestDTO{}, "Waypoints")
+	}
+	return nil
+}
+
+// participantsBackfillStatement copies assignees of quests stored before participants were tracked
+const participantsBackfillStatement = `INSERT INTO quest_participants (quest_id, user_id, joined_at)
+SELECT id, assignee, updated_at FROM quests WHERE assignee IS NOT NULL
+ON CONFLICT DO NOTHING`
+
+// MigrateParticipants creates the quest_participants table and its cascading foreign key to quests,
+// then backfills it from the assignee column. Must be called after the quests table has been migrated.
+func MigrateParticipants(db *gorm.DB) error {
+	if err := db.AutoMigrate(&ParticipantDTO{}); err != nil {
+		return err
+	}
+	if !db.Migrator().HasConstraint(&QuestDTO{}, "Participants") {
+		if err := db.Migrator().CreateConstraint(&QuestDTO{}, "Participants"); err != nil {
+			return err
+		}
+	}
+	return db.Exec(participantsBackfillStatement).Error
+}
+
+// MigratePrerequisites creates the quest_prerequisites table and its cascading foreign key to quests.
+// Must be called after the quests table has been migrated.
+func MigratePrerequisites(db *gorm.DB) error {
+	if err := db.AutoMigrate(&PrerequisiteDTO{}); err != nil {
+		return err
+	}
+	if !db.Migrator().HasConstraint(&QuestDTO{}, "Prerequisites") {
+		return db.Migrator().CreateConstraint(&QuestDTO{}, "Prerequisites")
+	}
+	return nil
+}
+
+// geohashBackfillBatchSize is the number of rows updated per batch when backfilling geohashes
+const geohashBackfillBatchSize = 500
+
+// geohashStatements create prefix indexes for LIKE 'prefix%' lookups on the geohash columns
+var geohashStatements = []string{
+	`CREATE INDEX IF NOT EXISTS idx_quests_target_geohash ON quests (target_geohash varchar_pattern_ops)`,
+	`CREATE INDEX IF NOT EXISTS idx_quests_execution_geohash ON quests (execution_geohash varchar_pattern_ops)`,
+}
+
+// MigrateGeohash creates the geohash prefix indexes and fills in geohashes for quests
+// stored before the columns existed. Must be called after the quests table has been migrated.
+func MigrateGeohash(db *gorm.DB) error {
+	for _, stmt := range geohashStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+
+	var dtos []QuestDTO
+	return db.Where("target_geohash IS NULL OR target_geohash = '' OR execution_geohash IS NULL OR execution_geohash = ''").
+		FindInBatches(&dtos, geohashBackfillBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, dto := range dtos {
+				target := kernel.GeoCoordinate{Lat: dto.TargetLatitude, Lon: dto.TargetLongitude}
+				execution := kernel.GeoCoordinate{Lat: dto.ExecutionLatitude, Lon: dto.ExecutionLongitude}
+				if err := tx.Model(&QuestDTO{}).Where("id = ?", dto.ID).UpdateColumns(map[string]interface{}{
+					"target_geohash":    target.Geohash(kernel.GeohashMaxPrecision),
+					"execution_geohash": execution.Geohash(kernel.GeohashMaxPrecision),
+				}).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// routeLengthBackfillBatchSize is the number of rows updated per batch when backfilling route lengths
+const routeLengthBackfillBatchSize = 500
+
+// MigrateRouteLength fills in route lengths for quests stored before the column existed.
+// Must be called after the quests and quest_waypoints tables have been migrated.
+func MigrateRouteLength(db *gorm.DB) error {
+	var dtos []QuestDTO
+	return db.Scopes(preloadWaypoints).Where("route_km IS NULL").
+		FindInBatches(&dtos, routeLengthBackfillBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, dto := range dtos {
+				q, err := DtoToDomain(dto)
+				if err != nil {
+					return err
+				}
+				if err := tx.Model(&QuestDTO{}).Where("id = ?", dto.ID).UpdateColumn("route_km", q.RouteDistanceKm()).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// postGISStatements enable PostGIS and add geography columns generated from the
+// plain coordinate columns, so writes keep going through QuestDTO unchanged
+var postGISStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS postgis`,
+	`ALTER TABLE quests ADD COLUMN IF NOT EXISTS target_geog geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(target_longitude, target_latitude), 4326)::geography) STORED`,
+	`ALTER TABLE quests ADD COLUMN IF NOT EXISTS execution_geog geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(execution_longitude, execution_latitude), 4326)::geography) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_quests_target_geog ON quests USING gist (target_geog)`,
+	`CREATE INDEX IF NOT EXISTS idx_quests_execution_geog ON quests USING gist (execution_geog)`,
+}
+
+// MigratePostGIS creates the geography columns and GiST indexes used by PostGISRepository.
+// Must be called after the quests table has been migrated.
+func MigratePostGIS(db *gorm.DB) error {
+	for _, stmt := range postGISStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
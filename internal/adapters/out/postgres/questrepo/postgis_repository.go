@@ -0,0 +1,93 @@
+package questrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/adapters/out/postgres/geoquery"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+var _ ports.QuestRepository = &PostGISRepository{}
+
+// geographyPoint builds a geography point from (longitude, latitude) placeholders
+const geographyPoint = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+
+// PostGISRepository is a quest repository that runs geospatial queries in PostGIS
+// using the generated geography columns and their GiST indexes (see MigratePostGIS).
+// All other operations are inherited from the plain SQL Repository.
+type PostGISRepository struct {
+	*Repository
+}
+
+func NewPostGISRepository(tracker ports.Tracker) (*PostGISRepository, error) {
+	repo, err := NewRepository(tracker)
+	if err != nil {
+		return nil, err
+	}
+	return &PostGISRepository{Repository: repo}, nil
+}
+
+// FindWithinRadius retrieves quests within radiusKm of center using ST_DWithin, ordered by
+// sortBy with ST_Distance to the nearest of target and execution locations and limited in SQL.
+func (r *PostGISRepository) FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64, sortBy quest.RadiusSort, limit int) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+	radiusMeters := radiusKm * 1000
+
+	db := r.tracker.Db()
+	query := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Table("quests").
+		Select("quests.*, LEAST(ST_Distance(target_geog, "+geographyPoint+"), ST_Distance(execution_geog, "+geographyPoint+")) AS distance_m",
+			center.Lon, center.Lat, center.Lon, center.Lat).
+		Where("ST_DWithin(target_geog, "+geographyPoint+", ?) OR ST_DWithin(execution_geog, "+geographyPoint+", ?)",
+			center.Lon, center.Lat, radiusMeters, center.Lon, center.Lat, radiusMeters).
+		Order(radiusOrder(sortBy, "distance_m"))
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quests within radius", err)
+	}
+
+	return dtosToDomain(dtos)
+}
+
+// FindInPolygon retrieves quests inside the polygon using ST_Covers on the matched
+// geography columns, so their GiST indexes apply. The bounding box pre-filter lets the
+// planner use the coordinate indexes instead where they are more selective.
+func (r *PostGISRepository) FindInPolygon(ctx context.Context, polygon kernel.Polygon, match quest.LocationMatch) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+
+	wkt := geoquery.PolygonWKT(polygon)
+	bbox := polygon.BoundingBox()
+
+	targetBox, targetArgs := geoquery.BoundingBoxCondition("target_latitude", "target_longitude", bbox)
+	targetCond := "(" + targetBox + " AND ST_Covers(ST_GeogFromText(?), target_geog))"
+	targetArgs = append(targetArgs, wkt)
+
+	execBox, execArgs := geoquery.BoundingBoxCondition("execution_latitude", "execution_longitude", bbox)
+	execCond := "(" + execBox + " AND ST_Covers(ST_GeogFromText(?), execution_geog))"
+	execArgs = append(execArgs, wkt)
+
+	var cond string
+	var args []interface{}
+	switch match {
+	case quest.LocationMatchTarget:
+		cond, args = targetCond, targetArgs
+	case quest.LocationMatchExecution:
+		cond, args = execCond, execArgs
+	default:
+		cond, args = targetCond+" OR "+execCond, append(targetArgs, execArgs...)
+	}
+
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Where(cond, args...).
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quests in polygon", err)
+	}
+
+	return dtosToDomain(dtos)
+}
@@ -3,6 +3,7 @@ package questrepo
 import (
 	"context"
 
+	"quest-manager/internal/adapters/out/postgres/geoquery"
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
 	"quest-manager/internal/core/ports"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ ports.QuestRepository = &Repository{}
@@ -37,7 +39,17 @@ func (r *Repository) Save(ctx context.Context, q quest.Quest) error {
 	}
 	tx := r.tracker.Tx()
 
-	err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto).Error
+	// Waypoints, participants and prerequisites are replaced as a whole: remove the stored ones, Save inserts the current ones
+	err := tx.WithContext(ctx).Where("quest_id = ?", dto.ID).Delete(&WaypointDTO{}).Error
+	if err == nil {
+		err = tx.WithContext(ctx).Where("quest_id = ?", dto.ID).Delete(&ParticipantDTO{}).Error
+	}
+	if err == nil {
+		err = tx.WithContext(ctx).Where("quest_id = ?", dto.ID).Delete(&PrerequisiteDTO{}).Error
+	}
+	if err == nil {
+		err = tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto).Error
+	}
 	if err != nil {
 		if !isInTransaction {
 			if rollbackErr := r.tracker.Rollback(); rollbackErr != nil {
@@ -56,11 +68,31 @@ func (r *Repository) Save(ctx context.Context, q quest.Quest) error {
 	return nil
 }
 
-// GetByID retrieves a quest by its ID.
+// GetByID retrieves a quest by its ID. Inside a transaction it reads the saved but
+// uncommitted state, so event handlers see the quest the published events are about.
 func (r *Repository) GetByID(ctx context.Context, questID uuid.UUID) (quest.Quest, error) {
-	var dto QuestWithAddressDTO
 	db := r.tracker.Db()
-	if err := db.WithContext(ctx).
+	if r.tracker.InTx() {
+		db = r.tracker.Tx()
+	}
+	return getByID(db.WithContext(ctx), questID)
+}
+
+// GetByIDForUpdate retrieves a quest by its ID and locks its row with SELECT ... FOR UPDATE
+// until the current transaction ends. Participants are preloaded after the lock is taken,
+// so they include the changes committed by the transactions it waited for.
+func (r *Repository) GetByIDForUpdate(ctx context.Context, questID uuid.UUID) (quest.Quest, error) {
+	if !r.tracker.InTx() {
+		return quest.Quest{}, errs.NewValueIsRequiredError("transaction")
+	}
+	db := r.tracker.Tx().WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "quests"}})
+	return getByID(db, questID)
+}
+
+// getByID loads the quest with its preloads and the addresses of its locations.
+func getByID(db *gorm.DB, questID uuid.UUID) (quest.Quest, error) {
+	var dto QuestWithAddressDTO
+	if err := db.Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
 		Select("quests.*, target_loc.address as target_address, exec_loc.address as execution_address").
 		Table("quests").
 		Joins("LEFT JOIN locations target_loc ON quests.target_location_id = target_loc.id").
@@ -74,85 +106,320 @@ func (r *Repository) GetByID(ctx context.Context, questID uuid.UUID) (quest.Ques
 }
 
 // FindByBoundingBox retrieves quests within a bounding box area.
-// Simple database query without business logic.
+// Simple database query without business logic. Boxes crossing the
+// antimeridian are queried as two longitude ranges.
 func (r *Repository) FindByBoundingBox(ctx context.Context, bbox kernel.BoundingBox) ([]quest.Quest, error) {
+	return r.findInBoundingBox(ctx, bbox, bbox.Geohashes(geoquery.MaxGeohashPrefixes))
+}
+
+// findInBoundingBox retrieves quests with a target or execution location inside bbox.
+// Rows are pre-filtered by geohash prefixes that must cover bbox.
+func (r *Repository) findInBoundingBox(ctx context.Context, bbox kernel.BoundingBox, geohashes []string) ([]quest.Quest, error) {
 	var dtos []QuestDTO
 
+	cond, args := locationMatchBoundingBoxCondition(bbox, geohashes, quest.LocationMatchAny)
+
 	db := r.tracker.Db()
-	if err := db.WithContext(ctx).
-		Where("(target_latitude BETWEEN ? AND ? AND target_longitude BETWEEN ? AND ?) OR "+
-			"(execution_latitude BETWEEN ? AND ? AND execution_longitude BETWEEN ? AND ?)",
-			bbox.MinLat, bbox.MaxLat, bbox.MinLon, bbox.MaxLon,
-			bbox.MinLat, bbox.MaxLat, bbox.MinLon, bbox.MaxLon).
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Where(cond, args...).
 		Find(&dtos).Error; err != nil {
 		return nil, errs.WrapInfrastructureError("failed to get quests by bounding box", err)
 	}
 
-	quests := make([]quest.Quest, len(dtos))
-	for i, dto := range dtos {
-		q, err := DtoToDomain(dto)
-		if err != nil {
-			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+	return dtosToDomain(dtos)
+}
+
+// tileClusterRow holds the quests of one status in one cell of a tile.
+type tileClusterRow struct {
+	CellX     int
+	CellY     int
+	Status    string
+	Count     int
+	Latitude  float64
+	Longitude float64
+}
+
+// ClusterByTile groups the quests with a target location in the tile by cell and status in SQL
+// and merges the statuses of each cell. Clusters are ordered row by row (north to south, west to east).
+func (r *Repository) ClusterByTile(ctx context.Context, tile kernel.Tile, cellZoom int) ([]quest.TileCluster, error) {
+	bbox := tile.BoundingBox()
+	cond, args := geoquery.IndexedBoundingBoxCondition("target_latitude", "target_longitude", "target_geohash",
+		bbox, bbox.Geohashes(geoquery.MaxGeohashPrefixes))
+	cellX, cellY := geoquery.TileIndexExpressions("target_latitude", "target_longitude", cellZoom)
+
+	// Quests on the border of the bounding box may lie in a cell of a neighbouring tile
+	shift := cellZoom - tile.Z
+	minX, maxX := tile.X<<shift, (tile.X+1)<<shift-1
+	minY, maxY := tile.Y<<shift, (tile.Y+1)<<shift-1
+
+	db := r.tracker.Db()
+	cells := db.Model(&QuestDTO{}).
+		Select(cellX+" AS cell_x, "+cellY+" AS cell_y, status, target_latitude, target_longitude").
+		Where(cond, args...)
+
+	var rows []tileClusterRow
+	if err := db.WithContext(ctx).Table("(?) AS cells", cells).
+		Select("cell_x, cell_y, status, COUNT(*) AS count, AVG(target_latitude) AS latitude, AVG(target_longitude) AS longitude").
+		Where("cell_x BETWEEN ? AND ? AND cell_y BETWEEN ? AND ?", minX, maxX, minY, maxY).
+		Group("cell_x, cell_y, status").
+		Order("cell_y, cell_x, status").
+		Scan(&rows).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to cluster quests by tile", err)
+	}
+
+	var clusters []quest.TileCluster
+	for _, row := range rows {
+		cell := kernel.Tile{Z: cellZoom, X: row.CellX, Y: row.CellY}
+		if len(clusters) == 0 || clusters[len(clusters)-1].Cell != cell {
+			clusters = append(clusters, quest.NewTileCluster(cell))
 		}
-		quests[i] = q
+		clusters[len(clusters)-1].Add(quest.Status(row.Status), row.Count,
+			kernel.GeoCoordinate{Lat: row.Latitude, Lon: row.Longitude})
 	}
+	return clusters, nil
+}
 
-	return quests, nil
+// FindWithinRadius retrieves quests within radiusKm of center, ordered by sortBy and limited in SQL.
+// Candidates are pre-selected by the geohash cells around center and the bounding box,
+// then filtered and ordered by Haversine distance. Used when PostGIS is not available.
+func (r *Repository) FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64, sortBy quest.RadiusSort, limit int) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+
+	bbox := center.BoundingBoxForRadius(radiusKm)
+	geohashes := center.GeohashesForRadius(radiusKm)
+	if geohashes == nil {
+		geohashes = bbox.Geohashes(geoquery.MaxGeohashPrefixes)
+	}
+	candidateCond, candidateArgs := locationMatchBoundingBoxCondition(bbox, geohashes, quest.LocationMatchAny)
+
+	targetWithin, targetArgs := geoquery.WithinRadiusCondition("target_latitude", "target_longitude", center, radiusKm)
+	execWithin, execArgs := geoquery.WithinRadiusCondition("execution_latitude", "execution_longitude", center, radiusKm)
+
+	targetDistance, targetDistanceArgs := geoquery.DistanceExpression("target_latitude", "target_longitude", center)
+	execDistance, execDistanceArgs := geoquery.DistanceExpression("execution_latitude", "execution_longitude", center)
+
+	db := r.tracker.Db()
+	query := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Table("quests").
+		Select("quests.*, LEAST("+targetDistance+", "+execDistance+") AS distance_km",
+			append(targetDistanceArgs, execDistanceArgs...)...).
+		Where(candidateCond, candidateArgs...).
+		Where(targetWithin+" OR "+execWithin, append(targetArgs, execArgs...)...).
+		Order(radiusOrder(sortBy, "distance_km"))
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quests within radius", err)
+	}
+
+	return dtosToDomain(dtos)
 }
 
-// FindByAssignee retrieves all quests assigned to a specific user.
-func (r *Repository) FindByAssignee(ctx context.Context, userID uuid.UUID) ([]quest.Quest, error) {
+// radiusOrder returns the ORDER BY clause for sortBy; ties are ordered by distanceColumn, then ID.
+func radiusOrder(sortBy quest.RadiusSort, distanceColumn string) string {
+	switch sortBy {
+	case quest.RadiusSortCreatedAt:
+		return "quests.created_at DESC, " + distanceColumn + ", quests.id"
+	case quest.RadiusSortReward:
+		return "quests.reward DESC, " + distanceColumn + ", quests.id"
+	default:
+		return distanceColumn + ", quests.id"
+	}
+}
+
+// FindInPolygon retrieves quests inside the polygon. Candidates are pre-selected by the
+// polygon bounding box on the matched location columns and then tested with point-in-polygon in Go.
+func (r *Repository) FindInPolygon(ctx context.Context, polygon kernel.Polygon, match quest.LocationMatch) ([]quest.Quest, error) {
 	var dtos []QuestDTO
 
+	bbox := polygon.BoundingBox()
+	cond, args := locationMatchBoundingBoxCondition(bbox, bbox.Geohashes(geoquery.MaxGeohashPrefixes), match)
+
 	db := r.tracker.Db()
-	if err := db.WithContext(ctx).
-		Where("assignee = ?", userID.String()).
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Where(cond, args...).
 		Find(&dtos).Error; err != nil {
-		return nil, errs.WrapInfrastructureError("failed to get quests by assignee", err)
+		return nil, errs.WrapInfrastructureError("failed to get quests in polygon", err)
 	}
 
-	quests := make([]quest.Quest, len(dtos))
-	for i, dto := range dtos {
-		q, err := DtoToDomain(dto)
-		if err != nil {
-			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+	candidates, err := dtosToDomain(dtos)
+	if err != nil {
+		return nil, err
+	}
+
+	quests := make([]quest.Quest, 0, len(candidates))
+	for _, q := range candidates {
+		if match.Matches(q, polygon.Contains) {
+			quests = append(quests, q)
 		}
-		quests[i] = q
 	}
 
 	return quests, nil
 }
 
+// locationMatchBoundingBoxCondition builds the bounding box condition for the location columns selected by match
+func locationMatchBoundingBoxCondition(bbox kernel.BoundingBox, geohashes []string, match quest.LocationMatch) (string, []interface{}) {
+	targetCond, targetArgs := geoquery.IndexedBoundingBoxCondition("target_latitude", "target_longitude", "target_geohash", bbox, geohashes)
+	execCond, execArgs := geoquery.IndexedBoundingBoxCondition("execution_latitude", "execution_longitude", "execution_geohash", bbox, geohashes)
+
+	switch match {
+	case quest.LocationMatchTarget:
+		return targetCond, targetArgs
+	case quest.LocationMatchExecution:
+		return execCond, execArgs
+	default:
+		return targetCond + " OR " + execCond, append(targetArgs, execArgs...)
+	}
+}
+
+// FindByAssignee retrieves all quests the user takes part in.
+func (r *Repository) FindByAssignee(ctx context.Context, userID uuid.UUID) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Where("id IN (?)", db.Model(&ParticipantDTO{}).Select("quest_id").Where("user_id = ?", userID.String())).
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quests by assignee", err)
+	}
+
+	return dtosToDomain(dtos)
+}
+
 // FindAll retrieves all quests without any filter.
 func (r *Repository) FindAll(ctx context.Context) ([]quest.Quest, error) {
 	var dtos []QuestDTO
 	db := r.tracker.Db()
-	if err := db.WithContext(ctx).Find(&dtos).Error; err != nil {
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).Find(&dtos).Error; err != nil {
 		return nil, errs.WrapInfrastructureError("failed to get all quests", err)
 	}
 
-	quests := make([]quest.Quest, len(dtos))
-	for i, dto := range dtos {
-		q, err := DtoToDomain(dto)
-		if err != nil {
-			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
-		}
-		quests[i] = q
-	}
-
-	return quests, nil
+	return dtosToDomain(dtos)
 }
 
 // FindByStatus retrieves all quests with the specified status.
 func (r *Repository) FindByStatus(ctx context.Context, status quest.Status) ([]quest.Quest, error) {
 	var dtos []QuestDTO
 	db := r.tracker.Db()
-	if err := db.WithContext(ctx).
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
 		Where("status = ?", string(status)).
 		Find(&dtos).Error; err != nil {
 		return nil, errs.WrapInfrastructureError("failed to get quests by status", err)
 	}
 
+	return dtosToDomain(dtos)
+}
+
+// FindByFilter retrieves the quests matching the filter; the route length is read from the stored
+// column and the creator rating is aggregated from the reviews table in a subquery.
+func (r *Repository) FindByFilter(ctx context.Context, filter quest.ListFilter) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+	db := r.tracker.Db()
+	query := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites)
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+	if filter.MaxRouteKm != nil {
+		query = query.Where("route_km <= ?", *filter.MaxRouteKm)
+	}
+	if filter.MinCreatorRating != nil {
+		// Same rounding as review.NewReputation
+		query = query.Where("creator IN (?)", db.Table("reviews").
+			Select("reviewee_id").
+			Group("reviewee_id").
+			Having("ROUND(AVG(rating), 2) >= ?", *filter.MinCreatorRating))
+	}
+	if err := query.Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quests by filter", err)
+	}
+
+	return dtosToDomain(dtos)
+}
+
+// FindDependents retrieves the quests that have the quest as a prerequisite.
+// Inside a transaction it reads the uncommitted state, like GetByID.
+func (r *Repository) FindDependents(ctx context.Context, prerequisiteID uuid.UUID) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+	db := r.tracker.Db()
+	if r.tracker.InTx() {
+		db = r.tracker.Tx()
+	}
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Where("id IN (?)", db.Model(&PrerequisiteDTO{}).Select("quest_id").Where("prerequisite_id = ?", prerequisiteID.String())).
+		Order("created_at, id").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get dependent quests", err)
+	}
+	return dtosToDomain(dtos)
+}
+
+// linkedQuestIDsQuery walks quest_prerequisites in both directions from a quest;
+// UNION drops the IDs already found, so the walk ends on cycles too
+const linkedQuestIDsQuery = `WITH RECURSIVE linked(id) AS (
+	SELECT CAST(? AS text)
+	UNION
+	SELECT CASE WHEN p.quest_id = linked.id THEN p.prerequisite_id ELSE p.quest_id END
+	FROM quest_prerequisites p
+	JOIN linked ON p.quest_id = linked.id OR p.prerequisite_id = linked.id
+)
+SELECT id FROM linked`
+
+// FindLinkedByPrerequisites retrieves the quest and every quest linked to it through prerequisites,
+// in either direction and transitively, oldest first. Inside a transaction it reads the uncommitted state.
+func (r *Repository) FindLinkedByPrerequisites(ctx context.Context, questID uuid.UUID) ([]quest.Quest, error) {
+	var dtos []QuestDTO
+	db := r.tracker.Db()
+	if r.tracker.InTx() {
+		db = r.tracker.Tx()
+	}
+	if err := db.WithContext(ctx).Scopes(preloadWaypoints, preloadParticipants, preloadPrerequisites).
+		Where("id IN (?)", gorm.Expr(linkedQuestIDsQuery, questID.String())).
+		Order("created_at, id").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quests linked by prerequisites", err)
+	}
+	return dtosToDomain(dtos)
+}
+
+// LockLinkedByPrerequisites locks the quests and every quest linked to them through prerequisites
+// with SELECT ... FOR UPDATE, in ID order. Links committed by the transactions it waited for are
+// followed until no unlocked quest is linked, so the caller reads the links after they are final.
+func (r *Repository) LockLinkedByPrerequisites(ctx context.Context, questIDs ...uuid.UUID) error {
+	if !r.tracker.InTx() {
+		return errs.NewValueIsRequiredError("transaction")
+	}
+	tx := r.tracker.Tx().WithContext(ctx)
+
+	locked := make(map[string]bool)
+	for {
+		var missing []string
+		for _, questID := range questIDs {
+			var linked []string
+			if err := tx.Raw(linkedQuestIDsQuery, questID.String()).Scan(&linked).Error; err != nil {
+				return errs.WrapInfrastructureError("failed to get quests linked by prerequisites", err)
+			}
+			for _, id := range linked {
+				if !locked[id] {
+					locked[id] = true
+					missing = append(missing, id)
+				}
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+
+		var ids []string
+		if err := tx.Raw("SELECT id FROM quests WHERE id IN ? ORDER BY id FOR UPDATE", missing).
+			Scan(&ids).Error; err != nil {
+			return errs.WrapInfrastructureError("failed to lock quests linked by prerequisites", err)
+		}
+	}
+}
+
+// dtosToDomain converts the quest DTOs to domain quests
+func dtosToDomain(dtos []QuestDTO) ([]quest.Quest, error) {
 	quests := make([]quest.Quest, len(dtos))
 	for i, dto := range dtos {
 		q, err := DtoToDomain(dto)
@@ -161,6 +428,26 @@ func (r *Repository) FindByStatus(ctx context.Context, status quest.Status) ([]q
 		}
 		quests[i] = q
 	}
-
 	return quests, nil
 }
+
+// preloadWaypoints loads the route waypoints of the selected quests in route order
+func preloadWaypoints(db *gorm.DB) *gorm.DB {
+	return db.Preload("Waypoints", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	})
+}
+
+// preloadParticipants loads the participants of the selected quests in joining order
+func preloadParticipants(db *gorm.DB) *gorm.DB {
+	return db.Preload("Participants", func(db *gorm.DB) *gorm.DB {
+		return db.Order("joined_at")
+	})
+}
+
+// preloadPrerequisites loads the prerequisites of the selected quests in the order they were added
+func preloadPrerequisites(db *gorm.DB) *gorm.DB {
+	return db.Preload("Prerequisites", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	})
+}
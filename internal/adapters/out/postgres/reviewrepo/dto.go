@@ -0,0 +1,18 @@
+package reviewrepo
+
+import "time"
+
+// ReviewDTO is the database model for a review of a completed quest.
+type ReviewDTO struct {
+	ID         string `gorm:"primaryKey"`
+	QuestID    string `gorm:"not null;uniqueIndex:idx_review_quest_reviewer_reviewee"`
+	ReviewerID string `gorm:"not null;uniqueIndex:idx_review_quest_reviewer_reviewee"`
+	RevieweeID string `gorm:"not null;uniqueIndex:idx_review_quest_reviewer_reviewee;index"`
+	Rating     int    `gorm:"not null"`
+	Comment    string
+	CreatedAt  time.Time
+}
+
+func (ReviewDTO) TableName() string {
+	return "reviews"
+}
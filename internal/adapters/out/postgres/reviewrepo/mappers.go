@@ -0,0 +1,50 @@
+package reviewrepo
+
+import (
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts Review domain model to ReviewDTO
+func DomainToDTO(r review.Review) ReviewDTO {
+	return ReviewDTO{
+		ID:         r.ID().String(),
+		QuestID:    r.QuestID.String(),
+		ReviewerID: r.ReviewerID.String(),
+		RevieweeID: r.RevieweeID.String(),
+		Rating:     r.Rating,
+		Comment:    r.Comment,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+// DtoToDomain converts ReviewDTO to Review domain model
+func DtoToDomain(dto ReviewDTO) (review.Review, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return review.Review{}, err
+	}
+	questID, err := uuid.Parse(dto.QuestID)
+	if err != nil {
+		return review.Review{}, err
+	}
+	reviewerID, err := uuid.Parse(dto.ReviewerID)
+	if err != nil {
+		return review.Review{}, err
+	}
+	revieweeID, err := uuid.Parse(dto.RevieweeID)
+	if err != nil {
+		return review.Review{}, err
+	}
+	return review.Review{
+		BaseAggregate: ddd.NewBaseAggregate(id),
+		QuestID:       questID,
+		ReviewerID:    reviewerID,
+		RevieweeID:    revieweeID,
+		Rating:        dto.Rating,
+		Comment:       dto.Comment,
+		CreatedAt:     dto.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package reviewrepo
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var _ ports.ReviewRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts a review. A second review of the same reviewee by the same reviewer for the quest violates a unique index.
+func (r *Repository) Save(ctx context.Context, rev review.Review) error {
+	dto := DomainToDTO(rev)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin review transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	if err := tx.WithContext(ctx).Create(&dto).Error; err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError("failed to save review", err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit review transaction", err)
+		}
+	}
+	return nil
+}
+
+// FindByQuest retrieves the reviews of a quest, oldest first.
+func (r *Repository) FindByQuest(ctx context.Context, questID uuid.UUID) ([]review.Review, error) {
+	var dtos []ReviewDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("quest_id = ?", questID.String()).
+		Order("created_at ASC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find reviews by quest", err)
+	}
+
+	reviews := make([]review.Review, 0, len(dtos))
+	for _, dto := range dtos {
+		rev, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		reviews = append(reviews, rev)
+	}
+	return reviews, nil
+}
+
+// reputationRow is one row of the reputation aggregate query
+type reputationRow struct {
+	RevieweeID  string
+	RatingSum   int
+	ReviewCount int
+}
+
+// Reputations sums the ratings received by each user in SQL.
+func (r *Repository) Reputations(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]review.Reputation, error) {
+	result := make(map[uuid.UUID]review.Reputation, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id.String()
+		result[id] = review.NewReputation(id, 0, 0)
+	}
+
+	var rows []reputationRow
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Model(&ReviewDTO{}).
+		Select("reviewee_id, SUM(rating) AS rating_sum, COUNT(*) AS review_count").
+		Where("reviewee_id IN ?", ids).
+		Group("reviewee_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to aggregate reputations", err)
+	}
+
+	for _, row := range rows {
+		userID, err := uuid.Parse(row.RevieweeID)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to parse reviewee ID", err)
+		}
+		result[userID] = review.NewReputation(userID, row.RatingSum, row.ReviewCount)
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package templaterepo
+
+import "time"
+
+// QuestTemplateDTO is the database model for a quest template.
+type QuestTemplateDTO struct {
+	ID      string  `gorm:"primaryKey"`
+	OwnerID string  `gorm:"not null;index"`
+	Name    string  `gorm:"size:200;not null"`
+	Quest   SpecDTO `gorm:"type:jsonb;serializer:json;not null"`
+
+	// Recurrence rule and its start, both empty for templates without a schedule
+	RecurrenceRule  *string `gorm:"size:200"`
+	RecurrenceStart *time.Time
+
+	NextOccurrence *time.Time `gorm:"index"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (QuestTemplateDTO) TableName() string {
+	return "quest_templates"
+}
+
+// SpecDTO is the quest spec of a template, stored as JSON.
+type SpecDTO struct {
+	Title             string          `json:"title"`
+	Description       string          `json:"description"`
+	Difficulty        string          `json:"difficulty"`
+	Reward            int             `json:"reward"`
+	DurationMinutes   int             `json:"duration_minutes"`
+	TargetLocation    *CoordinateDTO  `json:"target_location,omitempty"`
+	TargetName        *string         `json:"target_name,omitempty"`
+	TargetAddress     *string         `json:"target_address,omitempty"`
+	ExecutionLocation *CoordinateDTO  `json:"execution_location,omitempty"`
+	ExecutionName     *string         `json:"execution_name,omitempty"`
+	ExecutionAddress  *string         `json:"execution_address,omitempty"`
+	Waypoints         []CoordinateDTO `json:"waypoints,omitempty"`
+	Equipment         []string        `json:"equipment,omitempty"`
+	Skills            []string        `json:"skills,omitempty"`
+	EligibilityPolicy string          `json:"eligibility_policy,omitempty"`
+	AssignmentMode    string          `json:"assignment_mode,omitempty"`
+	Capacity          int             `json:"capacity,omitempty"`
+	CompletionQuorum  int             `json:"completion_quorum,omitempty"`
+	GeofenceRadius    int             `json:"geofence_radius,omitempty"`
+}
+
+// CoordinateDTO is a coordinate in the quest spec of a template.
+type CoordinateDTO struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// OccurrenceDTO is the database model for an occurrence of a template the scheduler created a quest for.
+// The primary key makes adding an occurrence twice a no-op.
+type OccurrenceDTO struct {
+	TemplateID string    `gorm:"primaryKey"`
+	OccursAt   time.Time `gorm:"primaryKey"`
+	QuestID    *string
+	CreatedAt  time.Time
+	ClaimedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (OccurrenceDTO) TableName() string {
+	return "quest_template_occurrences"
+}
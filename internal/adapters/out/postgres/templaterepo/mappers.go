@@ -0,0 +1,187 @@
+package templaterepo
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts QuestTemplate domain model to QuestTemplateDTO
+func DomainToDTO(t *template.QuestTemplate) QuestTemplateDTO {
+	dto := QuestTemplateDTO{
+		ID:             t.ID().String(),
+		OwnerID:        t.OwnerID.String(),
+		Name:           t.Name,
+		Quest:          specToDTO(t.Quest),
+		NextOccurrence: t.NextOccurrence,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+	if t.Recurrence != nil {
+		rule := t.Recurrence.String()
+		start := t.Recurrence.Start
+		dto.RecurrenceRule = &rule
+		dto.RecurrenceStart = &start
+	}
+	return dto
+}
+
+// DtoToDomain converts QuestTemplateDTO to QuestTemplate domain model
+func DtoToDomain(dto QuestTemplateDTO) (*template.QuestTemplate, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := uuid.Parse(dto.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := dtoToSpec(dto.Quest)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &template.QuestTemplate{
+		BaseAggregate:  ddd.NewBaseAggregate(id),
+		OwnerID:        ownerID,
+		Name:           dto.Name,
+		Quest:          spec,
+		NextOccurrence: dto.NextOccurrence,
+		CreatedAt:      dto.CreatedAt,
+		UpdatedAt:      dto.UpdatedAt,
+	}
+	if dto.RecurrenceRule != nil && dto.RecurrenceStart != nil {
+		recurrence, err := template.ParseRecurrence(*dto.RecurrenceRule, *dto.RecurrenceStart)
+		if err != nil {
+			return nil, err
+		}
+		t.Recurrence = &recurrence
+	}
+	return t, nil
+}
+
+// OccurrenceToDTO converts Occurrence domain model to OccurrenceDTO
+func OccurrenceToDTO(o template.Occurrence) OccurrenceDTO {
+	dto := OccurrenceDTO{
+		TemplateID: o.TemplateID.String(),
+		OccursAt:   o.At,
+		CreatedAt:  o.CreatedAt,
+		ClaimedAt:  o.ClaimedAt,
+	}
+	if o.QuestID != nil {
+		questID := o.QuestID.String()
+		dto.QuestID = &questID
+	}
+	return dto
+}
+
+// DtoToOccurrence converts OccurrenceDTO to Occurrence domain model
+func DtoToOccurrence(dto OccurrenceDTO) (template.Occurrence, error) {
+	templateID, err := uuid.Parse(dto.TemplateID)
+	if err != nil {
+		return template.Occurrence{}, err
+	}
+
+	o := template.Occurrence{
+		TemplateID: templateID,
+		At:         dto.OccursAt,
+		CreatedAt:  dto.CreatedAt,
+		ClaimedAt:  dto.ClaimedAt,
+	}
+	if dto.QuestID != nil {
+		questID, err := uuid.Parse(*dto.QuestID)
+		if err != nil {
+			return template.Occurrence{}, err
+		}
+		o.QuestID = &questID
+	}
+	return o, nil
+}
+
+func specToDTO(s template.Spec) SpecDTO {
+	dto := SpecDTO{
+		Title:             s.Title,
+		Description:       s.Description,
+		Difficulty:        s.Difficulty,
+		Reward:            s.Reward,
+		DurationMinutes:   s.DurationMinutes,
+		TargetLocation:    coordinateToDTO(s.TargetLocation),
+		TargetName:        s.TargetName,
+		TargetAddress:     s.TargetAddress,
+		ExecutionLocation: coordinateToDTO(s.ExecutionLocation),
+		ExecutionName:     s.ExecutionName,
+		ExecutionAddress:  s.ExecutionAddress,
+		Equipment:         s.Equipment,
+		Skills:            s.Skills,
+		EligibilityPolicy: s.EligibilityPolicy,
+		AssignmentMode:    s.AssignmentMode,
+		Capacity:          s.Capacity,
+		CompletionQuorum:  s.CompletionQuorum,
+		GeofenceRadius:    s.GeofenceRadius,
+	}
+	for _, w := range s.Waypoints {
+		dto.Waypoints = append(dto.Waypoints, CoordinateDTO{Latitude: w.Latitude(), Longitude: w.Longitude()})
+	}
+	return dto
+}
+
+func dtoToSpec(dto SpecDTO) (template.Spec, error) {
+	targetLocation, err := dtoToCoordinate(dto.TargetLocation)
+	if err != nil {
+		return template.Spec{}, err
+	}
+	executionLocation, err := dtoToCoordinate(dto.ExecutionLocation)
+	if err != nil {
+		return template.Spec{}, err
+	}
+
+	spec := template.Spec{
+		Title:             dto.Title,
+		Description:       dto.Description,
+		Difficulty:        dto.Difficulty,
+		Reward:            dto.Reward,
+		DurationMinutes:   dto.DurationMinutes,
+		TargetLocation:    targetLocation,
+		TargetName:        dto.TargetName,
+		TargetAddress:     dto.TargetAddress,
+		ExecutionLocation: executionLocation,
+		ExecutionName:     dto.ExecutionName,
+		ExecutionAddress:  dto.ExecutionAddress,
+		Waypoints:         make([]kernel.GeoCoordinate, 0, len(dto.Waypoints)),
+		Equipment:         dto.Equipment,
+		Skills:            dto.Skills,
+		EligibilityPolicy: dto.EligibilityPolicy,
+		AssignmentMode:    dto.AssignmentMode,
+		Capacity:          dto.Capacity,
+		CompletionQuorum:  dto.CompletionQuorum,
+		GeofenceRadius:    dto.GeofenceRadius,
+	}
+	for _, w := range dto.Waypoints {
+		waypoint, err := kernel.NewGeoCoordinate(w.Latitude, w.Longitude)
+		if err != nil {
+			return template.Spec{}, err
+		}
+		spec.Waypoints = append(spec.Waypoints, waypoint)
+	}
+	return spec, nil
+}
+
+func coordinateToDTO(c *kernel.GeoCoordinate) *CoordinateDTO {
+	if c == nil {
+		return nil
+	}
+	return &CoordinateDTO{Latitude: c.Latitude(), Longitude: c.Longitude()}
+}
+
+func dtoToCoordinate(dto *CoordinateDTO) (*kernel.GeoCoordinate, error) {
+	if dto == nil {
+		return nil, nil
+	}
+	c, err := kernel.NewGeoCoordinate(dto.Latitude, dto.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,192 @@
+package templaterepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.QuestTemplateRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts or updates a quest template.
+func (r *Repository) Save(ctx context.Context, t *template.QuestTemplate) error {
+	dto := DomainToDTO(t)
+	return r.write(ctx, "failed to save quest template", func(tx *gorm.DB) error {
+		return tx.Save(&dto).Error
+	})
+}
+
+// GetByID retrieves a quest template.
+// Returns an error wrapping ports.ErrQuestTemplateNotFound if it does not exist.
+func (r *Repository) GetByID(ctx context.Context, templateID uuid.UUID) (*template.QuestTemplate, error) {
+	var dto QuestTemplateDTO
+	if err := r.db().WithContext(ctx).
+		Where("id = ?", templateID.String()).
+		First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("quest template %s: %w", templateID, ports.ErrQuestTemplateNotFound)
+		}
+		return nil, errs.WrapInfrastructureError("failed to get quest template by ID", err)
+	}
+	return DtoToDomain(dto)
+}
+
+// FindByOwner retrieves the quest templates of a user, oldest first.
+func (r *Repository) FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]*template.QuestTemplate, error) {
+	var dtos []QuestTemplateDTO
+	if err := r.db().WithContext(ctx).
+		Where("owner_id = ?", ownerID.String()).
+		Order("created_at ASC, id ASC").
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find quest templates by owner", err)
+	}
+	return dtosToDomain(dtos)
+}
+
+// FindDue retrieves the quest templates with an occurrence due at the given time, earliest first.
+func (r *Repository) FindDue(ctx context.Context, now time.Time, limit int) ([]*template.QuestTemplate, error) {
+	var dtos []QuestTemplateDTO
+	if err := r.db().WithContext(ctx).
+		Where("next_occurrence IS NOT NULL AND next_occurrence <= ?", now).
+		Order("next_occurrence ASC, id ASC").
+		Limit(limit).
+		Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find due quest templates", err)
+	}
+	return dtosToDomain(dtos)
+}
+
+// Delete removes a quest template together with its occurrences.
+// Returns an error wrapping ports.ErrQuestTemplateNotFound if it does not exist.
+func (r *Repository) Delete(ctx context.Context, templateID uuid.UUID) error {
+	var deleted int64
+	err := r.write(ctx, "failed to delete quest template", func(tx *gorm.DB) error {
+		if err := tx.Where("template_id = ?", templateID.String()).Delete(&OccurrenceDTO{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("id = ?", templateID.String()).Delete(&QuestTemplateDTO{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("quest template %s: %w", templateID, ports.ErrQuestTemplateNotFound)
+	}
+	return nil
+}
+
+// AddOccurrence inserts the occurrence, skipping it if the template already has one at the same time.
+func (r *Repository) AddOccurrence(ctx context.Context, o template.Occurrence) (bool, error) {
+	dto := OccurrenceToDTO(o)
+	var added bool
+	err := r.write(ctx, "failed to add quest template occurrence", func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dto)
+		added = result.RowsAffected > 0
+		return result.Error
+	})
+	return added, err
+}
+
+// SaveOccurrence updates the quest and the claim time of an occurrence.
+func (r *Repository) SaveOccurrence(ctx context.Context, o template.Occurrence) error {
+	dto := OccurrenceToDTO(o)
+	return r.write(ctx, "failed to save quest template occurrence", func(tx *gorm.DB) error {
+		return tx.Model(&OccurrenceDTO{}).
+			Where("template_id = ? AND occurs_at = ?", dto.TemplateID, dto.OccursAt).
+			Updates(map[string]interface{}{"quest_id": dto.QuestID, "claimed_at": dto.ClaimedAt}).Error
+	})
+}
+
+// FindRetryableOccurrences retrieves the occurrences without a quest whose claim has expired at now,
+// earliest first. In a transaction the rows are locked FOR UPDATE SKIP LOCKED, so concurrent runs
+// retry different occurrences.
+func (r *Repository) FindRetryableOccurrences(ctx context.Context, now time.Time, limit int) ([]template.Occurrence, error) {
+	query := r.db().WithContext(ctx).
+		Where("quest_id IS NULL AND claimed_at <= ?", now.Add(-template.OccurrenceClaimTimeout)).
+		Order("occurs_at ASC, template_id ASC").
+		Limit(limit)
+	if r.tracker.InTx() {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+	}
+
+	var dtos []OccurrenceDTO
+	if err := query.Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("failed to find retryable quest template occurrences", err)
+	}
+
+	occurrences := make([]template.Occurrence, 0, len(dtos))
+	for _, dto := range dtos {
+		o, err := DtoToOccurrence(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert quest template occurrence", err)
+		}
+		occurrences = append(occurrences, o)
+	}
+	return occurrences, nil
+}
+
+func dtosToDomain(dtos []QuestTemplateDTO) ([]*template.QuestTemplate, error) {
+	templates := make([]*template.QuestTemplate, 0, len(dtos))
+	for _, dto := range dtos {
+		t, err := DtoToDomain(dto)
+		if err != nil {
+			return nil, errs.WrapInfrastructureError("failed to convert dto to domain", err)
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+// write runs fn in the current transaction, or in its own one if there is none.
+func (r *Repository) write(ctx context.Context, message string, fn func(tx *gorm.DB) error) error {
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin quest template transaction", err)
+		}
+	}
+
+	if err := fn(r.tracker.Tx().WithContext(ctx)); err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError(message, err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit quest template transaction", err)
+		}
+	}
+	return nil
+}
+
+// db reads inside the current transaction if there is one.
+func (r *Repository) db() *gorm.DB {
+	if r.tracker.InTx() {
+		return r.tracker.Tx()
+	}
+	return r.tracker.Db()
+}
@@ -3,8 +3,18 @@ package postgres
 import (
 	"context"
 
+	"quest-manager/internal/adapters/out/postgres/achievementrepo"
+	"quest-manager/internal/adapters/out/postgres/applicationrepo"
+	"quest-manager/internal/adapters/out/postgres/commentrepo"
+	"quest-manager/internal/adapters/out/postgres/evidencerepo"
+	"quest-manager/internal/adapters/out/postgres/leaderboardrepo"
+	"quest-manager/internal/adapters/out/postgres/ledgerrepo"
 	"quest-manager/internal/adapters/out/postgres/locationrepo"
+	"quest-manager/internal/adapters/out/postgres/notificationrepo"
 	"quest-manager/internal/adapters/out/postgres/questrepo"
+	"quest-manager/internal/adapters/out/postgres/reviewrepo"
+	"quest-manager/internal/adapters/out/postgres/templaterepo"
+	"quest-manager/internal/adapters/out/postgres/userrepo"
 	"quest-manager/internal/core/ports"
 	"quest-manager/internal/pkg/errs"
 
@@ -14,19 +24,126 @@ import (
 var _ ports.UnitOfWork = &UnitOfWork{}
 
 type UnitOfWork struct {
-	tx                 *gorm.DB
-	db                 *gorm.DB
-	questRepository    ports.QuestRepository
-	locationRepository ports.LocationRepository
+	tx                      *gorm.DB
+	db                      *gorm.DB
+	questRepository         ports.QuestRepository
+	locationRepository      ports.LocationRepository
+	userRepository          ports.UserRepository
+	applicationRepository   ports.ApplicationRepository
+	evidenceRepository      ports.EvidenceRepository
+	ledgerRepository        ports.LedgerRepository
+	leaderboardRepository   ports.LeaderboardRepository
+	achievementRepository   ports.AchievementRepository
+	reviewRepository        ports.ReviewRepository
+	commentRepository       ports.CommentRepository
+	notificationRepository  ports.NotificationRepository
+	questTemplateRepository ports.QuestTemplateRepository
+	afterCommit             []func()
 }
 
-func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
+// Option configures how NewUnitOfWork builds its repositories.
+type Option func(*options)
+
+type options struct {
+	postGIS bool
+}
+
+// WithPostGIS selects the PostGIS-backed quest, location and leaderboard repositories.
+// When disabled, the plain SQL repositories are used.
+func WithPostGIS(enabled bool) Option {
+	return func(o *options) {
+		o.postGIS = enabled
+	}
+}
+
+func NewUnitOfWork(db *gorm.DB, opts ...Option) (ports.UnitOfWork, error) {
 	if db == nil {
 		return nil, errs.NewValueIsRequiredError("db")
 	}
 
+	var cfg options
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	uow := &UnitOfWork{db: db}
 
+	userRepo, err := userrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.userRepository = userRepo
+
+	applicationRepo, err := applicationrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.applicationRepository = applicationRepo
+
+	evidenceRepo, err := evidencerepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.evidenceRepository = evidenceRepo
+
+	ledgerRepo, err := ledgerrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.ledgerRepository = ledgerRepo
+
+	achievementRepo, err := achievementrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.achievementRepository = achievementRepo
+
+	reviewRepo, err := reviewrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.reviewRepository = reviewRepo
+
+	commentRepo, err := commentrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.commentRepository = commentRepo
+
+	notificationRepo, err := notificationrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.notificationRepository = notificationRepo
+
+	templateRepo, err := templaterepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.questTemplateRepository = templateRepo
+
+	if cfg.postGIS {
+		questRepo, err := questrepo.NewPostGISRepository(uow)
+		if err != nil {
+			return nil, err
+		}
+		uow.questRepository = questRepo
+
+		locationRepo, err := locationrepo.NewPostGISRepository(uow)
+		if err != nil {
+			return nil, err
+		}
+		uow.locationRepository = locationRepo
+
+		leaderboardRepo, err := leaderboardrepo.NewPostGISRepository(uow)
+		if err != nil {
+			return nil, err
+		}
+		uow.leaderboardRepository = leaderboardRepo
+
+		return uow, nil
+	}
+
 	questRepo, err := questrepo.NewRepository(uow)
 	if err != nil {
 		return nil, err
@@ -39,6 +156,12 @@ func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
 	}
 	uow.locationRepository = locationRepo
 
+	leaderboardRepo, err := leaderboardrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.leaderboardRepository = leaderboardRepo
+
 	return uow, nil
 }
 
@@ -60,10 +183,12 @@ func (u *UnitOfWork) Begin(ctx context.Context) error {
 		return tx.Error
 	}
 	u.tx = tx
+	u.afterCommit = nil
 	return nil
 }
 
 func (u *UnitOfWork) Rollback() error {
+	u.afterCommit = nil
 	if u.tx != nil {
 		err := u.tx.Rollback().Error
 		u.tx = nil
@@ -77,13 +202,28 @@ func (u *UnitOfWork) Commit(ctx context.Context) error {
 		return errs.NewValueIsRequiredError("cannot commit without transaction")
 	}
 
+	callbacks := u.afterCommit
+	u.afterCommit = nil
 	if err := u.tx.WithContext(ctx).Commit().Error; err != nil {
 		return err
 	}
 	u.tx = nil
+
+	for _, fn := range callbacks {
+		fn()
+	}
 	return nil
 }
 
+// AfterCommit runs fn after the current transaction commits, or right away outside a transaction.
+func (u *UnitOfWork) AfterCommit(fn func()) {
+	if u.tx == nil {
+		fn()
+		return
+	}
+	u.afterCommit = append(u.afterCommit, fn)
+}
+
 // Repository getters
 func (u *UnitOfWork) QuestRepository() ports.QuestRepository {
 	return u.questRepository
@@ -92,3 +232,43 @@ func (u *UnitOfWork) QuestRepository() ports.QuestRepository {
 func (u *UnitOfWork) LocationRepository() ports.LocationRepository {
 	return u.locationRepository
 }
+
+func (u *UnitOfWork) UserRepository() ports.UserRepository {
+	return u.userRepository
+}
+
+func (u *UnitOfWork) ApplicationRepository() ports.ApplicationRepository {
+	return u.applicationRepository
+}
+
+func (u *UnitOfWork) EvidenceRepository() ports.EvidenceRepository {
+	return u.evidenceRepository
+}
+
+func (u *UnitOfWork) LedgerRepository() ports.LedgerRepository {
+	return u.ledgerRepository
+}
+
+func (u *UnitOfWork) LeaderboardRepository() ports.LeaderboardRepository {
+	return u.leaderboardRepository
+}
+
+func (u *UnitOfWork) AchievementRepository() ports.AchievementRepository {
+	return u.achievementRepository
+}
+
+func (u *UnitOfWork) ReviewRepository() ports.ReviewRepository {
+	return u.reviewRepository
+}
+
+func (u *UnitOfWork) CommentRepository() ports.CommentRepository {
+	return u.commentRepository
+}
+
+func (u *UnitOfWork) NotificationRepository() ports.NotificationRepository {
+	return u.notificationRepository
+}
+
+func (u *UnitOfWork) QuestTemplateRepository() ports.QuestTemplateRepository {
+	return u.questTemplateRepository
+}
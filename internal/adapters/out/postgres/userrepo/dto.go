@@ -0,0 +1,20 @@
+package userrepo
+
+import "time"
+
+// UserDTO is the database model for User.
+type UserDTO struct {
+	ID                string `gorm:"primaryKey"`
+	DisplayName       string `gorm:"not null"`
+	Skills            string // stored as comma-separated string
+	Equipment         string // stored as comma-separated string
+	HomeLatitude      *float64
+	HomeLongitude     *float64
+	MaxTravelRadiusKm *float64
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (UserDTO) TableName() string {
+	return "users"
+}
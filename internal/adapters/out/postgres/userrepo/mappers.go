@@ -0,0 +1,65 @@
+package userrepo
+
+import (
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+// DomainToDTO converts User domain model to UserDTO
+func DomainToDTO(u *user.User) UserDTO {
+	dto := UserDTO{
+		ID:                u.ID().String(),
+		DisplayName:       u.DisplayName,
+		Skills:            strings.Join(u.Skills, ","),
+		Equipment:         strings.Join(u.Equipment, ","),
+		MaxTravelRadiusKm: u.MaxTravelRadiusKm,
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+	}
+	if u.HomeLocation != nil {
+		lat, lon := u.HomeLocation.Latitude(), u.HomeLocation.Longitude()
+		dto.HomeLatitude = &lat
+		dto.HomeLongitude = &lon
+	}
+	return dto
+}
+
+// DtoToDomain converts UserDTO to User domain model
+func DtoToDomain(dto UserDTO) (*user.User, error) {
+	id, err := uuid.Parse(dto.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var home *kernel.GeoCoordinate
+	if dto.HomeLatitude != nil && dto.HomeLongitude != nil {
+		coordinate, err := kernel.NewGeoCoordinate(*dto.HomeLatitude, *dto.HomeLongitude)
+		if err != nil {
+			return nil, err
+		}
+		home = &coordinate
+	}
+
+	return &user.User{
+		BaseAggregate:     ddd.NewBaseAggregate(id),
+		DisplayName:       dto.DisplayName,
+		Skills:            splitList(dto.Skills),
+		Equipment:         splitList(dto.Equipment),
+		HomeLocation:      home,
+		MaxTravelRadiusKm: dto.MaxTravelRadiusKm,
+		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+	}, nil
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,70 @@
+package userrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var _ ports.UserRepository = &Repository{}
+
+type Repository struct {
+	tracker ports.Tracker
+}
+
+func NewRepository(tracker ports.Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+	return &Repository{tracker: tracker}, nil
+}
+
+// Save inserts or updates a user profile.
+func (r *Repository) Save(ctx context.Context, u *user.User) error {
+	dto := DomainToDTO(u)
+
+	isInTransaction := r.tracker.InTx()
+	if !isInTransaction {
+		if err := r.tracker.Begin(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to begin user transaction", err)
+		}
+	}
+	tx := r.tracker.Tx()
+
+	if err := tx.WithContext(ctx).Save(&dto).Error; err != nil {
+		if !isInTransaction {
+			_ = r.tracker.Rollback()
+		}
+		return errs.WrapInfrastructureError("failed to save user", err)
+	}
+
+	if !isInTransaction {
+		if err := r.tracker.Commit(ctx); err != nil {
+			return errs.WrapInfrastructureError("failed to commit user transaction", err)
+		}
+	}
+	return nil
+}
+
+// GetByID retrieves the profile of a user.
+// Returns an error wrapping ports.ErrUserNotFound if no profile is stored.
+func (r *Repository) GetByID(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	var dto UserDTO
+	db := r.tracker.Db()
+	if err := db.WithContext(ctx).
+		Where("id = ?", userID.String()).
+		First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("user %s: %w", userID, ports.ErrUserNotFound)
+		}
+		return nil, errs.WrapInfrastructureError("failed to get user by ID", err)
+	}
+	return DtoToDomain(dto)
+}
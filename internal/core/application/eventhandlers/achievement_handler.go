@@ -0,0 +1,112 @@
+package eventhandlers
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type achievementHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+	definitions    []achievement.Definition
+}
+
+// NewAchievementHandler creates the handler awarding achievements for what users do with quests.
+// It is subscribed to "quest.assigned" and "quest.status_changed".
+func NewAchievementHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher, definitions []achievement.Definition) ports.EventHandler {
+	return &achievementHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+		definitions:    definitions,
+	}
+}
+
+// Handle records the fact of the event for every user concerned and unlocks the achievements
+// their facts now meet. A creator taking or completing their own quest is not concerned. Facts and unlocks are idempotent, so handling the same event again
+// unlocks nothing.
+func (h *achievementHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	var questID uuid.UUID
+	var kind achievement.FactKind
+	var occurredAt time.Time
+	var assignee *uuid.UUID
+
+	switch e := event.(type) {
+	case quest.QuestAssigned:
+		questID, kind, occurredAt, assignee = e.GetAggregateID(), achievement.FactAssigned, e.Timestamp, &e.UserID
+	case quest.QuestStatusChanged:
+		if e.NewStatus != quest.StatusCompleted {
+			return nil
+		}
+		questID, kind, occurredAt = e.GetAggregateID(), achievement.FactCompleted, e.Timestamp
+	default:
+		return nil
+	}
+
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, questID)
+	if err != nil {
+		return errs.WrapInfrastructureError("failed to get quest for achievements", err)
+	}
+
+	// The creator is never credited for their own quest, like in Quest.RewardRecipients
+	users := q.RewardRecipients()
+	if assignee != nil {
+		users = nil
+		if assignee.String() != q.Creator {
+			users = []uuid.UUID{*assignee}
+		}
+	}
+
+	var unlocked []ddd.DomainEvent
+	for _, userID := range users {
+		recorded, err := h.unitOfWork.AchievementRepository().RecordFact(ctx, achievement.NewQuestFact(userID, q, kind, occurredAt))
+		if err != nil {
+			return err
+		}
+		if !recorded {
+			continue
+		}
+		events, err := h.evaluate(ctx, userID, occurredAt)
+		if err != nil {
+			return err
+		}
+		unlocked = append(unlocked, events...)
+	}
+
+	if len(unlocked) == 0 || h.eventPublisher == nil {
+		return nil
+	}
+	return h.eventPublisher.Publish(ctx, unlocked...)
+}
+
+// evaluate unlocks every achievement the facts of the user meet and returns the events of the new ones.
+func (h *achievementHandler) evaluate(ctx context.Context, userID uuid.UUID, at time.Time) ([]ddd.DomainEvent, error) {
+	repo := h.unitOfWork.AchievementRepository()
+	facts, err := repo.FindFacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []ddd.DomainEvent
+	for _, definition := range h.definitions {
+		if !definition.IsMetBy(facts) {
+			continue
+		}
+		unlocked := achievement.Unlock(userID, definition, at)
+		added, err := repo.Unlock(ctx, unlocked)
+		if err != nil {
+			return nil, err
+		}
+		if added {
+			events = append(events, achievement.NewAchievementUnlocked(unlocked))
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,69 @@
+package eventhandlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+)
+
+var _ ports.EventPublisher = &Dispatcher{}
+
+// Dispatcher publishes domain events and passes them to the handlers subscribed to their name.
+// Handlers run synchronously within Publish, so they share the transaction of the use case
+// publishing the events, and their errors roll it back. Events published by handlers are
+// dispatched the same way.
+type Dispatcher struct {
+	publisher ports.EventPublisher
+	handlers  map[string][]ports.EventHandler
+	mu        sync.RWMutex
+}
+
+// NewDispatcher creates a Dispatcher storing events through the publisher.
+func NewDispatcher(publisher ports.EventPublisher) (*Dispatcher, error) {
+	if publisher == nil {
+		return nil, errs.NewValueIsRequiredError("publisher")
+	}
+	return &Dispatcher{
+		publisher: publisher,
+		handlers:  make(map[string][]ports.EventHandler),
+	}, nil
+}
+
+// Subscribe registers the handler for events with the name, e.g. "quest.status_changed".
+func (d *Dispatcher) Subscribe(eventName string, handler ports.EventHandler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers[eventName] = append(d.handlers[eventName], handler)
+}
+
+// Publish stores the events and then runs the subscribed handlers in event order.
+func (d *Dispatcher) Publish(ctx context.Context, events ...ddd.DomainEvent) error {
+	if err := d.publisher.Publish(ctx, events...); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		for _, handler := range d.subscribers(event.GetName()) {
+			if err := handler.Handle(ctx, event); err != nil {
+				return fmt.Errorf("handle %s event %s: %w", event.GetName(), event.GetID(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// PublishAsync stores the events asynchronously. Handlers are not run: they react
+// within the transaction of the publishing use case only.
+func (d *Dispatcher) PublishAsync(ctx context.Context, events ...ddd.DomainEvent) {
+	d.publisher.PublishAsync(ctx, events...)
+}
+
+func (d *Dispatcher) subscribers(eventName string) []ports.EventHandler {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.handlers[eventName]
+}
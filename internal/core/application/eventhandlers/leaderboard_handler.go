@@ -0,0 +1,48 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/leaderboard"
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+)
+
+type leaderboardHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewLeaderboardHandler creates the handler projecting credited points into the leaderboard.
+// It is subscribed to "ledger.points_credited".
+func NewLeaderboardHandler(unitOfWork ports.UnitOfWork) ports.EventHandler {
+	return &leaderboardHandler{unitOfWork: unitOfWork}
+}
+
+// Handle records a leaderboard score for the credited ledger entry. Quest rewards count
+// as completed quests at the execution location of the quest.
+func (h *leaderboardHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	credited, ok := event.(ledger.PointsCredited)
+	if !ok {
+		return nil
+	}
+
+	var score leaderboard.Score
+	var err error
+	if credited.Kind == ledger.KindQuestReward && credited.QuestID != nil {
+		q, getErr := h.unitOfWork.QuestRepository().GetByID(ctx, *credited.QuestID)
+		if getErr != nil {
+			return errs.WrapInfrastructureError("failed to get rewarded quest", getErr)
+		}
+		score, err = leaderboard.NewQuestCompletionScore(credited.EntryID, credited.GetAggregateID(), q.ID(),
+			credited.Points, q.ExecutionLocation, credited.Timestamp)
+	} else {
+		score, err = leaderboard.NewScore(credited.EntryID, credited.GetAggregateID(), credited.Points, credited.Timestamp)
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.unitOfWork.LeaderboardRepository().Record(ctx, score)
+}
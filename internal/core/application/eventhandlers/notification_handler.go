@@ -0,0 +1,169 @@
+package eventhandlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type notificationHandler struct {
+	unitOfWork ports.UnitOfWork
+	channel    ports.NotificationChannel
+}
+
+// NewNotificationHandler creates the handler writing notifications to the inboxes of the users
+// a quest event concerns and handing them to the delivery channel once the transaction commits.
+// It is subscribed to "quest.assigned", "quest.status_changed" and "quest.comment_added".
+func NewNotificationHandler(unitOfWork ports.UnitOfWork, channel ports.NotificationChannel) ports.EventHandler {
+	return &notificationHandler{
+		unitOfWork: unitOfWork,
+		channel:    channel,
+	}
+}
+
+// Handle notifies the recipients of the event who receive its kind:
+//   - quest.assigned: the creator
+//   - quest.status_changed: the creator, the participants and the participants released by
+//     reopening the quest; never the actor. Changes to "assigned" are left to quest.assigned
+//   - quest.comment_added: the creator for public comments, the creator and the participants
+//     for private ones; never the author
+//
+// Notifications are saved in the transaction of the use case that published the event and
+// delivered only after it commits, so rolled back changes are never announced and a slow
+// channel does not hold the transaction open. Delivery is best effort: a channel error is
+// logged and the notification stays in the inbox.
+func (h *notificationHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	switch e := event.(type) {
+	case quest.QuestAssigned:
+		q, err := h.quest(ctx, e.GetAggregateID())
+		if err != nil {
+			return err
+		}
+		return h.notifyAll(ctx, except(creatorOf(q), e.UserID),
+			notification.KindQuestAssigned, q.ID(), notification.QuestAssignedMessage(q), e.Timestamp)
+	case quest.QuestStatusChanged:
+		if e.NewStatus == quest.StatusAssigned {
+			return nil
+		}
+		q, err := h.quest(ctx, e.GetAggregateID())
+		if err != nil {
+			return err
+		}
+		// Participants released by a reopen are no longer on the quest, so they come from the event
+		recipients := append(creatorOf(q), participantsOf(q)...)
+		recipients = append(recipients, e.ReleasedParticipantIDs...)
+		if e.ActorID != nil {
+			recipients = except(recipients, *e.ActorID)
+		}
+		return h.notifyAll(ctx, recipients,
+			notification.KindQuestStatusChanged, q.ID(), notification.QuestStatusChangedMessage(q, e.NewStatus), e.Timestamp)
+	case quest.CommentAdded:
+		q, err := h.quest(ctx, e.GetAggregateID())
+		if err != nil {
+			return err
+		}
+		recipients := creatorOf(q)
+		if e.Visibility == quest.CommentPrivate {
+			recipients = append(recipients, participantsOf(q)...)
+		}
+		return h.notifyAll(ctx, except(recipients, e.AuthorID),
+			notification.KindQuestCommentAdded, q.ID(), notification.QuestCommentAddedMessage(q), e.Timestamp)
+	default:
+		return nil
+	}
+}
+
+func (h *notificationHandler) quest(ctx context.Context, questID uuid.UUID) (quest.Quest, error) {
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, questID)
+	if err != nil {
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to get quest for notifications", err)
+	}
+	return q, nil
+}
+
+// notifyAll notifies every recipient once.
+func (h *notificationHandler) notifyAll(ctx context.Context, recipients []uuid.UUID, kind notification.Kind, questID uuid.UUID, message string, at time.Time) error {
+	for _, userID := range unique(recipients) {
+		if err := h.notify(ctx, userID, kind, questID, message, at); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notify saves the notification unless the user turned its kind off and schedules its delivery.
+func (h *notificationHandler) notify(ctx context.Context, userID uuid.UUID, kind notification.Kind, questID uuid.UUID, message string, at time.Time) error {
+	repo := h.unitOfWork.NotificationRepository()
+	preferences, err := repo.Preferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !preferences.Receives(kind) {
+		return nil
+	}
+
+	n, err := notification.New(userID, kind, questID, message, at)
+	if err != nil {
+		return err
+	}
+	if err := repo.Save(ctx, n); err != nil {
+		return err
+	}
+
+	if h.channel == nil {
+		return nil
+	}
+	h.unitOfWork.AfterCommit(func() {
+		if err := h.channel.Deliver(ctx, n); err != nil {
+			slog.WarnContext(ctx, "failed to deliver notification",
+				slog.String("notification_id", n.ID.String()),
+				slog.Any("error", err),
+			)
+		}
+	})
+	return nil
+}
+
+// creatorOf returns the creator of the quest, or nothing if the creator is not a user ID.
+func creatorOf(q quest.Quest) []uuid.UUID {
+	creatorID, err := uuid.Parse(q.Creator)
+	if err != nil {
+		return nil
+	}
+	return []uuid.UUID{creatorID}
+}
+
+// participantsOf returns the participants of the quest, or its assignee if it has none.
+func participantsOf(q quest.Quest) []uuid.UUID {
+	return q.ParticipantIDs()
+}
+
+func except(users []uuid.UUID, excluded uuid.UUID) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(users))
+	for _, userID := range users {
+		if userID != excluded {
+			result = append(result, userID)
+		}
+	}
+	return result
+}
+
+func unique(users []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(users))
+	result := make([]uuid.UUID, 0, len(users))
+	for _, userID := range users {
+		if !seen[userID] {
+			seen[userID] = true
+			result = append(result, userID)
+		}
+	}
+	return result
+}
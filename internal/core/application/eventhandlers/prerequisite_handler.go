@@ -0,0 +1,56 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+)
+
+type prerequisiteHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewPrerequisiteHandler creates the handler unblocking the quests that depend on a completed quest.
+// It is subscribed to "quest.status_changed".
+func NewPrerequisiteHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) ports.EventHandler {
+	return &prerequisiteHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle records the completion of a quest on every quest depending on it. A dependent quest
+// in "created" status whose last prerequisite is completed is posted, and its status change
+// is published like any other. Dependents that already recorded the completion are left as they are.
+func (h *prerequisiteHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	e, ok := event.(quest.QuestStatusChanged)
+	if !ok || e.NewStatus != quest.StatusCompleted {
+		return nil
+	}
+
+	repo := h.unitOfWork.QuestRepository()
+	dependents, err := repo.FindDependents(ctx, e.GetAggregateID())
+	if err != nil {
+		return errs.WrapInfrastructureError("failed to get dependent quests", err)
+	}
+
+	var events []ddd.DomainEvent
+	for _, q := range dependents {
+		if !q.CompletePrerequisite(e.GetAggregateID()) {
+			continue
+		}
+		if err := repo.Save(ctx, q); err != nil {
+			return err
+		}
+		events = append(events, q.GetDomainEvents()...)
+	}
+
+	if len(events) == 0 || h.eventPublisher == nil {
+		return nil
+	}
+	return h.eventPublisher.Publish(ctx, events...)
+}
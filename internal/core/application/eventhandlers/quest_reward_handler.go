@@ -0,0 +1,63 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+)
+
+type questRewardHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+	formula        ledger.RewardFormula
+}
+
+// NewQuestRewardHandler creates the handler crediting points when a quest is completed.
+// It is subscribed to "quest.status_changed".
+func NewQuestRewardHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher, formula ledger.RewardFormula) ports.EventHandler {
+	return &questRewardHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+		formula:        formula,
+	}
+}
+
+// Handle credits every participant of a completed quest with the points of the reward formula.
+// A creator who took their own quest is not credited.
+// Entries are idempotent per quest and user, so handling the same completion again credits nothing.
+func (h *questRewardHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	changed, ok := event.(quest.QuestStatusChanged)
+	if !ok || changed.NewStatus != quest.StatusCompleted {
+		return nil
+	}
+
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, changed.GetAggregateID())
+	if err != nil {
+		return errs.WrapInfrastructureError("failed to get completed quest", err)
+	}
+
+	points := h.formula.Points(q.Reward, q.Difficulty)
+	var credited []ddd.DomainEvent
+	for _, userID := range q.RewardRecipients() {
+		entry, err := ledger.NewQuestReward(userID, q.ID(), points)
+		if err != nil {
+			return err
+		}
+		added, err := h.unitOfWork.LedgerRepository().Append(ctx, entry)
+		if err != nil {
+			return err
+		}
+		if added {
+			credited = append(credited, ledger.NewPointsCredited(entry))
+		}
+	}
+
+	if len(credited) == 0 || h.eventPublisher == nil {
+		return nil
+	}
+	return h.eventPublisher.Publish(ctx, credited...)
+}
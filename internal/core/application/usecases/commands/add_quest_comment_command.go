@@ -0,0 +1,13 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// AddQuestCommentCommand represents the input for adding a comment to the thread of a quest.
+type AddQuestCommentCommand struct {
+	QuestID    uuid.UUID
+	UserID     uuid.UUID
+	Body       string
+	Visibility string // empty means public while the quest is open and private afterwards
+}
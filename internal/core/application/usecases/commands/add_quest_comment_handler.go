@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// AddQuestCommentCommandHandler defines the interface for handling AddQuestCommentCommand.
+type AddQuestCommentCommandHandler interface {
+	Handle(ctx context.Context, cmd AddQuestCommentCommand) (quest.Comment, error)
+}
+
+var _ AddQuestCommentCommandHandler = &addQuestCommentHandler{}
+
+type addQuestCommentHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewAddQuestCommentCommandHandler creates a new instance of AddQuestCommentCommandHandler.
+func NewAddQuestCommentCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) AddQuestCommentCommandHandler {
+	return &addQuestCommentHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle adds the comment of the user to the thread of the quest.
+func (h *addQuestCommentHandler) Handle(ctx context.Context, cmd AddQuestCommentCommand) (quest.Comment, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to begin comment transaction", err)
+	}
+
+	// Get quest - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+
+	// Private comments are limited to the creator and the participants → 403
+	if cmd.Visibility == string(quest.CommentPrivate) && !q.IsMember(cmd.UserID) {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewForbiddenError("add comment", "only the quest creator and participants can post private comments")
+	}
+
+	// Use domain logic - business rules errors → 400
+	comment, err := q.AddComment(cmd.UserID, cmd.Body, cmd.Visibility)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewDomainValidationErrorWithCause("comment", "failed to add comment", err)
+	}
+
+	if err := h.unitOfWork.CommentRepository().Save(ctx, comment); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to save comment", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, q.GetDomainEvents()...); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return quest.Comment{}, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to commit comment transaction", err)
+	}
+
+	q.ClearDomainEvents()
+
+	return comment, nil
+}
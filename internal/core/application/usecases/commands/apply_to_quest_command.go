@@ -0,0 +1,12 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// ApplyToQuestCommand represents the input for applying to a quest in review mode.
+type ApplyToQuestCommand struct {
+	QuestID uuid.UUID
+	UserID  uuid.UUID
+	Message string
+}
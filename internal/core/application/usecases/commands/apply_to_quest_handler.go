@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// ApplyToQuestCommandHandler defines the interface for handling ApplyToQuestCommand.
+type ApplyToQuestCommandHandler interface {
+	Handle(ctx context.Context, cmd ApplyToQuestCommand) (quest.Application, error)
+}
+
+var _ ApplyToQuestCommandHandler = &applyToQuestHandler{}
+
+type applyToQuestHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewApplyToQuestCommandHandler creates a new instance of ApplyToQuestCommandHandler.
+func NewApplyToQuestCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) ApplyToQuestCommandHandler {
+	return &applyToQuestHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle submits an application of the user to the quest.
+func (h *applyToQuestHandler) Handle(ctx context.Context, cmd ApplyToQuestCommand) (quest.Application, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return quest.Application{}, errs.WrapInfrastructureError("failed to begin application transaction", err)
+	}
+
+	// Get quest - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Application{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+
+	existing, err := h.unitOfWork.ApplicationRepository().FindByQuest(ctx, q.ID())
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Application{}, errs.WrapInfrastructureError("failed to get quest applications", err)
+	}
+
+	// Use domain logic - business rules errors → 400
+	application, err := q.Apply(cmd.UserID, cmd.Message, existing)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Application{}, errs.NewDomainValidationErrorWithCause("application", "failed to apply to quest", err)
+	}
+
+	if err := h.unitOfWork.ApplicationRepository().Save(ctx, application); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Application{}, errs.WrapInfrastructureError("failed to save application", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, q.GetDomainEvents()...); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return quest.Application{}, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return quest.Application{}, errs.WrapInfrastructureError("failed to commit application transaction", err)
+	}
+
+	q.ClearDomainEvents()
+
+	return application, nil
+}
@@ -2,6 +2,9 @@ package commands
 
 import (
 	"github.com/google/uuid"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
 )
 
 // AssignQuestCommand represents the input for assigning a quest to a user.
@@ -15,4 +18,10 @@ type AssignQuestResult struct {
 	ID       uuid.UUID
 	Assignee uuid.UUID
 	Status   string
+
+	// All participants of the quest, including the assigned user
+	Participants []quest.Participant
+
+	// Unmet requirements of quests with the "warn" eligibility policy
+	EligibilityWarnings []errs.Violation
 }
@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 
+	"quest-manager/internal/core/domain/model/quest"
 	"quest-manager/internal/core/ports"
 	"quest-manager/internal/pkg/errs"
 )
@@ -35,19 +36,33 @@ func (h *assignQuestHandler) Handle(ctx context.Context, cmd AssignQuestCommand)
 		return AssignQuestResult{}, errs.WrapInfrastructureError("failed to begin quest assignment transaction", err)
 	}
 
-	// Get quest - if not found → 404
-	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.ID)
+	// Get quest locked until commit, participants change one transaction at a time - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByIDForUpdate(ctx, cmd.ID)
 	if err != nil {
 		_ = h.unitOfWork.Rollback()
 		return AssignQuestResult{}, errs.NewNotFoundErrorWithCause("quest", cmd.ID.String(), err)
 	}
 
+	// Quests in review mode are assigned by accepting an application → 400
+	if q.AssignmentMode == quest.AssignmentReview {
+		_ = h.unitOfWork.Rollback()
+		return AssignQuestResult{}, errs.NewDomainValidationError("assignment", "quest is assigned by application review, apply to it instead")
+	}
+
 	// Use domain logic - business rules errors → 400
+	q.SetActor(cmd.UserID)
 	if err := q.AssignTo(cmd.UserID); err != nil {
 		_ = h.unitOfWork.Rollback()
 		return AssignQuestResult{}, errs.NewDomainValidationErrorWithCause("assignment", "failed to assign quest", err)
 	}
 
+	// Check the user against the quest requirements - strict policy violations → 400
+	warnings, err := checkEligibility(ctx, h.unitOfWork.UserRepository(), q, cmd.UserID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return AssignQuestResult{}, err
+	}
+
 	// Save quest - infrastructure error → 500
 	if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
 		_ = h.unitOfWork.Rollback()
@@ -75,5 +90,9 @@ func (h *assignQuestHandler) Handle(ctx context.Context, cmd AssignQuestCommand)
 		ID:       q.ID(),
 		Assignee: cmd.UserID,
 		Status:   string(q.Status),
+
+		Participants: q.Participants,
+
+		EligibilityWarnings: warnings,
 	}, nil
 }
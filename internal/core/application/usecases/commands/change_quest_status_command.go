@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
 
 	"github.com/google/uuid"
@@ -8,7 +9,10 @@ import (
 
 type ChangeQuestStatusCommand struct {
 	QuestID uuid.UUID
+	UserID  uuid.UUID // acting user; starting and completing depend on who acts
 	Status  quest.Status
+	// Position of the acting user; required to start or complete a quest with a geofence
+	Position *kernel.GeoCoordinate
 }
 
 // ChangeQuestStatusResult represents the output after status change.
@@ -16,4 +20,7 @@ type ChangeQuestStatusResult struct {
 	ID       uuid.UUID
 	Assignee *uuid.UUID // can be nil if quest is not assigned
 	Status   string
+
+	Participants     []quest.Participant
+	CompletionQuorum int
 }
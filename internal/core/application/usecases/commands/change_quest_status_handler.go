@@ -2,7 +2,10 @@ package commands
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
 	"quest-manager/internal/core/ports"
 	"quest-manager/internal/pkg/errs"
@@ -30,7 +33,12 @@ func NewChangeQuestStatusCommandHandler(unitOfWork ports.UnitOfWork, eventPublis
 func (h *changeQuestStatusHandler) Handle(ctx context.Context, cmd ChangeQuestStatusCommand) (ChangeQuestStatusResult, error) {
 	// Validate status - this is domain validation error → 400
 	if !quest.IsValidStatus(string(cmd.Status)) {
-		return ChangeQuestStatusResult{}, errs.NewDomainValidationError("status", "must be one of 'created', 'posted', 'assigned', 'in_progress', 'declined', 'completed'")
+		return ChangeQuestStatusResult{}, errs.NewDomainValidationError("status", "must be one of 'created', 'posted', 'assigned', 'in_progress', 'pending_review', 'declined', 'completed'")
+	}
+	if cmd.Position != nil {
+		if _, err := kernel.NewGeoCoordinate(cmd.Position.Lat, cmd.Position.Lon); err != nil {
+			return ChangeQuestStatusResult{}, errs.NewDomainValidationErrorWithCause("position", "invalid GPS position", err)
+		}
 	}
 
 	// Begin transaction
@@ -38,19 +46,47 @@ func (h *changeQuestStatusHandler) Handle(ctx context.Context, cmd ChangeQuestSt
 		return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to begin quest status change transaction", err)
 	}
 
-	// Get quest - if not found → 404
-	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.QuestID)
+	// Get quest locked until commit, participants change one transaction at a time - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByIDForUpdate(ctx, cmd.QuestID)
 	if err != nil {
 		_ = h.unitOfWork.Rollback()
 		return ChangeQuestStatusResult{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
 	}
+	q.SetActor(cmd.UserID)
+	if cmd.Position != nil {
+		q.ReportPosition(*cmd.Position)
+	}
 
-	// Use domain logic for status change - domain validation error → 400
-	if err := q.ChangeStatus(cmd.Status); err != nil {
+	// Use domain logic for status change: participants start the quest, the creator
+	// completes it directly; review goes through completion evidence - domain validation error → 400
+	switch cmd.Status {
+	case quest.StatusInProgress:
+		err = q.Start(cmd.UserID)
+	case quest.StatusCompleted:
+		err = q.Complete(cmd.UserID)
+	case quest.StatusPendingReview:
+		err = errors.New("quest goes to review when participants submit completion evidence")
+	default:
+		err = q.ChangeStatus(cmd.Status)
+	}
+	if errors.Is(err, quest.ErrNotParticipant) {
+		_ = h.unitOfWork.Rollback()
+		return ChangeQuestStatusResult{}, errs.NewForbiddenError("change quest status", err.Error())
+	}
+	if err != nil {
 		_ = h.unitOfWork.Rollback()
 		return ChangeQuestStatusResult{}, errs.NewDomainValidationErrorWithCause("status", "invalid status transition", err)
 	}
 
+	// On-site quests are started at the target location → 400. Only the creator completes
+	// here and may sign off remotely; participants are checked when they submit evidence.
+	if cmd.Status == quest.StatusInProgress {
+		if err := checkGeofence(q, q.TargetLocation, cmd.Position, "target"); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ChangeQuestStatusResult{}, err
+		}
+	}
+
 	// Save quest - infrastructure error → 500
 	if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
 		_ = h.unitOfWork.Rollback()
@@ -79,5 +115,26 @@ func (h *changeQuestStatusHandler) Handle(ctx context.Context, cmd ChangeQuestSt
 		ID:       q.ID(),
 		Assignee: q.Assignee, // Now both are *uuid.UUID
 		Status:   string(q.Status),
+
+		Participants:     q.Participants,
+		CompletionQuorum: q.CompletionQuorum,
 	}, nil
 }
+
+// checkGeofence returns a validation error unless the position lies within the geofence
+// radius of the quest around the location. Quests without a geofence accept any position.
+func checkGeofence(q quest.Quest, location kernel.GeoCoordinate, position *kernel.GeoCoordinate, locationName string) error {
+	if !q.HasGeofence() {
+		return nil
+	}
+	if position == nil {
+		return errs.NewDomainValidationError("position", fmt.Sprintf("is required, the quest has a geofence of %d meters", q.GeofenceRadius))
+	}
+
+	distance := position.DistanceTo(location) * 1000 // kilometers to meters
+	if distance > float64(q.GeofenceRadius) {
+		return errs.NewDomainValidationError("position",
+			fmt.Sprintf("must be within %d meters of the %s location, is %.0f meters away", q.GeofenceRadius, locationName, distance))
+	}
+	return nil
+}
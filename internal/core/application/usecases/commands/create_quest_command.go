@@ -10,11 +10,19 @@ type CreateQuestCommand struct {
 	Difficulty        string // Changed to string, validation in domain
 	Reward            int
 	DurationMinutes   int
-	TargetLocation    kernel.GeoCoordinate
+	TargetLocation    *kernel.GeoCoordinate // nil: geocoded from TargetAddress
+	TargetName        *string
 	TargetAddress     *string
-	ExecutionLocation kernel.GeoCoordinate
+	ExecutionLocation *kernel.GeoCoordinate // nil: geocoded from ExecutionAddress
+	ExecutionName     *string
 	ExecutionAddress  *string
+	Waypoints         []kernel.GeoCoordinate // intermediate route stops, in travel order
 	Equipment         []string
 	Skills            []string
+	EligibilityPolicy string // "strict", "warn" or "off"; empty means "off"
+	AssignmentMode    string // "first_come" or "review"; empty means "first_come"
+	Capacity          int    // number of participants; zero means one
+	CompletionQuorum  int    // participants confirming completion; zero means a majority of the capacity
+	GeofenceRadius    int    // meters around target and execution location; zero means no geofence
 	Creator           string
 }
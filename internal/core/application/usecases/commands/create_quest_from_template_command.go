@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+)
+
+// CreateQuestFromTemplateCommand represents the input for creating a quest from a template of the user.
+type CreateQuestFromTemplateCommand struct {
+	TemplateID uuid.UUID
+	UserID     uuid.UUID
+	Overrides  QuestOverrides
+}
+
+// QuestOverrides replace fields of the template quest; nil fields keep the template value.
+type QuestOverrides struct {
+	Title             *string
+	Description       *string
+	Difficulty        *string
+	Reward            *int
+	DurationMinutes   *int
+	TargetLocation    *LocationOverride
+	ExecutionLocation *LocationOverride
+	Waypoints         *[]kernel.GeoCoordinate
+	Equipment         *[]string
+	Skills            *[]string
+	EligibilityPolicy *string
+	AssignmentMode    *string
+	Capacity          *int
+	CompletionQuorum  *int
+	GeofenceRadius    *int
+}
+
+// LocationOverride replaces a template location as a whole.
+type LocationOverride struct {
+	Coordinate *kernel.GeoCoordinate // nil: geocoded from Address
+	Name       *string
+	Address    *string
+}
@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// CreateQuestFromTemplateCommandHandler defines the interface for handling CreateQuestFromTemplateCommand.
+type CreateQuestFromTemplateCommandHandler interface {
+	Handle(ctx context.Context, cmd CreateQuestFromTemplateCommand) (quest.Quest, error)
+}
+
+var _ CreateQuestFromTemplateCommandHandler = &createQuestFromTemplateHandler{}
+
+type createQuestFromTemplateHandler struct {
+	unitOfWork         ports.UnitOfWork
+	createQuestHandler CreateQuestCommandHandler
+}
+
+// NewCreateQuestFromTemplateCommandHandler creates a new instance of CreateQuestFromTemplateCommandHandler.
+// Quests are created by createQuestHandler, so they are geocoded and published like any other quest.
+func NewCreateQuestFromTemplateCommandHandler(unitOfWork ports.UnitOfWork, createQuestHandler CreateQuestCommandHandler) CreateQuestFromTemplateCommandHandler {
+	return &createQuestFromTemplateHandler{
+		unitOfWork:         unitOfWork,
+		createQuestHandler: createQuestHandler,
+	}
+}
+
+// Handle creates a quest of the template owner from the template with the overrides applied.
+func (h *createQuestFromTemplateHandler) Handle(ctx context.Context, cmd CreateQuestFromTemplateCommand) (quest.Quest, error) {
+	t, err := getOwnQuestTemplate(ctx, h.unitOfWork, cmd.TemplateID, cmd.UserID)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+
+	return h.createQuestHandler.Handle(ctx, createQuestCommandFromSpec(cmd.Overrides.apply(t.Quest), t.OwnerID))
+}
+
+// apply returns the spec with the overridden fields replaced.
+func (o QuestOverrides) apply(spec template.Spec) template.Spec {
+	if o.Title != nil {
+		spec.Title = *o.Title
+	}
+	if o.Description != nil {
+		spec.Description = *o.Description
+	}
+	if o.Difficulty != nil {
+		spec.Difficulty = *o.Difficulty
+	}
+	if o.Reward != nil {
+		spec.Reward = *o.Reward
+	}
+	if o.DurationMinutes != nil {
+		spec.DurationMinutes = *o.DurationMinutes
+	}
+	if o.TargetLocation != nil {
+		spec.TargetLocation = o.TargetLocation.Coordinate
+		spec.TargetName = o.TargetLocation.Name
+		spec.TargetAddress = o.TargetLocation.Address
+	}
+	if o.ExecutionLocation != nil {
+		spec.ExecutionLocation = o.ExecutionLocation.Coordinate
+		spec.ExecutionName = o.ExecutionLocation.Name
+		spec.ExecutionAddress = o.ExecutionLocation.Address
+	}
+	if o.Waypoints != nil {
+		spec.Waypoints = *o.Waypoints
+	}
+	if o.Equipment != nil {
+		spec.Equipment = *o.Equipment
+	}
+	if o.Skills != nil {
+		spec.Skills = *o.Skills
+	}
+	if o.EligibilityPolicy != nil {
+		spec.EligibilityPolicy = *o.EligibilityPolicy
+	}
+	if o.AssignmentMode != nil {
+		spec.AssignmentMode = *o.AssignmentMode
+	}
+	if o.Capacity != nil {
+		spec.Capacity = *o.Capacity
+	}
+	if o.CompletionQuorum != nil {
+		spec.CompletionQuorum = *o.CompletionQuorum
+	}
+	if o.GeofenceRadius != nil {
+		spec.GeofenceRadius = *o.GeofenceRadius
+	}
+	return spec
+}
+
+// createQuestCommandFromSpec builds the command creating the quest of a template for its owner.
+func createQuestCommandFromSpec(spec template.Spec, ownerID uuid.UUID) CreateQuestCommand {
+	equipment := spec.Equipment
+	if equipment == nil {
+		equipment = []string{}
+	}
+	skills := spec.Skills
+	if skills == nil {
+		skills = []string{}
+	}
+
+	return CreateQuestCommand{
+		Title:             spec.Title,
+		Description:       spec.Description,
+		Difficulty:        spec.Difficulty,
+		Reward:            spec.Reward,
+		DurationMinutes:   spec.DurationMinutes,
+		TargetLocation:    spec.TargetLocation,
+		TargetName:        spec.TargetName,
+		TargetAddress:     spec.TargetAddress,
+		ExecutionLocation: spec.ExecutionLocation,
+		ExecutionName:     spec.ExecutionName,
+		ExecutionAddress:  spec.ExecutionAddress,
+		Waypoints:         spec.Waypoints,
+		Equipment:         equipment,
+		Skills:            skills,
+		EligibilityPolicy: spec.EligibilityPolicy,
+		AssignmentMode:    spec.AssignmentMode,
+		Capacity:          spec.Capacity,
+		CompletionQuorum:  spec.CompletionQuorum,
+		GeofenceRadius:    spec.GeofenceRadius,
+		Creator:           ownerID.String(),
+	}
+}
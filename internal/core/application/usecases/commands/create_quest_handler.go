@@ -2,7 +2,11 @@ package commands
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"strings"
 
+	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/location"
 	"quest-manager/internal/core/domain/model/quest"
 	"quest-manager/internal/core/ports"
@@ -21,33 +25,53 @@ var _ CreateQuestCommandHandler = &createQuestHandler{}
 type createQuestHandler struct {
 	unitOfWork     ports.UnitOfWork
 	eventPublisher ports.EventPublisher
+	geocoder       ports.Geocoder
 }
 
 // NewCreateQuestCommandHandler creates a new instance of CreateQuestCommandHandler.
-func NewCreateQuestCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) CreateQuestCommandHandler {
+// The geocoder completes locations given only by coordinates or only by address.
+func NewCreateQuestCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher, geocoder ports.Geocoder) CreateQuestCommandHandler {
 	return &createQuestHandler{
 		unitOfWork:     unitOfWork,
 		eventPublisher: eventPublisher,
+		geocoder:       geocoder,
 	}
 }
 
 func (h *createQuestHandler) Handle(ctx context.Context, cmd CreateQuestCommand) (quest.Quest, error) {
+	return h.handle(ctx, cmd, nil)
+}
+
+// handle creates the quest. beforeCommit, if set, runs in the quest creation transaction
+// once the quest is saved; its error rolls the quest back and is returned as is.
+func (h *createQuestHandler) handle(ctx context.Context, cmd CreateQuestCommand, beforeCommit func(q quest.Quest) error) (quest.Quest, error) {
 	var targetLocationID *uuid.UUID
 	var executionLocationID *uuid.UUID
 
+	// Complete locations before the transaction, geocoding may call an external service
+	targetCoordinate, targetAddress, err := h.resolveLocation(ctx, "target_location", cmd.TargetLocation, cmd.TargetAddress)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+	executionCoordinate, executionAddress, err := h.resolveLocation(ctx, "execution_location", cmd.ExecutionLocation, cmd.ExecutionAddress)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+
 	// Begin transaction
 	if err := h.unitOfWork.Begin(ctx); err != nil {
 		return quest.Quest{}, errs.WrapInfrastructureError("failed to begin quest creation transaction", err)
 	}
 
 	// Create or find target location
-	targetLoc, err := location.NewLocation(
-		cmd.TargetLocation,
-		cmd.TargetAddress,
+	targetLoc, err := location.NewNamedLocation(
+		targetCoordinate,
+		cmd.TargetName,
+		targetAddress,
 	)
 	if err != nil {
 		_ = h.unitOfWork.Rollback()
-		return quest.Quest{}, errs.WrapInfrastructureError("failed to create target location", err)
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("target_location", "invalid location data", err)
 	}
 
 	// Save target location
@@ -61,17 +85,18 @@ func (h *createQuestHandler) Handle(ctx context.Context, cmd CreateQuestCommand)
 
 	// Create or find execution location (can be the same as target)
 	var executionLoc *location.Location
-	if cmd.TargetLocation.Equals(cmd.ExecutionLocation) {
+	if targetCoordinate.Equals(executionCoordinate) {
 		executionLoc = targetLoc
 		executionLocationID = targetLocationID
 	} else {
-		executionLoc, err = location.NewLocation(
-			cmd.ExecutionLocation,
-			cmd.ExecutionAddress,
+		executionLoc, err = location.NewNamedLocation(
+			executionCoordinate,
+			cmd.ExecutionName,
+			executionAddress,
 		)
 		if err != nil {
 			_ = h.unitOfWork.Rollback()
-			return quest.Quest{}, errs.WrapInfrastructureError("failed to create execution location", err)
+			return quest.Quest{}, errs.NewDomainValidationErrorWithCause("execution_location", "invalid location data", err)
 		}
 
 		// Save execution location
@@ -91,8 +116,8 @@ func (h *createQuestHandler) Handle(ctx context.Context, cmd CreateQuestCommand)
 		cmd.Difficulty,
 		cmd.Reward,
 		cmd.DurationMinutes,
-		cmd.TargetLocation,
-		cmd.ExecutionLocation,
+		targetCoordinate,
+		executionCoordinate,
 		cmd.Creator,
 		cmd.Equipment,
 		cmd.Skills,
@@ -102,6 +127,31 @@ func (h *createQuestHandler) Handle(ctx context.Context, cmd CreateQuestCommand)
 		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("quest", "invalid quest data", err)
 	}
 
+	if err := q.SetWaypoints(cmd.Waypoints); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("waypoints", "invalid route", err)
+	}
+
+	if err := q.SetEligibilityPolicy(cmd.EligibilityPolicy); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("eligibility_policy", "invalid eligibility policy", err)
+	}
+
+	if err := q.SetAssignmentMode(cmd.AssignmentMode); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("assignment_mode", "invalid assignment mode", err)
+	}
+
+	if err := q.SetCapacity(cmd.Capacity, cmd.CompletionQuorum); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("capacity", "invalid capacity", err)
+	}
+
+	if err := q.SetGeofenceRadius(cmd.GeofenceRadius); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("geofence_radius", "invalid geofence radius", err)
+	}
+
 	// Link quest with created locations
 	q.TargetLocationID = targetLocationID
 	q.ExecutionLocationID = executionLocationID
@@ -113,6 +163,13 @@ func (h *createQuestHandler) Handle(ctx context.Context, cmd CreateQuestCommand)
 		return quest.Quest{}, errs.WrapInfrastructureError("failed to save quest", err)
 	}
 
+	if beforeCommit != nil {
+		if err := beforeCommit(q); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return quest.Quest{}, err
+		}
+	}
+
 	// Commit transaction
 	err = h.unitOfWork.Commit(ctx)
 	if err != nil {
@@ -128,3 +185,77 @@ func (h *createQuestHandler) Handle(ctx context.Context, cmd CreateQuestCommand)
 
 	return q, nil
 }
+
+// resolveLocation completes a location given by coordinates, by address, or both.
+// A missing address is looked up by reverse geocoding on a best-effort basis;
+// missing coordinates are required, so a failed forward lookup is an error.
+func (h *createQuestHandler) resolveLocation(
+	ctx context.Context,
+	field string,
+	coordinate *kernel.GeoCoordinate,
+	address *string,
+) (kernel.GeoCoordinate, *string, error) {
+	hasAddress := address != nil && strings.TrimSpace(*address) != ""
+
+	switch {
+	case coordinate != nil && hasAddress:
+		return *coordinate, address, nil
+	case coordinate != nil:
+		return *coordinate, h.reverseGeocode(ctx, *coordinate), nil
+	case hasAddress:
+		resolved, err := h.geocode(ctx, *address)
+		if errors.Is(err, ports.ErrGeocodeNotFound) {
+			return kernel.GeoCoordinate{}, nil, errs.NewDomainValidationErrorWithCause(field, "address could not be geocoded", err)
+		}
+		if err != nil {
+			return kernel.GeoCoordinate{}, nil, errs.WrapInfrastructureError("failed to geocode "+field+" address", err)
+		}
+		return resolved, address, nil
+	default:
+		return kernel.GeoCoordinate{}, nil, errs.NewDomainValidationError(field, "coordinates or address is required")
+	}
+}
+
+// geocode resolves an address to coordinates. Stored locations act as a cache:
+// the provider is only asked when no location with the same address exists.
+func (h *createQuestHandler) geocode(ctx context.Context, address string) (kernel.GeoCoordinate, error) {
+	cached, err := h.unitOfWork.LocationRepository().FindByAddress(ctx, address)
+	if err != nil {
+		return kernel.GeoCoordinate{}, err
+	}
+	if len(cached) > 0 {
+		return cached[0].Coordinate, nil
+	}
+
+	result, err := h.geocoder.Geocode(ctx, address)
+	if err != nil {
+		return kernel.GeoCoordinate{}, err
+	}
+	return result.Coordinate, nil
+}
+
+// reverseGeocode looks up the address of a coordinate, first among stored locations,
+// then with the provider. Returns nil when no address is known; the quest is created anyway.
+func (h *createQuestHandler) reverseGeocode(ctx context.Context, coordinate kernel.GeoCoordinate) *string {
+	cached, err := h.unitOfWork.LocationRepository().FindByCoordinate(ctx, coordinate)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to look up cached address", slog.Any("error", err))
+	}
+	for _, l := range cached {
+		if l.Address != nil {
+			return l.Address
+		}
+	}
+
+	result, err := h.geocoder.ReverseGeocode(ctx, coordinate)
+	if err != nil {
+		if !errors.Is(err, ports.ErrGeocodeNotFound) {
+			slog.WarnContext(ctx, "reverse geocoding failed", slog.Any("error", err))
+		}
+		return nil
+	}
+	if result.Address == "" {
+		return nil
+	}
+	return &result.Address
+}
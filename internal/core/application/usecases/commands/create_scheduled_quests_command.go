@@ -0,0 +1,9 @@
+package commands
+
+import "time"
+
+// CreateScheduledQuestsCommand represents the input for creating the quests of due template occurrences.
+type CreateScheduledQuestsCommand struct {
+	Now   time.Time
+	Limit int // templates handled per run; zero means DefaultScheduledTemplatesPerRun
+}
@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// DefaultScheduledTemplatesPerRun limits the templates one scheduler run handles
+const DefaultScheduledTemplatesPerRun = 100
+
+// CreateScheduledQuestsCommandHandler defines the interface for handling CreateScheduledQuestsCommand.
+type CreateScheduledQuestsCommandHandler interface {
+	// Handle returns the quests created for due occurrences.
+	Handle(ctx context.Context, cmd CreateScheduledQuestsCommand) ([]quest.Quest, error)
+}
+
+var _ CreateScheduledQuestsCommandHandler = &createScheduledQuestsHandler{}
+
+type createScheduledQuestsHandler struct {
+	unitOfWork         ports.UnitOfWork
+	createQuestHandler *createQuestHandler
+}
+
+// NewCreateScheduledQuestsCommandHandler creates a new instance of CreateScheduledQuestsCommandHandler.
+// Quests are created and published like any other quest, in the transaction of unitOfWork
+// that also records them on their occurrences.
+func NewCreateScheduledQuestsCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher, geocoder ports.Geocoder) CreateScheduledQuestsCommandHandler {
+	return &createScheduledQuestsHandler{
+		unitOfWork: unitOfWork,
+		createQuestHandler: &createQuestHandler{
+			unitOfWork:     unitOfWork,
+			eventPublisher: eventPublisher,
+			geocoder:       geocoder,
+		},
+	}
+}
+
+// Handle creates a quest for every occurrence due at cmd.Now, including occurrences
+// missed while no scheduler ran. Each occurrence is claimed before its quest is
+// created, so concurrent or repeated runs do not create its quest twice. A quest
+// that cannot be created is logged, and a run after OccurrenceClaimTimeout retries it.
+func (h *createScheduledQuestsHandler) Handle(ctx context.Context, cmd CreateScheduledQuestsCommand) ([]quest.Quest, error) {
+	limit := cmd.Limit
+	if limit <= 0 {
+		limit = DefaultScheduledTemplatesPerRun
+	}
+
+	created := make([]quest.Quest, 0)
+
+	retryable, err := h.claimRetryableOccurrences(ctx, cmd.Now, limit)
+	if err != nil {
+		return created, err
+	}
+	for _, occurrence := range retryable {
+		t, err := h.unitOfWork.QuestTemplateRepository().GetByID(ctx, occurrence.TemplateID)
+		if err != nil {
+			if errors.Is(err, ports.ErrQuestTemplateNotFound) {
+				// Deleted together with its occurrences since it was claimed
+				continue
+			}
+			return created, errs.WrapInfrastructureError("failed to get quest template", err)
+		}
+		q, ok, err := h.createQuest(ctx, t, occurrence)
+		if err != nil {
+			return created, err
+		}
+		if ok {
+			created = append(created, q)
+		}
+	}
+
+	due, err := h.unitOfWork.QuestTemplateRepository().FindDue(ctx, cmd.Now, limit)
+	if err != nil {
+		return created, errs.WrapInfrastructureError("failed to find due quest templates", err)
+	}
+
+	for _, t := range due {
+		for {
+			occurrence, current, ok, err := h.claimOccurrence(ctx, t.ID(), cmd.Now)
+			if err != nil {
+				return created, err
+			}
+			if !ok {
+				break
+			}
+			if occurrence == nil {
+				// Another run has claimed the occurrence
+				continue
+			}
+
+			q, ok, err := h.createQuest(ctx, current, *occurrence)
+			if err != nil {
+				return created, err
+			}
+			if ok {
+				created = append(created, q)
+			}
+		}
+	}
+	return created, nil
+}
+
+// createQuest creates the quest of the claimed occurrence and records it on the occurrence
+// in the same transaction, so an occurrence never gets a second quest when it is retried.
+// ok is false if the quest could not be created; the occurrence is then left to be retried.
+func (h *createScheduledQuestsHandler) createQuest(ctx context.Context, t *template.QuestTemplate, occurrence template.Occurrence) (q quest.Quest, ok bool, err error) {
+	var saveErr error
+	q, err = h.createQuestHandler.handle(ctx, createQuestCommandFromSpec(t.Quest, t.OwnerID), func(q quest.Quest) error {
+		questID := q.ID()
+		occurrence.QuestID = &questID
+		saveErr = h.unitOfWork.QuestTemplateRepository().SaveOccurrence(ctx, occurrence)
+		return saveErr
+	})
+	if saveErr != nil {
+		return quest.Quest{}, false, errs.WrapInfrastructureError("failed to save quest template occurrence", saveErr)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "failed to create scheduled quest",
+			slog.String("template_id", t.ID().String()),
+			slog.Time("occurrence", occurrence.At),
+			slog.String("error", err.Error()))
+		return quest.Quest{}, false, nil
+	}
+	return q, true, nil
+}
+
+// claimRetryableOccurrences claims up to limit occurrences whose quest an earlier run
+// did not create, so that no other run retries them before OccurrenceClaimTimeout.
+func (h *createScheduledQuestsHandler) claimRetryableOccurrences(ctx context.Context, now time.Time, limit int) ([]template.Occurrence, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to begin quest template transaction", err)
+	}
+
+	occurrences, err := h.unitOfWork.QuestTemplateRepository().FindRetryableOccurrences(ctx, now, limit)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, errs.WrapInfrastructureError("failed to find retryable quest template occurrences", err)
+	}
+
+	for i := range occurrences {
+		occurrences[i].ClaimedAt = now
+		if err := h.unitOfWork.QuestTemplateRepository().SaveOccurrence(ctx, occurrences[i]); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return nil, errs.WrapInfrastructureError("failed to save quest template occurrence", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to commit quest template transaction", err)
+	}
+	return occurrences, nil
+}
+
+// claimOccurrence records the due occurrence of the template and moves its schedule on.
+// ok is false if no occurrence is due; occurrence is nil if it was recorded before.
+func (h *createScheduledQuestsHandler) claimOccurrence(ctx context.Context, templateID uuid.UUID, now time.Time) (occurrence *template.Occurrence, t *template.QuestTemplate, ok bool, err error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return nil, nil, false, errs.WrapInfrastructureError("failed to begin quest template transaction", err)
+	}
+
+	t, err = h.unitOfWork.QuestTemplateRepository().GetByID(ctx, templateID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		if errors.Is(err, ports.ErrQuestTemplateNotFound) {
+			// Deleted since it was found due
+			return nil, nil, false, nil
+		}
+		return nil, nil, false, errs.WrapInfrastructureError("failed to get quest template", err)
+	}
+
+	at, due := t.DueOccurrence(now)
+	if !due {
+		_ = h.unitOfWork.Rollback()
+		return nil, t, false, nil
+	}
+
+	o := template.Occurrence{TemplateID: t.ID(), At: at, CreatedAt: now, ClaimedAt: now}
+	added, err := h.unitOfWork.QuestTemplateRepository().AddOccurrence(ctx, o)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, nil, false, errs.WrapInfrastructureError("failed to add quest template occurrence", err)
+	}
+
+	t.AdvanceOccurrence(now)
+	if err := h.unitOfWork.QuestTemplateRepository().Save(ctx, t); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, nil, false, errs.WrapInfrastructureError("failed to save quest template", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return nil, nil, false, errs.WrapInfrastructureError("failed to commit quest template transaction", err)
+	}
+
+	if !added {
+		return nil, t, true, nil
+	}
+	return &o, t, true, nil
+}
@@ -0,0 +1,13 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// EditQuestCommentCommand represents the input for editing a comment of a quest.
+type EditQuestCommentCommand struct {
+	QuestID   uuid.UUID
+	CommentID uuid.UUID
+	UserID    uuid.UUID
+	Body      string
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// EditQuestCommentCommandHandler defines the interface for handling EditQuestCommentCommand.
+type EditQuestCommentCommandHandler interface {
+	Handle(ctx context.Context, cmd EditQuestCommentCommand) (quest.Comment, error)
+}
+
+var _ EditQuestCommentCommandHandler = &editQuestCommentHandler{}
+
+type editQuestCommentHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewEditQuestCommentCommandHandler creates a new instance of EditQuestCommentCommandHandler.
+func NewEditQuestCommentCommandHandler(unitOfWork ports.UnitOfWork) EditQuestCommentCommandHandler {
+	return &editQuestCommentHandler{unitOfWork: unitOfWork}
+}
+
+// Handle replaces the body of a comment on behalf of its author.
+func (h *editQuestCommentHandler) Handle(ctx context.Context, cmd EditQuestCommentCommand) (quest.Comment, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to begin comment transaction", err)
+	}
+
+	// Get quest - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+
+	// Comments of other quests and private comments hidden from the user → 404
+	comment, err := h.unitOfWork.CommentRepository().GetByID(ctx, cmd.CommentID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		if errors.Is(err, ports.ErrCommentNotFound) {
+			return quest.Comment{}, errs.NewNotFoundErrorWithCause("comment", cmd.CommentID.String(), err)
+		}
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to get comment", err)
+	}
+	if comment.QuestID != q.ID() || !q.CanSeeComment(cmd.UserID, comment) {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewNotFoundError("comment", cmd.CommentID.String())
+	}
+
+	// Only the author edits → 403
+	if comment.AuthorID != cmd.UserID {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewForbiddenError("edit comment", "only the author can edit a comment")
+	}
+
+	// Use domain logic - business rules errors → 400
+	if err := q.EditComment(&comment, cmd.UserID, cmd.Body, time.Now()); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.NewDomainValidationErrorWithCause("comment", "failed to edit comment", err)
+	}
+
+	if err := h.unitOfWork.CommentRepository().Save(ctx, comment); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to save comment", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return quest.Comment{}, errs.WrapInfrastructureError("failed to commit comment transaction", err)
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// checkEligibility applies the quest's eligibility policy to the user's profile.
+// Users without a profile have no skills or equipment.
+// Returns the unmet requirements as warnings for the "warn" policy and as a
+// DomainValidationError with violations for the "strict" policy.
+func checkEligibility(ctx context.Context, users ports.UserRepository, q quest.Quest, userID uuid.UUID) ([]errs.Violation, error) {
+	if q.EligibilityPolicy == "" || q.EligibilityPolicy == quest.EligibilityOff {
+		return nil, nil
+	}
+
+	var capabilities quest.Capabilities
+	u, err := users.GetByID(ctx, userID)
+	switch {
+	case errors.Is(err, ports.ErrUserNotFound):
+	case err != nil:
+		return nil, errs.WrapInfrastructureError("failed to get user profile", err)
+	default:
+		capabilities = quest.Capabilities{
+			Skills:        u.Skills,
+			Equipment:     u.Equipment,
+			Position:      u.HomeLocation,
+			MaxDistanceKm: u.MaxTravelRadiusKm,
+		}
+	}
+
+	eligibility := q.CheckEligibility(capabilities)
+	if eligibility.Eligible() {
+		return nil, nil
+	}
+
+	violations := eligibilityViolations(eligibility)
+	if q.EligibilityPolicy == quest.EligibilityStrict {
+		return nil, errs.NewDomainValidationErrorWithViolations("eligibility", "user does not meet the quest requirements", violations)
+	}
+	return violations, nil
+}
+
+// eligibilityViolations lists the unmet requirements, one violation per field
+func eligibilityViolations(e quest.Eligibility) []errs.Violation {
+	var violations []errs.Violation
+	if len(e.MissingSkills) > 0 {
+		violations = append(violations, errs.Violation{
+			Field:   "skills",
+			Message: "missing required skills",
+			Values:  e.MissingSkills,
+		})
+	}
+	if len(e.MissingEquipment) > 0 {
+		violations = append(violations, errs.Violation{
+			Field:   "equipment",
+			Message: "missing required equipment",
+			Values:  e.MissingEquipment,
+		})
+	}
+	if e.TooFar() {
+		violations = append(violations, errs.Violation{
+			Field: "execution_location",
+			Message: fmt.Sprintf("execution location is %.1f km from home, beyond the max travel radius of %.1f km",
+				*e.DistanceKm, *e.MaxDistanceKm),
+		})
+	}
+	return violations
+}
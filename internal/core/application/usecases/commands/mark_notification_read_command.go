@@ -0,0 +1,16 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// MarkNotificationReadCommand represents the input for marking a notification of a user read.
+type MarkNotificationReadCommand struct {
+	NotificationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+// MarkAllNotificationsReadCommand represents the input for marking every notification of a user read.
+type MarkAllNotificationsReadCommand struct {
+	UserID uuid.UUID
+}
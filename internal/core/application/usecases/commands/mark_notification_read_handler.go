@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// MarkNotificationReadCommandHandler defines the interface for handling MarkNotificationReadCommand.
+type MarkNotificationReadCommandHandler interface {
+	Handle(ctx context.Context, cmd MarkNotificationReadCommand) (notification.Notification, error)
+}
+
+// MarkAllNotificationsReadCommandHandler defines the interface for handling MarkAllNotificationsReadCommand.
+type MarkAllNotificationsReadCommandHandler interface {
+	// Handle returns the number of notifications marked read.
+	Handle(ctx context.Context, cmd MarkAllNotificationsReadCommand) (int, error)
+}
+
+var _ MarkNotificationReadCommandHandler = &markNotificationReadHandler{}
+var _ MarkAllNotificationsReadCommandHandler = &markAllNotificationsReadHandler{}
+
+type markNotificationReadHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewMarkNotificationReadCommandHandler creates a new instance of MarkNotificationReadCommandHandler.
+func NewMarkNotificationReadCommandHandler(unitOfWork ports.UnitOfWork) MarkNotificationReadCommandHandler {
+	return &markNotificationReadHandler{unitOfWork: unitOfWork}
+}
+
+// Handle marks the notification read. Marking a read notification again changes nothing.
+func (h *markNotificationReadHandler) Handle(ctx context.Context, cmd MarkNotificationReadCommand) (notification.Notification, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return notification.Notification{}, errs.WrapInfrastructureError("failed to begin notification transaction", err)
+	}
+
+	// Notifications of other users are not found → 404
+	n, err := h.unitOfWork.NotificationRepository().GetByID(ctx, cmd.NotificationID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		if errors.Is(err, ports.ErrNotificationNotFound) {
+			return notification.Notification{}, errs.NewNotFoundErrorWithCause("notification", cmd.NotificationID.String(), err)
+		}
+		return notification.Notification{}, errs.WrapInfrastructureError("failed to get notification", err)
+	}
+	if n.UserID != cmd.UserID {
+		_ = h.unitOfWork.Rollback()
+		return notification.Notification{}, errs.NewNotFoundError("notification", cmd.NotificationID.String())
+	}
+
+	n.MarkRead(time.Now())
+
+	if err := h.unitOfWork.NotificationRepository().Save(ctx, n); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return notification.Notification{}, errs.WrapInfrastructureError("failed to save notification", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return notification.Notification{}, errs.WrapInfrastructureError("failed to commit notification transaction", err)
+	}
+
+	return n, nil
+}
+
+type markAllNotificationsReadHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewMarkAllNotificationsReadCommandHandler creates a new instance of MarkAllNotificationsReadCommandHandler.
+func NewMarkAllNotificationsReadCommandHandler(unitOfWork ports.UnitOfWork) MarkAllNotificationsReadCommandHandler {
+	return &markAllNotificationsReadHandler{unitOfWork: unitOfWork}
+}
+
+// Handle marks every unread notification of the user read.
+func (h *markAllNotificationsReadHandler) Handle(ctx context.Context, cmd MarkAllNotificationsReadCommand) (int, error) {
+	marked, err := h.unitOfWork.NotificationRepository().MarkAllRead(ctx, cmd.UserID, time.Now())
+	if err != nil {
+		return 0, errs.WrapInfrastructureError("failed to mark notifications read", err)
+	}
+	return marked, nil
+}
@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// AddQuestPrerequisiteCommand represents the input for making a quest depend on another quest.
+type AddQuestPrerequisiteCommand struct {
+	QuestID        uuid.UUID
+	PrerequisiteID uuid.UUID
+	UserID         uuid.UUID
+}
+
+// RemoveQuestPrerequisiteCommand represents the input for dropping a prerequisite of a quest.
+type RemoveQuestPrerequisiteCommand struct {
+	QuestID        uuid.UUID
+	PrerequisiteID uuid.UUID
+	UserID         uuid.UUID
+}
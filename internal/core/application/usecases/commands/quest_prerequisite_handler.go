@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// AddQuestPrerequisiteCommandHandler defines the interface for handling AddQuestPrerequisiteCommand.
+type AddQuestPrerequisiteCommandHandler interface {
+	Handle(ctx context.Context, cmd AddQuestPrerequisiteCommand) (quest.Quest, error)
+}
+
+// RemoveQuestPrerequisiteCommandHandler defines the interface for handling RemoveQuestPrerequisiteCommand.
+type RemoveQuestPrerequisiteCommandHandler interface {
+	Handle(ctx context.Context, cmd RemoveQuestPrerequisiteCommand) (quest.Quest, error)
+}
+
+var (
+	_ AddQuestPrerequisiteCommandHandler    = &addQuestPrerequisiteHandler{}
+	_ RemoveQuestPrerequisiteCommandHandler = &removeQuestPrerequisiteHandler{}
+)
+
+type addQuestPrerequisiteHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewAddQuestPrerequisiteCommandHandler creates a new instance of AddQuestPrerequisiteCommandHandler.
+func NewAddQuestPrerequisiteCommandHandler(unitOfWork ports.UnitOfWork) AddQuestPrerequisiteCommandHandler {
+	return &addQuestPrerequisiteHandler{unitOfWork: unitOfWork}
+}
+
+// Handle makes the quest of the creator depend on another quest, unless the other quest
+// already depends on it directly or through its own prerequisites.
+func (h *addQuestPrerequisiteHandler) Handle(ctx context.Context, cmd AddQuestPrerequisiteCommand) (quest.Quest, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to begin quest prerequisite transaction", err)
+	}
+
+	// Wait for concurrent changes of linked quests, so the cycle check sees their links
+	if err := h.unitOfWork.QuestRepository().LockLinkedByPrerequisites(ctx, cmd.QuestID, cmd.PrerequisiteID); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to lock quest dependencies", err)
+	}
+
+	q, err := getOwnQuestForPrerequisites(ctx, h.unitOfWork, cmd.QuestID, cmd.UserID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, err
+	}
+
+	// Get prerequisite quest - if not found → 404
+	prerequisite, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.PrerequisiteID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewNotFoundErrorWithCause("quest", cmd.PrerequisiteID.String(), err)
+	}
+
+	// Every quest the prerequisite depends on is linked to it
+	linked, err := h.unitOfWork.QuestRepository().FindLinkedByPrerequisites(ctx, prerequisite.ID())
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to get quest dependencies", err)
+	}
+
+	// Use domain logic - business rules errors, cycles included → 400
+	if err := q.AddPrerequisite(prerequisite, quest.NewDependencyGraph(linked)); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("prerequisite_id", "failed to add prerequisite", err)
+	}
+
+	if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to save quest", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to commit quest prerequisite transaction", err)
+	}
+	return q, nil
+}
+
+type removeQuestPrerequisiteHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewRemoveQuestPrerequisiteCommandHandler creates a new instance of RemoveQuestPrerequisiteCommandHandler.
+func NewRemoveQuestPrerequisiteCommandHandler(unitOfWork ports.UnitOfWork) RemoveQuestPrerequisiteCommandHandler {
+	return &removeQuestPrerequisiteHandler{unitOfWork: unitOfWork}
+}
+
+// Handle drops a prerequisite of the quest of the creator. The quest is not posted by it;
+// the creator posts it once nothing blocks it anymore.
+func (h *removeQuestPrerequisiteHandler) Handle(ctx context.Context, cmd RemoveQuestPrerequisiteCommand) (quest.Quest, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to begin quest prerequisite transaction", err)
+	}
+
+	// Wait for concurrent prerequisite changes, so none of them is overwritten
+	if err := h.unitOfWork.QuestRepository().LockLinkedByPrerequisites(ctx, cmd.QuestID); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to lock quest dependencies", err)
+	}
+
+	q, err := getOwnQuestForPrerequisites(ctx, h.unitOfWork, cmd.QuestID, cmd.UserID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, err
+	}
+
+	// Unknown prerequisite → 404
+	if !q.HasPrerequisite(cmd.PrerequisiteID) {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewNotFoundError("prerequisite", cmd.PrerequisiteID.String())
+	}
+
+	// Use domain logic - business rules errors → 400
+	if err := q.RemovePrerequisite(cmd.PrerequisiteID); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.NewDomainValidationErrorWithCause("prerequisite_id", "failed to remove prerequisite", err)
+	}
+
+	if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to save quest", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return quest.Quest{}, errs.WrapInfrastructureError("failed to commit quest prerequisite transaction", err)
+	}
+	return q, nil
+}
+
+// getOwnQuestForPrerequisites returns the quest if the user created it.
+// Unknown quests → 404, quests of other creators → 403.
+func getOwnQuestForPrerequisites(ctx context.Context, unitOfWork ports.UnitOfWork, questID, userID uuid.UUID) (quest.Quest, error) {
+	q, err := unitOfWork.QuestRepository().GetByID(ctx, questID)
+	if err != nil {
+		return quest.Quest{}, errs.NewNotFoundErrorWithCause("quest", questID.String(), err)
+	}
+	if q.Creator != userID.String() {
+		return quest.Quest{}, errs.NewForbiddenError("change quest prerequisites", "only the quest creator can change prerequisites")
+	}
+	return q, nil
+}
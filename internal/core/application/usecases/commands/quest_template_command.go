@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"time"
+
+	"quest-manager/internal/core/domain/model/template"
+
+	"github.com/google/uuid"
+)
+
+// CreateQuestTemplateCommand represents the input for creating a quest template of a user.
+type CreateQuestTemplateCommand struct {
+	OwnerID         uuid.UUID
+	Name            string
+	Quest           template.Spec
+	RecurrenceRule  *string    // RRULE subset, like "FREQ=WEEKLY;COUNT=10"; nil for a template without a schedule
+	RecurrenceStart *time.Time // first occurrence; nil means now
+}
+
+// UpdateQuestTemplateCommand represents the input for replacing a quest template of a user.
+type UpdateQuestTemplateCommand struct {
+	TemplateID      uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	Quest           template.Spec
+	RecurrenceRule  *string
+	RecurrenceStart *time.Time
+}
+
+// DeleteQuestTemplateCommand represents the input for deleting a quest template of a user.
+type DeleteQuestTemplateCommand struct {
+	TemplateID uuid.UUID
+	UserID     uuid.UUID
+}
@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// CreateQuestTemplateCommandHandler defines the interface for handling CreateQuestTemplateCommand.
+type CreateQuestTemplateCommandHandler interface {
+	Handle(ctx context.Context, cmd CreateQuestTemplateCommand) (*template.QuestTemplate, error)
+}
+
+// UpdateQuestTemplateCommandHandler defines the interface for handling UpdateQuestTemplateCommand.
+type UpdateQuestTemplateCommandHandler interface {
+	Handle(ctx context.Context, cmd UpdateQuestTemplateCommand) (*template.QuestTemplate, error)
+}
+
+// DeleteQuestTemplateCommandHandler defines the interface for handling DeleteQuestTemplateCommand.
+type DeleteQuestTemplateCommandHandler interface {
+	Handle(ctx context.Context, cmd DeleteQuestTemplateCommand) error
+}
+
+var _ CreateQuestTemplateCommandHandler = &createQuestTemplateHandler{}
+var _ UpdateQuestTemplateCommandHandler = &updateQuestTemplateHandler{}
+var _ DeleteQuestTemplateCommandHandler = &deleteQuestTemplateHandler{}
+
+type createQuestTemplateHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewCreateQuestTemplateCommandHandler creates a new instance of CreateQuestTemplateCommandHandler.
+func NewCreateQuestTemplateCommandHandler(unitOfWork ports.UnitOfWork) CreateQuestTemplateCommandHandler {
+	return &createQuestTemplateHandler{unitOfWork: unitOfWork}
+}
+
+// Handle creates the template. Its schedule starts with the first occurrence from now on.
+func (h *createQuestTemplateHandler) Handle(ctx context.Context, cmd CreateQuestTemplateCommand) (*template.QuestTemplate, error) {
+	now := time.Now()
+	recurrence, err := parseRecurrence(cmd.RecurrenceRule, cmd.RecurrenceStart, now)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := template.NewQuestTemplate(cmd.OwnerID, cmd.Name, cmd.Quest, recurrence, now)
+	if err != nil {
+		return nil, errs.NewDomainValidationErrorWithCause("template", "invalid quest template", err)
+	}
+
+	if err := h.unitOfWork.QuestTemplateRepository().Save(ctx, t); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to save quest template", err)
+	}
+	return t, nil
+}
+
+type updateQuestTemplateHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewUpdateQuestTemplateCommandHandler creates a new instance of UpdateQuestTemplateCommandHandler.
+func NewUpdateQuestTemplateCommandHandler(unitOfWork ports.UnitOfWork) UpdateQuestTemplateCommandHandler {
+	return &updateQuestTemplateHandler{unitOfWork: unitOfWork}
+}
+
+// Handle replaces the template of the user and restarts its schedule from now.
+func (h *updateQuestTemplateHandler) Handle(ctx context.Context, cmd UpdateQuestTemplateCommand) (*template.QuestTemplate, error) {
+	now := time.Now()
+	recurrence, err := parseRecurrence(cmd.RecurrenceRule, cmd.RecurrenceStart, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to begin quest template transaction", err)
+	}
+
+	t, err := getOwnQuestTemplate(ctx, h.unitOfWork, cmd.TemplateID, cmd.UserID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, err
+	}
+
+	if err := t.Update(cmd.Name, cmd.Quest, recurrence, now); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, errs.NewDomainValidationErrorWithCause("template", "invalid quest template", err)
+	}
+
+	if err := h.unitOfWork.QuestTemplateRepository().Save(ctx, t); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, errs.WrapInfrastructureError("failed to save quest template", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to commit quest template transaction", err)
+	}
+	return t, nil
+}
+
+type deleteQuestTemplateHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewDeleteQuestTemplateCommandHandler creates a new instance of DeleteQuestTemplateCommandHandler.
+func NewDeleteQuestTemplateCommandHandler(unitOfWork ports.UnitOfWork) DeleteQuestTemplateCommandHandler {
+	return &deleteQuestTemplateHandler{unitOfWork: unitOfWork}
+}
+
+// Handle deletes the template of the user. Quests already created from it are kept.
+func (h *deleteQuestTemplateHandler) Handle(ctx context.Context, cmd DeleteQuestTemplateCommand) error {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return errs.WrapInfrastructureError("failed to begin quest template transaction", err)
+	}
+
+	if _, err := getOwnQuestTemplate(ctx, h.unitOfWork, cmd.TemplateID, cmd.UserID); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return err
+	}
+
+	if err := h.unitOfWork.QuestTemplateRepository().Delete(ctx, cmd.TemplateID); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return errs.WrapInfrastructureError("failed to delete quest template", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return errs.WrapInfrastructureError("failed to commit quest template transaction", err)
+	}
+	return nil
+}
+
+// getOwnQuestTemplate returns the template if the user owns it.
+// Templates of other users are not found → 404.
+func getOwnQuestTemplate(ctx context.Context, unitOfWork ports.UnitOfWork, templateID, userID uuid.UUID) (*template.QuestTemplate, error) {
+	t, err := unitOfWork.QuestTemplateRepository().GetByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, ports.ErrQuestTemplateNotFound) {
+			return nil, errs.NewNotFoundErrorWithCause("quest template", templateID.String(), err)
+		}
+		return nil, errs.WrapInfrastructureError("failed to get quest template", err)
+	}
+	if !t.IsOwner(userID) {
+		return nil, errs.NewNotFoundError("quest template", templateID.String())
+	}
+	return t, nil
+}
+
+// parseRecurrence parses the optional recurrence rule of a template, starting now if no start is given.
+func parseRecurrence(rule *string, start *time.Time, now time.Time) (*template.Recurrence, error) {
+	if rule == nil {
+		return nil, nil
+	}
+	from := now
+	if start != nil {
+		from = *start
+	}
+	recurrence, err := template.ParseRecurrence(*rule, from)
+	if err != nil {
+		return nil, errs.NewDomainValidationErrorWithCause("recurrence", "invalid recurrence rule", err)
+	}
+	return &recurrence, nil
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+)
+
+// ApplicationDecision is the creator's verdict on an application.
+type ApplicationDecision string
+
+const (
+	ApplicationDecisionAccept ApplicationDecision = "accept"
+	ApplicationDecisionReject ApplicationDecision = "reject"
+)
+
+// ReviewApplicationCommand represents the creator accepting or rejecting an application.
+type ReviewApplicationCommand struct {
+	QuestID       uuid.UUID
+	ApplicationID uuid.UUID
+	UserID        uuid.UUID // the reviewer, must be the quest creator
+	Decision      ApplicationDecision
+}
+
+// ReviewApplicationResult represents the output after review.
+type ReviewApplicationResult struct {
+	Application quest.Application
+	QuestStatus quest.Status
+
+	// Unmet requirements of the accepted applicant on quests with the "warn" eligibility policy
+	EligibilityWarnings []errs.Violation
+}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// ReviewApplicationCommandHandler defines the interface for handling ReviewApplicationCommand.
+type ReviewApplicationCommandHandler interface {
+	Handle(ctx context.Context, cmd ReviewApplicationCommand) (ReviewApplicationResult, error)
+}
+
+var _ ReviewApplicationCommandHandler = &reviewApplicationHandler{}
+
+type reviewApplicationHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewReviewApplicationCommandHandler creates a new instance of ReviewApplicationCommandHandler.
+func NewReviewApplicationCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) ReviewApplicationCommandHandler {
+	return &reviewApplicationHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle accepts or rejects an application. Accepting assigns the quest to the applicant.
+func (h *reviewApplicationHandler) Handle(ctx context.Context, cmd ReviewApplicationCommand) (ReviewApplicationResult, error) {
+	if cmd.Decision != ApplicationDecisionAccept && cmd.Decision != ApplicationDecisionReject {
+		return ReviewApplicationResult{}, errs.NewDomainValidationError("decision", "must be one of 'accept', 'reject'")
+	}
+
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return ReviewApplicationResult{}, errs.WrapInfrastructureError("failed to begin application review transaction", err)
+	}
+
+	// Get quest locked until commit, participants change one transaction at a time - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByIDForUpdate(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return ReviewApplicationResult{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+
+	// Only the creator reviews applications → 403
+	if q.Creator != cmd.UserID.String() {
+		_ = h.unitOfWork.Rollback()
+		return ReviewApplicationResult{}, errs.NewForbiddenError("review application", "only the quest creator can review applications")
+	}
+
+	// Get application - unknown or belonging to another quest → 404
+	application, err := h.unitOfWork.ApplicationRepository().GetByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		if errors.Is(err, ports.ErrApplicationNotFound) {
+			return ReviewApplicationResult{}, errs.NewNotFoundErrorWithCause("application", cmd.ApplicationID.String(), err)
+		}
+		return ReviewApplicationResult{}, err
+	}
+	if application.QuestID != q.ID() {
+		_ = h.unitOfWork.Rollback()
+		return ReviewApplicationResult{}, errs.NewNotFoundError("application", cmd.ApplicationID.String())
+	}
+
+	var warnings []errs.Violation
+	q.SetActor(cmd.UserID)
+	switch cmd.Decision {
+	case ApplicationDecisionAccept:
+		// Use domain logic - business rules errors → 400
+		if err := q.AcceptApplication(&application); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ReviewApplicationResult{}, errs.NewDomainValidationErrorWithCause("application", "failed to accept application", err)
+		}
+
+		// Check the applicant against the quest requirements - strict policy violations → 400
+		warnings, err = checkEligibility(ctx, h.unitOfWork.UserRepository(), q, application.ApplicantID)
+		if err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ReviewApplicationResult{}, err
+		}
+
+		if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ReviewApplicationResult{}, errs.WrapInfrastructureError("failed to save quest", err)
+		}
+	case ApplicationDecisionReject:
+		if err := q.RejectApplication(&application); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ReviewApplicationResult{}, errs.NewDomainValidationErrorWithCause("application", "failed to reject application", err)
+		}
+	}
+
+	if err := h.unitOfWork.ApplicationRepository().Save(ctx, application); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return ReviewApplicationResult{}, errs.WrapInfrastructureError("failed to save application", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, q.GetDomainEvents()...); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ReviewApplicationResult{}, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return ReviewApplicationResult{}, errs.WrapInfrastructureError("failed to commit application review transaction", err)
+	}
+
+	q.ClearDomainEvents()
+
+	return ReviewApplicationResult{
+		Application:         application,
+		QuestStatus:         q.Status,
+		EligibilityWarnings: warnings,
+	}, nil
+}
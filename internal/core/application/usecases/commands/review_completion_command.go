@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// CompletionDecision is the creator's verdict on a quest under review.
+type CompletionDecision string
+
+const (
+	CompletionDecisionApprove CompletionDecision = "approve"
+	CompletionDecisionReject  CompletionDecision = "reject"
+)
+
+// ReviewCompletionCommand represents the creator approving or rejecting the completion of a quest.
+type ReviewCompletionCommand struct {
+	QuestID  uuid.UUID
+	UserID   uuid.UUID // the reviewer, must be the quest creator
+	Decision CompletionDecision
+	Reason   string // required when rejecting
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// ReviewCompletionCommandHandler defines the interface for handling ReviewCompletionCommand.
+type ReviewCompletionCommandHandler interface {
+	Handle(ctx context.Context, cmd ReviewCompletionCommand) (ChangeQuestStatusResult, error)
+}
+
+var _ ReviewCompletionCommandHandler = &reviewCompletionHandler{}
+
+type reviewCompletionHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewReviewCompletionCommandHandler creates a new instance of ReviewCompletionCommandHandler.
+func NewReviewCompletionCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) ReviewCompletionCommandHandler {
+	return &reviewCompletionHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle approves the completion of a quest under review (→ "completed") or rejects it
+// with a reason (→ "in_progress"). The pending evidence gets the same verdict.
+func (h *reviewCompletionHandler) Handle(ctx context.Context, cmd ReviewCompletionCommand) (ChangeQuestStatusResult, error) {
+	if cmd.Decision != CompletionDecisionApprove && cmd.Decision != CompletionDecisionReject {
+		return ChangeQuestStatusResult{}, errs.NewDomainValidationError("decision", "must be one of 'approve', 'reject'")
+	}
+
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to begin completion review transaction", err)
+	}
+
+	// Get quest locked until commit, participants change one transaction at a time - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByIDForUpdate(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return ChangeQuestStatusResult{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+
+	// Only the creator reviews the completion → 403
+	if q.Creator != cmd.UserID.String() {
+		_ = h.unitOfWork.Rollback()
+		return ChangeQuestStatusResult{}, errs.NewForbiddenError("review completion", "only the quest creator can review the completion")
+	}
+
+	evidence, err := h.unitOfWork.EvidenceRepository().FindByQuest(ctx, q.ID())
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to load completion evidence", err)
+	}
+	// Only the evidence awaiting review gets the verdict
+	reviewed := make([]*quest.Evidence, 0, len(evidence))
+	for i := range evidence {
+		if evidence[i].Status == quest.EvidenceStatusPending {
+			reviewed = append(reviewed, &evidence[i])
+		}
+	}
+
+	// Use domain logic - business rules errors → 400
+	q.SetActor(cmd.UserID)
+	switch cmd.Decision {
+	case CompletionDecisionApprove:
+		if err := q.ApproveCompletion(reviewed); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ChangeQuestStatusResult{}, errs.NewDomainValidationErrorWithCause("status", "failed to approve completion", err)
+		}
+	case CompletionDecisionReject:
+		if err := q.RejectCompletion(reviewed, cmd.Reason); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ChangeQuestStatusResult{}, errs.NewDomainValidationErrorWithCause("reason", "failed to reject completion", err)
+		}
+	}
+
+	for _, e := range reviewed {
+		if err := h.unitOfWork.EvidenceRepository().Save(ctx, *e); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to save evidence", err)
+		}
+	}
+
+	if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to save quest", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, q.GetDomainEvents()...); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return ChangeQuestStatusResult{}, errs.WrapInfrastructureError("failed to commit completion review transaction", err)
+	}
+
+	q.ClearDomainEvents()
+
+	return ChangeQuestStatusResult{
+		ID:       q.ID(),
+		Assignee: q.Assignee,
+		Status:   string(q.Status),
+
+		Participants:     q.Participants,
+		CompletionQuorum: q.CompletionQuorum,
+	}, nil
+}
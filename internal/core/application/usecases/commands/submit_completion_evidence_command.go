@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// EvidencePhoto is a photo uploaded with completion evidence.
+type EvidencePhoto struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// SubmitCompletionEvidenceCommand represents a participant reporting the quest as done.
+type SubmitCompletionEvidenceCommand struct {
+	QuestID  uuid.UUID
+	UserID   uuid.UUID // the submitting participant
+	Note     string
+	Position kernel.GeoCoordinate // GPS position at completion
+	Photos   []EvidencePhoto
+}
+
+// SubmitCompletionEvidenceResult represents the output after submission.
+type SubmitCompletionEvidenceResult struct {
+	Evidence    quest.Evidence
+	QuestStatus quest.Status
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// SubmitCompletionEvidenceCommandHandler defines the interface for handling SubmitCompletionEvidenceCommand.
+type SubmitCompletionEvidenceCommandHandler interface {
+	Handle(ctx context.Context, cmd SubmitCompletionEvidenceCommand) (SubmitCompletionEvidenceResult, error)
+}
+
+var _ SubmitCompletionEvidenceCommandHandler = &submitCompletionEvidenceHandler{}
+
+type submitCompletionEvidenceHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+	blobStorage    ports.BlobStorage
+}
+
+// NewSubmitCompletionEvidenceCommandHandler creates a new instance of SubmitCompletionEvidenceCommandHandler.
+func NewSubmitCompletionEvidenceCommandHandler(
+	unitOfWork ports.UnitOfWork,
+	eventPublisher ports.EventPublisher,
+	blobStorage ports.BlobStorage,
+) SubmitCompletionEvidenceCommandHandler {
+	return &submitCompletionEvidenceHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+		blobStorage:    blobStorage,
+	}
+}
+
+// Handle records the participant's completion evidence and stores its photos.
+// The quest goes to "pending_review" once the completion quorum is reached.
+func (h *submitCompletionEvidenceHandler) Handle(ctx context.Context, cmd SubmitCompletionEvidenceCommand) (SubmitCompletionEvidenceResult, error) {
+	if _, err := kernel.NewGeoCoordinate(cmd.Position.Lat, cmd.Position.Lon); err != nil {
+		return SubmitCompletionEvidenceResult{}, errs.NewDomainValidationErrorWithCause("position", "invalid GPS position", err)
+	}
+
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return SubmitCompletionEvidenceResult{}, errs.WrapInfrastructureError("failed to begin evidence submission transaction", err)
+	}
+
+	// Get quest locked until commit, participants change one transaction at a time - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByIDForUpdate(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return SubmitCompletionEvidenceResult{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+	q.SetActor(cmd.UserID)
+	q.ReportPosition(cmd.Position)
+
+	attachments := make([]quest.Attachment, 0, len(cmd.Photos))
+	for _, photo := range cmd.Photos {
+		attachmentID := uuid.New()
+		attachments = append(attachments, quest.Attachment{
+			ID:          attachmentID,
+			FileName:    photo.FileName,
+			ContentType: photo.ContentType,
+			Size:        int64(len(photo.Content)),
+			StorageKey:  fmt.Sprintf("quests/%s/evidence/%s", q.ID(), attachmentID),
+		})
+	}
+
+	// Use domain logic - outsiders → 403, business rules errors → 400
+	evidence, err := q.SubmitEvidence(cmd.UserID, cmd.Note, cmd.Position, attachments)
+	if errors.Is(err, quest.ErrNotParticipant) {
+		_ = h.unitOfWork.Rollback()
+		return SubmitCompletionEvidenceResult{}, errs.NewForbiddenError("submit completion evidence", err.Error())
+	}
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return SubmitCompletionEvidenceResult{}, errs.NewDomainValidationErrorWithCause("evidence", "failed to submit completion evidence", err)
+	}
+
+	// Evidence of an on-site quest is submitted at the execution location → 400
+	if err := checkGeofence(q, q.ExecutionLocation, &cmd.Position, "execution"); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return SubmitCompletionEvidenceResult{}, err
+	}
+
+	// Store the photos before the evidence referencing them is committed
+	for i, photo := range cmd.Photos {
+		if err := h.blobStorage.Put(ctx, attachments[i].StorageKey, bytes.NewReader(photo.Content)); err != nil {
+			h.abort(ctx, attachments[:i])
+			return SubmitCompletionEvidenceResult{}, errs.WrapInfrastructureError("failed to store evidence photo", err)
+		}
+	}
+
+	if err := h.unitOfWork.EvidenceRepository().Save(ctx, evidence); err != nil {
+		h.abort(ctx, attachments)
+		return SubmitCompletionEvidenceResult{}, errs.WrapInfrastructureError("failed to save evidence", err)
+	}
+
+	if err := h.unitOfWork.QuestRepository().Save(ctx, q); err != nil {
+		h.abort(ctx, attachments)
+		return SubmitCompletionEvidenceResult{}, errs.WrapInfrastructureError("failed to save quest", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, q.GetDomainEvents()...); err != nil {
+			h.abort(ctx, attachments)
+			return SubmitCompletionEvidenceResult{}, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		h.deleteBlobs(ctx, attachments)
+		return SubmitCompletionEvidenceResult{}, errs.WrapInfrastructureError("failed to commit evidence submission transaction", err)
+	}
+
+	q.ClearDomainEvents()
+
+	return SubmitCompletionEvidenceResult{
+		Evidence:    evidence,
+		QuestStatus: q.Status,
+	}, nil
+}
+
+// abort rolls back the transaction and removes the photos already stored
+func (h *submitCompletionEvidenceHandler) abort(ctx context.Context, stored []quest.Attachment) {
+	_ = h.unitOfWork.Rollback()
+	h.deleteBlobs(ctx, stored)
+}
+
+// deleteBlobs removes stored photos on a best-effort basis; leftovers are unreferenced
+func (h *submitCompletionEvidenceHandler) deleteBlobs(ctx context.Context, stored []quest.Attachment) {
+	for _, a := range stored {
+		_ = h.blobStorage.Delete(ctx, a.StorageKey)
+	}
+}
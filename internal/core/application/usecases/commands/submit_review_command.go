@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// SubmitReviewCommand represents the creator or a participant of a completed quest rating another of them.
+type SubmitReviewCommand struct {
+	QuestID    uuid.UUID
+	UserID     uuid.UUID // the reviewer
+	RevieweeID uuid.UUID
+	Rating     int
+	Comment    string
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// SubmitReviewCommandHandler defines the interface for handling SubmitReviewCommand.
+type SubmitReviewCommandHandler interface {
+	Handle(ctx context.Context, cmd SubmitReviewCommand) (review.Review, error)
+}
+
+var _ SubmitReviewCommandHandler = &submitReviewHandler{}
+
+type submitReviewHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewSubmitReviewCommandHandler creates a new instance of SubmitReviewCommandHandler.
+func NewSubmitReviewCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) SubmitReviewCommandHandler {
+	return &submitReviewHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle stores the review of the user for the quest.
+func (h *submitReviewHandler) Handle(ctx context.Context, cmd SubmitReviewCommand) (review.Review, error) {
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return review.Review{}, errs.WrapInfrastructureError("failed to begin review transaction", err)
+	}
+
+	// Get quest - if not found → 404
+	q, err := h.unitOfWork.QuestRepository().GetByID(ctx, cmd.QuestID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return review.Review{}, errs.NewNotFoundErrorWithCause("quest", cmd.QuestID.String(), err)
+	}
+
+	// Only the creator and the participants review → 403
+	if !review.TakesPart(q, cmd.UserID) {
+		_ = h.unitOfWork.Rollback()
+		return review.Review{}, errs.NewForbiddenError("review quest", "only the quest creator and the participants can review the quest")
+	}
+
+	existing, err := h.unitOfWork.ReviewRepository().FindByQuest(ctx, q.ID())
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return review.Review{}, errs.WrapInfrastructureError("failed to get quest reviews", err)
+	}
+
+	// Use domain logic - business rules errors → 400
+	r, err := review.NewReview(q, cmd.UserID, cmd.RevieweeID, cmd.Rating, cmd.Comment, existing)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return review.Review{}, errs.NewDomainValidationErrorWithCause("review", "failed to review quest", err)
+	}
+
+	if err := h.unitOfWork.ReviewRepository().Save(ctx, *r); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return review.Review{}, errs.WrapInfrastructureError("failed to save review", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, r.GetDomainEvents()...); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return review.Review{}, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return review.Review{}, errs.WrapInfrastructureError("failed to commit review transaction", err)
+	}
+
+	r.ClearDomainEvents()
+
+	return *r, nil
+}
@@ -0,0 +1,12 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+)
+
+// UpdateNotificationPreferencesCommand sets which kinds of notifications a user receives.
+// Kinds missing from Enabled keep their setting.
+type UpdateNotificationPreferencesCommand struct {
+	UserID  uuid.UUID
+	Enabled map[string]bool
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// UpdateNotificationPreferencesCommandHandler defines the interface for handling UpdateNotificationPreferencesCommand.
+type UpdateNotificationPreferencesCommandHandler interface {
+	Handle(ctx context.Context, cmd UpdateNotificationPreferencesCommand) (notification.Preferences, error)
+}
+
+var _ UpdateNotificationPreferencesCommandHandler = &updateNotificationPreferencesHandler{}
+
+type updateNotificationPreferencesHandler struct {
+	unitOfWork ports.UnitOfWork
+}
+
+// NewUpdateNotificationPreferencesCommandHandler creates a new instance of UpdateNotificationPreferencesCommandHandler.
+func NewUpdateNotificationPreferencesCommandHandler(unitOfWork ports.UnitOfWork) UpdateNotificationPreferencesCommandHandler {
+	return &updateNotificationPreferencesHandler{unitOfWork: unitOfWork}
+}
+
+// Handle saves the settings of the command and returns the resulting preferences of the user.
+func (h *updateNotificationPreferencesHandler) Handle(ctx context.Context, cmd UpdateNotificationPreferencesCommand) (notification.Preferences, error) {
+	enabled := make(map[notification.Kind]bool, len(cmd.Enabled))
+	for kind, on := range cmd.Enabled {
+		enabled[notification.Kind(kind)] = on
+	}
+
+	// Unknown kinds → 400
+	update, err := notification.NewPreferences(cmd.UserID, enabled)
+	if err != nil {
+		return notification.Preferences{}, errs.NewDomainValidationErrorWithCause("preferences", "invalid notification preferences", err)
+	}
+
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return notification.Preferences{}, errs.WrapInfrastructureError("failed to begin notification preferences transaction", err)
+	}
+
+	repo := h.unitOfWork.NotificationRepository()
+	if err := repo.SavePreferences(ctx, update); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return notification.Preferences{}, errs.WrapInfrastructureError("failed to save notification preferences", err)
+	}
+	preferences, err := repo.Preferences(ctx, cmd.UserID)
+	if err != nil {
+		_ = h.unitOfWork.Rollback()
+		return notification.Preferences{}, errs.WrapInfrastructureError("failed to get notification preferences", err)
+	}
+
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return notification.Preferences{}, errs.WrapInfrastructureError("failed to commit notification preferences transaction", err)
+	}
+
+	return preferences, nil
+}
@@ -0,0 +1,17 @@
+package commands
+
+import (
+	"quest-manager/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+)
+
+// UpdateUserProfileCommand replaces the profile of a user, creating it on first use.
+type UpdateUserProfileCommand struct {
+	UserID            uuid.UUID
+	DisplayName       string
+	Skills            []string
+	Equipment         []string
+	HomeLocation      *kernel.GeoCoordinate
+	MaxTravelRadiusKm *float64
+}
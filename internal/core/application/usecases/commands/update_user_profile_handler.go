@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// UpdateUserProfileCommandHandler defines the interface for handling UpdateUserProfileCommand.
+type UpdateUserProfileCommandHandler interface {
+	Handle(ctx context.Context, cmd UpdateUserProfileCommand) (*user.User, error)
+}
+
+var _ UpdateUserProfileCommandHandler = &updateUserProfileHandler{}
+
+type updateUserProfileHandler struct {
+	unitOfWork     ports.UnitOfWork
+	eventPublisher ports.EventPublisher
+}
+
+// NewUpdateUserProfileCommandHandler creates a new instance of UpdateUserProfileCommandHandler.
+func NewUpdateUserProfileCommandHandler(unitOfWork ports.UnitOfWork, eventPublisher ports.EventPublisher) UpdateUserProfileCommandHandler {
+	return &updateUserProfileHandler{
+		unitOfWork:     unitOfWork,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Handle creates the profile on the first call and replaces it on later calls.
+func (h *updateUserProfileHandler) Handle(ctx context.Context, cmd UpdateUserProfileCommand) (*user.User, error) {
+	profile := user.Profile{
+		DisplayName:       cmd.DisplayName,
+		Skills:            cmd.Skills,
+		Equipment:         cmd.Equipment,
+		HomeLocation:      cmd.HomeLocation,
+		MaxTravelRadiusKm: cmd.MaxTravelRadiusKm,
+	}
+
+	// Begin transaction
+	if err := h.unitOfWork.Begin(ctx); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to begin user profile transaction", err)
+	}
+
+	u, err := h.unitOfWork.UserRepository().GetByID(ctx, cmd.UserID)
+	switch {
+	case errors.Is(err, ports.ErrUserNotFound):
+		u, err = user.NewUser(cmd.UserID, profile)
+	case err != nil:
+		_ = h.unitOfWork.Rollback()
+		return nil, errs.WrapInfrastructureError("failed to get user profile", err)
+	default:
+		err = u.UpdateProfile(profile)
+	}
+	if err != nil {
+		// Domain validation errors → 400
+		_ = h.unitOfWork.Rollback()
+		return nil, errs.NewDomainValidationErrorWithCause("profile", "invalid profile", err)
+	}
+
+	// Save user - infrastructure error → 500
+	if err := h.unitOfWork.UserRepository().Save(ctx, u); err != nil {
+		_ = h.unitOfWork.Rollback()
+		return nil, errs.WrapInfrastructureError("failed to save user profile", err)
+	}
+
+	// Publish domain events within the same transaction
+	if h.eventPublisher != nil {
+		if err := h.eventPublisher.Publish(ctx, u.GetDomainEvents()...); err != nil {
+			_ = h.unitOfWork.Rollback()
+			return nil, errs.WrapInfrastructureError("failed to publish events", err)
+		}
+	}
+
+	// Commit transaction
+	if err := h.unitOfWork.Commit(ctx); err != nil {
+		return nil, errs.WrapInfrastructureError("failed to commit user profile transaction", err)
+	}
+
+	// Clear events after successful commit
+	u.ClearDomainEvents()
+
+	return u, nil
+}
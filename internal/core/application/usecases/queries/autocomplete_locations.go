@@ -0,0 +1,51 @@
+package queries
+
+import (
+	"context"
+	"strings"
+
+	"quest-manager/internal/core/domain/model/location"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+const (
+	// MinAutocompleteQueryLength is the minimum number of characters required for autocomplete
+	MinAutocompleteQueryLength = 2
+	// DefaultAutocompleteLimit is used when the caller does not specify a limit
+	DefaultAutocompleteLimit = 10
+	// MaxAutocompleteLimit caps the number of suggestions returned
+	MaxAutocompleteLimit = 50
+)
+
+// AutocompleteLocationsQueryHandler defines the interface for location name/address autocomplete.
+type AutocompleteLocationsQueryHandler interface {
+	Handle(ctx context.Context, query string, limit int) ([]location.SearchMatch, error)
+}
+
+type autocompleteLocationsHandler struct {
+	repo ports.LocationRepository
+}
+
+// NewAutocompleteLocationsQueryHandler creates a new AutocompleteLocationsQueryHandler instance.
+func NewAutocompleteLocationsQueryHandler(repo ports.LocationRepository) AutocompleteLocationsQueryHandler {
+	return &autocompleteLocationsHandler{repo: repo}
+}
+
+// Handle returns locations whose name or address fuzzily match the query, best match first.
+// A zero limit falls back to DefaultAutocompleteLimit.
+func (h *autocompleteLocationsHandler) Handle(ctx context.Context, query string, limit int) ([]location.SearchMatch, error) {
+	query = strings.TrimSpace(query)
+	if len([]rune(query)) < MinAutocompleteQueryLength {
+		return nil, errs.NewDomainValidationError("q", "must contain at least 2 non-whitespace characters")
+	}
+
+	if limit == 0 {
+		limit = DefaultAutocompleteLimit
+	}
+	if limit < 0 || limit > MaxAutocompleteLimit {
+		return nil, errs.NewDomainValidationError("limit", "must be between 1 and 50")
+	}
+
+	return h.repo.SearchByText(ctx, query, limit)
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// Balance is the points balance of a user.
+type Balance struct {
+	UserID uuid.UUID
+	Points int
+}
+
+// GetBalanceQueryHandler defines the interface for getting the points balance of a user.
+type GetBalanceQueryHandler interface {
+	Handle(ctx context.Context, userID uuid.UUID) (Balance, error)
+}
+
+type getBalanceHandler struct {
+	repo ports.LedgerRepository
+}
+
+// NewGetBalanceQueryHandler creates a new GetBalanceQueryHandler instance.
+func NewGetBalanceQueryHandler(repo ports.LedgerRepository) GetBalanceQueryHandler {
+	return &getBalanceHandler{repo: repo}
+}
+
+// Handle returns the sum of the points credited to the user, 0 for users without ledger entries.
+func (h *getBalanceHandler) Handle(ctx context.Context, userID uuid.UUID) (Balance, error) {
+	points, err := h.repo.Balance(ctx, userID)
+	if err != nil {
+		return Balance{}, err
+	}
+	return Balance{UserID: userID, Points: points}, nil
+}
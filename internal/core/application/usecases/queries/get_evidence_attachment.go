@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// GetEvidenceAttachmentQuery represents the input for downloading an evidence photo.
+type GetEvidenceAttachmentQuery struct {
+	QuestID      uuid.UUID
+	EvidenceID   uuid.UUID
+	AttachmentID uuid.UUID
+	UserID       uuid.UUID // the requester, must be the creator or a participant
+}
+
+// EvidenceAttachmentContent is an evidence photo with its content. The caller closes Content.
+type EvidenceAttachmentContent struct {
+	Attachment quest.Attachment
+	Content    io.ReadCloser
+}
+
+// GetEvidenceAttachmentQueryHandler defines the interface for downloading evidence photos.
+type GetEvidenceAttachmentQueryHandler interface {
+	Handle(ctx context.Context, query GetEvidenceAttachmentQuery) (EvidenceAttachmentContent, error)
+}
+
+type getEvidenceAttachmentHandler struct {
+	questRepo    ports.QuestRepository
+	evidenceRepo ports.EvidenceRepository
+	blobStorage  ports.BlobStorage
+}
+
+// NewGetEvidenceAttachmentQueryHandler creates a new GetEvidenceAttachmentQueryHandler instance.
+func NewGetEvidenceAttachmentQueryHandler(
+	questRepo ports.QuestRepository,
+	evidenceRepo ports.EvidenceRepository,
+	blobStorage ports.BlobStorage,
+) GetEvidenceAttachmentQueryHandler {
+	return &getEvidenceAttachmentHandler{
+		questRepo:    questRepo,
+		evidenceRepo: evidenceRepo,
+		blobStorage:  blobStorage,
+	}
+}
+
+// Handle opens the photo of the evidence. Only the creator and the participants may download it.
+func (h *getEvidenceAttachmentHandler) Handle(ctx context.Context, query GetEvidenceAttachmentQuery) (EvidenceAttachmentContent, error) {
+	q, err := h.questRepo.GetByID(ctx, query.QuestID)
+	if err != nil {
+		return EvidenceAttachmentContent{}, errs.NewNotFoundErrorWithCause("quest", query.QuestID.String(), err)
+	}
+
+	if !canSeeEvidence(q, query.UserID) {
+		return EvidenceAttachmentContent{}, errs.NewForbiddenError("get evidence attachment", "only the quest creator and participants can see completion evidence")
+	}
+
+	// Unknown evidence or evidence of another quest → 404
+	evidence, err := h.evidenceRepo.GetByID(ctx, query.EvidenceID)
+	if err != nil {
+		if errors.Is(err, ports.ErrEvidenceNotFound) {
+			return EvidenceAttachmentContent{}, errs.NewNotFoundErrorWithCause("evidence", query.EvidenceID.String(), err)
+		}
+		return EvidenceAttachmentContent{}, err
+	}
+	if evidence.QuestID != q.ID() {
+		return EvidenceAttachmentContent{}, errs.NewNotFoundError("evidence", query.EvidenceID.String())
+	}
+
+	attachment, ok := evidence.Attachment(query.AttachmentID)
+	if !ok {
+		return EvidenceAttachmentContent{}, errs.NewNotFoundError("attachment", query.AttachmentID.String())
+	}
+
+	content, err := h.blobStorage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, ports.ErrBlobNotFound) {
+			return EvidenceAttachmentContent{}, errs.NewNotFoundErrorWithCause("attachment", query.AttachmentID.String(), err)
+		}
+		return EvidenceAttachmentContent{}, errs.WrapInfrastructureError("failed to read evidence attachment", err)
+	}
+
+	return EvidenceAttachmentContent{Attachment: attachment, Content: content}, nil
+}
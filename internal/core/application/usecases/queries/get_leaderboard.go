@@ -0,0 +1,106 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+const (
+	// DefaultLeaderboardSize is the number of standings returned when no limit is given
+	DefaultLeaderboardSize = 10
+	// MaxLeaderboardSize caps the number of standings of a leaderboard
+	MaxLeaderboardSize = 100
+	// MaxLeaderboardRadiusKm caps the radius of an area leaderboard
+	MaxLeaderboardRadiusKm = 20000
+)
+
+// GetLeaderboardQuery selects a leaderboard. Empty Period means the current week, empty Metric
+// means completed quests, zero Limit means DefaultLeaderboardSize. Center and RadiusKm restrict
+// the leaderboard to quests completed within the radius.
+type GetLeaderboardQuery struct {
+	Period   leaderboard.Period
+	Metric   leaderboard.Metric
+	Center   *kernel.GeoCoordinate
+	RadiusKm float64
+	Limit    int
+}
+
+// Leaderboard is the ranking of users for the current period.
+type Leaderboard struct {
+	Period    leaderboard.Period
+	Metric    leaderboard.Metric
+	Since     time.Time
+	Standings []leaderboard.Standing
+}
+
+// GetLeaderboardQueryHandler defines the interface for handling leaderboard queries.
+type GetLeaderboardQueryHandler interface {
+	Handle(ctx context.Context, query GetLeaderboardQuery) (Leaderboard, error)
+}
+
+type getLeaderboardHandler struct {
+	repo ports.LeaderboardRepository
+}
+
+// NewGetLeaderboardQueryHandler creates a new GetLeaderboardQueryHandler instance.
+func NewGetLeaderboardQueryHandler(repo ports.LeaderboardRepository) GetLeaderboardQueryHandler {
+	return &getLeaderboardHandler{repo: repo}
+}
+
+// Handle ranks users by the metric over the period that contains the current time.
+func (h *getLeaderboardHandler) Handle(ctx context.Context, query GetLeaderboardQuery) (Leaderboard, error) {
+	period := query.Period
+	if period == "" {
+		period = leaderboard.PeriodWeek
+	}
+	if !period.IsValid() {
+		return Leaderboard{}, errs.NewDomainValidationError("period", "must be one of 'day', 'week', 'month'")
+	}
+	metric := query.Metric
+	if metric == "" {
+		metric = leaderboard.MetricCompleted
+	}
+	if !metric.IsValid() {
+		return Leaderboard{}, errs.NewDomainValidationError("metric", "must be one of 'completed', 'points'")
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultLeaderboardSize
+	}
+	if limit < 1 || limit > MaxLeaderboardSize {
+		return Leaderboard{}, errs.NewDomainValidationError("limit", "must be between 1 and 100")
+	}
+	if query.Center == nil && query.RadiusKm != 0 {
+		return Leaderboard{}, errs.NewDomainValidationError("radius_km", "requires lat and lon")
+	}
+	if query.Center != nil {
+		if query.RadiusKm <= 0 || query.RadiusKm > MaxLeaderboardRadiusKm {
+			return Leaderboard{}, errs.NewDomainValidationError("radius_km", "must be above 0 and at most 20000")
+		}
+	}
+
+	since := period.Start(time.Now())
+	standings, err := h.repo.Top(ctx, leaderboard.Filter{
+		Metric:   metric,
+		Since:    since,
+		Center:   query.Center,
+		RadiusKm: query.RadiusKm,
+		Limit:    limit,
+	})
+	if err != nil {
+		return Leaderboard{}, err
+	}
+	leaderboard.AssignRanks(standings)
+
+	return Leaderboard{
+		Period:    period,
+		Metric:    metric,
+		Since:     since,
+		Standings: standings,
+	}, nil
+}
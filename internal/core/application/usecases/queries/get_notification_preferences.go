@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// GetNotificationPreferencesQueryHandler defines the interface for getting the notification preferences of a user.
+type GetNotificationPreferencesQueryHandler interface {
+	Handle(ctx context.Context, userID uuid.UUID) (notification.Preferences, error)
+}
+
+type getNotificationPreferencesHandler struct {
+	repo ports.NotificationRepository
+}
+
+// NewGetNotificationPreferencesQueryHandler creates a new GetNotificationPreferencesQueryHandler instance.
+func NewGetNotificationPreferencesQueryHandler(repo ports.NotificationRepository) GetNotificationPreferencesQueryHandler {
+	return &getNotificationPreferencesHandler{repo: repo}
+}
+
+// Handle returns the preferences of the user; users who never set them receive every kind.
+func (h *getNotificationPreferencesHandler) Handle(ctx context.Context, userID uuid.UUID) (notification.Preferences, error) {
+	return h.repo.Preferences(ctx, userID)
+}
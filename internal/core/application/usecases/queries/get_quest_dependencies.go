@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// GetQuestDependenciesQueryHandler defines the interface for getting the dependency graph of a quest.
+type GetQuestDependenciesQueryHandler interface {
+	Handle(ctx context.Context, questID uuid.UUID) ([]quest.Quest, error)
+}
+
+type getQuestDependenciesHandler struct {
+	repo ports.QuestRepository
+}
+
+// NewGetQuestDependenciesQueryHandler creates a new GetQuestDependenciesQueryHandler instance.
+func NewGetQuestDependenciesQueryHandler(repo ports.QuestRepository) GetQuestDependenciesQueryHandler {
+	return &getQuestDependenciesHandler{repo: repo}
+}
+
+// Handle returns the quest and every quest linked to it through prerequisites, oldest first.
+// The prerequisites of each returned quest are the edges of the graph.
+func (h *getQuestDependenciesHandler) Handle(ctx context.Context, questID uuid.UUID) ([]quest.Quest, error) {
+	quests, err := h.repo.FindLinkedByPrerequisites(ctx, questID)
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("failed to get quest dependencies", err)
+	}
+	if len(quests) == 0 {
+		return nil, errs.NewNotFoundError("quest", questID.String())
+	}
+	return quests, nil
+}
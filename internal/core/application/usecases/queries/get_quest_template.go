@@ -0,0 +1,47 @@
+package queries
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// GetQuestTemplateQuery represents the input for getting a quest template of a user.
+type GetQuestTemplateQuery struct {
+	TemplateID uuid.UUID
+	UserID     uuid.UUID // the requester, must own the template
+}
+
+// GetQuestTemplateQueryHandler defines the interface for getting a quest template.
+type GetQuestTemplateQueryHandler interface {
+	Handle(ctx context.Context, query GetQuestTemplateQuery) (*template.QuestTemplate, error)
+}
+
+type getQuestTemplateHandler struct {
+	repo ports.QuestTemplateRepository
+}
+
+// NewGetQuestTemplateQueryHandler creates a new GetQuestTemplateQueryHandler instance.
+func NewGetQuestTemplateQueryHandler(repo ports.QuestTemplateRepository) GetQuestTemplateQueryHandler {
+	return &getQuestTemplateHandler{repo: repo}
+}
+
+// Handle returns the template. Templates of other users are not found.
+func (h *getQuestTemplateHandler) Handle(ctx context.Context, query GetQuestTemplateQuery) (*template.QuestTemplate, error) {
+	t, err := h.repo.GetByID(ctx, query.TemplateID)
+	if err != nil {
+		if errors.Is(err, ports.ErrQuestTemplateNotFound) {
+			return nil, errs.NewNotFoundErrorWithCause("quest template", query.TemplateID.String(), err)
+		}
+		return nil, err
+	}
+	if !t.IsOwner(query.UserID) {
+		return nil, errs.NewNotFoundError("quest template", query.TemplateID.String())
+	}
+	return t, nil
+}
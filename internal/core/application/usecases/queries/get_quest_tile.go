@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+const (
+	// DefaultTileResolution splits a tile into 8x8 cluster cells
+	DefaultTileResolution = 3
+	// MaxTileResolution splits a tile into at most 64x64 cluster cells
+	MaxTileResolution = 6
+)
+
+// GetQuestTileQuery requests quest clusters for a map tile.
+// The tile is split into 2^Resolution x 2^Resolution cells (sub-tiles at zoom Tile.Z+Resolution);
+// zero Resolution means DefaultTileResolution.
+type GetQuestTileQuery struct {
+	Tile       kernel.Tile
+	Resolution int
+}
+
+// QuestTile holds the non-empty clusters of a tile, ordered row by row (north to south, west to east).
+type QuestTile struct {
+	Tile       kernel.Tile
+	Resolution int
+	Clusters   []quest.TileCluster
+}
+
+// GetQuestTileQueryHandler defines the interface for handling quest tile queries.
+type GetQuestTileQueryHandler interface {
+	Handle(ctx context.Context, query GetQuestTileQuery) (QuestTile, error)
+}
+
+type getQuestTileHandler struct {
+	repo ports.QuestRepository
+}
+
+// NewGetQuestTileQueryHandler creates a new GetQuestTileQueryHandler instance.
+func NewGetQuestTileQueryHandler(repo ports.QuestRepository) GetQuestTileQueryHandler {
+	return &getQuestTileHandler{repo: repo}
+}
+
+// Handle clusters the quests of the tile by target location.
+// Every quest belongs to exactly one cell, so adjacent tiles never count a quest twice.
+func (h *getQuestTileHandler) Handle(ctx context.Context, query GetQuestTileQuery) (QuestTile, error) {
+	resolution := query.Resolution
+	if resolution == 0 {
+		resolution = DefaultTileResolution
+	}
+	if resolution < 1 || resolution > MaxTileResolution {
+		return QuestTile{}, errs.NewDomainValidationError("resolution", "must be between 1 and 6")
+	}
+
+	clusters, err := h.repo.ClusterByTile(ctx, query.Tile, query.Tile.Z+resolution)
+	if err != nil {
+		return QuestTile{}, err
+	}
+	if clusters == nil {
+		clusters = []quest.TileCluster{}
+	}
+
+	return QuestTile{Tile: query.Tile, Resolution: resolution, Clusters: clusters}, nil
+}
@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// GetReputationsQueryHandler defines the interface for getting the reputation of users.
+type GetReputationsQueryHandler interface {
+	Handle(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]review.Reputation, error)
+}
+
+type getReputationsHandler struct {
+	repo ports.ReviewRepository
+}
+
+// NewGetReputationsQueryHandler creates a new GetReputationsQueryHandler instance.
+func NewGetReputationsQueryHandler(repo ports.ReviewRepository) GetReputationsQueryHandler {
+	return &getReputationsHandler{repo: repo}
+}
+
+// Handle returns the reputation of every given user, a zero reputation for users without reviews.
+func (h *getReputationsHandler) Handle(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]review.Reputation, error) {
+	return h.repo.Reputations(ctx, userIDs)
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// GetUserProfileQueryHandler defines the interface for handling user profile retrieval.
+type GetUserProfileQueryHandler interface {
+	Handle(ctx context.Context, userID uuid.UUID) (*user.User, error)
+}
+
+type getUserProfileHandler struct {
+	repo ports.UserRepository
+}
+
+// NewGetUserProfileQueryHandler creates a new GetUserProfileQueryHandler instance.
+func NewGetUserProfileQueryHandler(repo ports.UserRepository) GetUserProfileQueryHandler {
+	return &getUserProfileHandler{repo: repo}
+}
+
+// Handle returns the profile of the user, or NotFoundError if none has been saved yet.
+func (h *getUserProfileHandler) Handle(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	u, err := h.repo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrUserNotFound) {
+			return nil, errs.NewNotFoundErrorWithCause("user profile", userID.String(), err)
+		}
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// Achievement is an achievement with the progress of a user toward it.
+type Achievement struct {
+	Code        string
+	Title       string
+	Description string
+	Progress    int
+	// Target is the count the achievement needs; 0 for achievements no longer defined
+	Target     int
+	UnlockedAt *time.Time
+}
+
+// ListAchievementsQueryHandler defines the interface for listing the achievements of a user.
+type ListAchievementsQueryHandler interface {
+	Handle(ctx context.Context, userID uuid.UUID) ([]Achievement, error)
+}
+
+type listAchievementsHandler struct {
+	repo        ports.AchievementRepository
+	definitions []achievement.Definition
+}
+
+// NewListAchievementsQueryHandler creates a new ListAchievementsQueryHandler instance.
+func NewListAchievementsQueryHandler(repo ports.AchievementRepository, definitions []achievement.Definition) ListAchievementsQueryHandler {
+	return &listAchievementsHandler{repo: repo, definitions: definitions}
+}
+
+// Handle returns every defined achievement in definition order with the progress of the user,
+// followed by achievements the user unlocked that are no longer defined.
+func (h *listAchievementsHandler) Handle(ctx context.Context, userID uuid.UUID) ([]Achievement, error) {
+	facts, err := h.repo.FindFacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	unlocked, err := h.repo.FindUnlocked(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockedByCode := make(map[string]achievement.Unlocked, len(unlocked))
+	for _, u := range unlocked {
+		unlockedByCode[u.Code] = u
+	}
+
+	result := make([]Achievement, 0, len(h.definitions)+len(unlocked))
+	defined := make(map[string]struct{}, len(h.definitions))
+	for _, d := range h.definitions {
+		defined[d.Code] = struct{}{}
+		item := Achievement{
+			Code:        d.Code,
+			Title:       d.Title,
+			Description: d.Description,
+			Progress:    d.Progress(facts),
+			Target:      d.Count,
+		}
+		if u, ok := unlockedByCode[d.Code]; ok {
+			unlockedAt := u.UnlockedAt
+			item.UnlockedAt = &unlockedAt
+			item.Progress = d.Count
+		}
+		result = append(result, item)
+	}
+
+	for _, u := range unlocked {
+		if _, ok := defined[u.Code]; ok {
+			continue
+		}
+		unlockedAt := u.UnlockedAt
+		result = append(result, Achievement{Code: u.Code, Title: u.Title, UnlockedAt: &unlockedAt})
+	}
+	return result, nil
+}
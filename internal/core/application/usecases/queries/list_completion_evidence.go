@@ -0,0 +1,55 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ListCompletionEvidenceQuery represents the input for listing the completion evidence of a quest.
+type ListCompletionEvidenceQuery struct {
+	QuestID uuid.UUID
+	UserID  uuid.UUID // the requester, must be the creator or a participant
+}
+
+// ListCompletionEvidenceQueryHandler defines the interface for listing completion evidence.
+type ListCompletionEvidenceQueryHandler interface {
+	Handle(ctx context.Context, query ListCompletionEvidenceQuery) ([]quest.Evidence, error)
+}
+
+type listCompletionEvidenceHandler struct {
+	questRepo    ports.QuestRepository
+	evidenceRepo ports.EvidenceRepository
+}
+
+// NewListCompletionEvidenceQueryHandler creates a new ListCompletionEvidenceQueryHandler instance.
+func NewListCompletionEvidenceQueryHandler(questRepo ports.QuestRepository, evidenceRepo ports.EvidenceRepository) ListCompletionEvidenceQueryHandler {
+	return &listCompletionEvidenceHandler{
+		questRepo:    questRepo,
+		evidenceRepo: evidenceRepo,
+	}
+}
+
+// Handle returns the evidence submitted for the quest, oldest first.
+// Only the creator and the participants may list it.
+func (h *listCompletionEvidenceHandler) Handle(ctx context.Context, query ListCompletionEvidenceQuery) ([]quest.Evidence, error) {
+	q, err := h.questRepo.GetByID(ctx, query.QuestID)
+	if err != nil {
+		return nil, errs.NewNotFoundErrorWithCause("quest", query.QuestID.String(), err)
+	}
+
+	if !canSeeEvidence(q, query.UserID) {
+		return nil, errs.NewForbiddenError("list completion evidence", "only the quest creator and participants can see completion evidence")
+	}
+
+	return h.evidenceRepo.FindByQuest(ctx, q.ID())
+}
+
+// canSeeEvidence checks if the user is the creator or a participant of the quest
+func canSeeEvidence(q quest.Quest, userID uuid.UUID) bool {
+	return q.Creator == userID.String() || q.IsParticipant(userID)
+}
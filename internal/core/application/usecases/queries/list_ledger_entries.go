@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultLedgerPageSize is the number of ledger entries per page when no limit is given
+	DefaultLedgerPageSize = 20
+	// MaxLedgerPageSize limits the number of ledger entries per page
+	MaxLedgerPageSize = 100
+)
+
+// ListLedgerEntriesQuery represents the input for listing the ledger entries of a user.
+type ListLedgerEntriesQuery struct {
+	UserID uuid.UUID
+	Limit  int // zero means DefaultLedgerPageSize
+	Offset int
+}
+
+// LedgerPage is a page of ledger entries with the total number of entries of the user.
+type LedgerPage struct {
+	Entries []ledger.Entry
+	Total   int
+}
+
+// ListLedgerEntriesQueryHandler defines the interface for listing ledger entries.
+type ListLedgerEntriesQueryHandler interface {
+	Handle(ctx context.Context, query ListLedgerEntriesQuery) (LedgerPage, error)
+}
+
+type listLedgerEntriesHandler struct {
+	repo ports.LedgerRepository
+}
+
+// NewListLedgerEntriesQueryHandler creates a new ListLedgerEntriesQueryHandler instance.
+func NewListLedgerEntriesQueryHandler(repo ports.LedgerRepository) ListLedgerEntriesQueryHandler {
+	return &listLedgerEntriesHandler{repo: repo}
+}
+
+// Handle returns a page of the user's ledger entries, newest first.
+func (h *listLedgerEntriesHandler) Handle(ctx context.Context, query ListLedgerEntriesQuery) (LedgerPage, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultLedgerPageSize
+	}
+	if limit < 1 || limit > MaxLedgerPageSize {
+		return LedgerPage{}, errs.NewDomainValidationError("limit", fmt.Sprintf("must be between 1 and %d", MaxLedgerPageSize))
+	}
+	if query.Offset < 0 {
+		return LedgerPage{}, errs.NewDomainValidationError("offset", "must not be negative")
+	}
+
+	entries, total, err := h.repo.FindByUser(ctx, query.UserID, limit, query.Offset)
+	if err != nil {
+		return LedgerPage{}, err
+	}
+	return LedgerPage{Entries: entries, Total: total}, nil
+}
@@ -0,0 +1,73 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultNotificationPageSize is the number of notifications per page when no limit is given
+	DefaultNotificationPageSize = 20
+	// MaxNotificationPageSize limits the number of notifications per page
+	MaxNotificationPageSize = 100
+)
+
+// ListNotificationsQuery represents the input for listing the inbox of a user.
+type ListNotificationsQuery struct {
+	UserID     uuid.UUID
+	UnreadOnly bool
+	Limit      int // zero means DefaultNotificationPageSize
+	Offset     int
+}
+
+// NotificationPage is a page of notifications with the total number of notifications listed
+// and the number of unread notifications of the user.
+type NotificationPage struct {
+	Notifications []notification.Notification
+	Total         int
+	Unread        int
+}
+
+// ListNotificationsQueryHandler defines the interface for listing notifications.
+type ListNotificationsQueryHandler interface {
+	Handle(ctx context.Context, query ListNotificationsQuery) (NotificationPage, error)
+}
+
+type listNotificationsHandler struct {
+	repo ports.NotificationRepository
+}
+
+// NewListNotificationsQueryHandler creates a new ListNotificationsQueryHandler instance.
+func NewListNotificationsQueryHandler(repo ports.NotificationRepository) ListNotificationsQueryHandler {
+	return &listNotificationsHandler{repo: repo}
+}
+
+// Handle returns a page of the notifications of the user, newest first.
+func (h *listNotificationsHandler) Handle(ctx context.Context, query ListNotificationsQuery) (NotificationPage, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultNotificationPageSize
+	}
+	if limit < 1 || limit > MaxNotificationPageSize {
+		return NotificationPage{}, errs.NewDomainValidationError("limit", fmt.Sprintf("must be between 1 and %d", MaxNotificationPageSize))
+	}
+	if query.Offset < 0 {
+		return NotificationPage{}, errs.NewDomainValidationError("offset", "must not be negative")
+	}
+
+	notifications, total, err := h.repo.FindByUser(ctx, query.UserID, query.UnreadOnly, limit, query.Offset)
+	if err != nil {
+		return NotificationPage{}, err
+	}
+	unread, err := h.repo.CountUnread(ctx, query.UserID)
+	if err != nil {
+		return NotificationPage{}, err
+	}
+	return NotificationPage{Notifications: notifications, Total: total, Unread: unread}, nil
+}
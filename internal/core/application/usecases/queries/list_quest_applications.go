@@ -0,0 +1,49 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ListQuestApplicationsQuery represents the input for listing the applications to a quest.
+type ListQuestApplicationsQuery struct {
+	QuestID uuid.UUID
+	UserID  uuid.UUID // the requester, must be the quest creator
+}
+
+// ListQuestApplicationsQueryHandler defines the interface for listing quest applications.
+type ListQuestApplicationsQueryHandler interface {
+	Handle(ctx context.Context, query ListQuestApplicationsQuery) ([]quest.Application, error)
+}
+
+type listQuestApplicationsHandler struct {
+	questRepo       ports.QuestRepository
+	applicationRepo ports.ApplicationRepository
+}
+
+// NewListQuestApplicationsQueryHandler creates a new ListQuestApplicationsQueryHandler instance.
+func NewListQuestApplicationsQueryHandler(questRepo ports.QuestRepository, applicationRepo ports.ApplicationRepository) ListQuestApplicationsQueryHandler {
+	return &listQuestApplicationsHandler{
+		questRepo:       questRepo,
+		applicationRepo: applicationRepo,
+	}
+}
+
+// Handle returns the applications to the quest, oldest first. Only the creator may list them.
+func (h *listQuestApplicationsHandler) Handle(ctx context.Context, query ListQuestApplicationsQuery) ([]quest.Application, error) {
+	q, err := h.questRepo.GetByID(ctx, query.QuestID)
+	if err != nil {
+		return nil, errs.NewNotFoundErrorWithCause("quest", query.QuestID.String(), err)
+	}
+
+	if q.Creator != query.UserID.String() {
+		return nil, errs.NewForbiddenError("list applications", "only the quest creator can see applications")
+	}
+
+	return h.applicationRepo.FindByQuest(ctx, q.ID())
+}
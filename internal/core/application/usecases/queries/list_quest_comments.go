@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultCommentPageSize is the number of comments per page when no limit is given
+	DefaultCommentPageSize = 50
+	// MaxCommentPageSize limits the number of comments per page
+	MaxCommentPageSize = 100
+)
+
+// ListQuestCommentsQuery represents the input for listing the comment thread of a quest.
+type ListQuestCommentsQuery struct {
+	QuestID uuid.UUID
+	UserID  uuid.UUID
+	Limit   int // zero means DefaultCommentPageSize
+	Offset  int
+}
+
+// CommentPage is a page of comments with the total number of comments the user can see.
+type CommentPage struct {
+	Comments []quest.Comment
+	Total    int
+}
+
+// ListQuestCommentsQueryHandler defines the interface for listing quest comments.
+type ListQuestCommentsQueryHandler interface {
+	Handle(ctx context.Context, query ListQuestCommentsQuery) (CommentPage, error)
+}
+
+type listQuestCommentsHandler struct {
+	questRepo   ports.QuestRepository
+	commentRepo ports.CommentRepository
+}
+
+// NewListQuestCommentsQueryHandler creates a new ListQuestCommentsQueryHandler instance.
+func NewListQuestCommentsQueryHandler(questRepo ports.QuestRepository, commentRepo ports.CommentRepository) ListQuestCommentsQueryHandler {
+	return &listQuestCommentsHandler{
+		questRepo:   questRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// Handle returns a page of the comments of the quest, oldest first.
+// Private comments are listed only for the creator and the participants.
+func (h *listQuestCommentsHandler) Handle(ctx context.Context, query ListQuestCommentsQuery) (CommentPage, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultCommentPageSize
+	}
+	if limit < 1 || limit > MaxCommentPageSize {
+		return CommentPage{}, errs.NewDomainValidationError("limit", fmt.Sprintf("must be between 1 and %d", MaxCommentPageSize))
+	}
+	if query.Offset < 0 {
+		return CommentPage{}, errs.NewDomainValidationError("offset", "must not be negative")
+	}
+
+	q, err := h.questRepo.GetByID(ctx, query.QuestID)
+	if err != nil {
+		return CommentPage{}, errs.NewNotFoundErrorWithCause("quest", query.QuestID.String(), err)
+	}
+
+	comments, total, err := h.commentRepo.FindByQuest(ctx, q.ID(), q.IsMember(query.UserID), limit, query.Offset)
+	if err != nil {
+		return CommentPage{}, err
+	}
+	return CommentPage{Comments: comments, Total: total}, nil
+}
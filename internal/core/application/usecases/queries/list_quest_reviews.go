@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// ListQuestReviewsQueryHandler defines the interface for listing the reviews of a quest.
+type ListQuestReviewsQueryHandler interface {
+	Handle(ctx context.Context, questID uuid.UUID) ([]review.Review, error)
+}
+
+type listQuestReviewsHandler struct {
+	questRepo  ports.QuestRepository
+	reviewRepo ports.ReviewRepository
+}
+
+// NewListQuestReviewsQueryHandler creates a new ListQuestReviewsQueryHandler instance.
+func NewListQuestReviewsQueryHandler(questRepo ports.QuestRepository, reviewRepo ports.ReviewRepository) ListQuestReviewsQueryHandler {
+	return &listQuestReviewsHandler{
+		questRepo:  questRepo,
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Handle returns the reviews of the quest, oldest first.
+func (h *listQuestReviewsHandler) Handle(ctx context.Context, questID uuid.UUID) ([]review.Review, error) {
+	q, err := h.questRepo.GetByID(ctx, questID)
+	if err != nil {
+		return nil, errs.NewNotFoundErrorWithCause("quest", questID.String(), err)
+	}
+	return h.reviewRepo.FindByQuest(ctx, q.ID())
+}
@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// ListQuestTemplatesQueryHandler defines the interface for listing the quest templates of a user.
+type ListQuestTemplatesQueryHandler interface {
+	Handle(ctx context.Context, userID uuid.UUID) ([]*template.QuestTemplate, error)
+}
+
+type listQuestTemplatesHandler struct {
+	repo ports.QuestTemplateRepository
+}
+
+// NewListQuestTemplatesQueryHandler creates a new ListQuestTemplatesQueryHandler instance.
+func NewListQuestTemplatesQueryHandler(repo ports.QuestTemplateRepository) ListQuestTemplatesQueryHandler {
+	return &listQuestTemplatesHandler{repo: repo}
+}
+
+// Handle returns the templates of the user, oldest first.
+func (h *listQuestTemplatesHandler) Handle(ctx context.Context, userID uuid.UUID) ([]*template.QuestTemplate, error) {
+	return h.repo.FindByOwner(ctx, userID)
+}
@@ -4,14 +4,25 @@ import (
 	"context"
 
 	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/review"
 	"quest-manager/internal/core/ports"
 	"quest-manager/internal/pkg/errs"
+
+	"github.com/google/uuid"
 )
 
+// ListQuestsQuery filters the quest list. Nil fields are not applied.
+type ListQuestsQuery struct {
+	Status     *quest.Status
+	MaxRouteKm *float64 // maximum route length (target, waypoints, execution) in kilometers
+	// MinCreatorRating keeps quests whose creator has at least this average rating; unreviewed creators are excluded
+	MinCreatorRating *float64
+}
+
 // ListQuestsQueryHandler defines the interface for handling quest listing.
-// If status is nil, all quests are returned. Otherwise, filters by status.
+// Without filters, all quests are returned.
 type ListQuestsQueryHandler interface {
-	Handle(ctx context.Context, status *quest.Status) ([]quest.Quest, error)
+	Handle(ctx context.Context, query ListQuestsQuery) ([]quest.Quest, error)
 }
 
 type listQuestsHandler struct {
@@ -23,17 +34,41 @@ func NewListQuestsQueryHandler(repo ports.QuestRepository) ListQuestsQueryHandle
 	return &listQuestsHandler{repo: repo}
 }
 
-// Handle retrieves quests from the repository, optionally filtered by status.
-func (h *listQuestsHandler) Handle(ctx context.Context, status *quest.Status) ([]quest.Quest, error) {
-	if status != nil {
-		// Validate status using domain logic - return DomainValidationError for 400
-		if !quest.IsValidStatus(string(*status)) {
-			return nil, errs.NewDomainValidationError("status", "must be one of 'created', 'posted', 'assigned', 'in_progress', 'declined', 'completed'")
-		}
+// Handle retrieves quests from the repository, optionally filtered by status, route length
+// and the reputation of the creator.
+func (h *listQuestsHandler) Handle(ctx context.Context, query ListQuestsQuery) ([]quest.Quest, error) {
+	if query.MaxRouteKm != nil && *query.MaxRouteKm <= 0 {
+		return nil, errs.NewDomainValidationError("max_route_km", "must be greater than 0")
+	}
+	if query.MinCreatorRating != nil && (*query.MinCreatorRating < review.MinRating || *query.MinCreatorRating > review.MaxRating) {
+		return nil, errs.NewDomainValidationError("min_creator_rating", "must be between 1 and 5")
+	}
+	// Validate status using domain logic - return DomainValidationError for 400
+	if query.Status != nil && !quest.IsValidStatus(string(*query.Status)) {
+		return nil, errs.NewDomainValidationError("status", "must be one of 'created', 'posted', 'assigned', 'in_progress', 'pending_review', 'declined', 'completed'")
+	}
+
+	// Every filter is applied by the repository
+	return h.repo.FindByFilter(ctx, quest.ListFilter{
+		Status:           query.Status,
+		MaxRouteKm:       query.MaxRouteKm,
+		MinCreatorRating: query.MinCreatorRating,
+	})
+}
 
-		// Filter by status
-		return h.repo.FindByStatus(ctx, *status)
+// CreatorIDs returns the distinct creators of the quests that are user IDs.
+func CreatorIDs(quests []quest.Quest) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(quests))
+	ids := make([]uuid.UUID, 0, len(quests))
+	for _, q := range quests {
+		creatorID, err := uuid.Parse(q.Creator)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[creatorID]; !ok {
+			seen[creatorID] = struct{}{}
+			ids = append(ids, creatorID)
+		}
 	}
-	// Return all quests
-	return h.repo.FindAll(ctx)
+	return ids
 }
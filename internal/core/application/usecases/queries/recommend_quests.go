@@ -0,0 +1,274 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+const (
+	// DefaultRecommendRadiusKm is the search radius when none is given
+	DefaultRecommendRadiusKm = 10.0
+	// MaxRecommendRadiusKm caps the search radius of recommendations
+	MaxRecommendRadiusKm = 200.0
+	// DefaultRecommendLimit is the number of recommendations when no limit is given
+	DefaultRecommendLimit = 20
+	// MaxRecommendLimit caps the number of recommendations
+	MaxRecommendLimit = 100
+
+	// Weights of the partial scores in the total score; they sum to 1
+	RecommendWeightDistance   = 0.30
+	RecommendWeightSkills     = 0.25
+	RecommendWeightDifficulty = 0.15
+	RecommendWeightReward     = 0.10
+	RecommendWeightHistory    = 0.20
+)
+
+// RecommendQuestsQuery requests quests for a user near a position.
+// The user's stored profile supplies the defaults; the other fields only override it:
+// nil Position means the home location, zero RadiusKm the travel radius (capped at
+// MaxRecommendRadiusKm) and nil Skills and Equipment the declared ones.
+// Without a profile value RadiusKm and Limit fall back to DefaultRecommendRadiusKm and DefaultRecommendLimit.
+type RecommendQuestsQuery struct {
+	UserID    uuid.UUID
+	Position  *kernel.GeoCoordinate
+	RadiusKm  float64
+	Skills    []string
+	Equipment []string
+	Limit     int
+}
+
+// RecommendationScores are the partial scores of a recommendation, each between 0 and 1.
+type RecommendationScores struct {
+	Distance   float64 // 1 at the position, 0 at the edge of the radius
+	Skills     float64 // share of required skills and equipment the user has (1 if none required)
+	Difficulty float64 // closeness to the difficulty of the user's completed quests (easy if none)
+	Reward     float64 // reward scaled from 1..5 to 0..1
+	History    float64 // 1 at the location of a past quest of the user, 0 at the radius (0 without history)
+}
+
+// QuestRecommendation is a ranked quest with the explanation of its score.
+type QuestRecommendation struct {
+	Quest                 quest.Quest
+	DistanceKm            float64 // to the nearer of target and execution location
+	Score                 float64 // weighted sum of Scores
+	Scores                RecommendationScores
+	MatchedRequirements   []string
+	MissingRequirements   []string
+	CompletedAtDifficulty int // number of the user's completed quests with the same difficulty
+	// NearestPastQuestKm is the distance to the nearest location of the user's past quests, nil without history
+	NearestPastQuestKm *float64
+}
+
+// RecommendQuestsQueryHandler defines the interface for handling quest recommendations.
+type RecommendQuestsQueryHandler interface {
+	Handle(ctx context.Context, query RecommendQuestsQuery) ([]QuestRecommendation, error)
+}
+
+type recommendQuestsHandler struct {
+	repo  ports.QuestRepository
+	users ports.UserRepository
+}
+
+// NewRecommendQuestsQueryHandler creates a new RecommendQuestsQueryHandler instance.
+func NewRecommendQuestsQueryHandler(repo ports.QuestRepository, users ports.UserRepository) RecommendQuestsQueryHandler {
+	return &recommendQuestsHandler{repo: repo, users: users}
+}
+
+// Handle ranks posted quests within the radius, best match first.
+// Quests created by the user are not recommended. Ties are broken by distance.
+func (h *recommendQuestsHandler) Handle(ctx context.Context, query RecommendQuestsQuery) ([]QuestRecommendation, error) {
+	if query.RadiusKm < 0 || query.RadiusKm > MaxRecommendRadiusKm {
+		return nil, errs.NewDomainValidationError("radius_km", "must be between 0 and 200")
+	}
+
+	profile, err := h.users.GetByID(ctx, query.UserID)
+	if err != nil && !errors.Is(err, ports.ErrUserNotFound) {
+		return nil, err
+	}
+
+	position := query.Position
+	if position == nil && profile != nil {
+		position = profile.HomeLocation
+	}
+	if position == nil {
+		return nil, errs.NewDomainValidationError("position", "lat and lon are required without a home location in the profile")
+	}
+
+	radiusKm := query.RadiusKm
+	if radiusKm == 0 && profile != nil && profile.MaxTravelRadiusKm != nil && *profile.MaxTravelRadiusKm > 0 {
+		radiusKm = math.Min(*profile.MaxTravelRadiusKm, MaxRecommendRadiusKm)
+	}
+	if radiusKm == 0 {
+		radiusKm = DefaultRecommendRadiusKm
+	}
+
+	skills, equipment := query.Skills, query.Equipment
+	if profile != nil {
+		if skills == nil {
+			skills = profile.Skills
+		}
+		if equipment == nil {
+			equipment = profile.Equipment
+		}
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultRecommendLimit
+	}
+	if limit < 0 || limit > MaxRecommendLimit {
+		return nil, errs.NewDomainValidationError("limit", "must be between 1 and 100")
+	}
+
+	history, err := h.repo.FindByAssignee(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	completed := make(map[quest.Difficulty]int)
+	for _, q := range history {
+		if q.Status == quest.StatusCompleted {
+			completed[q.Difficulty]++
+		}
+	}
+	preferredLevel := preferredDifficultyLevel(completed)
+
+	candidates, err := h.repo.FindWithinRadius(ctx, *position, radiusKm, quest.RadiusSortDistance, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := normalizedSet(append(append([]string{}, skills...), equipment...))
+	creator := query.UserID.String()
+
+	results := make([]QuestRecommendation, 0, len(candidates))
+	for _, q := range candidates {
+		if q.Status != quest.StatusPosted || q.Creator == creator {
+			continue
+		}
+
+		r := QuestRecommendation{
+			Quest:                 q,
+			DistanceKm:            q.DistanceFrom(*position),
+			CompletedAtDifficulty: completed[q.Difficulty],
+			NearestPastQuestKm:    nearestPastQuestKm(q, history),
+		}
+		r.MatchedRequirements, r.MissingRequirements = matchRequirements(q, owned)
+
+		historyScore := 0.0
+		if r.NearestPastQuestKm != nil {
+			historyScore = math.Max(0, 1-*r.NearestPastQuestKm/radiusKm)
+		}
+
+		r.Scores = RecommendationScores{
+			Distance:   math.Max(0, 1-r.DistanceKm/radiusKm),
+			Skills:     skillScore(len(r.MatchedRequirements), len(r.MissingRequirements)),
+			Difficulty: 1 - math.Abs(difficultyLevel(q.Difficulty)-preferredLevel)/2,
+			Reward:     float64(q.Reward-1) / 4,
+			History:    historyScore,
+		}
+		r.Score = RecommendWeightDistance*r.Scores.Distance +
+			RecommendWeightSkills*r.Scores.Skills +
+			RecommendWeightDifficulty*r.Scores.Difficulty +
+			RecommendWeightReward*r.Scores.Reward +
+			RecommendWeightHistory*r.Scores.History
+
+		results = append(results, r)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].DistanceKm < results[j].DistanceKm
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// difficultyLevel maps difficulties to 0 (easy), 1 (medium), 2 (hard).
+func difficultyLevel(d quest.Difficulty) float64 {
+	switch d {
+	case quest.DifficultyMedium:
+		return 1
+	case quest.DifficultyHard:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// preferredDifficultyLevel is the mean level of completed quests; users without history start at easy.
+func preferredDifficultyLevel(completed map[quest.Difficulty]int) float64 {
+	total, sum := 0, 0.0
+	for d, n := range completed {
+		total += n
+		sum += difficultyLevel(d) * float64(n)
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / float64(total)
+}
+
+// nearestPastQuestKm is the shortest distance between the locations of the quest and those of the user's past quests.
+func nearestPastQuestKm(q quest.Quest, history []quest.Quest) *float64 {
+	var nearest *float64
+	for _, past := range history {
+		if past.ID() == q.ID() {
+			continue
+		}
+		d := math.Min(q.DistanceFrom(past.TargetLocation), q.DistanceFrom(past.ExecutionLocation))
+		if nearest == nil || d < *nearest {
+			nearest = &d
+		}
+	}
+	return nearest
+}
+
+// matchRequirements splits the quest's skills and equipment into those the user has and those missing.
+func matchRequirements(q quest.Quest, owned map[string]struct{}) (matched, missing []string) {
+	matched, missing = []string{}, []string{}
+	seen := make(map[string]struct{})
+	for _, req := range append(append([]string{}, q.Skills...), q.Equipment...) {
+		key := strings.ToLower(strings.TrimSpace(req))
+		if _, dup := seen[key]; dup || key == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		if _, ok := owned[key]; ok {
+			matched = append(matched, req)
+		} else {
+			missing = append(missing, req)
+		}
+	}
+	return matched, missing
+}
+
+func skillScore(matched, missing int) float64 {
+	if matched+missing == 0 {
+		return 1
+	}
+	return float64(matched) / float64(matched+missing)
+}
+
+func normalizedSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if key := strings.ToLower(strings.TrimSpace(v)); key != "" {
+			set[key] = struct{}{}
+		}
+	}
+	return set
+}
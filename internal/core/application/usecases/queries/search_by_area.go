@@ -0,0 +1,70 @@
+package queries
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
+)
+
+// SearchQuestsByAreaQuery contains area search parameters.
+// Exactly one of Polygon and BoundingBox must be set; empty Match means quest.LocationMatchAny.
+type SearchQuestsByAreaQuery struct {
+	Polygon     *kernel.Polygon
+	BoundingBox *kernel.BoundingBox
+	Match       quest.LocationMatch
+}
+
+// SearchQuestsByAreaQueryHandler defines the interface for handling quest search inside an area.
+type SearchQuestsByAreaQueryHandler interface {
+	Handle(ctx context.Context, query SearchQuestsByAreaQuery) ([]quest.Quest, error)
+}
+
+type searchQuestsByAreaHandler struct {
+	repo ports.QuestRepository
+}
+
+// NewSearchQuestsByAreaQueryHandler creates a new SearchQuestsByAreaQueryHandler instance.
+func NewSearchQuestsByAreaQueryHandler(repo ports.QuestRepository) SearchQuestsByAreaQueryHandler {
+	return &searchQuestsByAreaHandler{repo: repo}
+}
+
+// Handle retrieves quests whose selected locations lie inside the polygon or bounding box.
+func (h *searchQuestsByAreaHandler) Handle(ctx context.Context, query SearchQuestsByAreaQuery) ([]quest.Quest, error) {
+	match := query.Match
+	if match == "" {
+		match = quest.LocationMatchAny
+	}
+	if !quest.IsValidLocationMatch(string(match)) {
+		return nil, errs.NewDomainValidationError("match", "must be one of 'target', 'execution', 'any'")
+	}
+
+	switch {
+	case query.Polygon != nil && query.BoundingBox != nil:
+		return nil, errs.NewDomainValidationError("polygon", "polygon and bbox are mutually exclusive")
+	case query.Polygon != nil:
+		return h.repo.FindInPolygon(ctx, *query.Polygon, match)
+	case query.BoundingBox != nil:
+		return h.searchBoundingBox(ctx, *query.BoundingBox, match)
+	default:
+		return nil, errs.NewDomainValidationError("polygon", "either polygon or bbox is required")
+	}
+}
+
+// searchBoundingBox loads quests with any location in the box and keeps those matching the mode.
+func (h *searchQuestsByAreaHandler) searchBoundingBox(ctx context.Context, bbox kernel.BoundingBox, match quest.LocationMatch) ([]quest.Quest, error) {
+	candidates, err := h.repo.FindByBoundingBox(ctx, bbox)
+	if err != nil {
+		return nil, err
+	}
+
+	quests := make([]quest.Quest, 0, len(candidates))
+	for _, q := range candidates {
+		if match.Matches(q, bbox.Contains) {
+			quests = append(quests, q)
+		}
+	}
+	return quests, nil
+}
@@ -6,11 +6,51 @@ import (
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
 	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/errs"
 )
 
+// RadiusSort defines the order of radius search results.
+type RadiusSort = quest.RadiusSort
+
+const (
+	// RadiusSortDistance orders results closest first (default)
+	RadiusSortDistance = quest.RadiusSortDistance
+	// RadiusSortCreatedAt orders results newest first
+	RadiusSortCreatedAt = quest.RadiusSortCreatedAt
+	// RadiusSortReward orders results by highest reward first
+	RadiusSortReward = quest.RadiusSortReward
+
+	// MaxRadiusSearchLimit caps the number of results returned by radius search
+	MaxRadiusSearchLimit = 500
+)
+
+// SearchQuestsByRadiusQuery contains radius search parameters.
+// Empty SortBy means RadiusSortDistance, zero Limit means no limit.
+type SearchQuestsByRadiusQuery struct {
+	Center   kernel.GeoCoordinate
+	RadiusKm float64
+	SortBy   RadiusSort
+	Limit    int
+}
+
+// QuestWithDistance is a radius search result with distances (km) from the search center.
+type QuestWithDistance struct {
+	Quest               quest.Quest
+	TargetDistanceKm    float64
+	ExecutionDistanceKm float64
+}
+
+// NearestDistanceKm returns the distance to the nearer of target and execution locations.
+func (r QuestWithDistance) NearestDistanceKm() float64 {
+	if r.TargetDistanceKm < r.ExecutionDistanceKm {
+		return r.TargetDistanceKm
+	}
+	return r.ExecutionDistanceKm
+}
+
 // SearchQuestsByRadiusQueryHandler defines the interface for handling quest search by radius.
 type SearchQuestsByRadiusQueryHandler interface {
-	Handle(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64) ([]quest.Quest, error)
+	Handle(ctx context.Context, query SearchQuestsByRadiusQuery) ([]QuestWithDistance, error)
 }
 
 type searchQuestsByRadiusHandler struct {
@@ -23,28 +63,33 @@ func NewSearchQuestsByRadiusQueryHandler(repo ports.QuestRepository) SearchQuest
 }
 
 // Handle retrieves quests within the specified radius from the center coordinate.
-// This method contains the business logic for geospatial search:
-// 1. Calculate bounding box for efficient database query
-// 2. Filter results by exact radius using Haversine distance
-func (h *searchQuestsByRadiusHandler) Handle(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64) ([]quest.Quest, error) {
-	// Step 1: Calculate bounding box using domain model
-	bbox := center.BoundingBoxForRadius(radiusKm)
-
-	// Step 2: Get candidates from repository using simple bounding box query
-	candidates, err := h.repo.FindByBoundingBox(ctx, bbox)
+// Distance filtering, ordering and the limit are applied by the repository (PostGIS or
+// Haversine in SQL); the handler attaches distances to both locations.
+func (h *searchQuestsByRadiusHandler) Handle(ctx context.Context, query SearchQuestsByRadiusQuery) ([]QuestWithDistance, error) {
+	sortBy := query.SortBy
+	if sortBy == "" {
+		sortBy = RadiusSortDistance
+	}
+	if !sortBy.IsValid() {
+		return nil, errs.NewDomainValidationError("sort", "must be one of 'distance', 'created_at', 'reward'")
+	}
+	if query.Limit < 0 || query.Limit > MaxRadiusSearchLimit {
+		return nil, errs.NewDomainValidationError("limit", "must be between 1 and 500")
+	}
+
+	quests, err := h.repo.FindWithinRadius(ctx, query.Center, query.RadiusKm, sortBy, query.Limit)
 	if err != nil {
 		return nil, err
 	}
 
-	// Step 3: Apply business logic - filter by exact radius using accurate Haversine distance
-	var result []quest.Quest
-	for _, q := range candidates {
-		// Check if either target location OR execution location is within radius
-		if center.DistanceTo(q.TargetLocation) <= radiusKm ||
-			center.DistanceTo(q.ExecutionLocation) <= radiusKm {
-			result = append(result, q)
+	results := make([]QuestWithDistance, len(quests))
+	for i, q := range quests {
+		results[i] = QuestWithDistance{
+			Quest:               q,
+			TargetDistanceKm:    query.Center.DistanceTo(q.TargetLocation),
+			ExecutionDistanceKm: query.Center.DistanceTo(q.ExecutionLocation),
 		}
 	}
 
-	return result, nil
+	return results, nil
 }
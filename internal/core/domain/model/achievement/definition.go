@@ -0,0 +1,125 @@
+package achievement
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+// Criterion is what an achievement counts.
+type Criterion string
+
+const (
+	// CriterionCompletedQuests counts completed quests, optionally of one difficulty
+	CriterionCompletedQuests Criterion = "completed_quests"
+	// CriterionDistinctLocations counts the distinct execution locations of completed quests
+	CriterionDistinctLocations Criterion = "distinct_locations"
+	// CriterionAssignedQuests counts quests the user took on, optionally of one difficulty
+	CriterionAssignedQuests Criterion = "assigned_quests"
+)
+
+var codePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Definition is a rule unlocking an achievement once Count facts match the criterion.
+type Definition struct {
+	// Code identifies the achievement, e.g. "hard_worker"; lowercase letters, digits, '_' and '-'
+	Code        string
+	Title       string
+	Description string
+	Criterion   Criterion
+	Count       int
+	// Difficulty restricts quest criteria to quests of the difficulty; empty means any
+	Difficulty quest.Difficulty
+}
+
+// Validate checks that the definition can be evaluated.
+func (d Definition) Validate() error {
+	if !codePattern.MatchString(d.Code) {
+		return fmt.Errorf("code %q must be 1 to 64 lowercase letters, digits, '_' or '-'", d.Code)
+	}
+	if strings.TrimSpace(d.Title) == "" {
+		return errors.New("title is required")
+	}
+	switch d.Criterion {
+	case CriterionCompletedQuests, CriterionDistinctLocations, CriterionAssignedQuests:
+	default:
+		return fmt.Errorf("unknown criterion %q", d.Criterion)
+	}
+	if d.Count < 1 {
+		return errors.New("count must be at least 1")
+	}
+	switch d.Difficulty {
+	case "", quest.DifficultyEasy, quest.DifficultyMedium, quest.DifficultyHard:
+	default:
+		return fmt.Errorf("unknown difficulty %q", d.Difficulty)
+	}
+	return nil
+}
+
+// ValidateDefinitions validates every definition and checks that codes are unique.
+func ValidateDefinitions(definitions []Definition) error {
+	codes := make(map[string]struct{}, len(definitions))
+	for i, d := range definitions {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("achievement %d: %w", i, err)
+		}
+		if _, exists := codes[d.Code]; exists {
+			return fmt.Errorf("achievement %d: duplicate code %q", i, d.Code)
+		}
+		codes[d.Code] = struct{}{}
+	}
+	return nil
+}
+
+// Progress returns how many of the user's facts count toward the achievement, at most Count.
+func (d Definition) Progress(facts []Fact) int {
+	progress := 0
+	switch d.Criterion {
+	case CriterionCompletedQuests:
+		progress = d.countQuests(facts, FactCompleted)
+	case CriterionAssignedQuests:
+		progress = d.countQuests(facts, FactAssigned)
+	case CriterionDistinctLocations:
+		locations := make(map[string]struct{})
+		for _, f := range facts {
+			if f.Kind == FactCompleted && d.matchesDifficulty(f) && f.LocationKey != "" {
+				locations[f.LocationKey] = struct{}{}
+			}
+		}
+		progress = len(locations)
+	}
+	return min(progress, d.Count)
+}
+
+// IsMetBy reports whether the facts unlock the achievement.
+func (d Definition) IsMetBy(facts []Fact) bool {
+	return d.Progress(facts) >= d.Count
+}
+
+func (d Definition) countQuests(facts []Fact, kind FactKind) int {
+	count := 0
+	for _, f := range facts {
+		if f.Kind == kind && d.matchesDifficulty(f) {
+			count++
+		}
+	}
+	return count
+}
+
+func (d Definition) matchesDifficulty(f Fact) bool {
+	return d.Difficulty == "" || d.Difficulty == f.Difficulty
+}
+
+// DefaultDefinitions returns the achievements used when no definitions file is configured.
+func DefaultDefinitions() []Definition {
+	return []Definition{
+		{Code: "first_steps", Title: "First Steps", Description: "Take on your first quest", Criterion: CriterionAssignedQuests, Count: 1},
+		{Code: "first_quest", Title: "Quest Completed", Description: "Complete your first quest", Criterion: CriterionCompletedQuests, Count: 1},
+		{Code: "seasoned", Title: "Seasoned", Description: "Complete 25 quests", Criterion: CriterionCompletedQuests, Count: 25},
+		{Code: "hard_worker", Title: "Hard Worker", Description: "Complete 10 hard quests", Criterion: CriterionCompletedQuests, Count: 10, Difficulty: quest.DifficultyHard},
+		{Code: "explorer", Title: "Explorer", Description: "Complete quests in 5 distinct locations", Criterion: CriterionDistinctLocations, Count: 5},
+	}
+}
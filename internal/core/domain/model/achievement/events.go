@@ -0,0 +1,21 @@
+package achievement
+
+import (
+	"quest-manager/internal/pkg/ddd"
+)
+
+// AchievementUnlocked represents an achievement awarded to a user
+type AchievementUnlocked struct {
+	ddd.BaseEvent
+	Code  string `json:"code"`
+	Title string `json:"title"`
+}
+
+// NewAchievementUnlocked creates the event for the award; the aggregate is the user.
+func NewAchievementUnlocked(unlocked Unlocked) AchievementUnlocked {
+	return AchievementUnlocked{
+		BaseEvent: ddd.NewBaseEvent(unlocked.UserID, "user.achievement_unlocked"),
+		Code:      unlocked.Code,
+		Title:     unlocked.Title,
+	}
+}
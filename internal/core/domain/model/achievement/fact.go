@@ -0,0 +1,45 @@
+package achievement
+
+import (
+	"time"
+
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// FactKind tells what a user did with a quest.
+type FactKind string
+
+const (
+	FactAssigned  FactKind = "assigned"
+	FactCompleted FactKind = "completed"
+)
+
+// LocationGeohashPrecision is the geohash length (about 150 m) that tells locations apart.
+// Quests store a location record each, so nearby quests are matched by their geohash cell.
+const LocationGeohashPrecision = 7
+
+// Fact is something a user did that achievements count. There is at most one fact
+// per user, quest and kind, so that handling an event again changes nothing.
+type Fact struct {
+	UserID     uuid.UUID
+	QuestID    uuid.UUID
+	Kind       FactKind
+	Difficulty quest.Difficulty
+	// LocationKey is the geohash cell of the execution location of the quest
+	LocationKey string
+	OccurredAt  time.Time
+}
+
+// NewQuestFact records that the user was assigned or completed the quest.
+func NewQuestFact(userID uuid.UUID, q quest.Quest, kind FactKind, occurredAt time.Time) Fact {
+	return Fact{
+		UserID:      userID,
+		QuestID:     q.ID(),
+		Kind:        kind,
+		Difficulty:  q.Difficulty,
+		LocationKey: q.ExecutionLocation.Geohash(LocationGeohashPrecision),
+		OccurredAt:  occurredAt,
+	}
+}
@@ -0,0 +1,26 @@
+package achievement
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Unlocked is an achievement awarded to a user. It keeps the title it was unlocked with,
+// so it stays listed even if its definition is changed or removed later.
+type Unlocked struct {
+	UserID     uuid.UUID
+	Code       string
+	Title      string
+	UnlockedAt time.Time
+}
+
+// Unlock awards the achievement of the definition to the user.
+func Unlock(userID uuid.UUID, definition Definition, unlockedAt time.Time) Unlocked {
+	return Unlocked{
+		UserID:     userID,
+		Code:       definition.Code,
+		Title:      definition.Title,
+		UnlockedAt: unlockedAt,
+	}
+}
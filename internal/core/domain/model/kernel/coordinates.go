@@ -10,8 +10,7 @@ const (
 	MaxLatitude   = 90.0
 	MinLongitude  = -180.0
 	MaxLongitude  = 180.0
-	earthRadiusKm = 6371.0
-	epsilon       = 1e-12
+	EarthRadiusKm = 6371.0 // mean radius used for great-circle distances
 )
 
 type GeoCoordinate struct {
@@ -20,6 +19,10 @@ type GeoCoordinate struct {
 }
 
 // BoundingBox represents a geographical bounding box.
+//
+// Latitudes are always within [-90, 90] and longitudes within [-180, 180].
+// A box that crosses the antimeridian has MinLon > MaxLon (the GeoJSON convention):
+// it covers [MinLon, 180] and [-180, MaxLon]. Use LongitudeRanges to query it.
 type BoundingBox struct {
 	MinLat float64
 	MaxLat float64
@@ -27,6 +30,42 @@ type BoundingBox struct {
 	MaxLon float64
 }
 
+// LongitudeRange is a contiguous longitude interval with Min <= Max.
+type LongitudeRange struct {
+	Min float64
+	Max float64
+}
+
+// CrossesAntimeridian reports whether the box wraps around the 180th meridian.
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.MinLon > b.MaxLon
+}
+
+// LongitudeRanges splits the box into contiguous longitude intervals:
+// one for a regular box, two for a box that crosses the antimeridian.
+func (b BoundingBox) LongitudeRanges() []LongitudeRange {
+	if b.CrossesAntimeridian() {
+		return []LongitudeRange{
+			{Min: b.MinLon, Max: MaxLongitude},
+			{Min: MinLongitude, Max: b.MaxLon},
+		}
+	}
+	return []LongitudeRange{{Min: b.MinLon, Max: b.MaxLon}}
+}
+
+// Contains reports whether the coordinate lies inside the box (edges included).
+func (b BoundingBox) Contains(c GeoCoordinate) bool {
+	if c.Lat < b.MinLat || c.Lat > b.MaxLat {
+		return false
+	}
+	for _, r := range b.LongitudeRanges() {
+		if c.Lon >= r.Min && c.Lon <= r.Max {
+			return true
+		}
+	}
+	return false
+}
+
 // NewGeoCoordinate creates a new coordinate with validation.
 func NewGeoCoordinate(lat, lon float64) (GeoCoordinate, error) {
 	if lat < MinLatitude || lat > MaxLatitude {
@@ -58,37 +97,61 @@ func (g GeoCoordinate) DistanceTo(other GeoCoordinate) float64 {
 		math.Cos(lat1Rad)*math.Cos(lat2Rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
 	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 
-	return earthRadiusKm * c
+	return EarthRadiusKm * c
 }
 
-// BoundingBoxForRadius calculates a bounding box for the given radius in kilometers.
-// This provides an approximate rectangular area that encompasses all points within the radius.
+// BoundingBoxForRadius calculates the smallest bounding box that contains every point
+// within radiusKm (great-circle distance) of the coordinate.
 //
-// Near the poles (|latitude| ≈ 90°), the longitude radius approaches infinity as
-// meridians converge. In these cases the longitude radius is clamped to cover the
-// full range of longitudes (180°).
+// Near the antimeridian the box wraps (MinLon > MaxLon, see BoundingBox).
+// If the circle reaches a pole, latitude is clamped to ±90 and the box covers
+// all longitudes, since meridians converge there.
 func (g GeoCoordinate) BoundingBoxForRadius(radiusKm float64) BoundingBox {
-	// Calculate latitude radius in degrees (1 degree latitude ≈ 111.32 km)
-	latRadiusInDegrees := radiusKm / 111.32
-
-	// Calculate longitude radius in degrees, adjusted for latitude distortion
-	// At latitude, 1 degree longitude = cos(latitude) * 111.32 km
-	cosLat := math.Cos(g.Lat * math.Pi / 180.0)
-	var lonRadiusInDegrees float64
-	if math.Abs(cosLat) < epsilon {
-		lonRadiusInDegrees = 180.0
-	} else {
-		lonRadiusInDegrees = radiusKm / (111.32 * cosLat)
+	// Angular radius on the sphere, in radians
+	angular := radiusKm / EarthRadiusKm
+
+	latRad := degreesToRadians(g.Lat)
+	minLatRad := latRad - angular
+	maxLatRad := latRad + angular
+
+	if minLatRad <= -math.Pi/2 || maxLatRad >= math.Pi/2 {
+		return BoundingBox{
+			MinLat: math.Max(radiansToDegrees(minLatRad), MinLatitude),
+			MaxLat: math.Min(radiansToDegrees(maxLatRad), MaxLatitude),
+			MinLon: MinLongitude,
+			MaxLon: MaxLongitude,
+		}
+	}
+
+	// Largest longitude offset reached by the circle (at its tangent points), at most 90°
+	deltaLon := radiansToDegrees(math.Asin(math.Sin(angular) / math.Cos(latRad)))
+
+	// Wrap around the antimeridian instead of leaving [-180, 180]
+	minLon := g.Lon - deltaLon
+	if minLon < MinLongitude {
+		minLon += 360
+	}
+	maxLon := g.Lon + deltaLon
+	if maxLon > MaxLongitude {
+		maxLon -= 360
 	}
 
 	return BoundingBox{
-		MinLat: g.Lat - latRadiusInDegrees,
-		MaxLat: g.Lat + latRadiusInDegrees,
-		MinLon: g.Lon - lonRadiusInDegrees,
-		MaxLon: g.Lon + lonRadiusInDegrees,
+		MinLat: radiansToDegrees(minLatRad),
+		MaxLat: radiansToDegrees(maxLatRad),
+		MinLon: minLon,
+		MaxLon: maxLon,
 	}
 }
 
+func degreesToRadians(deg float64) float64 {
+	return deg * math.Pi / 180.0
+}
+
+func radiansToDegrees(rad float64) float64 {
+	return rad * 180.0 / math.Pi
+}
+
 func (g GeoCoordinate) Equals(other GeoCoordinate) bool {
 	return g.Lat == other.Lat && g.Lon == other.Lon
 }
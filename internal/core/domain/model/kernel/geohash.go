@@ -0,0 +1,254 @@
+package kernel
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// GeohashMaxPrecision is the longest supported geohash (cells of about 3.7cm x 1.9cm).
+const GeohashMaxPrecision = 12
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash encodes the coordinate as a base32 geohash of the given length.
+// Precision is clamped to [1, GeohashMaxPrecision]. A longer hash always starts
+// with the shorter ones, so prefix matching finds every coordinate inside a cell.
+func (g GeoCoordinate) Geohash(precision int) string {
+	precision = clampGeohashPrecision(precision)
+
+	minLat, maxLat := MinLatitude, MaxLatitude
+	minLon, maxLon := MinLongitude, MaxLongitude
+
+	var sb strings.Builder
+	sb.Grow(precision)
+	bits, ch, even := 0, 0, true
+	for sb.Len() < precision {
+		if even {
+			mid := (minLon + maxLon) / 2
+			if g.Lon >= mid {
+				ch = ch<<1 | 1
+				minLon = mid
+			} else {
+				ch <<= 1
+				maxLon = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if g.Lat >= mid {
+				ch = ch<<1 | 1
+				minLat = mid
+			} else {
+				ch <<= 1
+				maxLat = mid
+			}
+		}
+		even = !even
+
+		bits++
+		if bits == 5 {
+			sb.WriteByte(geohashAlphabet[ch])
+			bits, ch = 0, 0
+		}
+	}
+	return sb.String()
+}
+
+// GeohashBoundingBox returns the cell covered by the geohash.
+func GeohashBoundingBox(hash string) (BoundingBox, error) {
+	if hash == "" || len(hash) > GeohashMaxPrecision {
+		return BoundingBox{}, fmt.Errorf("geohash length must be between 1 and %d", GeohashMaxPrecision)
+	}
+
+	bbox := BoundingBox{MinLat: MinLatitude, MaxLat: MaxLatitude, MinLon: MinLongitude, MaxLon: MaxLongitude}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		ch := strings.IndexByte(geohashAlphabet, hash[i])
+		if ch < 0 {
+			return BoundingBox{}, fmt.Errorf("geohash %q contains invalid character %q", hash, hash[i])
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			if even {
+				mid := (bbox.MinLon + bbox.MaxLon) / 2
+				if ch&mask != 0 {
+					bbox.MinLon = mid
+				} else {
+					bbox.MaxLon = mid
+				}
+			} else {
+				mid := (bbox.MinLat + bbox.MaxLat) / 2
+				if ch&mask != 0 {
+					bbox.MinLat = mid
+				} else {
+					bbox.MaxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return bbox, nil
+}
+
+// DecodeGeohash returns the center of the geohash cell.
+func DecodeGeohash(hash string) (GeoCoordinate, error) {
+	bbox, err := GeohashBoundingBox(hash)
+	if err != nil {
+		return GeoCoordinate{}, err
+	}
+	return GeoCoordinate{
+		Lat: (bbox.MinLat + bbox.MaxLat) / 2,
+		Lon: (bbox.MinLon + bbox.MaxLon) / 2,
+	}, nil
+}
+
+// GeohashNeighbors returns the cells of the same precision surrounding the geohash,
+// clockwise from north: N, NE, E, SE, S, SW, W, NW.
+// Longitude wraps around the antimeridian; cells beyond a pole are omitted.
+func GeohashNeighbors(hash string) ([]string, error) {
+	bbox, err := GeohashBoundingBox(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	height := bbox.MaxLat - bbox.MinLat
+	width := bbox.MaxLon - bbox.MinLon
+	center := GeoCoordinate{Lat: (bbox.MinLat + bbox.MaxLat) / 2, Lon: (bbox.MinLon + bbox.MaxLon) / 2}
+
+	offsets := [][2]float64{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+	neighbors := make([]string, 0, len(offsets))
+	for _, o := range offsets {
+		lat := center.Lat + o[0]*height
+		if lat > MaxLatitude || lat < MinLatitude {
+			continue
+		}
+		lon := wrapLongitude(center.Lon + o[1]*width)
+		neighbors = append(neighbors, GeoCoordinate{Lat: lat, Lon: lon}.Geohash(len(hash)))
+	}
+	return neighbors, nil
+}
+
+// GeohashPrecisionForRadius returns the longest precision whose cell is at least as large
+// as the bounding box of the radius, so that the cell of the coordinate and its neighbors
+// cover every point within radiusKm. Returns 0 when no precision is coarse enough
+// (the circle reaches a pole or spans more than a top-level cell).
+func (g GeoCoordinate) GeohashPrecisionForRadius(radiusKm float64) int {
+	bbox := g.BoundingBoxForRadius(radiusKm)
+	if bbox.MinLat <= MinLatitude || bbox.MaxLat >= MaxLatitude {
+		return 0
+	}
+
+	halfHeight := math.Max(g.Lat-bbox.MinLat, bbox.MaxLat-g.Lat)
+	halfWidth := wrapLongitude(bbox.MaxLon - g.Lon) // symmetric around the coordinate
+
+	for precision := GeohashMaxPrecision; precision >= 1; precision-- {
+		height, width := geohashCellSize(precision)
+		if height >= halfHeight && width >= halfWidth {
+			return precision
+		}
+	}
+	return 0
+}
+
+// GeohashesForRadius returns the geohash prefixes (the cell of the coordinate and its neighbors)
+// that together cover every point within radiusKm. Returns nil when the radius is too large
+// for a prefix search; callers should fall back to a bounding box query.
+func (g GeoCoordinate) GeohashesForRadius(radiusKm float64) []string {
+	precision := g.GeohashPrecisionForRadius(radiusKm)
+	if precision == 0 {
+		return nil
+	}
+	hash := g.Geohash(precision)
+	neighbors, _ := GeohashNeighbors(hash)
+	return append([]string{hash}, neighbors...)
+}
+
+// Geohashes returns geohash prefixes covering the box with at most maxCells cells,
+// using the longest precision that fits. Returns nil when even single-character
+// cells exceed maxCells; callers should fall back to a plain bounding box query.
+func (b BoundingBox) Geohashes(maxCells int) []string {
+	for precision := GeohashMaxPrecision; precision >= 1; precision-- {
+		if cells := b.geohashCells(precision, maxCells); cells != nil {
+			return cells
+		}
+	}
+	return nil
+}
+
+// geohashCells lists the cells of the given precision overlapping the box,
+// or returns nil if there are more than maxCells of them.
+func (b BoundingBox) geohashCells(precision, maxCells int) []string {
+	height, width := geohashCellSize(precision)
+
+	rows := geohashCellIndex(b.MaxLat, MinLatitude, MaxLatitude, height) -
+		geohashCellIndex(b.MinLat, MinLatitude, MaxLatitude, height) + 1
+	cols := 0
+	for _, r := range b.LongitudeRanges() {
+		cols += geohashCellIndex(r.Max, MinLongitude, MaxLongitude, width) -
+			geohashCellIndex(r.Min, MinLongitude, MaxLongitude, width) + 1
+	}
+	if rows*cols > maxCells {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, rows*cols)
+	cells := make([]string, 0, rows*cols)
+	for _, r := range b.LongitudeRanges() {
+		for lat := b.MinLat; ; lat += height {
+			lat = math.Min(lat, b.MaxLat)
+			for lon := r.Min; ; lon += width {
+				lon = math.Min(lon, r.Max)
+				hash := GeoCoordinate{Lat: lat, Lon: lon}.Geohash(precision)
+				if _, ok := seen[hash]; !ok {
+					seen[hash] = struct{}{}
+					cells = append(cells, hash)
+				}
+				if lon >= r.Max {
+					break
+				}
+			}
+			if lat >= b.MaxLat {
+				break
+			}
+		}
+	}
+	return cells
+}
+
+// geohashCellSize returns the height and width of a geohash cell in degrees.
+func geohashCellSize(precision int) (height, width float64) {
+	bits := precision * 5
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 180.0 / math.Exp2(float64(latBits)), 360.0 / math.Exp2(float64(lonBits))
+}
+
+// geohashCellIndex returns the index of the cell containing v along one axis;
+// the upper bound belongs to the last cell, as in Geohash.
+func geohashCellIndex(v, min, max, size float64) int {
+	i := int(math.Floor((v - min) / size))
+	if last := int(math.Round((max-min)/size)) - 1; i > last {
+		return last
+	}
+	return i
+}
+
+func clampGeohashPrecision(precision int) int {
+	if precision < 1 {
+		return 1
+	}
+	if precision > GeohashMaxPrecision {
+		return GeohashMaxPrecision
+	}
+	return precision
+}
+
+// wrapLongitude normalizes a longitude into [-180, 180).
+func wrapLongitude(lon float64) float64 {
+	for lon >= MaxLongitude {
+		lon -= 360
+	}
+	for lon < MinLongitude {
+		lon += 360
+	}
+	return lon
+}
@@ -0,0 +1,162 @@
+package kernel
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MaxPolygonVertices limits the total number of vertices (all rings) of a polygon.
+const MaxPolygonVertices = 1000
+
+// ErrPolygonCrossesAntimeridian is returned for rings with an edge spanning 180 degrees
+// of longitude or more, which would be read as crossing the antimeridian.
+var ErrPolygonCrossesAntimeridian = errors.New("polygon edges must span less than 180 degrees of longitude, polygons crossing the antimeridian are not supported")
+
+// Polygon is a polygon with an exterior ring and optional holes.
+//
+// Edges are straight lines in longitude/latitude space (like GeoJSON); the PostGIS
+// backend follows geodesics instead, which only differ noticeably for large polygons.
+// Polygons must not cross the antimeridian.
+// Points on the boundary, including hole boundaries, are considered inside.
+type Polygon struct {
+	exterior []GeoCoordinate
+	holes    [][]GeoCoordinate
+}
+
+// NewPolygon creates a polygon from its exterior ring and optional holes.
+// Rings may be open or closed (first point repeated at the end); each ring
+// must have at least 3 distinct vertices.
+func NewPolygon(exterior []GeoCoordinate, holes ...[]GeoCoordinate) (Polygon, error) {
+	ext, err := normalizeRing(exterior)
+	if err != nil {
+		return Polygon{}, fmt.Errorf("exterior ring: %w", err)
+	}
+	total := len(ext)
+
+	normalizedHoles := make([][]GeoCoordinate, 0, len(holes))
+	for i, hole := range holes {
+		h, err := normalizeRing(hole)
+		if err != nil {
+			return Polygon{}, fmt.Errorf("hole %d: %w", i, err)
+		}
+		total += len(h)
+		normalizedHoles = append(normalizedHoles, h)
+	}
+
+	if total > MaxPolygonVertices {
+		return Polygon{}, fmt.Errorf("polygon has %d vertices, maximum is %d", total, MaxPolygonVertices)
+	}
+
+	return Polygon{exterior: ext, holes: normalizedHoles}, nil
+}
+
+// Exterior returns the vertices of the exterior ring (open, without the closing point).
+func (p Polygon) Exterior() []GeoCoordinate {
+	return append([]GeoCoordinate(nil), p.exterior...)
+}
+
+// Holes returns the vertices of every hole (open rings).
+func (p Polygon) Holes() [][]GeoCoordinate {
+	holes := make([][]GeoCoordinate, len(p.holes))
+	for i, h := range p.holes {
+		holes[i] = append([]GeoCoordinate(nil), h...)
+	}
+	return holes
+}
+
+// BoundingBox returns the smallest box enclosing the exterior ring.
+func (p Polygon) BoundingBox() BoundingBox {
+	bbox := BoundingBox{
+		MinLat: math.Inf(1), MaxLat: math.Inf(-1),
+		MinLon: math.Inf(1), MaxLon: math.Inf(-1),
+	}
+	for _, c := range p.exterior {
+		bbox.MinLat = math.Min(bbox.MinLat, c.Lat)
+		bbox.MaxLat = math.Max(bbox.MaxLat, c.Lat)
+		bbox.MinLon = math.Min(bbox.MinLon, c.Lon)
+		bbox.MaxLon = math.Max(bbox.MaxLon, c.Lon)
+	}
+	return bbox
+}
+
+// Contains reports whether the coordinate lies inside the polygon or on its boundary.
+func (p Polygon) Contains(c GeoCoordinate) bool {
+	inside, onBoundary := ringContains(p.exterior, c)
+	if onBoundary {
+		return true
+	}
+	if !inside {
+		return false
+	}
+
+	for _, hole := range p.holes {
+		inHole, onHoleBoundary := ringContains(hole, c)
+		if onHoleBoundary {
+			return true
+		}
+		if inHole {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeRing validates ring vertices and drops the closing point if present.
+func normalizeRing(ring []GeoCoordinate) ([]GeoCoordinate, error) {
+	if len(ring) > 1 && ring[0].Equals(ring[len(ring)-1]) {
+		ring = ring[:len(ring)-1]
+	}
+	if len(ring) < 3 {
+		return nil, errors.New("ring must have at least 3 distinct vertices")
+	}
+
+	normalized := make([]GeoCoordinate, len(ring))
+	for i, c := range ring {
+		valid, err := NewGeoCoordinate(c.Lat, c.Lon)
+		if err != nil {
+			return nil, err
+		}
+		normalized[i] = valid
+	}
+	for i, c := range normalized {
+		next := normalized[(i+1)%len(normalized)]
+		if math.Abs(next.Lon-c.Lon) >= 180 {
+			return nil, ErrPolygonCrossesAntimeridian
+		}
+	}
+	return normalized, nil
+}
+
+// ringContains runs the even-odd ray casting test for a ring.
+// onBoundary is true when the point lies on one of the ring edges.
+func ringContains(ring []GeoCoordinate, c GeoCoordinate) (inside, onBoundary bool) {
+	n := len(ring)
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		a, b := ring[j], ring[i]
+
+		if onSegment(a, b, c) {
+			return false, true
+		}
+
+		// Edge crosses the horizontal ray going east from c
+		if (a.Lat > c.Lat) != (b.Lat > c.Lat) {
+			crossLon := a.Lon + (c.Lat-a.Lat)*(b.Lon-a.Lon)/(b.Lat-a.Lat)
+			if c.Lon < crossLon {
+				inside = !inside
+			}
+		}
+	}
+	return inside, false
+}
+
+// onSegment reports whether c lies on the segment a-b.
+func onSegment(a, b, c GeoCoordinate) bool {
+	const tolerance = 1e-12
+	cross := (b.Lon-a.Lon)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lon-a.Lon)
+	if math.Abs(cross) > tolerance {
+		return false
+	}
+	return c.Lon >= math.Min(a.Lon, b.Lon)-tolerance && c.Lon <= math.Max(a.Lon, b.Lon)+tolerance &&
+		c.Lat >= math.Min(a.Lat, b.Lat)-tolerance && c.Lat <= math.Max(a.Lat, b.Lat)+tolerance
+}
@@ -0,0 +1,114 @@
+package kernel
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// MaxTileZoom is the deepest supported slippy map zoom level.
+	MaxTileZoom = 22
+	// MaxMercatorLatitude is the latitude limit of the Web Mercator projection.
+	MaxMercatorLatitude = 85.0511287798066
+)
+
+// Tile is a slippy map tile (Web Mercator, XYZ scheme: x grows east, y grows south).
+type Tile struct {
+	Z int
+	X int
+	Y int
+}
+
+// NewTile creates a tile with validation of zoom and x/y ranges.
+func NewTile(z, x, y int) (Tile, error) {
+	if z < 0 || z > MaxTileZoom {
+		return Tile{}, fmt.Errorf("zoom %d is out of range (0–%d)", z, MaxTileZoom)
+	}
+	n := 1 << z
+	if x < 0 || x >= n || y < 0 || y >= n {
+		return Tile{}, fmt.Errorf("tile %d/%d is out of range for zoom %d (0–%d)", x, y, z, n-1)
+	}
+	return Tile{Z: z, X: x, Y: y}, nil
+}
+
+// TileForCoordinate returns the tile at zoom z that contains the coordinate.
+// Latitudes beyond the Web Mercator limit fall into the top or bottom row,
+// longitude 180 falls into the last column.
+func TileForCoordinate(c GeoCoordinate, z int) Tile {
+	n := 1 << z
+	fx, fy := mercatorFraction(c)
+	return Tile{
+		Z: z,
+		X: clampTileIndex(int(math.Floor(fx*float64(n))), n),
+		Y: clampTileIndex(int(math.Floor(fy*float64(n))), n),
+	}
+}
+
+// BoundingBox returns the geographic bounds of the tile.
+// Tiles in the top and bottom rows extend to the poles, so that every
+// coordinate belongs to some tile.
+func (t Tile) BoundingBox() BoundingBox {
+	n := float64(int(1) << t.Z)
+	bbox := BoundingBox{
+		MinLon: float64(t.X)/n*360.0 - 180.0,
+		MaxLon: float64(t.X+1)/n*360.0 - 180.0,
+		MaxLat: tileLatitude(float64(t.Y) / n),
+		MinLat: tileLatitude(float64(t.Y+1) / n),
+	}
+	if t.Y == 0 {
+		bbox.MaxLat = MaxLatitude
+	}
+	if t.Y == int(n)-1 {
+		bbox.MinLat = MinLatitude
+	}
+	return bbox
+}
+
+// Contains reports whether the tile is the tile itself or one of its descendants.
+func (t Tile) Contains(other Tile) bool {
+	if other.Z < t.Z {
+		return false
+	}
+	shift := other.Z - t.Z
+	return other.X>>shift == t.X && other.Y>>shift == t.Y
+}
+
+// Project returns the position of the coordinate inside the tile in a
+// extent x extent pixel grid, origin at the top-left corner.
+// Coordinates outside the tile map outside [0, extent).
+func (t Tile) Project(c GeoCoordinate, extent int) (x, y int) {
+	n := float64(int(1) << t.Z)
+	fx, fy := mercatorFraction(c)
+	x = int(math.Floor((fx*n - float64(t.X)) * float64(extent)))
+	y = int(math.Floor((fy*n - float64(t.Y)) * float64(extent)))
+	return x, y
+}
+
+// String returns the tile in z/x/y form.
+func (t Tile) String() string {
+	return fmt.Sprintf("%d/%d/%d", t.Z, t.X, t.Y)
+}
+
+// mercatorFraction returns Web Mercator coordinates normalized to [0, 1] (y grows south).
+func mercatorFraction(c GeoCoordinate) (fx, fy float64) {
+	lat := math.Max(-MaxMercatorLatitude, math.Min(MaxMercatorLatitude, c.Lat))
+	latRad := degreesToRadians(lat)
+	fx = (c.Lon + 180.0) / 360.0
+	fy = (1.0 - math.Log(math.Tan(latRad)+1.0/math.Cos(latRad))/math.Pi) / 2.0
+	return fx, fy
+}
+
+// tileLatitude converts a normalized Web Mercator y back to latitude.
+func tileLatitude(fy float64) float64 {
+	return radiansToDegrees(math.Atan(math.Sinh(math.Pi * (1 - 2*fy))))
+}
+
+func clampTileIndex(i, n int) int {
+	if i < 0 {
+		return 0
+	}
+	if i >= n {
+		return n - 1
+	}
+	return i
+}
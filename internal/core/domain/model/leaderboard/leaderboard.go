@@ -0,0 +1,101 @@
+package leaderboard
+
+import (
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+)
+
+// Metric is what users are ranked by.
+type Metric string
+
+const (
+	// MetricCompleted ranks users by the number of quests they completed
+	MetricCompleted Metric = "completed"
+	// MetricPoints ranks users by the points credited to them
+	MetricPoints Metric = "points"
+)
+
+// IsValid reports whether the metric is known.
+func (m Metric) IsValid() bool {
+	return m == MetricCompleted || m == MetricPoints
+}
+
+// Score is a row of the leaderboard projection: the points of one ledger entry,
+// where and when they were earned. Leaderboards aggregate scores per user.
+type Score struct {
+	// EntryID is the ledger entry the score was projected from; one score per entry
+	EntryID uuid.UUID
+	UserID  uuid.UUID
+	QuestID *uuid.UUID
+	Points  int
+	// Completion is true when the score counts as a completed quest
+	Completion bool
+	// Location of the quest execution; nil when the points are not tied to a place
+	Location   *kernel.GeoCoordinate
+	OccurredAt time.Time
+}
+
+// NewScore creates the score of points credited to the user, not tied to a quest or place.
+func NewScore(entryID, userID uuid.UUID, points int, occurredAt time.Time) (Score, error) {
+	if entryID == uuid.Nil {
+		return Score{}, errors.New("ledger entry is required")
+	}
+	if userID == uuid.Nil {
+		return Score{}, errors.New("user is required")
+	}
+
+	return Score{
+		EntryID:    entryID,
+		UserID:     userID,
+		Points:     points,
+		OccurredAt: occurredAt,
+	}, nil
+}
+
+// NewQuestCompletionScore creates the score of a participant completing a quest at the location.
+func NewQuestCompletionScore(entryID, userID, questID uuid.UUID, points int, location kernel.GeoCoordinate, occurredAt time.Time) (Score, error) {
+	score, err := NewScore(entryID, userID, points, occurredAt)
+	if err != nil {
+		return Score{}, err
+	}
+	score.QuestID = &questID
+	score.Completion = true
+	score.Location = &location
+	return score, nil
+}
+
+// Filter selects the scores aggregated into a leaderboard. Scores count from Since on;
+// for MetricCompleted only completions count, each as one, for MetricPoints every
+// score with points counts its points.
+type Filter struct {
+	Metric Metric
+	// Since is the start of the period; earlier scores are ignored
+	Since time.Time
+	// Center and RadiusKm restrict scores to those earned within the radius; nil Center means anywhere
+	Center   *kernel.GeoCoordinate
+	RadiusKm float64
+	Limit    int
+}
+
+// Standing is the position of a user on a leaderboard.
+type Standing struct {
+	Rank   int
+	UserID uuid.UUID
+	Score  int
+}
+
+// AssignRanks sets the ranks of standings sorted by score, highest first.
+// Users with equal scores share a rank and the next rank is skipped (1, 1, 3).
+func AssignRanks(standings []Standing) {
+	for i := range standings {
+		if i > 0 && standings[i].Score == standings[i-1].Score {
+			standings[i].Rank = standings[i-1].Rank
+			continue
+		}
+		standings[i].Rank = i + 1
+	}
+}
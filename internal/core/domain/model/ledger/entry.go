@@ -0,0 +1,55 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EntryKind tells why points were credited.
+type EntryKind string
+
+const (
+	// KindQuestReward credits a participant for a completed quest
+	KindQuestReward EntryKind = "quest_reward"
+)
+
+// Entry is an append-only record of points credited to a user. Entries are never
+// changed; the balance of a user is the sum of the points of their entries.
+type Entry struct {
+	ID      uuid.UUID
+	UserID  uuid.UUID
+	Kind    EntryKind
+	Points  int
+	QuestID *uuid.UUID
+	// Unique per credited fact, so that crediting the same fact twice adds no entry
+	IdempotencyKey string
+	CreatedAt      time.Time
+}
+
+// NewQuestReward creates the entry crediting the user for completing the quest.
+func NewQuestReward(userID, questID uuid.UUID, points int) (Entry, error) {
+	if userID == uuid.Nil {
+		return Entry{}, errors.New("user is required")
+	}
+	if points <= 0 {
+		return Entry{}, errors.New("points must be greater than 0")
+	}
+
+	return Entry{
+		ID:             uuid.New(),
+		UserID:         userID,
+		Kind:           KindQuestReward,
+		Points:         points,
+		QuestID:        &questID,
+		IdempotencyKey: QuestRewardKey(questID, userID),
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// QuestRewardKey is the idempotency key of the reward for the user completing the quest
+func QuestRewardKey(questID, userID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s:%s", KindQuestReward, questID, userID)
+}
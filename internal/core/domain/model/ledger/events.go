@@ -0,0 +1,27 @@
+package ledger
+
+import (
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+// PointsCredited represents points added to the balance of a user
+type PointsCredited struct {
+	ddd.BaseEvent
+	EntryID uuid.UUID  `json:"entry_id"`
+	Kind    EntryKind  `json:"kind"`
+	Points  int        `json:"points"`
+	QuestID *uuid.UUID `json:"quest_id,omitempty"`
+}
+
+// NewPointsCredited creates the event for a new ledger entry; the aggregate is the credited user.
+func NewPointsCredited(entry Entry) PointsCredited {
+	return PointsCredited{
+		BaseEvent: ddd.NewBaseEvent(entry.UserID, "ledger.points_credited"),
+		EntryID:   entry.ID,
+		Kind:      entry.Kind,
+		Points:    entry.Points,
+		QuestID:   entry.QuestID,
+	}
+}
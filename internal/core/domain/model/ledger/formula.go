@@ -0,0 +1,60 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+// Default reward formula: 10 points per reward unit, more for harder quests
+const (
+	DefaultPointsPerReward  = 10
+	DefaultEasyMultiplier   = 1.0
+	DefaultMediumMultiplier = 1.5
+	DefaultHardMultiplier   = 2.0
+)
+
+// RewardFormula derives the points for completing a quest from its reward and difficulty:
+// reward × PointsPerReward × the multiplier of the difficulty, rounded, at least 1.
+type RewardFormula struct {
+	PointsPerReward       int
+	DifficultyMultipliers map[quest.Difficulty]float64
+}
+
+// DefaultRewardFormula returns the formula used when none is configured.
+func DefaultRewardFormula() RewardFormula {
+	return RewardFormula{
+		PointsPerReward: DefaultPointsPerReward,
+		DifficultyMultipliers: map[quest.Difficulty]float64{
+			quest.DifficultyEasy:   DefaultEasyMultiplier,
+			quest.DifficultyMedium: DefaultMediumMultiplier,
+			quest.DifficultyHard:   DefaultHardMultiplier,
+		},
+	}
+}
+
+// Validate checks that the formula grants positive points for every difficulty.
+func (f RewardFormula) Validate() error {
+	if f.PointsPerReward <= 0 {
+		return errors.New("points per reward must be greater than 0")
+	}
+	for _, d := range []quest.Difficulty{quest.DifficultyEasy, quest.DifficultyMedium, quest.DifficultyHard} {
+		multiplier, ok := f.DifficultyMultipliers[d]
+		if !ok || multiplier <= 0 || math.IsInf(multiplier, 0) || math.IsNaN(multiplier) {
+			return fmt.Errorf("multiplier for difficulty %q must be greater than 0", d)
+		}
+	}
+	return nil
+}
+
+// Points returns the points for completing a quest with the reward and difficulty.
+func (f RewardFormula) Points(reward int, difficulty quest.Difficulty) int {
+	multiplier, ok := f.DifficultyMultipliers[difficulty]
+	if !ok {
+		multiplier = 1
+	}
+	points := int(math.Round(float64(reward*f.PointsPerReward) * multiplier))
+	return max(points, 1)
+}
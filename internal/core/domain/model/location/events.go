@@ -11,13 +11,15 @@ import (
 type LocationCreated struct {
 	ddd.BaseEvent
 	Coordinate kernel.GeoCoordinate `json:"coordinate"`
+	Name       *string              `json:"name,omitempty"`
 	Address    *string              `json:"address,omitempty"`
 }
 
-func NewLocationCreated(locationID uuid.UUID, coordinate kernel.GeoCoordinate, address *string) LocationCreated {
+func NewLocationCreated(locationID uuid.UUID, coordinate kernel.GeoCoordinate, name, address *string) LocationCreated {
 	return LocationCreated{
 		BaseEvent:  ddd.NewBaseEvent(locationID, "location.created"),
 		Coordinate: coordinate,
+		Name:       name,
 		Address:    address,
 	}
 }
@@ -26,13 +28,15 @@ func NewLocationCreated(locationID uuid.UUID, coordinate kernel.GeoCoordinate, a
 type LocationUpdated struct {
 	ddd.BaseEvent
 	Coordinate kernel.GeoCoordinate `json:"coordinate"`
+	Name       *string              `json:"name,omitempty"`
 	Address    *string              `json:"address,omitempty"`
 }
 
-func NewLocationUpdated(locationID uuid.UUID, coordinate kernel.GeoCoordinate, address *string) LocationUpdated {
+func NewLocationUpdated(locationID uuid.UUID, coordinate kernel.GeoCoordinate, name, address *string) LocationUpdated {
 	return LocationUpdated{
 		BaseEvent:  ddd.NewBaseEvent(locationID, "location.updated"),
 		Coordinate: coordinate,
+		Name:       name,
 		Address:    address,
 	}
 }
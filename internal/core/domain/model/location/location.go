@@ -1,6 +1,8 @@
 package location
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"quest-manager/internal/core/domain/model/kernel"
@@ -9,30 +11,51 @@ import (
 	"github.com/google/uuid"
 )
 
+// MaxNameLength is the maximum length of a location name
+const MaxNameLength = 200
+
 // Location represents a geographic location that can be reused across quests
 type Location struct {
 	*ddd.BaseAggregate[uuid.UUID]
 	Coordinate kernel.GeoCoordinate
+	Name       *string
 	Address    *string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
 
+// SearchMatch is a location found by text search together with its relevance score (0..1)
+type SearchMatch struct {
+	Location *Location
+	Score    float64
+}
+
 // NewLocation creates a new location with validation
 func NewLocation(coordinate kernel.GeoCoordinate, address *string) (*Location, error) {
+	return NewNamedLocation(coordinate, nil, address)
+}
+
+// NewNamedLocation creates a new location with an optional human-readable name
+func NewNamedLocation(coordinate kernel.GeoCoordinate, name, address *string) (*Location, error) {
+	normalizedName, err := normalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
 	id := uuid.New()
 	now := time.Now()
 
 	location := &Location{
 		BaseAggregate: ddd.NewBaseAggregate(id),
 		Coordinate:    coordinate,
+		Name:          normalizedName,
 		Address:       address,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
 
 	// Raise domain event
-	location.RaiseDomainEvent(NewLocationCreated(id, coordinate, address))
+	location.RaiseDomainEvent(NewLocationCreated(id, coordinate, normalizedName, address))
 
 	return location, nil
 }
@@ -44,7 +67,39 @@ func (l *Location) Update(coordinate kernel.GeoCoordinate, address *string) erro
 	l.UpdatedAt = time.Now()
 
 	// Raise domain event
-	l.RaiseDomainEvent(NewLocationUpdated(l.ID(), coordinate, address))
+	l.RaiseDomainEvent(NewLocationUpdated(l.ID(), coordinate, l.Name, address))
+
+	return nil
+}
+
+// Rename changes the location name. Passing nil removes the name.
+func (l *Location) Rename(name *string) error {
+	normalizedName, err := normalizeName(name)
+	if err != nil {
+		return err
+	}
+
+	l.Name = normalizedName
+	l.UpdatedAt = time.Now()
+
+	// Raise domain event
+	l.RaiseDomainEvent(NewLocationUpdated(l.ID(), l.Coordinate, l.Name, l.Address))
 
 	return nil
 }
+
+// normalizeName trims the name and validates its length
+func normalizeName(name *string) (*string, error) {
+	if name == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*name)
+	if trimmed == "" {
+		return nil, errors.New("location name must not be empty")
+	}
+	if len([]rune(trimmed)) > MaxNameLength {
+		return nil, errors.New("location name is too long, maximum is 200 characters")
+	}
+	return &trimmed, nil
+}
@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// Kind identifies what a notification is about; users choose the kinds they receive.
+type Kind string
+
+const (
+	// KindQuestAssigned tells the creator that someone took their quest
+	KindQuestAssigned Kind = "quest_assigned"
+	// KindQuestStatusChanged tells the creator and the participants about a new quest status
+	KindQuestStatusChanged Kind = "quest_status_changed"
+	// KindQuestCommentAdded tells the creator and the participants about a new comment
+	KindQuestCommentAdded Kind = "quest_comment_added"
+)
+
+// Kinds returns every notification kind in a stable order.
+func Kinds() []Kind {
+	return []Kind{KindQuestAssigned, KindQuestStatusChanged, KindQuestCommentAdded}
+}
+
+// IsValidKind checks if string is a valid notification kind
+func IsValidKind(kind string) bool {
+	for _, k := range Kinds() {
+		if string(k) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification is a message in the inbox of a user.
+type Notification struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Kind      Kind
+	QuestID   uuid.UUID
+	Message   string
+	CreatedAt time.Time
+	ReadAt    *time.Time
+}
+
+// New creates an unread notification of the user about the quest.
+func New(userID uuid.UUID, kind Kind, questID uuid.UUID, message string, createdAt time.Time) (Notification, error) {
+	if userID == uuid.Nil {
+		return Notification{}, errors.New("user is required")
+	}
+	if !IsValidKind(string(kind)) {
+		return Notification{}, fmt.Errorf("unknown notification kind %q", kind)
+	}
+	if message == "" {
+		return Notification{}, errors.New("message is required")
+	}
+	return Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Kind:      kind,
+		QuestID:   questID,
+		Message:   message,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// QuestAssignedMessage is the text of a KindQuestAssigned notification.
+func QuestAssignedMessage(q quest.Quest) string {
+	return fmt.Sprintf("Your quest %q was taken", q.Title)
+}
+
+// QuestStatusChangedMessage is the text of a KindQuestStatusChanged notification.
+func QuestStatusChangedMessage(q quest.Quest, status quest.Status) string {
+	return fmt.Sprintf("Quest %q is now %s", q.Title, status)
+}
+
+// QuestCommentAddedMessage is the text of a KindQuestCommentAdded notification.
+func QuestCommentAddedMessage(q quest.Quest) string {
+	return fmt.Sprintf("New comment on quest %q", q.Title)
+}
+
+// IsRead reports whether the user has read the notification.
+func (n Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// MarkRead marks the notification read. Marking a read notification again keeps the first time.
+func (n *Notification) MarkRead(at time.Time) {
+	if n.ReadAt == nil {
+		n.ReadAt = &at
+	}
+}
@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Preferences tell which kinds of notifications a user receives.
+// Kinds without a setting are received.
+type Preferences struct {
+	UserID  uuid.UUID
+	Enabled map[Kind]bool
+}
+
+// DefaultPreferences receives every kind of notification.
+func DefaultPreferences(userID uuid.UUID) Preferences {
+	return Preferences{UserID: userID, Enabled: map[Kind]bool{}}
+}
+
+// NewPreferences creates the preferences of the user from a setting per kind.
+func NewPreferences(userID uuid.UUID, enabled map[Kind]bool) (Preferences, error) {
+	p := DefaultPreferences(userID)
+	for kind, on := range enabled {
+		if !IsValidKind(string(kind)) {
+			return Preferences{}, fmt.Errorf("unknown notification kind %q", kind)
+		}
+		p.Enabled[kind] = on
+	}
+	return p, nil
+}
+
+// Receives reports whether the user wants notifications of the kind.
+func (p Preferences) Receives(kind Kind) bool {
+	on, ok := p.Enabled[kind]
+	return !ok || on
+}
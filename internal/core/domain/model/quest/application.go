@@ -0,0 +1,159 @@
+package quest
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// AssignmentMode defines how a quest gets its assignee.
+type AssignmentMode string
+
+const (
+	// AssignmentFirstCome assigns the quest to the first user who takes it (default)
+	AssignmentFirstCome AssignmentMode = "first_come"
+	// AssignmentReview lets users apply and the creator accept one of the applications
+	AssignmentReview AssignmentMode = "review"
+)
+
+// MaxApplicationMessageLength limits the message a user attaches to an application
+const MaxApplicationMessageLength = 1000
+
+// IsValidAssignmentMode checks if string is a valid assignment mode
+func IsValidAssignmentMode(mode string) bool {
+	switch AssignmentMode(mode) {
+	case AssignmentFirstCome, AssignmentReview:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetAssignmentMode changes how the quest gets its assignee.
+// An empty mode means AssignmentFirstCome.
+func (q *Quest) SetAssignmentMode(mode string) error {
+	if mode == "" {
+		mode = string(AssignmentFirstCome)
+	}
+	if !IsValidAssignmentMode(mode) {
+		return errors.New("invalid assignment mode: must be one of 'first_come', 'review'")
+	}
+	q.AssignmentMode = AssignmentMode(mode)
+	return nil
+}
+
+// ApplicationStatus represents the state of an application.
+type ApplicationStatus string
+
+const (
+	ApplicationStatusPending  ApplicationStatus = "pending"
+	ApplicationStatusAccepted ApplicationStatus = "accepted"
+	ApplicationStatusRejected ApplicationStatus = "rejected"
+)
+
+// Application is a user's request to be assigned to a quest in review mode.
+type Application struct {
+	ID          uuid.UUID
+	QuestID     uuid.UUID
+	ApplicantID uuid.UUID
+	Message     string
+	Status      ApplicationStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Apply creates an application of the user to the quest.
+// existing are the applications already submitted to the quest; a user may apply only once.
+func (q *Quest) Apply(applicantID uuid.UUID, message string, existing []Application) (Application, error) {
+	if q.AssignmentMode != AssignmentReview {
+		return Application{}, errors.New("quest does not accept applications, it is assigned on a first-come basis")
+	}
+	if !q.joinable() {
+		if q.capacity() > 1 {
+			return Application{}, errors.New("quest accepts applications only if status is 'created', 'posted' or 'assigned'")
+		}
+		return Application{}, errors.New("quest accepts applications only if status is 'created' or 'posted'")
+	}
+	if err := q.checkFreeSlot(); err != nil {
+		return Application{}, err
+	}
+	if q.IsParticipant(applicantID) {
+		return Application{}, errors.New("user is already a participant of this quest")
+	}
+	if q.Creator == applicantID.String() {
+		return Application{}, errors.New("creator cannot apply to their own quest")
+	}
+
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > MaxApplicationMessageLength {
+		return Application{}, errors.New("application message too long, maximum is 1000 characters")
+	}
+
+	for _, a := range existing {
+		if a.ApplicantID == applicantID {
+			return Application{}, errors.New("user has already applied to this quest")
+		}
+	}
+
+	now := time.Now()
+	application := Application{
+		ID:          uuid.New(),
+		QuestID:     q.ID(),
+		ApplicantID: applicantID,
+		Message:     message,
+		Status:      ApplicationStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	q.RaiseDomainEvent(NewApplicationSubmitted(q.ID(), application.ID, applicantID))
+
+	return application, nil
+}
+
+// AcceptApplication assigns the quest to the applicant and marks the application accepted.
+// Other pending applications are left as they are.
+func (q *Quest) AcceptApplication(application *Application) error {
+	if err := q.checkPendingApplication(application); err != nil {
+		return err
+	}
+
+	if err := q.AssignTo(application.ApplicantID); err != nil {
+		return err
+	}
+
+	application.Status = ApplicationStatusAccepted
+	application.UpdatedAt = time.Now()
+
+	q.RaiseDomainEvent(NewApplicationAccepted(q.ID(), application.ID, application.ApplicantID))
+
+	return nil
+}
+
+// RejectApplication marks the application rejected.
+func (q *Quest) RejectApplication(application *Application) error {
+	if err := q.checkPendingApplication(application); err != nil {
+		return err
+	}
+
+	application.Status = ApplicationStatusRejected
+	application.UpdatedAt = time.Now()
+
+	q.RaiseDomainEvent(NewApplicationRejected(q.ID(), application.ID, application.ApplicantID))
+
+	return nil
+}
+
+// checkPendingApplication verifies that the application belongs to the quest and is still undecided
+func (q *Quest) checkPendingApplication(application *Application) error {
+	if application.QuestID != q.ID() {
+		return errors.New("application does not belong to this quest")
+	}
+	if application.Status != ApplicationStatusPending {
+		return errors.New("application has already been " + string(application.Status))
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package quest
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// CommentVisibility defines who can read a comment.
+type CommentVisibility string
+
+const (
+	// CommentPublic is visible to every user, e.g. questions while the quest is open
+	CommentPublic CommentVisibility = "public"
+	// CommentPrivate is visible to the creator and the participants only
+	CommentPrivate CommentVisibility = "private"
+)
+
+const (
+	// MaxCommentLength limits the body of a comment
+	MaxCommentLength = 2000
+	// CommentEditWindow is how long after posting the author can edit a comment
+	CommentEditWindow = 15 * time.Minute
+)
+
+// IsValidCommentVisibility checks if string is a valid comment visibility
+func IsValidCommentVisibility(visibility string) bool {
+	switch CommentVisibility(visibility) {
+	case CommentPublic, CommentPrivate:
+		return true
+	default:
+		return false
+	}
+}
+
+// Comment is a message in the thread of a quest.
+type Comment struct {
+	ID         uuid.UUID
+	QuestID    uuid.UUID
+	AuthorID   uuid.UUID
+	Body       string
+	Visibility CommentVisibility
+	CreatedAt  time.Time
+	EditedAt   *time.Time
+}
+
+// IsMember reports whether the user is the creator or a participant of the quest.
+// Members read and write private comments.
+func (q Quest) IsMember(userID uuid.UUID) bool {
+	return q.Creator == userID.String() || q.IsParticipant(userID)
+}
+
+// CanSeeComment reports whether the user can read the comment.
+func (q Quest) CanSeeComment(userID uuid.UUID, c Comment) bool {
+	return c.Visibility == CommentPublic || q.IsMember(userID)
+}
+
+// AddComment adds a comment of the author to the thread of the quest.
+// Public comments are open to everyone while the quest is 'created' or 'posted'; once the quest
+// has participants, its members exchange private comments. An empty visibility means public
+// while the quest is open and private afterwards.
+func (q *Quest) AddComment(authorID uuid.UUID, body, visibility string) (Comment, error) {
+	open := q.Status == StatusCreated || q.Status == StatusPosted
+	if visibility == "" {
+		visibility = string(CommentPublic)
+		if !open {
+			visibility = string(CommentPrivate)
+		}
+	}
+	if !IsValidCommentVisibility(visibility) {
+		return Comment{}, errors.New("invalid comment visibility: must be one of 'public', 'private'")
+	}
+
+	switch CommentVisibility(visibility) {
+	case CommentPublic:
+		if !open {
+			return Comment{}, errors.New("public comments are allowed only if status is 'created' or 'posted'")
+		}
+	case CommentPrivate:
+		if q.Assignee == nil && len(q.Participants) == 0 {
+			return Comment{}, errors.New("private comments are allowed only after the quest is assigned")
+		}
+		if !q.IsMember(authorID) {
+			return Comment{}, errors.New("only the creator and the participants can post private comments")
+		}
+	}
+
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	comment := Comment{
+		ID:         uuid.New(),
+		QuestID:    q.ID(),
+		AuthorID:   authorID,
+		Body:       body,
+		Visibility: CommentVisibility(visibility),
+		CreatedAt:  time.Now(),
+	}
+
+	q.RaiseDomainEvent(NewCommentAdded(q.ID(), comment.ID, authorID, comment.Visibility))
+
+	return comment, nil
+}
+
+// EditComment replaces the body of the comment. Only the author edits a comment,
+// within CommentEditWindow after posting it.
+func (q *Quest) EditComment(comment *Comment, editorID uuid.UUID, body string, now time.Time) error {
+	if comment.QuestID != q.ID() {
+		return errors.New("comment does not belong to this quest")
+	}
+	if comment.AuthorID != editorID {
+		return errors.New("only the author can edit a comment")
+	}
+	if now.Sub(comment.CreatedAt) > CommentEditWindow {
+		return errors.New("comment can be edited only within 15 minutes after posting")
+	}
+
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return err
+	}
+
+	comment.Body = body
+	comment.EditedAt = &now
+	return nil
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", errors.New("comment body is required")
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return "", errors.New("comment too long, maximum is 2000 characters")
+	}
+	return body, nil
+}
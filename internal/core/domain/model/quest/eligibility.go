@@ -0,0 +1,113 @@
+package quest
+
+import (
+	"errors"
+	"strings"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// EligibilityPolicy controls what happens when a user lacks the quest's requirements on assignment.
+type EligibilityPolicy string
+
+const (
+	// EligibilityStrict rejects assignment to users who do not meet the requirements
+	EligibilityStrict EligibilityPolicy = "strict"
+	// EligibilityWarn allows the assignment and reports the unmet requirements
+	EligibilityWarn EligibilityPolicy = "warn"
+	// EligibilityOff skips the check (default)
+	EligibilityOff EligibilityPolicy = "off"
+)
+
+// IsValidEligibilityPolicy checks if string is a valid eligibility policy
+func IsValidEligibilityPolicy(policy string) bool {
+	switch EligibilityPolicy(policy) {
+	case EligibilityStrict, EligibilityWarn, EligibilityOff:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetEligibilityPolicy changes how assignments are checked against the quest requirements.
+// An empty policy means EligibilityOff.
+func (q *Quest) SetEligibilityPolicy(policy string) error {
+	if policy == "" {
+		policy = string(EligibilityOff)
+	}
+	if !IsValidEligibilityPolicy(policy) {
+		return errors.New("invalid eligibility policy: must be one of 'strict', 'warn', 'off'")
+	}
+	q.EligibilityPolicy = EligibilityPolicy(policy)
+	return nil
+}
+
+// Capabilities are what a user declares to bring to a quest.
+// The distance check applies only when both Position and MaxDistanceKm are set.
+type Capabilities struct {
+	Skills        []string
+	Equipment     []string
+	Position      *kernel.GeoCoordinate
+	MaxDistanceKm *float64
+}
+
+// Eligibility is the outcome of comparing a user's capabilities with the quest requirements.
+type Eligibility struct {
+	MissingSkills    []string
+	MissingEquipment []string
+
+	// Set when the distance was checked
+	DistanceKm    *float64 // from the user's position to the execution location
+	MaxDistanceKm *float64
+}
+
+// TooFar reports whether the execution location is beyond the user's maximum distance.
+func (e Eligibility) TooFar() bool {
+	return e.DistanceKm != nil && e.MaxDistanceKm != nil && *e.DistanceKm > *e.MaxDistanceKm
+}
+
+// Eligible reports whether the user meets all requirements.
+func (e Eligibility) Eligible() bool {
+	return len(e.MissingSkills) == 0 && len(e.MissingEquipment) == 0 && !e.TooFar()
+}
+
+// CheckEligibility compares the quest's skills, equipment and execution location with the capabilities.
+// Skills and equipment are matched case-insensitively. The policy is not applied here.
+func (q Quest) CheckEligibility(c Capabilities) Eligibility {
+	result := Eligibility{
+		MissingSkills:    missingItems(q.Skills, c.Skills),
+		MissingEquipment: missingItems(q.Equipment, c.Equipment),
+	}
+	if c.Position != nil && c.MaxDistanceKm != nil {
+		distance := c.Position.DistanceTo(q.ExecutionLocation)
+		maxDistance := *c.MaxDistanceKm
+		result.DistanceKm = &distance
+		result.MaxDistanceKm = &maxDistance
+	}
+	return result
+}
+
+// missingItems returns the required items that are not in owned, in required order
+func missingItems(required, owned []string) []string {
+	have := make(map[string]struct{}, len(owned))
+	for _, o := range owned {
+		have[strings.ToLower(strings.TrimSpace(o))] = struct{}{}
+	}
+
+	missing := []string{}
+	seen := make(map[string]struct{}, len(required))
+	for _, r := range required {
+		key := strings.ToLower(strings.TrimSpace(r))
+		if key == "" {
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		if _, ok := have[key]; !ok {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
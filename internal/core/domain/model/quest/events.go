@@ -1,6 +1,7 @@
 package quest
 
 import (
+	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/pkg/ddd"
 
 	"github.com/google/uuid"
@@ -37,12 +38,124 @@ type QuestStatusChanged struct {
 	ddd.BaseEvent
 	OldStatus Status `json:"old_status"`
 	NewStatus Status `json:"new_status"`
+	// Position submitted by the acting user, if any
+	Position *kernel.GeoCoordinate `json:"position,omitempty"`
+	// User who changed the status, if known
+	ActorID *uuid.UUID `json:"actor_id,omitempty"`
+	// Participants released by reopening the quest
+	ReleasedParticipantIDs []uuid.UUID `json:"released_participant_ids,omitempty"`
 }
 
-func NewQuestStatusChanged(questID uuid.UUID, oldStatus, newStatus Status) QuestStatusChanged {
+func NewQuestStatusChanged(questID uuid.UUID, oldStatus, newStatus Status, position *kernel.GeoCoordinate) QuestStatusChanged {
 	return QuestStatusChanged{
 		BaseEvent: ddd.NewBaseEvent(questID, "quest.status_changed"),
 		OldStatus: oldStatus,
 		NewStatus: newStatus,
+		Position:  position,
+	}
+}
+
+// ApplicationSubmitted represents a user applying to a quest in review mode
+type ApplicationSubmitted struct {
+	ddd.BaseEvent
+	ApplicationID uuid.UUID `json:"application_id"`
+	ApplicantID   uuid.UUID `json:"applicant_id"`
+}
+
+func NewApplicationSubmitted(questID, applicationID, applicantID uuid.UUID) ApplicationSubmitted {
+	return ApplicationSubmitted{
+		BaseEvent:     ddd.NewBaseEvent(questID, "quest.application_submitted"),
+		ApplicationID: applicationID,
+		ApplicantID:   applicantID,
+	}
+}
+
+// ApplicationAccepted represents the creator accepting an application
+type ApplicationAccepted struct {
+	ddd.BaseEvent
+	ApplicationID uuid.UUID `json:"application_id"`
+	ApplicantID   uuid.UUID `json:"applicant_id"`
+}
+
+func NewApplicationAccepted(questID, applicationID, applicantID uuid.UUID) ApplicationAccepted {
+	return ApplicationAccepted{
+		BaseEvent:     ddd.NewBaseEvent(questID, "quest.application_accepted"),
+		ApplicationID: applicationID,
+		ApplicantID:   applicantID,
+	}
+}
+
+// ApplicationRejected represents the creator rejecting an application
+type ApplicationRejected struct {
+	ddd.BaseEvent
+	ApplicationID uuid.UUID `json:"application_id"`
+	ApplicantID   uuid.UUID `json:"applicant_id"`
+}
+
+func NewApplicationRejected(questID, applicationID, applicantID uuid.UUID) ApplicationRejected {
+	return ApplicationRejected{
+		BaseEvent:     ddd.NewBaseEvent(questID, "quest.application_rejected"),
+		ApplicationID: applicationID,
+		ApplicantID:   applicantID,
+	}
+}
+
+// QuestParticipantCompleted represents a participant submitting completion evidence
+type QuestParticipantCompleted struct {
+	ddd.BaseEvent
+	UserID     uuid.UUID `json:"user_id"`
+	EvidenceID uuid.UUID `json:"evidence_id"`
+}
+
+func NewQuestParticipantCompleted(questID, userID, evidenceID uuid.UUID) QuestParticipantCompleted {
+	return QuestParticipantCompleted{
+		BaseEvent:  ddd.NewBaseEvent(questID, "quest.participant_completed"),
+		UserID:     userID,
+		EvidenceID: evidenceID,
+	}
+}
+
+// CompletionApproved represents the creator accepting the completion evidence of a quest
+type CompletionApproved struct {
+	ddd.BaseEvent
+	EvidenceIDs []uuid.UUID `json:"evidence_ids"`
+}
+
+func NewCompletionApproved(questID uuid.UUID, evidenceIDs []uuid.UUID) CompletionApproved {
+	return CompletionApproved{
+		BaseEvent:   ddd.NewBaseEvent(questID, "quest.completion_approved"),
+		EvidenceIDs: evidenceIDs,
+	}
+}
+
+// CompletionRejected represents the creator sending a quest under review back to work
+type CompletionRejected struct {
+	ddd.BaseEvent
+	EvidenceIDs []uuid.UUID `json:"evidence_ids"`
+	Reason      string      `json:"reason"`
+}
+
+func NewCompletionRejected(questID uuid.UUID, evidenceIDs []uuid.UUID, reason string) CompletionRejected {
+	return CompletionRejected{
+		BaseEvent:   ddd.NewBaseEvent(questID, "quest.completion_rejected"),
+		EvidenceIDs: evidenceIDs,
+		Reason:      reason,
+	}
+}
+
+// CommentAdded represents a new comment in the thread of a quest
+type CommentAdded struct {
+	ddd.BaseEvent
+	CommentID  uuid.UUID         `json:"comment_id"`
+	AuthorID   uuid.UUID         `json:"author_id"`
+	Visibility CommentVisibility `json:"visibility"`
+}
+
+func NewCommentAdded(questID, commentID, authorID uuid.UUID, visibility CommentVisibility) CommentAdded {
+	return CommentAdded{
+		BaseEvent:  ddd.NewBaseEvent(questID, "quest.comment_added"),
+		CommentID:  commentID,
+		AuthorID:   authorID,
+		Visibility: visibility,
 	}
 }
@@ -0,0 +1,192 @@
+package quest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"quest-manager/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxEvidenceNoteLength limits the note a participant attaches to completion evidence
+	MaxEvidenceNoteLength = 2000
+	// MaxEvidenceAttachments limits the number of photos per evidence
+	MaxEvidenceAttachments = 5
+	// MaxAttachmentSize limits the size of a single photo in bytes (5 MB)
+	MaxAttachmentSize = 5 << 20
+	// MaxRejectionReasonLength limits the reason the creator gives when rejecting a completion
+	MaxRejectionReasonLength = 1000
+)
+
+// allowedAttachmentTypes are the content types accepted for evidence photos
+var allowedAttachmentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// IsAllowedAttachmentType checks if the content type is accepted for evidence photos
+func IsAllowedAttachmentType(contentType string) bool {
+	return allowedAttachmentTypes[contentType]
+}
+
+// EvidenceStatus represents the review state of completion evidence.
+type EvidenceStatus string
+
+const (
+	EvidenceStatusPending  EvidenceStatus = "pending"
+	EvidenceStatusApproved EvidenceStatus = "approved"
+	EvidenceStatusRejected EvidenceStatus = "rejected"
+)
+
+// Attachment is a photo attached to completion evidence. The content lives in blob storage under StorageKey.
+type Attachment struct {
+	ID          uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+}
+
+// Evidence is a participant's proof that the quest is done.
+type Evidence struct {
+	ID          uuid.UUID
+	QuestID     uuid.UUID
+	SubmittedBy uuid.UUID
+	Note        string
+	// GPS position of the participant at completion
+	Position        kernel.GeoCoordinate
+	Attachments     []Attachment
+	Status          EvidenceStatus
+	RejectionReason string
+	SubmittedAt     time.Time
+	ReviewedAt      *time.Time
+}
+
+// Attachment returns the attachment with the given ID, false if the evidence has none.
+func (e Evidence) Attachment(attachmentID uuid.UUID) (Attachment, bool) {
+	for _, a := range e.Attachments {
+		if a.ID == attachmentID {
+			return a, true
+		}
+	}
+	return Attachment{}, false
+}
+
+// SubmitEvidence records the participant's completion evidence. The quest goes to
+// "pending_review" once the evidence count reaches the completion quorum.
+func (q *Quest) SubmitEvidence(userID uuid.UUID, note string, position kernel.GeoCoordinate, attachments []Attachment) (Evidence, error) {
+	if q.Status != StatusInProgress {
+		return Evidence{}, errors.New("completion evidence can only be submitted if status is 'in_progress'")
+	}
+	p := q.participant(userID)
+	if p == nil {
+		return Evidence{}, ErrNotParticipant
+	}
+	if p.StartedAt == nil {
+		return Evidence{}, errors.New("participant has to start the quest before completing it")
+	}
+	if p.CompletedAt != nil {
+		return Evidence{}, errors.New("participant has already submitted completion evidence")
+	}
+
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxEvidenceNoteLength {
+		return Evidence{}, fmt.Errorf("evidence note too long, maximum is %d characters", MaxEvidenceNoteLength)
+	}
+	if len(attachments) > MaxEvidenceAttachments {
+		return Evidence{}, fmt.Errorf("too many attachments, maximum is %d", MaxEvidenceAttachments)
+	}
+	for _, a := range attachments {
+		if !IsAllowedAttachmentType(a.ContentType) {
+			return Evidence{}, fmt.Errorf("attachment %q must be a JPEG, PNG or WebP image", a.FileName)
+		}
+		if a.Size <= 0 || a.Size > MaxAttachmentSize {
+			return Evidence{}, fmt.Errorf("attachment %q must be between 1 byte and 5 MB", a.FileName)
+		}
+	}
+
+	now := time.Now()
+	evidence := Evidence{
+		ID:          uuid.New(),
+		QuestID:     q.ID(),
+		SubmittedBy: userID,
+		Note:        note,
+		Position:    position,
+		Attachments: attachments,
+		Status:      EvidenceStatusPending,
+		SubmittedAt: now,
+	}
+
+	p.CompletedAt = &now
+	q.UpdatedAt = now
+	q.RaiseDomainEvent(NewQuestParticipantCompleted(q.ID(), userID, evidence.ID))
+
+	if q.CompletionVotes() >= max(q.CompletionQuorum, 1) {
+		if err := q.ChangeStatus(StatusPendingReview); err != nil {
+			return Evidence{}, err
+		}
+	}
+
+	return evidence, nil
+}
+
+// ApproveCompletion completes the quest under review and approves its pending evidence.
+func (q *Quest) ApproveCompletion(evidence []*Evidence) error {
+	if q.Status != StatusPendingReview {
+		return errors.New("completion can only be approved if status is 'pending_review'")
+	}
+
+	ids := q.reviewEvidence(evidence, EvidenceStatusApproved, "")
+	if err := q.ChangeStatus(StatusCompleted); err != nil {
+		return err
+	}
+	q.RaiseDomainEvent(NewCompletionApproved(q.ID(), ids))
+	return nil
+}
+
+// RejectCompletion sends the quest under review back to "in_progress" and rejects its
+// pending evidence with the reason. Participants have to submit new evidence.
+func (q *Quest) RejectCompletion(evidence []*Evidence, reason string) error {
+	if q.Status != StatusPendingReview {
+		return errors.New("completion can only be rejected if status is 'pending_review'")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("rejection reason is required")
+	}
+	if utf8.RuneCountInString(reason) > MaxRejectionReasonLength {
+		return fmt.Errorf("rejection reason too long, maximum is %d characters", MaxRejectionReasonLength)
+	}
+
+	ids := q.reviewEvidence(evidence, EvidenceStatusRejected, reason)
+	for i := range q.Participants {
+		q.Participants[i].CompletedAt = nil
+	}
+	if err := q.ChangeStatus(StatusInProgress); err != nil {
+		return err
+	}
+	q.RaiseDomainEvent(NewCompletionRejected(q.ID(), ids, reason))
+	return nil
+}
+
+// reviewEvidence marks the pending evidence of the quest with the verdict and returns the IDs of the marked evidence
+func (q *Quest) reviewEvidence(evidence []*Evidence, status EvidenceStatus, reason string) []uuid.UUID {
+	now := time.Now()
+	ids := make([]uuid.UUID, 0, len(evidence))
+	for _, e := range evidence {
+		if e.QuestID != q.ID() || e.Status != EvidenceStatusPending {
+			continue
+		}
+		e.Status = status
+		e.RejectionReason = reason
+		e.ReviewedAt = &now
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
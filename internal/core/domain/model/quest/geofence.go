@@ -0,0 +1,31 @@
+package quest
+
+import (
+	"fmt"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// MaxGeofenceRadius limits the geofence radius of a quest in meters
+const MaxGeofenceRadius = 10000
+
+// SetGeofenceRadius sets how close in meters a participant has to be to the target location
+// to start the quest and to the execution location to complete it. Zero disables the geofence.
+func (q *Quest) SetGeofenceRadius(meters int) error {
+	if meters < 0 || meters > MaxGeofenceRadius {
+		return fmt.Errorf("geofence radius must be between 0 and %d meters", MaxGeofenceRadius)
+	}
+	q.GeofenceRadius = meters
+	return nil
+}
+
+// HasGeofence reports whether status changes of the quest require a nearby position
+func (q Quest) HasGeofence() bool {
+	return q.GeofenceRadius > 0
+}
+
+// ReportPosition records the position of the acting user; the next status change of the
+// quest carries it in its quest.status_changed event.
+func (q *Quest) ReportPosition(position kernel.GeoCoordinate) {
+	q.reportedPosition = &position
+}
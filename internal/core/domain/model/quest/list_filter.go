@@ -0,0 +1,12 @@
+package quest
+
+// ListFilter selects quests for the quest list. Nil fields are not applied.
+// Filters are evaluated by the repository, so only matching quests are loaded.
+type ListFilter struct {
+	Status *Status
+	// MaxRouteKm keeps quests whose route (target, waypoints, execution) is at most this long, in kilometers
+	MaxRouteKm *float64
+	// MinCreatorRating keeps quests whose creator has at least this average rating (see review.Reputation.AtLeast);
+	// unreviewed creators are excluded
+	MinCreatorRating *float64
+}
@@ -0,0 +1,35 @@
+package quest
+
+import "quest-manager/internal/core/domain/model/kernel"
+
+// LocationMatch selects which quest locations an area search is matched against.
+type LocationMatch string
+
+const (
+	LocationMatchTarget    LocationMatch = "target"
+	LocationMatchExecution LocationMatch = "execution"
+	LocationMatchAny       LocationMatch = "any"
+)
+
+// IsValidLocationMatch checks if string is a valid location match mode
+func IsValidLocationMatch(match string) bool {
+	switch LocationMatch(match) {
+	case LocationMatchTarget, LocationMatchExecution, LocationMatchAny:
+		return true
+	default:
+		return false
+	}
+}
+
+// Matches reports whether the quest's selected locations satisfy contains.
+// For LocationMatchAny it is enough that either location does.
+func (m LocationMatch) Matches(q Quest, contains func(kernel.GeoCoordinate) bool) bool {
+	switch m {
+	case LocationMatchTarget:
+		return contains(q.TargetLocation)
+	case LocationMatchExecution:
+		return contains(q.ExecutionLocation)
+	default:
+		return contains(q.TargetLocation) || contains(q.ExecutionLocation)
+	}
+}
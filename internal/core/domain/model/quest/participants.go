@@ -0,0 +1,197 @@
+package quest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCapacity limits how many participants a single quest can take
+const MaxCapacity = 100
+
+// ErrNotParticipant is returned when a user acts on a quest they do not take part in
+var ErrNotParticipant = errors.New("user is not a participant of this quest")
+
+// Participant is a user taking part in a quest.
+type Participant struct {
+	UserID   uuid.UUID
+	JoinedAt time.Time
+	// When the participant started working on the quest
+	StartedAt *time.Time
+	// When the participant submitted completion evidence; counts towards the completion quorum
+	CompletedAt *time.Time
+}
+
+// SetCapacity sets how many participants the quest takes and how many of them
+// have to confirm completion. A zero capacity means a single participant,
+// a zero quorum means a majority of the capacity.
+func (q *Quest) SetCapacity(capacity, quorum int) error {
+	if capacity == 0 {
+		capacity = 1
+	}
+	if capacity < 1 || capacity > MaxCapacity {
+		return fmt.Errorf("capacity must be between 1 and %d", MaxCapacity)
+	}
+	if quorum == 0 {
+		quorum = capacity/2 + 1
+	}
+	if quorum < 1 || quorum > capacity {
+		return errors.New("completion quorum must be between 1 and the capacity")
+	}
+	if len(q.Participants) > capacity {
+		return errors.New("capacity cannot be lower than the number of participants")
+	}
+
+	q.Capacity = capacity
+	q.CompletionQuorum = quorum
+	return nil
+}
+
+// IsFull reports whether the quest has no free participant slots left
+func (q Quest) IsFull() bool {
+	taken := len(q.Participants)
+	if taken == 0 && q.Assignee != nil {
+		taken = 1 // assigned before participants were tracked
+	}
+	return taken >= q.capacity()
+}
+
+// IsParticipant reports whether the user takes part in the quest
+func (q Quest) IsParticipant(userID uuid.UUID) bool {
+	return q.participant(userID) != nil
+}
+
+// ParticipantIDs returns the participants of the quest; the assignee for quests
+// assigned before participants were tracked.
+func (q Quest) ParticipantIDs() []uuid.UUID {
+	if len(q.Participants) == 0 && q.Assignee != nil {
+		return []uuid.UUID{*q.Assignee}
+	}
+	users := make([]uuid.UUID, len(q.Participants))
+	for i, p := range q.Participants {
+		users[i] = p.UserID
+	}
+	return users
+}
+
+// RewardRecipients returns the participants credited when the quest is completed.
+// The creator is never rewarded for their own quest, even if they took it themselves.
+func (q Quest) RewardRecipients() []uuid.UUID {
+	recipients := make([]uuid.UUID, 0, len(q.Participants))
+	for _, userID := range q.ParticipantIDs() {
+		if userID.String() != q.Creator {
+			recipients = append(recipients, userID)
+		}
+	}
+	return recipients
+}
+
+// CompletionVotes returns how many participants have submitted completion evidence
+func (q Quest) CompletionVotes() int {
+	votes := 0
+	for _, p := range q.Participants {
+		if p.CompletedAt != nil {
+			votes++
+		}
+	}
+	return votes
+}
+
+// Start marks the participant as started. The first participant to start
+// moves the quest to "in_progress".
+func (q *Quest) Start(userID uuid.UUID) error {
+	if q.Status != StatusAssigned && q.Status != StatusInProgress {
+		return errors.New("quest can only be started if status is 'assigned' or 'in_progress'")
+	}
+	p := q.participant(userID)
+	if p == nil {
+		return ErrNotParticipant
+	}
+	if p.StartedAt != nil {
+		return errors.New("participant has already started the quest")
+	}
+
+	now := time.Now()
+	p.StartedAt = &now
+	q.UpdatedAt = now
+
+	if q.Status == StatusAssigned {
+		return q.ChangeStatus(StatusInProgress)
+	}
+	return nil
+}
+
+// Complete completes the quest on behalf of the user. Only the creator completes it
+// directly; participants submit completion evidence for the creator to review.
+func (q *Quest) Complete(userID uuid.UUID) error {
+	if q.Status != StatusInProgress {
+		return errors.New("quest can only be completed if status is 'in_progress'")
+	}
+	if q.Creator == userID.String() {
+		return q.ChangeStatus(StatusCompleted)
+	}
+	if q.participant(userID) == nil {
+		return ErrNotParticipant
+	}
+	return errors.New("participants complete a quest by submitting completion evidence")
+}
+
+// addParticipant appends the user to the participants while there are free slots
+func (q *Quest) addParticipant(userID uuid.UUID) error {
+	if q.IsParticipant(userID) {
+		return errors.New("user is already a participant of this quest")
+	}
+	if err := q.checkFreeSlot(); err != nil {
+		return err
+	}
+
+	q.Participants = append(q.Participants, Participant{UserID: userID, JoinedAt: time.Now()})
+	if q.Assignee == nil {
+		q.Assignee = &userID
+	}
+	return nil
+}
+
+// joinable reports whether the quest takes participants in its status; a team quest
+// keeps taking them while it is assigned
+func (q Quest) joinable() bool {
+	return q.Status == StatusCreated || q.Status == StatusPosted ||
+		(q.Status == StatusAssigned && q.capacity() > 1)
+}
+
+// checkFreeSlot returns an error if the quest has no free participant slots left
+func (q Quest) checkFreeSlot() error {
+	if !q.IsFull() {
+		return nil
+	}
+	if q.capacity() == 1 {
+		return errors.New("quest is already assigned to another user")
+	}
+	return fmt.Errorf("quest has reached its capacity of %d participants", q.capacity())
+}
+
+// releaseParticipants drops all participants when the quest is reopened
+func (q *Quest) releaseParticipants() {
+	q.Participants = []Participant{}
+	q.Assignee = nil
+}
+
+// capacity returns the number of participant slots; quests without a capacity take a single participant
+func (q Quest) capacity() int {
+	if q.Capacity < 1 {
+		return 1
+	}
+	return q.Capacity
+}
+
+// participant returns the participant entry of the user, nil if the user does not take part
+func (q *Quest) participant(userID uuid.UUID) *Participant {
+	for i := range q.Participants {
+		if q.Participants[i].UserID == userID {
+			return &q.Participants[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package quest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPrerequisites limits how many quests a single quest can depend on
+const MaxPrerequisites = 20
+
+// ErrPrerequisitesIncomplete is returned when a quest is assigned or moved on
+// while some of its prerequisites are not completed
+var ErrPrerequisitesIncomplete = errors.New("quest has incomplete prerequisites")
+
+// ErrPrerequisiteCycle is returned when a prerequisite would make a quest depend on itself
+var ErrPrerequisiteCycle = errors.New("prerequisite would create a dependency cycle")
+
+// Prerequisite is a quest that has to be completed before the dependent quest can be assigned.
+type Prerequisite struct {
+	QuestID uuid.UUID
+	// Completed is kept on the dependent quest, completion of a quest is final
+	Completed bool
+}
+
+// AddPrerequisite makes the quest depend on the prerequisite quest. graph holds the links
+// between quests known so far; a link making the prerequisite depend on the quest is a cycle.
+// Prerequisites can be changed until the quest is assigned.
+func (q *Quest) AddPrerequisite(prerequisite Quest, graph DependencyGraph) error {
+	if err := q.checkPrerequisitesChangeable(); err != nil {
+		return err
+	}
+	if prerequisite.ID() == q.ID() {
+		return errors.New("quest cannot be its own prerequisite")
+	}
+	if q.HasPrerequisite(prerequisite.ID()) {
+		return errors.New("quest is already a prerequisite of this quest")
+	}
+	if len(q.Prerequisites) >= MaxPrerequisites {
+		return fmt.Errorf("too many prerequisites, maximum is %d", MaxPrerequisites)
+	}
+	if graph.DependsOn(prerequisite.ID(), q.ID()) {
+		return ErrPrerequisiteCycle
+	}
+
+	q.Prerequisites = append(q.Prerequisites, Prerequisite{
+		QuestID:   prerequisite.ID(),
+		Completed: prerequisite.Status == StatusCompleted,
+	})
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+// RemovePrerequisite drops the prerequisite from the quest.
+func (q *Quest) RemovePrerequisite(prerequisiteID uuid.UUID) error {
+	if err := q.checkPrerequisitesChangeable(); err != nil {
+		return err
+	}
+
+	for i, p := range q.Prerequisites {
+		if p.QuestID == prerequisiteID {
+			q.Prerequisites = append(q.Prerequisites[:i:i], q.Prerequisites[i+1:]...)
+			q.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return errors.New("quest is not a prerequisite of this quest")
+}
+
+// CompletePrerequisite records that the prerequisite quest is completed. Once the last
+// prerequisite of a quest in "created" status is completed, the quest is posted.
+// changed is false if the quest does not depend on the prerequisite or already knew.
+func (q *Quest) CompletePrerequisite(prerequisiteID uuid.UUID) (changed bool) {
+	for i, p := range q.Prerequisites {
+		if p.QuestID != prerequisiteID || p.Completed {
+			continue
+		}
+		prerequisites := make([]Prerequisite, len(q.Prerequisites))
+		copy(prerequisites, q.Prerequisites)
+		prerequisites[i].Completed = true
+		q.Prerequisites = prerequisites
+		q.UpdatedAt = time.Now()
+
+		if q.Status == StatusCreated && q.PrerequisitesCompleted() {
+			// created → posted is always a valid transition
+			_ = q.ChangeStatus(StatusPosted)
+		}
+		return true
+	}
+	return false
+}
+
+// HasPrerequisite reports whether the quest depends on the other quest directly.
+func (q Quest) HasPrerequisite(questID uuid.UUID) bool {
+	for _, p := range q.Prerequisites {
+		if p.QuestID == questID {
+			return true
+		}
+	}
+	return false
+}
+
+// PrerequisitesCompleted reports whether every prerequisite of the quest is completed.
+func (q Quest) PrerequisitesCompleted() bool {
+	for _, p := range q.Prerequisites {
+		if !p.Completed {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPrerequisitesChangeable verifies that the quest has not been assigned yet
+func (q Quest) checkPrerequisitesChangeable() error {
+	if q.Status != StatusCreated && q.Status != StatusPosted {
+		return errors.New("prerequisites can only be changed if status is 'created' or 'posted'")
+	}
+	return nil
+}
+
+// DependencyGraph holds the prerequisite links between quests.
+type DependencyGraph struct {
+	prerequisites map[uuid.UUID][]uuid.UUID
+}
+
+// NewDependencyGraph builds the graph of the prerequisites of the quests.
+func NewDependencyGraph(quests []Quest) DependencyGraph {
+	g := DependencyGraph{prerequisites: make(map[uuid.UUID][]uuid.UUID, len(quests))}
+	for _, q := range quests {
+		for _, p := range q.Prerequisites {
+			g.prerequisites[q.ID()] = append(g.prerequisites[q.ID()], p.QuestID)
+		}
+	}
+	return g
+}
+
+// DependsOn reports whether the quest depends on the other quest, directly or
+// through prerequisites of its prerequisites.
+func (g DependencyGraph) DependsOn(questID, otherID uuid.UUID) bool {
+	visited := map[uuid.UUID]bool{questID: true}
+	pending := []uuid.UUID{questID}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, prerequisiteID := range g.prerequisites[current] {
+			if prerequisiteID == otherID {
+				return true
+			}
+			if !visited[prerequisiteID] {
+				visited[prerequisiteID] = true
+				pending = append(pending, prerequisiteID)
+			}
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package quest
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"quest-manager/internal/core/domain/model/kernel"
@@ -14,18 +15,19 @@ import (
 type Status string
 
 const (
-	StatusCreated    Status = "created"
-	StatusPosted     Status = "posted"
-	StatusAssigned   Status = "assigned"
-	StatusInProgress Status = "in_progress"
-	StatusDeclined   Status = "declined"
-	StatusCompleted  Status = "completed"
+	StatusCreated       Status = "created"
+	StatusPosted        Status = "posted"
+	StatusAssigned      Status = "assigned"
+	StatusInProgress    Status = "in_progress"
+	StatusPendingReview Status = "pending_review"
+	StatusDeclined      Status = "declined"
+	StatusCompleted     Status = "completed"
 )
 
 // IsValidStatus checks if string is a valid quest status
 func IsValidStatus(status string) bool {
 	switch Status(status) {
-	case StatusCreated, StatusPosted, StatusAssigned, StatusInProgress, StatusDeclined, StatusCompleted:
+	case StatusCreated, StatusPosted, StatusAssigned, StatusInProgress, StatusPendingReview, StatusDeclined, StatusCompleted:
 		return true
 	default:
 		return false
@@ -54,6 +56,9 @@ type Quest struct {
 	TargetLocation    kernel.GeoCoordinate
 	ExecutionLocation kernel.GeoCoordinate
 
+	// Intermediate route stops between target and execution location, in travel order
+	Waypoints []kernel.GeoCoordinate
+
 	// Optional references to location directory
 	TargetLocationID    *uuid.UUID
 	ExecutionLocationID *uuid.UUID
@@ -64,8 +69,35 @@ type Quest struct {
 
 	Equipment []string
 	Skills    []string
-	Status    Status
-	Creator   string
+
+	// How assignments are checked against Skills, Equipment and the execution location
+	EligibilityPolicy EligibilityPolicy
+
+	// Whether the first taker is assigned or the creator reviews applications
+	AssignmentMode AssignmentMode
+
+	// How many participants the quest takes and how many of them have to confirm completion
+	Capacity         int
+	CompletionQuorum int
+
+	// Users taking part in the quest, in joining order
+	Participants []Participant
+
+	// Quests that have to be completed before this one can be assigned, in the order they were added
+	Prerequisites []Prerequisite
+
+	// Radius in meters around the target and execution location within which the quest
+	// is started and completed; zero means no geofence
+	GeofenceRadius int
+
+	// Position of the acting user, recorded in the next status change event
+	reportedPosition *kernel.GeoCoordinate
+	// Acting user, recorded in the status change events
+	actorID *uuid.UUID
+
+	Status  Status
+	Creator string
+	// First participant of the quest, kept for single-assignee clients
 	Assignee  *uuid.UUID
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -120,8 +152,15 @@ func NewQuest(
 		DurationMinutes:   durationMinutes,
 		TargetLocation:    targetLocation,
 		ExecutionLocation: executionLocation,
+		Waypoints:         []kernel.GeoCoordinate{},
 		Equipment:         equipment,
 		Skills:            skills,
+		EligibilityPolicy: EligibilityOff,
+		AssignmentMode:    AssignmentFirstCome,
+		Capacity:          1,
+		CompletionQuorum:  1,
+		Participants:      []Participant{},
+		Prerequisites:     []Prerequisite{},
 		Status:            StatusCreated,
 		Creator:           creator,
 		CreatedAt:         now,
@@ -134,31 +173,41 @@ func NewQuest(
 	return quest, nil
 }
 
-// AssignTo sets the assignee for the quest and changes its status to "assigned".
-// Contains business logic for quest assignment.
+// AssignTo adds the user to the quest participants and changes its status to "assigned".
+// A team quest (capacity above one) keeps taking participants while it is assigned
+// and has free slots. Quests are assigned only once all their prerequisites are completed.
 func (q *Quest) AssignTo(userID uuid.UUID) error {
+	if !q.PrerequisitesCompleted() {
+		return ErrPrerequisitesIncomplete
+	}
+
 	// Business rules for assignment
-	if q.Status != StatusCreated && q.Status != StatusPosted {
+	if !q.joinable() {
+		if q.capacity() > 1 {
+			return errors.New("quest can only be joined if status is 'created', 'posted' or 'assigned'")
+		}
 		return errors.New("quest can only be assigned if status is 'created' or 'posted'")
 	}
 
-	if q.Assignee != nil {
-		return errors.New("quest is already assigned to another user")
+	if err := q.addParticipant(userID); err != nil {
+		return err
 	}
 
 	oldStatus := q.Status
-	q.Assignee = &userID
 	q.Status = StatusAssigned
 	q.UpdatedAt = time.Now()
 
 	// Create domain events
 	q.RaiseDomainEvent(NewQuestAssigned(q.ID(), userID))
-	q.RaiseDomainEvent(NewQuestStatusChanged(q.ID(), oldStatus, q.Status))
+	if oldStatus != StatusAssigned {
+		q.raiseStatusChanged(oldStatus, q.Status)
+	}
 
 	return nil
 }
 
-// ChangeStatus changes quest status with business rules validation
+// ChangeStatus changes quest status with business rules validation.
+// A quest with incomplete prerequisites can only be taken back to "created".
 func (q *Quest) ChangeStatus(newStatus Status) error {
 	// Validate that the new status is a valid enum value
 	if !IsValidStatus(string(newStatus)) {
@@ -169,26 +218,61 @@ func (q *Quest) ChangeStatus(newStatus Status) error {
 	if !q.isValidStatusTransition(q.Status, newStatus) {
 		return errors.New("invalid status transition from " + string(q.Status) + " to " + string(newStatus))
 	}
+	if newStatus != StatusCreated && !q.PrerequisitesCompleted() {
+		return ErrPrerequisitesIncomplete
+	}
 
 	oldStatus := q.Status
 	q.Status = newStatus
 	q.UpdatedAt = time.Now()
 
+	// Reopening the quest releases its participants
+	var released []uuid.UUID
+	if newStatus == StatusPosted || newStatus == StatusCreated {
+		released = q.ParticipantIDs()
+		q.releaseParticipants()
+	}
+
 	// Create domain event
-	q.RaiseDomainEvent(NewQuestStatusChanged(q.ID(), oldStatus, newStatus))
+	q.raiseStatusChanged(oldStatus, newStatus, released...)
 
 	return nil
 }
 
+// SetActor records the user acting on the quest; the status changes that follow
+// carry it in their quest.status_changed events.
+func (q *Quest) SetActor(userID uuid.UUID) {
+	q.actorID = &userID
+}
+
+// raiseStatusChanged raises quest.status_changed with the actor, the released participants
+// and the reported position, which is used up
+func (q *Quest) raiseStatusChanged(oldStatus, newStatus Status, released ...uuid.UUID) {
+	event := NewQuestStatusChanged(q.ID(), oldStatus, newStatus, q.reportedPosition)
+	event.ActorID = q.actorID
+	if len(released) > 0 {
+		event.ReleasedParticipantIDs = released
+	}
+	q.RaiseDomainEvent(event)
+	q.reportedPosition = nil
+}
+
+// DistanceFrom returns the distance in kilometers from point to the nearest
+// of the quest's target and execution locations.
+func (q Quest) DistanceFrom(point kernel.GeoCoordinate) float64 {
+	return math.Min(point.DistanceTo(q.TargetLocation), point.DistanceTo(q.ExecutionLocation))
+}
+
 // isValidStatusTransition checks validity of transition between statuses
 func (q *Quest) isValidStatusTransition(from, to Status) bool {
 	validTransitions := map[Status][]Status{
-		StatusCreated:    {StatusPosted, StatusAssigned},
-		StatusPosted:     {StatusAssigned, StatusCreated},
-		StatusAssigned:   {StatusInProgress, StatusDeclined, StatusPosted},
-		StatusInProgress: {StatusCompleted, StatusDeclined},
-		StatusDeclined:   {StatusPosted},
-		StatusCompleted:  {}, // Final status
+		StatusCreated:       {StatusPosted, StatusAssigned},
+		StatusPosted:        {StatusAssigned, StatusCreated},
+		StatusAssigned:      {StatusInProgress, StatusDeclined, StatusPosted},
+		StatusInProgress:    {StatusCompleted, StatusDeclined, StatusPendingReview},
+		StatusPendingReview: {StatusCompleted, StatusInProgress},
+		StatusDeclined:      {StatusPosted},
+		StatusCompleted:     {}, // Final status
 	}
 
 	allowed, exists := validTransitions[from]
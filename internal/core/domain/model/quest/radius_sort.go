@@ -0,0 +1,18 @@
+package quest
+
+// RadiusSort defines the order of radius search results; ties are ordered closest first.
+type RadiusSort string
+
+const (
+	// RadiusSortDistance orders results closest first (default)
+	RadiusSortDistance RadiusSort = "distance"
+	// RadiusSortCreatedAt orders results newest first
+	RadiusSortCreatedAt RadiusSort = "created_at"
+	// RadiusSortReward orders results by highest reward first
+	RadiusSortReward RadiusSort = "reward"
+)
+
+// IsValid reports whether the sort is known.
+func (s RadiusSort) IsValid() bool {
+	return s == RadiusSortDistance || s == RadiusSortCreatedAt || s == RadiusSortReward
+}
@@ -0,0 +1,49 @@
+package quest
+
+import (
+	"errors"
+	"math"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+const (
+	// MaxWaypoints limits the number of intermediate stops of a quest route
+	MaxWaypoints = 25
+	// TravelSpeedKmh is the average speed used to estimate travel time (walking pace)
+	TravelSpeedKmh = 5.0
+)
+
+// Route returns the points of the quest route in travel order:
+// the target location, the waypoints, then the execution location.
+func (q Quest) Route() []kernel.GeoCoordinate {
+	route := make([]kernel.GeoCoordinate, 0, len(q.Waypoints)+2)
+	route = append(route, q.TargetLocation)
+	route = append(route, q.Waypoints...)
+	return append(route, q.ExecutionLocation)
+}
+
+// RouteDistanceKm returns the total great-circle length of the route in kilometers.
+func (q Quest) RouteDistanceKm() float64 {
+	route := q.Route()
+	total := 0.0
+	for i := 1; i < len(route); i++ {
+		total += route[i-1].DistanceTo(route[i])
+	}
+	return total
+}
+
+// EstimatedTravelMinutes returns the time to travel the route at TravelSpeedKmh, rounded up to whole minutes.
+func (q Quest) EstimatedTravelMinutes() int {
+	return int(math.Ceil(q.RouteDistanceKm() / TravelSpeedKmh * 60))
+}
+
+// SetWaypoints replaces the intermediate stops between the target and the execution location.
+func (q *Quest) SetWaypoints(waypoints []kernel.GeoCoordinate) error {
+	if len(waypoints) > MaxWaypoints {
+		return errors.New("too many waypoints, maximum is 25")
+	}
+
+	q.Waypoints = append([]kernel.GeoCoordinate{}, waypoints...)
+	return nil
+}
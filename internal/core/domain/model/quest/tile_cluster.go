@@ -0,0 +1,29 @@
+package quest
+
+import "quest-manager/internal/core/domain/model/kernel"
+
+// TileCluster aggregates the quests whose target location lies in one cell of a map tile.
+type TileCluster struct {
+	Cell         kernel.Tile
+	Count        int
+	Center       kernel.GeoCoordinate // mean of the target locations
+	StatusCounts map[Status]int
+}
+
+// NewTileCluster creates an empty cluster of the cell.
+func NewTileCluster(cell kernel.Tile) TileCluster {
+	return TileCluster{Cell: cell, StatusCounts: make(map[Status]int)}
+}
+
+// Add counts count quests of the status whose target locations average to center.
+func (c *TileCluster) Add(status Status, count int, center kernel.GeoCoordinate) {
+	if count <= 0 {
+		return
+	}
+	total := float64(c.Count + count)
+	// Weighted mean; cells never cross the antimeridian
+	c.Center.Lat += (center.Lat - c.Center.Lat) * float64(count) / total
+	c.Center.Lon += (center.Lon - c.Center.Lon) * float64(count) / total
+	c.Count += count
+	c.StatusCounts[status] += count
+}
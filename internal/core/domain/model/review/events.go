@@ -0,0 +1,26 @@
+package review
+
+import (
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+// ReviewSubmitted represents a review submitted for a completed quest
+type ReviewSubmitted struct {
+	ddd.BaseEvent
+	QuestID    uuid.UUID `json:"quest_id"`
+	ReviewerID uuid.UUID `json:"reviewer_id"`
+	RevieweeID uuid.UUID `json:"reviewee_id"`
+	Rating     int       `json:"rating"`
+}
+
+func NewReviewSubmitted(r Review) ReviewSubmitted {
+	return ReviewSubmitted{
+		BaseEvent:  ddd.NewBaseEvent(r.ID(), "review.submitted"),
+		QuestID:    r.QuestID,
+		ReviewerID: r.ReviewerID,
+		RevieweeID: r.RevieweeID,
+		Rating:     r.Rating,
+	}
+}
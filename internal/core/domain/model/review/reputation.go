@@ -0,0 +1,30 @@
+package review
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// Reputation aggregates the reviews a user received.
+type Reputation struct {
+	UserID uuid.UUID
+	// AverageRating is the mean rating rounded to two decimals, 0 without reviews
+	AverageRating float64
+	ReviewCount   int
+}
+
+// NewReputation computes the reputation from the sum and the number of ratings.
+func NewReputation(userID uuid.UUID, ratingSum, reviewCount int) Reputation {
+	reputation := Reputation{UserID: userID, ReviewCount: reviewCount}
+	if reviewCount > 0 {
+		reputation.AverageRating = math.Round(float64(ratingSum)/float64(reviewCount)*100) / 100
+	}
+	return reputation
+}
+
+// AtLeast reports whether the user has been reviewed with at least the average rating.
+// Users without reviews never match.
+func (r Reputation) AtLeast(minRating float64) bool {
+	return r.ReviewCount > 0 && r.AverageRating >= minRating
+}
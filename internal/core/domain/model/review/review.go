@@ -0,0 +1,93 @@
+package review
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MinRating and MaxRating bound the rating of a review
+	MinRating = 1
+	MaxRating = 5
+	// MaxCommentLength limits the comment of a review
+	MaxCommentLength = 1000
+)
+
+// Review is the rating one side of a completed quest gives the other: the creator
+// rates each participant and each participant rates the creator, once per pair.
+// Participants do not rate each other.
+type Review struct {
+	*ddd.BaseAggregate[uuid.UUID]
+	QuestID    uuid.UUID
+	ReviewerID uuid.UUID
+	RevieweeID uuid.UUID
+	Rating     int
+	Comment    string
+	CreatedAt  time.Time
+}
+
+// TakesPart reports whether the user is the creator or a participant of the quest,
+// and so may review it and be reviewed on it.
+func TakesPart(q quest.Quest, userID uuid.UUID) bool {
+	// Creators without a user ID can neither review nor be reviewed
+	return isCreator(q, userID) || q.IsParticipant(userID)
+}
+
+func isCreator(q quest.Quest, userID uuid.UUID) bool {
+	creatorID, err := uuid.Parse(q.Creator)
+	return err == nil && creatorID != uuid.Nil && userID == creatorID
+}
+
+// NewReview creates the review the reviewer gives the reviewee for the completed quest.
+// existing are the reviews already submitted for the quest.
+func NewReview(q quest.Quest, reviewerID, revieweeID uuid.UUID, rating int, comment string, existing []Review) (*Review, error) {
+	if q.Status != quest.StatusCompleted {
+		return nil, errors.New("quest can be reviewed only if status is 'completed'")
+	}
+	if !TakesPart(q, reviewerID) {
+		return nil, errors.New("only the creator and the participants can review the quest")
+	}
+	if revieweeID == reviewerID {
+		return nil, errors.New("user cannot review themselves")
+	}
+	if !TakesPart(q, revieweeID) {
+		return nil, errors.New("reviewee must be the creator or a participant of the quest")
+	}
+	if !isCreator(q, reviewerID) && !isCreator(q, revieweeID) {
+		return nil, errors.New("participants can review only the creator of the quest")
+	}
+	if rating < MinRating || rating > MaxRating {
+		return nil, errors.New("rating must be between 1 and 5")
+	}
+	comment = strings.TrimSpace(comment)
+	if utf8.RuneCountInString(comment) > MaxCommentLength {
+		return nil, errors.New("review comment too long, maximum is 1000 characters")
+	}
+	for _, r := range existing {
+		if r.ReviewerID == reviewerID && r.RevieweeID == revieweeID {
+			return nil, errors.New("user has already reviewed this user for the quest")
+		}
+	}
+
+	r := &Review{
+		BaseAggregate: ddd.NewBaseAggregate(uuid.New()),
+		QuestID:       q.ID(),
+		ReviewerID:    reviewerID,
+		RevieweeID:    revieweeID,
+		Rating:        rating,
+		Comment:       comment,
+		CreatedAt:     time.Now(),
+	}
+
+	// Raise domain event
+	r.RaiseDomainEvent(NewReviewSubmitted(*r))
+
+	return r, nil
+}
@@ -0,0 +1,190 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the step between occurrences of a recurrence
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+// MaxInterval limits the number of frequency steps between occurrences
+const MaxInterval = 1000
+
+const (
+	untilDateTimeLayout = "20060102T150405Z"
+	untilDateLayout     = "20060102"
+)
+
+// Recurrence is a subset of the iCalendar RRULE: FREQ (DAILY, WEEKLY or MONTHLY),
+// INTERVAL, and either COUNT or UNTIL. The first occurrence is at Start,
+// the next ones are Interval frequency steps apart. Times are kept in UTC.
+type Recurrence struct {
+	Frequency Frequency
+	Interval  int
+	Count     int        // total number of occurrences; zero means no limit
+	Until     *time.Time // last possible occurrence time, inclusive
+	Start     time.Time
+}
+
+// ParseRecurrence parses a rule like "FREQ=WEEKLY;INTERVAL=2;COUNT=10" starting at start.
+// UNTIL is given as a UTC date-time (20261231T090000Z) or a date (20261231),
+// a date includes the whole day.
+func ParseRecurrence(rule string, start time.Time) (Recurrence, error) {
+	r := Recurrence{Interval: 1, Start: start.UTC().Truncate(time.Second)}
+	seen := map[string]bool{}
+
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			return Recurrence{}, fmt.Errorf("invalid rule part %q", part)
+		}
+		if seen[name] {
+			return Recurrence{}, fmt.Errorf("rule part %s is given twice", name)
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			r.Frequency = Frequency(strings.ToUpper(value))
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil {
+				return Recurrence{}, fmt.Errorf("invalid INTERVAL %q", value)
+			}
+			r.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return Recurrence{}, fmt.Errorf("invalid COUNT %q: must be a positive number", value)
+			}
+			r.Count = count
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return Recurrence{}, err
+			}
+			r.Until = &until
+		default:
+			return Recurrence{}, fmt.Errorf("unsupported rule part %s: only FREQ, INTERVAL, COUNT and UNTIL are supported", name)
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return Recurrence{}, err
+	}
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(untilDateTimeLayout, strings.ToUpper(value)); err == nil {
+		return until, nil
+	}
+	if day, err := time.Parse(untilDateLayout, value); err == nil {
+		return day.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q: must be like 20261231T090000Z or 20261231", value)
+}
+
+func (r Recurrence) validate() error {
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	case "":
+		return errors.New("FREQ is required")
+	default:
+		return fmt.Errorf("unsupported FREQ %q: must be one of DAILY, WEEKLY, MONTHLY", r.Frequency)
+	}
+	if r.Interval < 1 || r.Interval > MaxInterval {
+		return fmt.Errorf("INTERVAL must be between 1 and %d", MaxInterval)
+	}
+	if r.Count < 0 {
+		return errors.New("COUNT must be a positive number")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return errors.New("COUNT and UNTIL cannot be combined")
+	}
+	if r.Start.IsZero() {
+		return errors.New("recurrence start is required")
+	}
+	if r.Until != nil && r.Until.Before(r.Start) {
+		return errors.New("UNTIL cannot be before the recurrence start")
+	}
+	return nil
+}
+
+// String formats the recurrence back as a rule, without the start.
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilDateTimeLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence strictly after the given time.
+// ok is false if the recurrence has no more occurrences.
+func (r Recurrence) Next(after time.Time) (at time.Time, ok bool) {
+	// Daily and weekly occurrences never skip a step, so the search can start
+	// just before the given time instead of at the first occurrence
+	step := 0
+	if r.Frequency != FrequencyMonthly && after.After(r.Start) {
+		days := 1
+		if r.Frequency == FrequencyWeekly {
+			days = 7
+		}
+		period := time.Duration(days*r.Interval) * 24 * time.Hour
+		step = max(int(after.Sub(r.Start)/period)-1, 0)
+	}
+
+	for n := step; ; step++ {
+		candidate, valid := r.occurrence(step)
+		if !valid {
+			continue
+		}
+		n++
+		if r.Count > 0 && n > r.Count {
+			return time.Time{}, false
+		}
+		if r.Until != nil && candidate.After(*r.Until) {
+			return time.Time{}, false
+		}
+		if candidate.After(after) {
+			return candidate, true
+		}
+	}
+}
+
+// occurrence returns the candidate time of the given step. Monthly steps landing
+// on a day the month does not have (like February 30) are skipped, as in RRULE.
+func (r Recurrence) occurrence(step int) (time.Time, bool) {
+	switch r.Frequency {
+	case FrequencyWeekly:
+		return r.Start.AddDate(0, 0, 7*r.Interval*step), true
+	case FrequencyMonthly:
+		at := r.Start.AddDate(0, r.Interval*step, 0)
+		return at, at.Day() == r.Start.Day()
+	default:
+		return r.Start.AddDate(0, 0, r.Interval*step), true
+	}
+}
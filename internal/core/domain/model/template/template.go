@@ -0,0 +1,196 @@
+package template
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxNameLength limits the name of a template
+	MaxNameLength = 200
+	// OccurrenceClaimTimeout is how long the run that claimed an occurrence has to create
+	// its quest; after that a later run retries
+	OccurrenceClaimTimeout = 15 * time.Minute
+)
+
+// Spec holds everything needed to create a quest from a template.
+// Locations may be given by coordinates, by address, or both; addresses
+// are geocoded each time a quest is created.
+type Spec struct {
+	Title             string
+	Description       string
+	Difficulty        string
+	Reward            int
+	DurationMinutes   int
+	TargetLocation    *kernel.GeoCoordinate
+	TargetName        *string
+	TargetAddress     *string
+	ExecutionLocation *kernel.GeoCoordinate
+	ExecutionName     *string
+	ExecutionAddress  *string
+	Waypoints         []kernel.GeoCoordinate
+	Equipment         []string
+	Skills            []string
+	EligibilityPolicy string
+	AssignmentMode    string
+	Capacity          int
+	CompletionQuorum  int
+	GeofenceRadius    int
+}
+
+// Validate checks the spec against the rules of quest creation,
+// except for geocoding the addresses.
+func (s Spec) Validate() error {
+	if strings.TrimSpace(s.Title) == "" {
+		return errors.New("quest title is required")
+	}
+	if strings.TrimSpace(s.Description) == "" {
+		return errors.New("quest description is required")
+	}
+	if !hasLocation(s.TargetLocation, s.TargetAddress) {
+		return errors.New("target location requires coordinates or an address")
+	}
+	if !hasLocation(s.ExecutionLocation, s.ExecutionAddress) {
+		return errors.New("execution location requires coordinates or an address")
+	}
+
+	q, err := quest.NewQuest(
+		s.Title, s.Description, s.Difficulty, s.Reward, s.DurationMinutes,
+		kernel.GeoCoordinate{}, kernel.GeoCoordinate{}, "", s.Equipment, s.Skills,
+	)
+	if err != nil {
+		return err
+	}
+	if err := q.SetWaypoints(s.Waypoints); err != nil {
+		return err
+	}
+	if err := q.SetEligibilityPolicy(s.EligibilityPolicy); err != nil {
+		return err
+	}
+	if err := q.SetAssignmentMode(s.AssignmentMode); err != nil {
+		return err
+	}
+	if err := q.SetCapacity(s.Capacity, s.CompletionQuorum); err != nil {
+		return err
+	}
+	return q.SetGeofenceRadius(s.GeofenceRadius)
+}
+
+func hasLocation(coordinate *kernel.GeoCoordinate, address *string) bool {
+	return coordinate != nil || (address != nil && strings.TrimSpace(*address) != "")
+}
+
+// QuestTemplate is a reusable quest definition of its owner. Quests are created
+// from it on demand and, if it has a recurrence, on every occurrence.
+type QuestTemplate struct {
+	*ddd.BaseAggregate[uuid.UUID]
+	OwnerID    uuid.UUID
+	Name       string
+	Quest      Spec
+	Recurrence *Recurrence
+	// Next occurrence the scheduler has to create a quest for; nil if there is none
+	NextOccurrence *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewQuestTemplate creates a template of the owner. Occurrences of the recurrence
+// before now are not scheduled.
+func NewQuestTemplate(ownerID uuid.UUID, name string, spec Spec, recurrence *Recurrence, now time.Time) (*QuestTemplate, error) {
+	if ownerID == uuid.Nil {
+		return nil, errors.New("template owner is required")
+	}
+
+	t := &QuestTemplate{
+		BaseAggregate: ddd.NewBaseAggregate(uuid.New()),
+		OwnerID:       ownerID,
+		CreatedAt:     now,
+	}
+	if err := t.Update(name, spec, recurrence, now); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Update replaces the name, quest spec and recurrence of the template.
+// The schedule restarts from now, so a changed recurrence does not catch up
+// on occurrences in the past.
+func (t *QuestTemplate) Update(name string, spec Spec, recurrence *Recurrence, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("template name is required")
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return errors.New("template name too long, maximum is 200 characters")
+	}
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	if recurrence != nil {
+		if err := recurrence.validate(); err != nil {
+			return err
+		}
+	}
+
+	t.Name = name
+	t.Quest = spec
+	t.Recurrence = recurrence
+	t.NextOccurrence = nil
+	if recurrence != nil {
+		if next, ok := recurrence.Next(now.Add(-time.Nanosecond)); ok {
+			t.NextOccurrence = &next
+		}
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+// IsOwner reports whether the user owns the template.
+func (t QuestTemplate) IsOwner(userID uuid.UUID) bool {
+	return t.OwnerID == userID
+}
+
+// DueOccurrence returns the next occurrence if it is due at the given time.
+func (t QuestTemplate) DueOccurrence(now time.Time) (time.Time, bool) {
+	if t.NextOccurrence == nil || t.NextOccurrence.After(now) {
+		return time.Time{}, false
+	}
+	return *t.NextOccurrence, true
+}
+
+// AdvanceOccurrence moves the schedule past the next occurrence.
+func (t *QuestTemplate) AdvanceOccurrence(now time.Time) {
+	if t.NextOccurrence == nil || t.Recurrence == nil {
+		return
+	}
+	current := *t.NextOccurrence
+	t.NextOccurrence = nil
+	if next, ok := t.Recurrence.Next(current); ok {
+		t.NextOccurrence = &next
+	}
+	t.UpdatedAt = now
+}
+
+// Occurrence records that a quest is created for one occurrence of a template.
+// It is added before the quest is created, so concurrent runs do not create the quest twice.
+// An occurrence still without a quest when its claim expires is retried by a later run.
+type Occurrence struct {
+	TemplateID uuid.UUID
+	At         time.Time
+	QuestID    *uuid.UUID // nil until the quest is created
+	CreatedAt  time.Time
+	ClaimedAt  time.Time // when a run last took the occurrence to create its quest
+}
+
+// Retryable reports whether the occurrence has no quest and its claim has expired at now.
+func (o Occurrence) Retryable(now time.Time) bool {
+	return o.QuestID == nil && !now.Before(o.ClaimedAt.Add(OccurrenceClaimTimeout))
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+// UserCreated represents the creation of a user profile
+type UserCreated struct {
+	ddd.BaseEvent
+	DisplayName string `json:"display_name"`
+}
+
+func NewUserCreated(userID uuid.UUID, displayName string) UserCreated {
+	return UserCreated{
+		BaseEvent:   ddd.NewBaseEvent(userID, "user.created"),
+		DisplayName: displayName,
+	}
+}
+
+// UserProfileUpdated represents an update of a user profile
+type UserProfileUpdated struct {
+	ddd.BaseEvent
+	DisplayName string `json:"display_name"`
+}
+
+func NewUserProfileUpdated(userID uuid.UUID, displayName string) UserProfileUpdated {
+	return UserProfileUpdated{
+		BaseEvent:   ddd.NewBaseEvent(userID, "user.profile_updated"),
+		DisplayName: displayName,
+	}
+}
@@ -0,0 +1,176 @@
+package user
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/pkg/ddd"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxDisplayNameLength is the maximum length of a display name
+	MaxDisplayNameLength = 100
+	// MaxListItems is the maximum number of skills and of equipment items
+	MaxListItems = 50
+	// MaxListItemLength is the maximum length of a single skill or equipment item
+	MaxListItemLength = 100
+	// MaxTravelRadiusLimitKm caps the travel radius a user may declare
+	MaxTravelRadiusLimitKm = 1000.0
+)
+
+// User is the profile of a quest-manager user.
+// The ID is the user ID issued by the auth service; the profile is owned by quest-manager.
+type User struct {
+	*ddd.BaseAggregate[uuid.UUID]
+	DisplayName string
+	Skills      []string
+	Equipment   []string
+
+	// Optional home location and how far the user is willing to travel from it
+	HomeLocation      *kernel.GeoCoordinate
+	MaxTravelRadiusKm *float64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Profile holds the editable fields of a user.
+type Profile struct {
+	DisplayName       string
+	Skills            []string
+	Equipment         []string
+	HomeLocation      *kernel.GeoCoordinate
+	MaxTravelRadiusKm *float64
+}
+
+// NewUser creates the profile of the user with the given auth ID.
+func NewUser(id uuid.UUID, profile Profile) (*User, error) {
+	if id == uuid.Nil {
+		return nil, errors.New("user id must not be empty")
+	}
+
+	now := time.Now()
+	u := &User{
+		BaseAggregate: ddd.NewBaseAggregate(id),
+		CreatedAt:     now,
+	}
+	if err := u.apply(profile, now); err != nil {
+		return nil, err
+	}
+
+	// Raise domain event
+	u.RaiseDomainEvent(NewUserCreated(id, u.DisplayName))
+
+	return u, nil
+}
+
+// UpdateProfile replaces all editable fields of the profile.
+func (u *User) UpdateProfile(profile Profile) error {
+	if err := u.apply(profile, time.Now()); err != nil {
+		return err
+	}
+
+	// Raise domain event
+	u.RaiseDomainEvent(NewUserProfileUpdated(u.ID(), u.DisplayName))
+
+	return nil
+}
+
+// HasSkill reports whether the user has the skill (case-insensitive).
+func (u *User) HasSkill(skill string) bool {
+	return containsFold(u.Skills, skill)
+}
+
+// HasEquipment reports whether the user owns the equipment item (case-insensitive).
+func (u *User) HasEquipment(item string) bool {
+	return containsFold(u.Equipment, item)
+}
+
+// apply validates the profile and stores it; the user is left unchanged on error
+func (u *User) apply(profile Profile, now time.Time) error {
+	displayName := strings.TrimSpace(profile.DisplayName)
+	if displayName == "" {
+		return errors.New("display name must not be empty")
+	}
+	if len([]rune(displayName)) > MaxDisplayNameLength {
+		return errors.New("display name is too long, maximum is 100 characters")
+	}
+
+	skills, err := normalizeList("skills", profile.Skills)
+	if err != nil {
+		return err
+	}
+	equipment, err := normalizeList("equipment", profile.Equipment)
+	if err != nil {
+		return err
+	}
+
+	var home *kernel.GeoCoordinate
+	if profile.HomeLocation != nil {
+		c, err := kernel.NewGeoCoordinate(profile.HomeLocation.Lat, profile.HomeLocation.Lon)
+		if err != nil {
+			return errors.New("home location is invalid: " + err.Error())
+		}
+		home = &c
+	}
+
+	var radius *float64
+	if profile.MaxTravelRadiusKm != nil {
+		r := *profile.MaxTravelRadiusKm
+		if r <= 0 || r > MaxTravelRadiusLimitKm {
+			return errors.New("max travel radius must be greater than 0 and at most 1000 km")
+		}
+		radius = &r
+	}
+
+	u.DisplayName = displayName
+	u.Skills = skills
+	u.Equipment = equipment
+	u.HomeLocation = home
+	u.MaxTravelRadiusKm = radius
+	u.UpdatedAt = now
+	return nil
+}
+
+// normalizeList trims items and drops case-insensitive duplicates, keeping the first spelling.
+// Items must not contain commas because lists are stored comma-separated.
+func normalizeList(name string, items []string) ([]string, error) {
+	result := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		trimmed := strings.TrimSpace(item)
+		if trimmed == "" {
+			return nil, errors.New(name + " must not contain empty items")
+		}
+		if len([]rune(trimmed)) > MaxListItemLength {
+			return nil, errors.New(name + " item is too long, maximum is 100 characters")
+		}
+		if strings.Contains(trimmed, ",") {
+			return nil, errors.New(name + " item must not contain commas")
+		}
+		key := strings.ToLower(trimmed)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, trimmed)
+	}
+	if len(result) > MaxListItems {
+		return nil, errors.New(name + " has too many items, maximum is 50")
+	}
+	return result, nil
+}
+
+func containsFold(items []string, value string) bool {
+	value = strings.TrimSpace(value)
+	for _, item := range items {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
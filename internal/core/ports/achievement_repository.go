@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/achievement"
+
+	"github.com/google/uuid"
+)
+
+// AchievementRepository defines access methods for the facts achievements count and the unlocked achievements.
+type AchievementRepository interface {
+	// RecordFact stores the fact unless one for the same user, quest and kind exists; reports whether it was stored.
+	RecordFact(ctx context.Context, fact achievement.Fact) (bool, error)
+	// FindFacts returns all facts of the user.
+	FindFacts(ctx context.Context, userID uuid.UUID) ([]achievement.Fact, error)
+	// Unlock stores the achievement unless the user already unlocked it; reports whether it was stored.
+	Unlock(ctx context.Context, unlocked achievement.Unlocked) (bool, error)
+	// FindUnlocked returns the achievements of the user in the order they were unlocked.
+	FindUnlocked(ctx context.Context, userID uuid.UUID) ([]achievement.Unlocked, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// ErrApplicationNotFound is returned when no application exists with the given ID.
+var ErrApplicationNotFound = errors.New("application not found")
+
+// ApplicationRepository defines access methods for quest applications.
+type ApplicationRepository interface {
+	// GetByID returns the application; the error wraps ErrApplicationNotFound if there is none.
+	GetByID(ctx context.Context, applicationID uuid.UUID) (quest.Application, error)
+	// FindByQuest returns the applications to a quest, oldest first.
+	FindByQuest(ctx context.Context, questID uuid.UUID) ([]quest.Application, error)
+	Save(ctx context.Context, application quest.Application) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when no blob is stored under the given key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStorage stores binary content such as evidence photos under slash-separated keys.
+type BlobStorage interface {
+	// Put stores the content under the key, replacing any previous content.
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Get opens the content stored under the key; the error wraps ErrBlobNotFound if there is none.
+	// The caller closes the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under the key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
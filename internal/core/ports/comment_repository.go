@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// ErrCommentNotFound is returned when no comment exists with the given ID.
+var ErrCommentNotFound = errors.New("comment not found")
+
+// CommentRepository defines access methods for the comment threads of quests.
+type CommentRepository interface {
+	// GetByID returns the comment; the error wraps ErrCommentNotFound if there is none.
+	GetByID(ctx context.Context, commentID uuid.UUID) (quest.Comment, error)
+	// FindByQuest returns a page of the comments of a quest, oldest first, and the total number of
+	// comments. Private comments are included only if includePrivate is set.
+	FindByQuest(ctx context.Context, questID uuid.UUID, includePrivate bool, limit, offset int) ([]quest.Comment, int, error)
+	Save(ctx context.Context, comment quest.Comment) error
+}
@@ -13,6 +13,11 @@ type EventPublisher interface {
 	PublishAsync(ctx context.Context, events ...ddd.DomainEvent)
 }
 
+// EventHandler reacts to published domain events
+type EventHandler interface {
+	Handle(ctx context.Context, event ddd.DomainEvent) error
+}
+
 // NullEventPublisher is a no-op implementation for development
 type NullEventPublisher struct{}
 
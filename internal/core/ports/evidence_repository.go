@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// ErrEvidenceNotFound is returned when no completion evidence exists with the given ID.
+var ErrEvidenceNotFound = errors.New("evidence not found")
+
+// EvidenceRepository defines access methods for quest completion evidence.
+type EvidenceRepository interface {
+	// GetByID returns the evidence; the error wraps ErrEvidenceNotFound if there is none.
+	GetByID(ctx context.Context, evidenceID uuid.UUID) (quest.Evidence, error)
+	// FindByQuest returns the evidence submitted for a quest, oldest first.
+	FindByQuest(ctx context.Context, questID uuid.UUID) ([]quest.Evidence, error)
+	Save(ctx context.Context, evidence quest.Evidence) error
+}
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+// ErrGeocodeNotFound is returned when the geocoder has no result for the request.
+var ErrGeocodeNotFound = errors.New("geocode result not found")
+
+// GeocodeResult is a coordinate together with its human-readable address.
+type GeocodeResult struct {
+	Coordinate kernel.GeoCoordinate
+	Address    string
+}
+
+// Geocoder converts addresses to coordinates and back.
+type Geocoder interface {
+	// Geocode resolves a free-form address to a coordinate.
+	Geocode(ctx context.Context, address string) (GeocodeResult, error)
+
+	// ReverseGeocode resolves a coordinate to the nearest known address.
+	ReverseGeocode(ctx context.Context, coordinate kernel.GeoCoordinate) (GeocodeResult, error)
+}
+
+// NullGeocoder is a geocoder without a provider; every lookup returns ErrGeocodeNotFound.
+type NullGeocoder struct{}
+
+func (g *NullGeocoder) Geocode(ctx context.Context, address string) (GeocodeResult, error) {
+	return GeocodeResult{}, ErrGeocodeNotFound
+}
+
+func (g *NullGeocoder) ReverseGeocode(ctx context.Context, coordinate kernel.GeoCoordinate) (GeocodeResult, error) {
+	return GeocodeResult{}, ErrGeocodeNotFound
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/leaderboard"
+)
+
+// LeaderboardRepository defines access methods for the leaderboard projection.
+type LeaderboardRepository interface {
+	// Record stores the score unless a score for the same ledger entry exists.
+	Record(ctx context.Context, score leaderboard.Score) error
+	// Top returns the users with the highest totals of the matching scores, highest first
+	// (ties by user ID), at most filter.Limit. Ranks are not assigned.
+	Top(ctx context.Context, filter leaderboard.Filter) ([]leaderboard.Standing, error)
+}
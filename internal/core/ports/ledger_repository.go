@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/ledger"
+
+	"github.com/google/uuid"
+)
+
+// LedgerRepository defines access methods for the append-only points ledger.
+type LedgerRepository interface {
+	// Append adds the entry unless an entry with the same idempotency key exists; reports whether it was added.
+	Append(ctx context.Context, entry ledger.Entry) (bool, error)
+	// Balance returns the sum of the points credited to the user, 0 if there are none.
+	Balance(ctx context.Context, userID uuid.UUID) (int, error)
+	// FindByUser returns a page of the user's entries, newest first, and the total number of entries.
+	FindByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]ledger.Entry, int, error)
+}
@@ -20,6 +20,19 @@ type LocationRepository interface {
 	// FindByBoundingBox returns all locations within the specified bounding box area.
 	FindByBoundingBox(ctx context.Context, bbox kernel.BoundingBox) ([]*location.Location, error)
 
-	// FindByName searches locations by name (partial match).
+	// FindWithinRadius returns locations within radiusKm of center, closest first.
+	FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64) ([]*location.Location, error)
+
+	// FindByName searches locations by name or address (partial match).
 	FindByName(ctx context.Context, namePattern string) ([]*location.Location, error)
+
+	// FindByAddress returns locations whose address equals address (case-insensitive), newest first.
+	FindByAddress(ctx context.Context, address string) ([]*location.Location, error)
+
+	// FindByCoordinate returns locations stored at exactly the given coordinate, newest first.
+	FindByCoordinate(ctx context.Context, coordinate kernel.GeoCoordinate) ([]*location.Location, error)
+
+	// SearchByText performs fuzzy search across name and address.
+	// Results are ordered by relevance, best match first.
+	SearchByText(ctx context.Context, query string, limit int) ([]location.SearchMatch, error)
 }
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/notification"
+)
+
+// NotificationChannel delivers notifications outside the app, e.g. by email or push.
+// The inbox keeps every notification whether or not delivery succeeds.
+type NotificationChannel interface {
+	Deliver(ctx context.Context, n notification.Notification) error
+}
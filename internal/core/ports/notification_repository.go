@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/notification"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotificationNotFound is returned when no notification exists with the given ID.
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// NotificationRepository defines access methods for notification inboxes and preferences.
+type NotificationRepository interface {
+	// Save inserts or updates a notification.
+	Save(ctx context.Context, n notification.Notification) error
+	// GetByID returns the notification; the error wraps ErrNotificationNotFound if there is none.
+	GetByID(ctx context.Context, notificationID uuid.UUID) (notification.Notification, error)
+	// FindByUser returns a page of the user's notifications, newest first, and the total number
+	// of notifications. With unreadOnly read notifications are skipped.
+	FindByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]notification.Notification, int, error)
+	// CountUnread returns the number of unread notifications of the user.
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	// MarkAllRead marks every unread notification of the user read and returns how many there were.
+	MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+	// Preferences returns the preferences of the user, notification.DefaultPreferences if none are saved.
+	Preferences(ctx context.Context, userID uuid.UUID) (notification.Preferences, error)
+	SavePreferences(ctx context.Context, p notification.Preferences) error
+}
@@ -12,6 +12,12 @@ import (
 // QuestRepository defines access methods for quests.
 type QuestRepository interface {
 	GetByID(ctx context.Context, questID uuid.UUID) (quest.Quest, error)
+
+	// GetByIDForUpdate retrieves a quest and locks it until the current transaction ends,
+	// so that concurrent changes of its participants run one after another.
+	// Must be called inside a transaction.
+	GetByIDForUpdate(ctx context.Context, questID uuid.UUID) (quest.Quest, error)
+
 	Save(ctx context.Context, q quest.Quest) error
 
 	// FindAll retrieves all quests without filters.
@@ -20,10 +26,40 @@ type QuestRepository interface {
 	// FindByStatus retrieves all quests with the specified status.
 	FindByStatus(ctx context.Context, status quest.Status) ([]quest.Quest, error)
 
+	// FindByFilter retrieves the quests matching every set field of the filter.
+	FindByFilter(ctx context.Context, filter quest.ListFilter) ([]quest.Quest, error)
+
 	// FindByBoundingBox returns all quests within the specified bounding box area.
 	// This is a simple database query without business logic.
 	FindByBoundingBox(ctx context.Context, bbox kernel.BoundingBox) ([]quest.Quest, error)
 
+	// ClusterByTile groups the quests whose target location lies in the tile by the cell at
+	// cellZoom containing it, ordered row by row (north to south, west to east).
+	// Clusters are aggregated by the store, quests are not loaded.
+	ClusterByTile(ctx context.Context, tile kernel.Tile, cellZoom int) ([]quest.TileCluster, error)
+
+	// FindWithinRadius returns at most limit quests (zero means no limit) whose target or
+	// execution location lies within radiusKm of center, ordered by sortBy. Distances are to
+	// the nearest of the two locations; ties are ordered closest first, then by ID.
+	FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64, sortBy quest.RadiusSort, limit int) ([]quest.Quest, error)
+
+	// FindInPolygon returns quests whose locations selected by match lie inside the polygon
+	// (boundary included).
+	FindInPolygon(ctx context.Context, polygon kernel.Polygon, match quest.LocationMatch) ([]quest.Quest, error)
+
 	// FindByAssignee returns all quests assigned to a specific user.
 	FindByAssignee(ctx context.Context, userID uuid.UUID) ([]quest.Quest, error)
+
+	// FindDependents returns the quests that have the quest as a prerequisite.
+	FindDependents(ctx context.Context, prerequisiteID uuid.UUID) ([]quest.Quest, error)
+
+	// FindLinkedByPrerequisites returns the quest and every quest linked to it through
+	// prerequisites in either direction, directly or transitively, oldest first.
+	// The result is empty if the quest does not exist.
+	FindLinkedByPrerequisites(ctx context.Context, questID uuid.UUID) ([]quest.Quest, error)
+
+	// LockLinkedByPrerequisites locks the quests and every quest linked to them through
+	// prerequisites until the current transaction ends, so that concurrent prerequisite
+	// changes of linked quests run one after another. Must be called inside a transaction.
+	LockLinkedByPrerequisites(ctx context.Context, questIDs ...uuid.UUID) error
 }
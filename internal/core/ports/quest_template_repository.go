@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/template"
+
+	"github.com/google/uuid"
+)
+
+// ErrQuestTemplateNotFound is returned when no quest template exists with the given ID.
+var ErrQuestTemplateNotFound = errors.New("quest template not found")
+
+// QuestTemplateRepository defines access methods for quest templates and their scheduled occurrences.
+type QuestTemplateRepository interface {
+	// Save inserts or updates a template.
+	Save(ctx context.Context, t *template.QuestTemplate) error
+	// GetByID returns the template; the error wraps ErrQuestTemplateNotFound if there is none.
+	GetByID(ctx context.Context, templateID uuid.UUID) (*template.QuestTemplate, error)
+	// FindByOwner returns the templates of the user, oldest first.
+	FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]*template.QuestTemplate, error)
+	// FindDue returns up to limit templates whose next occurrence is at or before now, earliest first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*template.QuestTemplate, error)
+	// Delete removes the template and its occurrences; the error wraps ErrQuestTemplateNotFound if there is none.
+	Delete(ctx context.Context, templateID uuid.UUID) error
+	// AddOccurrence adds the occurrence unless one exists for the same template and time; reports whether it was added.
+	AddOccurrence(ctx context.Context, o template.Occurrence) (bool, error)
+	// SaveOccurrence updates the quest and the claim time of an added occurrence.
+	SaveOccurrence(ctx context.Context, o template.Occurrence) error
+	// FindRetryableOccurrences returns up to limit occurrences that are retryable at now, earliest first.
+	// Inside a transaction they are locked, skipping occurrences locked by another transaction.
+	FindRetryableOccurrences(ctx context.Context, now time.Time, limit int) ([]template.Occurrence, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/review"
+
+	"github.com/google/uuid"
+)
+
+// ReviewRepository defines access methods for reviews and the reputation aggregated from them.
+type ReviewRepository interface {
+	// FindByQuest returns the reviews of a quest, oldest first.
+	FindByQuest(ctx context.Context, questID uuid.UUID) ([]review.Review, error)
+	Save(ctx context.Context, r review.Review) error
+	// Reputations returns the reputation of every given user; users without reviews have a zero reputation.
+	Reputations(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]review.Reputation, error)
+}
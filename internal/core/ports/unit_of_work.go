@@ -8,6 +8,20 @@ type UnitOfWork interface {
 	Begin(ctx context.Context) error
 	Commit(ctx context.Context) error
 	Rollback() error
+	// AfterCommit registers fn to run once the current transaction has committed, e.g. to hand
+	// data to external systems only when it is durable. Callbacks are dropped on rollback;
+	// outside a transaction fn runs immediately.
+	AfterCommit(fn func())
 	QuestRepository() QuestRepository
 	LocationRepository() LocationRepository
+	UserRepository() UserRepository
+	ApplicationRepository() ApplicationRepository
+	EvidenceRepository() EvidenceRepository
+	LedgerRepository() LedgerRepository
+	LeaderboardRepository() LeaderboardRepository
+	AchievementRepository() AchievementRepository
+	ReviewRepository() ReviewRepository
+	CommentRepository() CommentRepository
+	NotificationRepository() NotificationRepository
+	QuestTemplateRepository() QuestTemplateRepository
 }
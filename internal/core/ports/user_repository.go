@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/user"
+
+	"github.com/google/uuid"
+)
+
+// ErrUserNotFound is returned when no profile is stored for the user.
+var ErrUserNotFound = errors.New("user not found")
+
+// UserRepository defines access methods for user profiles.
+type UserRepository interface {
+	// GetByID returns the profile of the user; the error wraps ErrUserNotFound if there is none.
+	GetByID(ctx context.Context, userID uuid.UUID) (*user.User, error)
+	Save(ctx context.Context, u *user.User) error
+}
@@ -4,9 +4,17 @@ import "fmt"
 
 // DomainValidationError represents validation error at domain/application level
 type DomainValidationError struct {
+	Field      string
+	Message    string
+	Cause      error
+	Violations []Violation // optional details, one per invalid field
+}
+
+// Violation describes why a single field is invalid; Values lists the offending values, if any
+type Violation struct {
 	Field   string
 	Message string
-	Cause   error
+	Values  []string
 }
 
 func (e *DomainValidationError) Error() string {
@@ -31,6 +39,15 @@ func NewDomainValidationErrorWithCause(field, message string, cause error) *Doma
 	}
 }
 
+// NewDomainValidationErrorWithViolations creates a validation error with structured details
+func NewDomainValidationErrorWithViolations(field, message string, violations []Violation) *DomainValidationError {
+	return &DomainValidationError{
+		Field:      field,
+		Message:    message,
+		Violations: violations,
+	}
+}
+
 // NotFoundError represents "not found" error
 type NotFoundError struct {
 	Resource string
@@ -59,3 +76,20 @@ func NewNotFoundErrorWithCause(resource, id string, cause error) *NotFoundError
 		Cause:    cause,
 	}
 }
+
+// ForbiddenError represents an operation the user is not allowed to perform
+type ForbiddenError struct {
+	Action string
+	Reason string
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("forbidden to %s: %s", e.Action, e.Reason)
+}
+
+func NewForbiddenError(action, reason string) *ForbiddenError {
+	return &ForbiddenError{
+		Action: action,
+		Reason: reason,
+	}
+}
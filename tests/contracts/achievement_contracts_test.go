@@ -0,0 +1,184 @@
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	"quest-manager/internal/core/application/eventhandlers"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// AchievementContractSuite defines contract tests for achievements unlocked by quest events
+type AchievementContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	ctx       context.Context
+}
+
+func (s *AchievementContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.creator = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *AchievementContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestAchievementContract(t *testing.T) {
+	suite.Run(t, new(AchievementContractSuite))
+}
+
+// assign creates a quest executed at the location and assigns it to the participant
+func (s *AchievementContractSuite) assign(participant uuid.UUID, difficulty string, execution kernel.GeoCoordinate) quest.Quest {
+	created := createQuest(&s.Suite, s.container, s.creator, withDifficulty(difficulty), withLocation(execution))
+	assignQuest(&s.Suite, s.container, created.ID(), participant)
+	return created
+}
+
+// complete assigns a quest to the participant and completes it
+func (s *AchievementContractSuite) complete(participant uuid.UUID, difficulty string, execution kernel.GeoCoordinate) quest.Quest {
+	created := s.assign(participant, difficulty, execution)
+	completeQuest(&s.Suite, s.container, created.ID(), participant, s.creator)
+	return created
+}
+
+// unlockedEvents returns the published user.achievement_unlocked events
+func (s *AchievementContractSuite) unlockedEvents() []achievement.AchievementUnlocked {
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var unlocked []achievement.AchievementUnlocked
+	for _, e := range publisher.PublishedEvents {
+		if u, ok := e.(achievement.AchievementUnlocked); ok {
+			unlocked = append(unlocked, u)
+		}
+	}
+	return unlocked
+}
+
+func (s *AchievementContractSuite) achievements(userID uuid.UUID) map[string]queries.Achievement {
+	list, err := s.container.ListAchievementsHandler.Handle(s.ctx, userID)
+	s.Require().NoError(err)
+	byCode := make(map[string]queries.Achievement, len(list))
+	for _, a := range list {
+		byCode[a.Code] = a
+	}
+	return byCode
+}
+
+func (s *AchievementContractSuite) TestAssignmentUnlocksFirstSteps() {
+	participant := uuid.New()
+	s.assign(participant, "easy", kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522})
+
+	unlocked := s.unlockedEvents()
+	s.Require().Len(unlocked, 1)
+	s.Equal("user.achievement_unlocked", unlocked[0].GetName())
+	s.Equal(participant, unlocked[0].GetAggregateID())
+	s.Equal("first_steps", unlocked[0].Code)
+
+	achievements := s.achievements(participant)
+	s.NotNil(achievements["first_steps"].UnlockedAt)
+	s.Nil(achievements["first_quest"].UnlockedAt)
+	s.Equal(0, achievements["first_quest"].Progress)
+}
+
+func (s *AchievementContractSuite) TestCreatorAssigningOwnQuestUnlocksNothing() {
+	s.assign(s.creator, "easy", kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522})
+
+	s.Empty(s.unlockedEvents())
+	achievements := s.achievements(s.creator)
+	s.Nil(achievements["first_steps"].UnlockedAt)
+	s.Equal(0, achievements["first_steps"].Progress)
+}
+
+func (s *AchievementContractSuite) TestCompletionUnlocksAndReportsProgress() {
+	participant := uuid.New()
+	s.complete(participant, "hard", kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522})
+
+	codes := make([]string, 0)
+	for _, u := range s.unlockedEvents() {
+		codes = append(codes, u.Code)
+	}
+	s.ElementsMatch([]string{"first_steps", "first_quest"}, codes)
+
+	achievements := s.achievements(participant)
+	s.NotNil(achievements["first_quest"].UnlockedAt)
+	s.Equal(1, achievements["hard_worker"].Progress)
+	s.Equal(10, achievements["hard_worker"].Target)
+	s.Equal(1, achievements["explorer"].Progress)
+	s.Nil(achievements["explorer"].UnlockedAt)
+	s.Empty(s.achievements(s.creator)["first_quest"].UnlockedAt, "the creator did not take part")
+}
+
+func (s *AchievementContractSuite) TestRepeatedCompletionEventUnlocksOnce() {
+	participant := uuid.New()
+	completed := s.complete(participant, "easy", kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522})
+	before := len(s.unlockedEvents())
+
+	// Deliver the completion again, as a retried event would be
+	completion := quest.NewQuestStatusChanged(completed.ID(), quest.StatusInProgress, quest.StatusCompleted, nil)
+	s.Require().NoError(s.container.EventDispatcher.Publish(s.ctx, completion))
+
+	s.Len(s.unlockedEvents(), before)
+	facts, err := s.container.UnitOfWork.AchievementRepository().FindFacts(s.ctx, participant)
+	s.Require().NoError(err)
+	s.Len(facts, 2, "one assigned and one completed fact")
+}
+
+func (s *AchievementContractSuite) TestCustomDefinitionsCountDifficultyAndLocations() {
+	definitions := []achievement.Definition{
+		{Code: "hard_pair", Title: "Hard Pair", Criterion: achievement.CriterionCompletedQuests, Count: 2, Difficulty: quest.DifficultyHard},
+		{Code: "traveller", Title: "Traveller", Criterion: achievement.CriterionDistinctLocations, Count: 2},
+	}
+	participant := uuid.New()
+	paris := kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522}
+	berlin := kernel.GeoCoordinate{Lat: 52.52, Lon: 13.405}
+	first := s.complete(participant, "hard", paris)
+	second := s.complete(participant, "easy", paris)
+	third := s.complete(participant, "hard", berlin)
+
+	// A separate handler evaluates the same facts against other definitions
+	uow := mocks.NewMockUnitOfWork()
+	publisher := &mocks.MockEventPublisher{}
+	handler := eventhandlers.NewAchievementHandler(uow, publisher, definitions)
+	for _, q := range []quest.Quest{first, second, third} {
+		stored, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, q.ID())
+		s.Require().NoError(err)
+		s.Require().NoError(uow.QuestRepository().Save(s.ctx, stored))
+	}
+
+	s.Require().NoError(handler.Handle(s.ctx, quest.NewQuestStatusChanged(first.ID(), quest.StatusInProgress, quest.StatusCompleted, nil)))
+	s.Require().NoError(handler.Handle(s.ctx, quest.NewQuestStatusChanged(second.ID(), quest.StatusInProgress, quest.StatusCompleted, nil)))
+	s.Empty(publisher.PublishedEvents, "one hard quest at one location unlocks nothing")
+
+	s.Require().NoError(handler.Handle(s.ctx, quest.NewQuestStatusChanged(third.ID(), quest.StatusInProgress, quest.StatusCompleted, nil)))
+	codes := make([]string, 0)
+	for _, e := range publisher.PublishedEvents {
+		codes = append(codes, e.(achievement.AchievementUnlocked).Code)
+	}
+	s.ElementsMatch([]string{"hard_pair", "traveller"}, codes)
+}
+
+func (s *AchievementContractSuite) TestRemovedDefinitionStaysListed() {
+	participant := uuid.New()
+	s.assign(participant, "easy", kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522})
+
+	// List against definitions that no longer contain first_steps
+	handler := queries.NewListAchievementsQueryHandler(s.container.UnitOfWork.AchievementRepository(), achievement.DefaultDefinitions()[1:])
+	list, err := handler.Handle(s.ctx, participant)
+	s.Require().NoError(err)
+
+	s.Require().Len(list, len(achievement.DefaultDefinitions()))
+	retired := list[len(list)-1]
+	s.Equal("first_steps", retired.Code)
+	s.Equal("First Steps", retired.Title)
+	s.Equal(0, retired.Target)
+	s.NotNil(retired.UnlockedAt)
+}
@@ -0,0 +1,90 @@
+package contracts
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"quest-manager/internal/adapters/out/blobstorage"
+	"quest-manager/internal/core/ports"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// BlobStorageContractSuite defines the contract every BlobStorage implementation must satisfy
+type BlobStorageContractSuite struct {
+	suite.Suite
+	newStorage func() ports.BlobStorage
+	storage    ports.BlobStorage
+	ctx        context.Context
+}
+
+func (s *BlobStorageContractSuite) SetupTest() {
+	s.storage = s.newStorage()
+	s.ctx = context.Background()
+}
+
+func TestLocalBlobStorageContract(t *testing.T) {
+	suite.Run(t, &BlobStorageContractSuite{newStorage: func() ports.BlobStorage {
+		storage, err := blobstorage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		return storage
+	}})
+}
+
+func TestMockBlobStorageContract(t *testing.T) {
+	suite.Run(t, &BlobStorageContractSuite{newStorage: func() ports.BlobStorage {
+		return mocks.NewMockBlobStorage()
+	}})
+}
+
+func (s *BlobStorageContractSuite) read(key string) string {
+	content, err := s.storage.Get(s.ctx, key)
+	require.NoError(s.T(), err)
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	require.NoError(s.T(), err)
+	return string(data)
+}
+
+func (s *BlobStorageContractSuite) TestPutAndGet() {
+	// Contract: Stored content is returned as is
+	require.NoError(s.T(), s.storage.Put(s.ctx, "quests/1/evidence/a", strings.NewReader("photo")))
+	require.Equal(s.T(), "photo", s.read("quests/1/evidence/a"))
+}
+
+func (s *BlobStorageContractSuite) TestPutReplacesContent() {
+	// Contract: Putting an existing key replaces its content
+	require.NoError(s.T(), s.storage.Put(s.ctx, "quests/1/evidence/a", strings.NewReader("first")))
+	require.NoError(s.T(), s.storage.Put(s.ctx, "quests/1/evidence/a", strings.NewReader("second")))
+	require.Equal(s.T(), "second", s.read("quests/1/evidence/a"))
+}
+
+func (s *BlobStorageContractSuite) TestGetMissingKey() {
+	// Contract: Missing keys return ErrBlobNotFound
+	_, err := s.storage.Get(s.ctx, "quests/1/evidence/missing")
+	require.ErrorIs(s.T(), err, ports.ErrBlobNotFound)
+}
+
+func (s *BlobStorageContractSuite) TestDelete() {
+	// Contract: Deleted content is gone, deleting again is not an error
+	require.NoError(s.T(), s.storage.Put(s.ctx, "quests/1/evidence/a", strings.NewReader("photo")))
+	require.NoError(s.T(), s.storage.Delete(s.ctx, "quests/1/evidence/a"))
+
+	_, err := s.storage.Get(s.ctx, "quests/1/evidence/a")
+	require.ErrorIs(s.T(), err, ports.ErrBlobNotFound)
+	require.NoError(s.T(), s.storage.Delete(s.ctx, "quests/1/evidence/a"))
+}
+
+func TestLocalBlobStorage_RejectsKeysOutsideRoot(t *testing.T) {
+	storage, err := blobstorage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"../escape", "/etc/passwd", "quests/../../escape", "quests//a", ""} {
+		require.Error(t, storage.Put(context.Background(), key, strings.NewReader("x")), "key %q", key)
+	}
+}
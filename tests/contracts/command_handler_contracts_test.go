@@ -9,6 +9,7 @@ import (
 	"quest-manager/internal/core/application/usecases/commands"
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/user"
 	"quest-manager/internal/core/ports"
 	"quest-manager/internal/pkg/errs"
 	"quest-manager/tests/contracts/mocks"
@@ -116,9 +117,9 @@ func (s *CreateQuestCommandHandlerContractSuite) TestHandleValidCommand() {
 		Reward:            5,
 		DurationMinutes:   60, // 60 minutes
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -151,9 +152,9 @@ func (s *CreateQuestCommandHandlerContractSuite) TestHandleInvalidDifficulty() {
 		Reward:            5,
 		DurationMinutes:   60,
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -166,8 +167,115 @@ func (s *CreateQuestCommandHandlerContractSuite) TestHandleInvalidDifficulty() {
 	require.True(s.T(), errors.As(err, &domainErr), "Should return domain validation error")
 }
 
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleAddressOnlyIsGeocoded() {
+	// Contract: Missing coordinates are resolved from the address
+	targetAddr := "Palace Square, Saint Petersburg"
+	palaceSquare := kernel.GeoCoordinate{Lat: 59.939, Lon: 30.3158}
+	s.container.Geocoder.AddPlace(targetAddr, palaceSquare)
+
+	cmd := s.geocodingCommand(nil, &targetAddr)
+
+	createdQuest, err := s.handler.Handle(s.ctx, cmd)
+	require.NoError(s.T(), err, "Handle should geocode the target address")
+	require.Equal(s.T(), palaceSquare, createdQuest.TargetLocation)
+
+	targetLoc, err := s.unitOfWork.LocationRepository().GetByID(s.ctx, *createdQuest.TargetLocationID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), targetAddr, *targetLoc.Address, "The given address should be stored, not the provider's")
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleAddressOnlyUsesStoredLocations() {
+	// Contract: Addresses of stored locations are reused without calling the geocoder
+	targetAddr := "Known Address"
+	known := kernel.GeoCoordinate{Lat: 48.8584, Lon: 2.2945}
+	_, err := s.handler.Handle(s.ctx, s.geocodingCommand(&known, &targetAddr))
+	require.NoError(s.T(), err)
+
+	addressOnly := "known address"
+	createdQuest, err := s.handler.Handle(s.ctx, s.geocodingCommand(nil, &addressOnly))
+	require.NoError(s.T(), err, "Handle should resolve the address from stored locations")
+	require.Equal(s.T(), known, createdQuest.TargetLocation)
+	require.Zero(s.T(), s.container.Geocoder.Calls(), "Geocoder should not be called on a cache hit")
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleCoordinatesOnlyFillsAddress() {
+	// Contract: A missing address is filled in by reverse geocoding
+	palaceSquare := kernel.GeoCoordinate{Lat: 59.939, Lon: 30.3158}
+	s.container.Geocoder.AddPlace("Palace Square, Saint Petersburg", palaceSquare)
+
+	createdQuest, err := s.handler.Handle(s.ctx, s.geocodingCommand(&palaceSquare, nil))
+	require.NoError(s.T(), err)
+
+	targetLoc, err := s.unitOfWork.LocationRepository().GetByID(s.ctx, *createdQuest.TargetLocationID)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), targetLoc.Address)
+	require.Equal(s.T(), "Palace Square, Saint Petersburg", *targetLoc.Address)
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleCoordinatesOnlyWithoutProvider() {
+	// Contract: Reverse geocoding is best effort, the quest is created without an address
+	s.container.Geocoder.Err = errors.New("provider unavailable")
+	coordinate := kernel.GeoCoordinate{Lat: 10.0, Lon: 10.0}
+
+	createdQuest, err := s.handler.Handle(s.ctx, s.geocodingCommand(&coordinate, nil))
+	require.NoError(s.T(), err)
+
+	targetLoc, err := s.unitOfWork.LocationRepository().GetByID(s.ctx, *createdQuest.TargetLocationID)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), targetLoc.Address)
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleUnknownAddress() {
+	// Contract: An address that cannot be geocoded is a validation error
+	unknown := "Nowhere 1"
+
+	_, err := s.handler.Handle(s.ctx, s.geocodingCommand(nil, &unknown))
+	require.Error(s.T(), err)
+	var domainErr *errs.DomainValidationError
+	require.True(s.T(), errors.As(err, &domainErr), "Should return domain validation error")
+	require.Equal(s.T(), "target_location", domainErr.Field)
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleGeocoderFailure() {
+	// Contract: A failing geocoder is an infrastructure error when coordinates are required
+	s.container.Geocoder.Err = errors.New("provider unavailable")
+	address := "Somewhere 1"
+
+	_, err := s.handler.Handle(s.ctx, s.geocodingCommand(nil, &address))
+	require.Error(s.T(), err)
+	var domainErr *errs.DomainValidationError
+	require.False(s.T(), errors.As(err, &domainErr), "Provider failures are not validation errors")
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleNoCoordinatesNoAddress() {
+	// Contract: A location needs coordinates or an address
+	_, err := s.handler.Handle(s.ctx, s.geocodingCommand(nil, nil))
+	require.Error(s.T(), err)
+	var domainErr *errs.DomainValidationError
+	require.True(s.T(), errors.As(err, &domainErr), "Should return domain validation error")
+}
+
+// geocodingCommand builds a command with the given target location and a fixed execution location
+func (s *CreateQuestCommandHandlerContractSuite) geocodingCommand(target *kernel.GeoCoordinate, targetAddr *string) commands.CreateQuestCommand {
+	execAddr := "Execution"
+	return commands.CreateQuestCommand{
+		Title:             "Geocoding Quest",
+		Description:       "Quest with a geocoded location",
+		Difficulty:        "easy",
+		Reward:            1,
+		DurationMinutes:   30,
+		Creator:           "test-creator",
+		TargetLocation:    target,
+		TargetAddress:     targetAddr,
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 1.0, Lon: 1.0},
+		ExecutionAddress:  &execAddr,
+		Equipment:         []string{},
+		Skills:            []string{},
+	}
+}
+
 // Note: Coordinate validation is handled at the API layer before hitting the command handler
-// Command handlers receive already validated GeoCoordinate structures
+// Command handlers receive already validated GeoCoordinate structures (or nil, to be geocoded)
 
 // AssignQuestCommandHandler contract tests
 
@@ -182,9 +290,9 @@ func (s *AssignQuestCommandHandlerContractSuite) TestHandleValidAssignment() {
 		Reward:            3,
 		DurationMinutes:   45,
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -235,9 +343,9 @@ func (s *AssignQuestCommandHandlerContractSuite) TestHandleAlreadyAssignedQuest(
 		Reward:            3,
 		DurationMinutes:   45,
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -280,9 +388,9 @@ func (s *ChangeQuestStatusCommandHandlerContractSuite) TestHandleValidStatusChan
 		Reward:            3,
 		DurationMinutes:   45,
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -317,9 +425,9 @@ func (s *ChangeQuestStatusCommandHandlerContractSuite) TestHandleInvalidStatus()
 		Reward:            3,
 		DurationMinutes:   45,
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -352,9 +460,9 @@ func (s *ChangeQuestStatusCommandHandlerContractSuite) TestHandleInvalidStatusTr
 		Reward:            3,
 		DurationMinutes:   45,
 		Creator:           "test-creator",
-		TargetLocation:    kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
 		TargetAddress:     &targetAddr,
-		ExecutionLocation: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
 		ExecutionAddress:  &execAddr,
 		Equipment:         []string{},
 		Skills:            []string{},
@@ -390,3 +498,136 @@ func (s *ChangeQuestStatusCommandHandlerContractSuite) TestHandleNonExistentQues
 	var notFoundErr *errs.NotFoundError
 	s.True(errors.As(err, &notFoundErr), "Should return not found error")
 }
+
+func (s *AssignQuestCommandHandlerContractSuite) createQuestWithPolicy(policy string) quest.Quest {
+	targetAddr := "Target"
+	execAddr := "Execution"
+	createdQuest, err := s.createHandler.Handle(s.ctx, commands.CreateQuestCommand{
+		Title:             "Eligibility Test Quest",
+		Description:       "Quest with required skills and equipment",
+		Difficulty:        "easy",
+		Reward:            3,
+		DurationMinutes:   45,
+		Creator:           "test-creator",
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		TargetAddress:     &targetAddr,
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		ExecutionAddress:  &execAddr,
+		Equipment:         []string{"rope"},
+		Skills:            []string{"climbing", "first aid"},
+		EligibilityPolicy: policy,
+	})
+	require.NoError(s.T(), err)
+	return createdQuest
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) saveProfile(userID uuid.UUID, profile user.Profile) {
+	u, err := user.NewUser(userID, profile)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.unitOfWork.UserRepository().Save(s.ctx, u))
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) TestHandleStrictPolicyRejectsIneligibleUser() {
+	createdQuest := s.createQuestWithPolicy("strict")
+	userID := uuid.New()
+	s.saveProfile(userID, user.Profile{DisplayName: "Climber", Skills: []string{"Climbing"}})
+
+	// Contract: Strict policy returns a validation error listing what is missing
+	_, err := s.handler.Handle(s.ctx, commands.AssignQuestCommand{ID: createdQuest.ID(), UserID: userID})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Equal("eligibility", validationErr.Field)
+	s.Equal([]errs.Violation{
+		{Field: "skills", Message: "missing required skills", Values: []string{"first aid"}},
+		{Field: "equipment", Message: "missing required equipment", Values: []string{"rope"}},
+	}, validationErr.Violations)
+
+	// Contract: The quest stays unassigned
+	stored, err := s.unitOfWork.QuestRepository().GetByID(s.ctx, createdQuest.ID())
+	s.Require().NoError(err)
+	s.Nil(stored.Assignee)
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) TestHandleStrictPolicyWithoutProfile() {
+	createdQuest := s.createQuestWithPolicy("strict")
+
+	// Contract: Users without profile have no skills or equipment
+	_, err := s.handler.Handle(s.ctx, commands.AssignQuestCommand{ID: createdQuest.ID(), UserID: uuid.New()})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Len(validationErr.Violations, 2)
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) TestHandleStrictPolicyChecksTravelRadius() {
+	createdQuest := s.createQuestWithPolicy("strict")
+	userID := uuid.New()
+	home := kernel.GeoCoordinate{Lat: 40.0, Lon: 10.0}
+	radius := 50.0
+	s.saveProfile(userID, user.Profile{
+		DisplayName:       "Far away",
+		Skills:            []string{"climbing", "first aid"},
+		Equipment:         []string{"rope"},
+		HomeLocation:      &home,
+		MaxTravelRadiusKm: &radius,
+	})
+
+	_, err := s.handler.Handle(s.ctx, commands.AssignQuestCommand{ID: createdQuest.ID(), UserID: userID})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Require().Len(validationErr.Violations, 1)
+	s.Equal("execution_location", validationErr.Violations[0].Field)
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) TestHandleStrictPolicyAcceptsEligibleUser() {
+	createdQuest := s.createQuestWithPolicy("strict")
+	userID := uuid.New()
+	s.saveProfile(userID, user.Profile{
+		DisplayName: "Rescuer",
+		Skills:      []string{"climbing", "First Aid"},
+		Equipment:   []string{"rope"},
+	})
+
+	result, err := s.handler.Handle(s.ctx, commands.AssignQuestCommand{ID: createdQuest.ID(), UserID: userID})
+
+	s.Require().NoError(err)
+	s.Equal(userID, result.Assignee)
+	s.Empty(result.EligibilityWarnings)
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) TestHandleWarnPolicyAssignsWithWarnings() {
+	createdQuest := s.createQuestWithPolicy("warn")
+	userID := uuid.New()
+	s.saveProfile(userID, user.Profile{DisplayName: "Climber", Skills: []string{"climbing"}, Equipment: []string{"rope"}})
+
+	// Contract: Warn policy assigns and reports what is missing
+	result, err := s.handler.Handle(s.ctx, commands.AssignQuestCommand{ID: createdQuest.ID(), UserID: userID})
+
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusAssigned), result.Status)
+	s.Equal([]errs.Violation{
+		{Field: "skills", Message: "missing required skills", Values: []string{"first aid"}},
+	}, result.EligibilityWarnings)
+}
+
+func (s *AssignQuestCommandHandlerContractSuite) TestHandleOffPolicySkipsCheck() {
+	createdQuest := s.createQuestWithPolicy("")
+
+	result, err := s.handler.Handle(s.ctx, commands.AssignQuestCommand{ID: createdQuest.ID(), UserID: uuid.New()})
+
+	s.Require().NoError(err)
+	s.Empty(result.EligibilityWarnings)
+}
+
+func (s *CreateQuestCommandHandlerContractSuite) TestHandleInvalidEligibilityPolicy() {
+	cmd := s.geocodingCommand(&kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0}, nil)
+	cmd.EligibilityPolicy = "lenient"
+
+	_, err := s.handler.Handle(s.ctx, cmd)
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("eligibility_policy", validationErr.Field)
+}
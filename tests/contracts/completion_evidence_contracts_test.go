@@ -0,0 +1,279 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// pngHeader is enough content for a photo in contract tests
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// CompletionEvidenceContractSuite defines contract tests for completion with evidence and its review
+type CompletionEvidenceContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	ctx       context.Context
+}
+
+func (s *CompletionEvidenceContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.creator = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *CompletionEvidenceContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestCompletionEvidenceContract(t *testing.T) {
+	suite.Run(t, new(CompletionEvidenceContractSuite))
+}
+
+// startQuest creates a quest for the participants and lets all of them start it
+func (s *CompletionEvidenceContractSuite) startQuest(quorum int, participants ...uuid.UUID) quest.Quest {
+	created := createQuest(&s.Suite, s.container, s.creator, withCapacity(len(participants), quorum))
+	assignQuest(&s.Suite, s.container, created.ID(), participants...)
+	for _, p := range participants {
+		_, err := s.container.ChangeQuestStatusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+			QuestID: created.ID(),
+			UserID:  p,
+			Status:  quest.StatusInProgress,
+		})
+		s.Require().NoError(err)
+	}
+	return created
+}
+
+func (s *CompletionEvidenceContractSuite) submit(questID, userID uuid.UUID, photos ...commands.EvidencePhoto) (commands.SubmitCompletionEvidenceResult, error) {
+	return s.container.SubmitCompletionEvidenceHandler.Handle(s.ctx, commands.SubmitCompletionEvidenceCommand{
+		QuestID:  questID,
+		UserID:   userID,
+		Note:     "Done",
+		Position: kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		Photos:   photos,
+	})
+}
+
+func (s *CompletionEvidenceContractSuite) TestSubmitStoresPhotosAndReachesReview() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+
+	result, err := s.submit(created.ID(), participant, commands.EvidencePhoto{
+		FileName:    "bags.png",
+		ContentType: "image/png",
+		Content:     pngHeader,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(quest.StatusPendingReview, result.QuestStatus)
+	s.Require().Len(result.Evidence.Attachments, 1)
+	s.Equal(int64(len(pngHeader)), result.Evidence.Attachments[0].Size)
+	s.Equal(1, s.container.BlobStorage.Len())
+
+	stored, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal(quest.StatusPendingReview, stored.Status)
+}
+
+func (s *CompletionEvidenceContractSuite) TestSubmitBelowQuorumStaysInProgress() {
+	first, second := uuid.New(), uuid.New()
+	created := s.startQuest(2, first, second)
+
+	result, err := s.submit(created.ID(), first)
+
+	s.Require().NoError(err)
+	s.Equal(quest.StatusInProgress, result.QuestStatus)
+}
+
+func (s *CompletionEvidenceContractSuite) TestSubmitByOutsiderIsForbidden() {
+	created := s.startQuest(1, uuid.New())
+
+	_, err := s.submit(created.ID(), uuid.New())
+
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "Outsiders should not submit evidence")
+}
+
+func (s *CompletionEvidenceContractSuite) TestSubmitRejectsInvalidPhotoWithoutStoringIt() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+
+	_, err := s.submit(created.ID(), participant, commands.EvidencePhoto{
+		FileName:    "report.pdf",
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.4"),
+	})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "Non-image attachments should be a validation error")
+	s.Equal(0, s.container.BlobStorage.Len())
+}
+
+func (s *CompletionEvidenceContractSuite) TestSubmitLeavesQuestUnchangedWhenStorageFails() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+	s.container.BlobStorage.PutErr = errors.New("disk full")
+
+	_, err := s.submit(created.ID(), participant, commands.EvidencePhoto{
+		FileName:    "bags.png",
+		ContentType: "image/png",
+		Content:     pngHeader,
+	})
+
+	s.Require().Error(err)
+	stored, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, created.ID())
+	s.Require().NoError(err)
+	s.Equal(quest.StatusInProgress, stored.Status)
+}
+
+func (s *CompletionEvidenceContractSuite) TestSubmitRejectsInvalidPosition() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+
+	_, err := s.container.SubmitCompletionEvidenceHandler.Handle(s.ctx, commands.SubmitCompletionEvidenceCommand{
+		QuestID:  created.ID(),
+		UserID:   participant,
+		Position: kernel.GeoCoordinate{Lat: 91, Lon: 0},
+	})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("position", validationErr.Field)
+}
+
+func (s *CompletionEvidenceContractSuite) TestApproveCompletesQuest() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+	submitted, err := s.submit(created.ID(), participant)
+	s.Require().NoError(err)
+
+	result, err := s.container.ReviewCompletionHandler.Handle(s.ctx, commands.ReviewCompletionCommand{
+		QuestID:  created.ID(),
+		UserID:   s.creator,
+		Decision: commands.CompletionDecisionApprove,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusCompleted), result.Status)
+
+	evidence, err := s.container.UnitOfWork.EvidenceRepository().GetByID(s.ctx, submitted.Evidence.ID)
+	s.Require().NoError(err)
+	s.Equal(quest.EvidenceStatusApproved, evidence.Status)
+}
+
+func (s *CompletionEvidenceContractSuite) TestRejectSendsQuestBackToWork() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+	submitted, err := s.submit(created.ID(), participant)
+	s.Require().NoError(err)
+
+	result, err := s.container.ReviewCompletionHandler.Handle(s.ctx, commands.ReviewCompletionCommand{
+		QuestID:  created.ID(),
+		UserID:   s.creator,
+		Decision: commands.CompletionDecisionReject,
+		Reason:   "Photos show the wrong park",
+	})
+
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusInProgress), result.Status)
+	s.Require().Len(result.Participants, 1)
+	s.Nil(result.Participants[0].CompletedAt)
+
+	evidence, err := s.container.UnitOfWork.EvidenceRepository().GetByID(s.ctx, submitted.Evidence.ID)
+	s.Require().NoError(err)
+	s.Equal(quest.EvidenceStatusRejected, evidence.Status)
+	s.Equal("Photos show the wrong park", evidence.RejectionReason)
+}
+
+func (s *CompletionEvidenceContractSuite) TestRejectWithoutReasonIsValidationError() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+	_, err := s.submit(created.ID(), participant)
+	s.Require().NoError(err)
+
+	_, err = s.container.ReviewCompletionHandler.Handle(s.ctx, commands.ReviewCompletionCommand{
+		QuestID:  created.ID(),
+		UserID:   s.creator,
+		Decision: commands.CompletionDecisionReject,
+	})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("reason", validationErr.Field)
+}
+
+func (s *CompletionEvidenceContractSuite) TestReviewByNonCreatorIsForbidden() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+	_, err := s.submit(created.ID(), participant)
+	s.Require().NoError(err)
+
+	_, err = s.container.ReviewCompletionHandler.Handle(s.ctx, commands.ReviewCompletionCommand{
+		QuestID:  created.ID(),
+		UserID:   participant,
+		Decision: commands.CompletionDecisionApprove,
+	})
+
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "Participants should not approve their own work")
+}
+
+func (s *CompletionEvidenceContractSuite) TestListAndDownloadForParticipantsOnly() {
+	participant := uuid.New()
+	created := s.startQuest(1, participant)
+	submitted, err := s.submit(created.ID(), participant, commands.EvidencePhoto{
+		FileName:    "bags.png",
+		ContentType: "image/png",
+		Content:     pngHeader,
+	})
+	s.Require().NoError(err)
+
+	evidence, err := s.container.ListCompletionEvidenceHandler.Handle(s.ctx, queries.ListCompletionEvidenceQuery{
+		QuestID: created.ID(),
+		UserID:  s.creator,
+	})
+	s.Require().NoError(err)
+	s.Len(evidence, 1)
+
+	attachment, err := s.container.GetEvidenceAttachmentHandler.Handle(s.ctx, queries.GetEvidenceAttachmentQuery{
+		QuestID:      created.ID(),
+		EvidenceID:   submitted.Evidence.ID,
+		AttachmentID: submitted.Evidence.Attachments[0].ID,
+		UserID:       participant,
+	})
+	s.Require().NoError(err)
+	content, err := io.ReadAll(attachment.Content)
+	s.Require().NoError(err)
+	s.NoError(attachment.Content.Close())
+	s.Equal(pngHeader, content)
+	s.Equal("image/png", attachment.Attachment.ContentType)
+
+	_, err = s.container.ListCompletionEvidenceHandler.Handle(s.ctx, queries.ListCompletionEvidenceQuery{
+		QuestID: created.ID(),
+		UserID:  uuid.New(),
+	})
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "Outsiders should not see evidence")
+
+	_, err = s.container.GetEvidenceAttachmentHandler.Handle(s.ctx, queries.GetEvidenceAttachmentQuery{
+		QuestID:      created.ID(),
+		EvidenceID:   submitted.Evidence.ID,
+		AttachmentID: uuid.New(),
+		UserID:       participant,
+	})
+	var notFoundErr *errs.NotFoundError
+	s.True(errors.As(err, &notFoundErr), "Unknown attachments should be not found")
+}
@@ -103,6 +103,7 @@ func (s *EventPublisherContractSuite) TestPublishMultipleEvents() {
 		uuid.New(),
 		quest.StatusCreated,
 		quest.StatusAssigned,
+		nil,
 	)
 
 	// Contract: Publish should handle multiple events without error
@@ -167,12 +168,14 @@ func (s *EventPublisherContractSuite) TestPublishAsyncMultipleEvents() {
 		uuid.New(),
 		quest.StatusAssigned,
 		quest.StatusInProgress,
+		nil,
 	)
 
 	event2 := quest.NewQuestStatusChanged(
 		uuid.New(),
 		quest.StatusInProgress,
 		quest.StatusCompleted,
+		nil,
 	)
 
 	// Contract: PublishAsync should handle multiple events without blocking or panicking
@@ -0,0 +1,142 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"quest-manager/internal/adapters/out/client/geocoder"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/ports"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+var geocoderPlaces = []geocoder.Place{
+	{Address: "Palace Square, Saint Petersburg", Latitude: 59.939, Longitude: 30.3158},
+	{Address: "Kazan Cathedral, Saint Petersburg", Latitude: 59.9343, Longitude: 30.3245},
+}
+
+// GeocoderContractSuite defines the contract every Geocoder implementation must satisfy
+type GeocoderContractSuite struct {
+	suite.Suite
+	newGeocoder func() ports.Geocoder
+	geocoder    ports.Geocoder
+	ctx         context.Context
+}
+
+func (s *GeocoderContractSuite) SetupTest() {
+	s.geocoder = s.newGeocoder()
+	s.ctx = context.Background()
+}
+
+func TestFileGeocoderContract(t *testing.T) {
+	suite.Run(t, &GeocoderContractSuite{newGeocoder: func() ports.Geocoder {
+		g, err := geocoder.NewPlacesGeocoder(geocoderPlaces)
+		require.NoError(t, err)
+		return g
+	}})
+}
+
+func TestNominatimGeocoderContract(t *testing.T) {
+	server := httptest.NewServer(fakeNominatim(geocoderPlaces))
+	t.Cleanup(server.Close)
+
+	suite.Run(t, &GeocoderContractSuite{newGeocoder: func() ports.Geocoder {
+		return geocoder.NewNominatimGeocoder(server.URL, "quest-manager-tests", nil)
+	}})
+}
+
+func (s *GeocoderContractSuite) TestGeocodeKnownAddress() {
+	// Contract: Known addresses resolve to their coordinate
+	result, err := s.geocoder.Geocode(s.ctx, "Palace Square, Saint Petersburg")
+	require.NoError(s.T(), err)
+	require.InDelta(s.T(), 59.939, result.Coordinate.Lat, 1e-6)
+	require.InDelta(s.T(), 30.3158, result.Coordinate.Lon, 1e-6)
+	require.Equal(s.T(), "Palace Square, Saint Petersburg", result.Address)
+}
+
+func (s *GeocoderContractSuite) TestGeocodeUnknownAddress() {
+	// Contract: Unknown addresses return ErrGeocodeNotFound
+	_, err := s.geocoder.Geocode(s.ctx, "Nowhere 1")
+	require.ErrorIs(s.T(), err, ports.ErrGeocodeNotFound)
+}
+
+func (s *GeocoderContractSuite) TestReverseGeocodeNearbyCoordinate() {
+	// Contract: A coordinate next to a known place resolves to its address
+	result, err := s.geocoder.ReverseGeocode(s.ctx, kernel.GeoCoordinate{Lat: 59.9391, Lon: 30.3159})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "Palace Square, Saint Petersburg", result.Address)
+}
+
+func (s *GeocoderContractSuite) TestReverseGeocodeRemoteCoordinate() {
+	// Contract: Coordinates far from any place return ErrGeocodeNotFound
+	_, err := s.geocoder.ReverseGeocode(s.ctx, kernel.GeoCoordinate{Lat: 0, Lon: 0})
+	require.ErrorIs(s.T(), err, ports.ErrGeocodeNotFound)
+}
+
+func TestNominatimGeocoder_SendsUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	g := geocoder.NewNominatimGeocoder(server.URL, "quest-manager-tests", nil)
+	_, err := g.Geocode(context.Background(), "Anywhere")
+	require.ErrorIs(t, err, ports.ErrGeocodeNotFound)
+	require.Equal(t, "quest-manager-tests", userAgent)
+}
+
+func TestNominatimGeocoder_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	g := geocoder.NewNominatimGeocoder(server.URL, "quest-manager-tests", nil)
+	_, err := g.ReverseGeocode(context.Background(), kernel.GeoCoordinate{Lat: 59.939, Lon: 30.3158})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ports.ErrGeocodeNotFound)
+}
+
+// fakeNominatim serves /search and /reverse in the Nominatim jsonv2 format
+func fakeNominatim(places []geocoder.Place) http.Handler {
+	toJSON := func(p geocoder.Place) map[string]string {
+		return map[string]string{
+			"lat":          strconv.FormatFloat(p.Latitude, 'f', -1, 64),
+			"lon":          strconv.FormatFloat(p.Longitude, 'f', -1, 64),
+			"display_name": p.Address,
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		results := []map[string]string{}
+		for _, p := range places {
+			if strings.EqualFold(p.Address, r.URL.Query().Get("q")) {
+				results = append(results, toJSON(p))
+			}
+		}
+		_ = json.NewEncoder(w).Encode(results)
+	})
+	mux.HandleFunc("/reverse", func(w http.ResponseWriter, r *http.Request) {
+		lat, _ := strconv.ParseFloat(r.URL.Query().Get("lat"), 64)
+		lon, _ := strconv.ParseFloat(r.URL.Query().Get("lon"), 64)
+		coord := kernel.GeoCoordinate{Lat: lat, Lon: lon}
+		for _, p := range places {
+			if coord.DistanceTo(kernel.GeoCoordinate{Lat: p.Latitude, Lon: p.Longitude}) < 0.1 {
+				_ = json.NewEncoder(w).Encode(toJSON(p))
+				return
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "Unable to geocode"})
+	})
+	return mux
+}
@@ -0,0 +1,207 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	geofenceTarget    = kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0}
+	geofenceExecution = kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0}
+	// About 1 km north of the target location
+	farFromTarget = kernel.GeoCoordinate{Lat: 50.009, Lon: 10.0}
+)
+
+// GeofenceContractSuite defines contract tests for quests started and completed on site
+type GeofenceContractSuite struct {
+	suite.Suite
+	container   *mocks.ContractDIContainer
+	creator     uuid.UUID
+	participant uuid.UUID
+	ctx         context.Context
+}
+
+func (s *GeofenceContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.creator = uuid.New()
+	s.participant = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *GeofenceContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestGeofenceContract(t *testing.T) {
+	suite.Run(t, new(GeofenceContractSuite))
+}
+
+// createAssignedQuest creates a quest with the geofence radius and assigns it to the participant
+func (s *GeofenceContractSuite) createAssignedQuest(radius int) quest.Quest {
+	created := createQuest(&s.Suite, s.container, s.creator, withLocations(geofenceTarget, geofenceExecution), withGeofence(radius))
+	assignQuest(&s.Suite, s.container, created.ID(), s.participant)
+	return created
+}
+
+func (s *GeofenceContractSuite) changeStatus(questID, userID uuid.UUID, status quest.Status, position *kernel.GeoCoordinate) (commands.ChangeQuestStatusResult, error) {
+	return s.container.ChangeQuestStatusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+		QuestID:  questID,
+		UserID:   userID,
+		Status:   status,
+		Position: position,
+	})
+}
+
+// lastStatusChanged returns the last published quest.status_changed event
+func (s *GeofenceContractSuite) lastStatusChanged() quest.QuestStatusChanged {
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	for i := len(publisher.PublishedEvents) - 1; i >= 0; i-- {
+		if event, ok := publisher.PublishedEvents[i].(quest.QuestStatusChanged); ok {
+			return event
+		}
+	}
+	s.FailNow("no quest.status_changed event published")
+	return quest.QuestStatusChanged{}
+}
+
+func (s *GeofenceContractSuite) requirePositionError(err error) {
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "Expected a validation error, got %v", err)
+	s.Equal("position", validationErr.Field)
+}
+
+func (s *GeofenceContractSuite) TestCreateRejectsInvalidRadius() {
+	_, err := s.container.CreateQuestHandler.Handle(s.ctx, commands.CreateQuestCommand{
+		Title:             "On-site Quest",
+		Description:       "Water the community garden",
+		Difficulty:        "easy",
+		Reward:            2,
+		DurationMinutes:   60,
+		Creator:           s.creator.String(),
+		TargetLocation:    &geofenceTarget,
+		ExecutionLocation: &geofenceExecution,
+		GeofenceRadius:    -5,
+	})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("geofence_radius", validationErr.Field)
+}
+
+func (s *GeofenceContractSuite) TestStartRequiresPosition() {
+	created := s.createAssignedQuest(200)
+
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, nil)
+
+	s.requirePositionError(err)
+}
+
+func (s *GeofenceContractSuite) TestStartOutsideGeofence() {
+	created := s.createAssignedQuest(200)
+
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &farFromTarget)
+
+	s.requirePositionError(err)
+	stored, getErr := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, created.ID())
+	s.Require().NoError(getErr)
+	s.Equal(quest.StatusAssigned, stored.Status, "Quest should not start outside the geofence")
+}
+
+func (s *GeofenceContractSuite) TestStartInsideGeofenceRecordsPosition() {
+	created := s.createAssignedQuest(200)
+
+	result, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &geofenceTarget)
+
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusInProgress), result.Status)
+	event := s.lastStatusChanged()
+	s.Equal(quest.StatusInProgress, event.NewStatus)
+	s.Require().NotNil(event.Position)
+	s.Equal(geofenceTarget, *event.Position)
+}
+
+func (s *GeofenceContractSuite) TestCreatorCompletesRemotely() {
+	created := s.createAssignedQuest(200)
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &geofenceTarget)
+	s.Require().NoError(err)
+
+	// The geofence is for the participant on site, the creator signs off from anywhere
+	result, err := s.changeStatus(created.ID(), s.creator, quest.StatusCompleted, nil)
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusCompleted), result.Status)
+}
+
+func (s *GeofenceContractSuite) TestCreatorCompletesFarFromExecutionLocation() {
+	created := s.createAssignedQuest(200)
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &geofenceTarget)
+	s.Require().NoError(err)
+
+	// The target location is far from the execution location
+	result, err := s.changeStatus(created.ID(), s.creator, quest.StatusCompleted, &geofenceTarget)
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusCompleted), result.Status)
+	s.Require().NotNil(s.lastStatusChanged().Position)
+}
+
+func (s *GeofenceContractSuite) TestNoGeofenceAcceptsAnyPosition() {
+	created := s.createAssignedQuest(0)
+
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, nil)
+
+	s.Require().NoError(err)
+	s.Nil(s.lastStatusChanged().Position)
+}
+
+func (s *GeofenceContractSuite) TestInvalidPosition() {
+	created := s.createAssignedQuest(0)
+
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &kernel.GeoCoordinate{Lat: 91, Lon: 0})
+
+	s.requirePositionError(err)
+}
+
+func (s *GeofenceContractSuite) TestEvidenceOutsideGeofence() {
+	created := s.createAssignedQuest(200)
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &geofenceTarget)
+	s.Require().NoError(err)
+
+	_, err = s.container.SubmitCompletionEvidenceHandler.Handle(s.ctx, commands.SubmitCompletionEvidenceCommand{
+		QuestID:  created.ID(),
+		UserID:   s.participant,
+		Note:     "Done",
+		Position: geofenceTarget,
+		Photos:   []commands.EvidencePhoto{{FileName: "garden.png", ContentType: "image/png", Content: pngHeader}},
+	})
+
+	s.requirePositionError(err)
+	s.Equal(0, s.container.BlobStorage.Len(), "No photo should be stored")
+}
+
+func (s *GeofenceContractSuite) TestEvidenceInsideGeofenceRecordsPosition() {
+	created := s.createAssignedQuest(200)
+	_, err := s.changeStatus(created.ID(), s.participant, quest.StatusInProgress, &geofenceTarget)
+	s.Require().NoError(err)
+
+	result, err := s.container.SubmitCompletionEvidenceHandler.Handle(s.ctx, commands.SubmitCompletionEvidenceCommand{
+		QuestID:  created.ID(),
+		UserID:   s.participant,
+		Note:     "Done",
+		Position: geofenceExecution,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(quest.StatusPendingReview, result.QuestStatus)
+	event := s.lastStatusChanged()
+	s.Require().NotNil(event.Position)
+	s.Equal(geofenceExecution, *event.Position)
+}
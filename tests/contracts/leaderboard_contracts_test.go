@@ -0,0 +1,165 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	moscowCenter    = kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	petersburgPlace = kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3351}
+)
+
+// LeaderboardContractSuite defines contract tests for leaderboards projected from credited points
+type LeaderboardContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	ctx       context.Context
+}
+
+func (s *LeaderboardContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.creator = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *LeaderboardContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestLeaderboardContract(t *testing.T) {
+	suite.Run(t, new(LeaderboardContractSuite))
+}
+
+// complete creates a quest executed at the location and completes it with the participant
+func (s *LeaderboardContractSuite) complete(participant uuid.UUID, difficulty string, reward int, execution kernel.GeoCoordinate) quest.Quest {
+	created := createQuest(&s.Suite, s.container, s.creator, withDifficulty(difficulty), withReward(reward), withLocation(execution))
+	assignQuest(&s.Suite, s.container, created.ID(), participant)
+	completeQuest(&s.Suite, s.container, created.ID(), participant, s.creator)
+	return created
+}
+
+func (s *LeaderboardContractSuite) leaderboard(query queries.GetLeaderboardQuery) queries.Leaderboard {
+	board, err := s.container.GetLeaderboardHandler.Handle(s.ctx, query)
+	s.Require().NoError(err)
+	return board
+}
+
+func (s *LeaderboardContractSuite) TestCompletionIsProjected() {
+	participant := uuid.New()
+	completed := s.complete(participant, "easy", 2, moscowCenter)
+
+	scores := s.container.UnitOfWork.LeaderboardRepository().(*mocks.MockLeaderboardRepository).Scores()
+
+	s.Require().Len(scores, 1)
+	s.Equal(participant, scores[0].UserID)
+	s.Equal(20, scores[0].Points)
+	s.True(scores[0].Completion)
+	s.Require().NotNil(scores[0].QuestID)
+	s.Equal(completed.ID(), *scores[0].QuestID)
+	s.Equal(&moscowCenter, scores[0].Location)
+}
+
+func (s *LeaderboardContractSuite) TestDefaultsToCompletedQuestsThisWeek() {
+	board := s.leaderboard(queries.GetLeaderboardQuery{})
+
+	s.Equal(leaderboard.PeriodWeek, board.Period)
+	s.Equal(leaderboard.MetricCompleted, board.Metric)
+	s.Empty(board.Standings)
+}
+
+func (s *LeaderboardContractSuite) TestRanksByCompletedQuests() {
+	busy, casual := uuid.New(), uuid.New()
+	s.complete(busy, "easy", 1, moscowCenter)
+	s.complete(busy, "easy", 1, moscowCenter)
+	s.complete(casual, "hard", 5, moscowCenter)
+
+	board := s.leaderboard(queries.GetLeaderboardQuery{Period: leaderboard.PeriodDay, Metric: leaderboard.MetricCompleted})
+
+	s.Require().Len(board.Standings, 2)
+	s.Equal(leaderboard.Standing{Rank: 1, UserID: busy, Score: 2}, board.Standings[0])
+	s.Equal(leaderboard.Standing{Rank: 2, UserID: casual, Score: 1}, board.Standings[1])
+}
+
+func (s *LeaderboardContractSuite) TestRanksByPoints() {
+	busy, casual := uuid.New(), uuid.New()
+	s.complete(busy, "easy", 1, moscowCenter)
+	s.complete(busy, "easy", 1, moscowCenter)
+	s.complete(casual, "hard", 5, moscowCenter)
+
+	board := s.leaderboard(queries.GetLeaderboardQuery{Period: leaderboard.PeriodMonth, Metric: leaderboard.MetricPoints, Limit: 1})
+
+	s.Require().Len(board.Standings, 1)
+	s.Equal(leaderboard.Standing{Rank: 1, UserID: casual, Score: 100}, board.Standings[0])
+}
+
+func (s *LeaderboardContractSuite) TestRestrictsToArea() {
+	local, remote := uuid.New(), uuid.New()
+	s.complete(local, "easy", 1, moscowCenter)
+	s.complete(remote, "easy", 1, petersburgPlace)
+	s.complete(remote, "easy", 1, petersburgPlace)
+
+	board := s.leaderboard(queries.GetLeaderboardQuery{Center: &moscowCenter, RadiusKm: 50})
+
+	s.Require().Len(board.Standings, 1)
+	s.Equal(local, board.Standings[0].UserID)
+	s.Equal(1, board.Standings[0].Score)
+}
+
+func (s *LeaderboardContractSuite) TestRepeatedCreditIsProjectedOnce() {
+	participant := uuid.New()
+	s.complete(participant, "easy", 1, moscowCenter)
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var credited ledger.PointsCredited
+	for _, e := range publisher.PublishedEvents {
+		if c, ok := e.(ledger.PointsCredited); ok {
+			credited = c
+		}
+	}
+	s.Require().NotEqual(uuid.Nil, credited.EntryID)
+
+	// Act - the same credit delivered again
+	s.Require().NoError(s.container.EventDispatcher.Publish(s.ctx, credited))
+
+	board := s.leaderboard(queries.GetLeaderboardQuery{})
+	s.Require().Len(board.Standings, 1)
+	s.Equal(1, board.Standings[0].Score)
+}
+
+func (s *LeaderboardContractSuite) TestRejectsInvalidQuery() {
+	testCases := []struct {
+		name  string
+		query queries.GetLeaderboardQuery
+		field string
+	}{
+		{name: "unknown period", query: queries.GetLeaderboardQuery{Period: "year"}, field: "period"},
+		{name: "unknown metric", query: queries.GetLeaderboardQuery{Metric: "reward"}, field: "metric"},
+		{name: "limit too large", query: queries.GetLeaderboardQuery{Limit: queries.MaxLeaderboardSize + 1}, field: "limit"},
+		{name: "radius without center", query: queries.GetLeaderboardQuery{RadiusKm: 10}, field: "radius_km"},
+		{name: "center without radius", query: queries.GetLeaderboardQuery{Center: &moscowCenter}, field: "radius_km"},
+		{name: "radius too large", query: queries.GetLeaderboardQuery{Center: &moscowCenter, RadiusKm: 20001}, field: "radius_km"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.container.GetLeaderboardHandler.Handle(s.ctx, tc.query)
+
+			var validationErr *errs.DomainValidationError
+			s.Require().True(errors.As(err, &validationErr), "Invalid query should be a validation error")
+			s.Equal(tc.field, validationErr.Field)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quest-manager/internal/core/application/eventhandlers"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/ddd"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// LedgerContractSuite defines contract tests for crediting points of completed quests
+type LedgerContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	ctx       context.Context
+}
+
+func (s *LedgerContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.creator = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *LedgerContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestLedgerContract(t *testing.T) {
+	suite.Run(t, new(LedgerContractSuite))
+}
+
+// completeQuest creates a medium quest with reward 2, lets the participants start it
+// and completes it as the creator
+func (s *LedgerContractSuite) completeQuest(participants ...uuid.UUID) quest.Quest {
+	created := createQuest(&s.Suite, s.container, s.creator, withDifficulty("medium"), withReward(2), withCapacity(len(participants), 0))
+	assignQuest(&s.Suite, s.container, created.ID(), participants...)
+	completeQuest(&s.Suite, s.container, created.ID(), participants[0], s.creator)
+	return created
+}
+
+func (s *LedgerContractSuite) balance(userID uuid.UUID) int {
+	balance, err := s.container.GetBalanceHandler.Handle(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Equal(userID, balance.UserID)
+	return balance.Points
+}
+
+// pointsCredited returns the published ledger.points_credited events
+func (s *LedgerContractSuite) pointsCredited() []ledger.PointsCredited {
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var credited []ledger.PointsCredited
+	for _, e := range publisher.PublishedEvents {
+		if c, ok := e.(ledger.PointsCredited); ok {
+			credited = append(credited, c)
+		}
+	}
+	return credited
+}
+
+func (s *LedgerContractSuite) TestCompletionCreditsEveryParticipant() {
+	first, second := uuid.New(), uuid.New()
+	completed := s.completeQuest(first, second)
+
+	// reward 2 × 10 points × 1.5 for medium difficulty
+	s.Equal(30, s.balance(first))
+	s.Equal(30, s.balance(second))
+	s.Equal(0, s.balance(s.creator))
+
+	credited := s.pointsCredited()
+	s.Require().Len(credited, 2)
+	for _, c := range credited {
+		s.Equal("ledger.points_credited", c.GetName())
+		s.Equal(ledger.KindQuestReward, c.Kind)
+		s.Equal(30, c.Points)
+		s.Require().NotNil(c.QuestID)
+		s.Equal(completed.ID(), *c.QuestID)
+	}
+}
+
+func (s *LedgerContractSuite) TestCreatorOwnQuestNotCredited() {
+	completed := s.completeQuest(s.creator)
+
+	s.Equal(0, s.balance(s.creator))
+	s.Empty(s.pointsCredited())
+
+	page, err := s.container.ListLedgerEntriesHandler.Handle(s.ctx, queries.ListLedgerEntriesQuery{UserID: s.creator})
+	s.Require().NoError(err)
+	s.Equal(0, page.Total, "Quest %s should not credit its creator", completed.ID())
+}
+
+func (s *LedgerContractSuite) TestNoCreditBeforeCompletion() {
+	participant := uuid.New()
+	created, err := s.container.CreateQuestHandler.Handle(s.ctx, commands.CreateQuestCommand{
+		Title:             "Solo Quest",
+		Description:       "Deliver the parcel",
+		Difficulty:        "easy",
+		Reward:            1,
+		DurationMinutes:   30,
+		Creator:           s.creator.String(),
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+	})
+	s.Require().NoError(err)
+	_, err = s.container.AssignQuestHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: participant})
+	s.Require().NoError(err)
+
+	s.Equal(0, s.balance(participant))
+	s.Empty(s.pointsCredited())
+}
+
+func (s *LedgerContractSuite) TestRepeatedCompletionEventCreditsOnce() {
+	participant := uuid.New()
+	completed := s.completeQuest(participant)
+
+	// Deliver the completion again, as a retried event would be
+	completion := quest.NewQuestStatusChanged(completed.ID(), quest.StatusInProgress, quest.StatusCompleted, nil)
+	s.Require().NoError(s.container.EventDispatcher.Publish(s.ctx, completion))
+
+	s.Equal(30, s.balance(participant))
+	s.Len(s.pointsCredited(), 1)
+
+	page, err := s.container.ListLedgerEntriesHandler.Handle(s.ctx, queries.ListLedgerEntriesQuery{UserID: participant})
+	s.Require().NoError(err)
+	s.Equal(1, page.Total)
+}
+
+func (s *LedgerContractSuite) TestListLedgerEntriesPagesNewestFirst() {
+	participant := uuid.New()
+	var quests []quest.Quest
+	for range 3 {
+		quests = append(quests, s.completeQuest(participant))
+	}
+
+	page, err := s.container.ListLedgerEntriesHandler.Handle(s.ctx, queries.ListLedgerEntriesQuery{
+		UserID: participant,
+		Limit:  2,
+	})
+	s.Require().NoError(err)
+	s.Equal(3, page.Total)
+	s.Require().Len(page.Entries, 2)
+	s.Equal(quests[2].ID(), *page.Entries[0].QuestID)
+	s.Equal(quests[1].ID(), *page.Entries[1].QuestID)
+
+	page, err = s.container.ListLedgerEntriesHandler.Handle(s.ctx, queries.ListLedgerEntriesQuery{
+		UserID: participant,
+		Limit:  2,
+		Offset: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(page.Entries, 1)
+	s.Equal(quests[0].ID(), *page.Entries[0].QuestID)
+	s.Equal(90, s.balance(participant))
+}
+
+func (s *LedgerContractSuite) TestListLedgerEntriesRejectsInvalidPage() {
+	testCases := []struct {
+		name  string
+		query queries.ListLedgerEntriesQuery
+		field string
+	}{
+		{name: "limit too large", query: queries.ListLedgerEntriesQuery{UserID: uuid.New(), Limit: queries.MaxLedgerPageSize + 1}, field: "limit"},
+		{name: "negative limit", query: queries.ListLedgerEntriesQuery{UserID: uuid.New(), Limit: -1}, field: "limit"},
+		{name: "negative offset", query: queries.ListLedgerEntriesQuery{UserID: uuid.New(), Offset: -1}, field: "offset"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.container.ListLedgerEntriesHandler.Handle(s.ctx, tc.query)
+
+			var validationErr *errs.DomainValidationError
+			s.Require().True(errors.As(err, &validationErr), "Invalid page should be a validation error")
+			s.Equal(tc.field, validationErr.Field)
+		})
+	}
+}
+
+// failingEventHandler fails every event it handles
+type failingEventHandler struct {
+	err error
+}
+
+func (h failingEventHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	_ = ctx // unused in test handler
+	return h.err
+}
+
+func (s *LedgerContractSuite) TestDispatcherReturnsHandlerErrors() {
+	publisher := &mocks.MockEventPublisher{}
+	dispatcher, err := eventhandlers.NewDispatcher(publisher)
+	s.Require().NoError(err)
+	handlerErr := errors.New("ledger unavailable")
+	dispatcher.Subscribe("quest.status_changed", failingEventHandler{err: handlerErr})
+
+	event := quest.NewQuestStatusChanged(uuid.New(), quest.StatusInProgress, quest.StatusCompleted, nil)
+	err = dispatcher.Publish(s.ctx, event)
+
+	s.ErrorIs(err, handlerErr)
+	s.Len(publisher.PublishedEvents, 1, "Events are stored before the handlers run")
+
+	// Events without subscribers are only stored
+	err = dispatcher.Publish(s.ctx, quest.NewQuestAssigned(uuid.New(), uuid.New()))
+	s.NoError(err)
+}
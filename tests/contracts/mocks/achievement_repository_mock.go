@@ -0,0 +1,103 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/achievement"
+
+	"github.com/google/uuid"
+)
+
+type factKey struct {
+	userID  uuid.UUID
+	questID uuid.UUID
+	kind    achievement.FactKind
+}
+
+type unlockedKey struct {
+	userID uuid.UUID
+	code   string
+}
+
+// MockAchievementRepository is an in-memory implementation of AchievementRepository for contract testing
+type MockAchievementRepository struct {
+	facts       []achievement.Fact
+	factKeys    map[factKey]struct{}
+	unlocked    []achievement.Unlocked
+	unlockedIdx map[unlockedKey]struct{}
+	mu          sync.RWMutex
+}
+
+func NewMockAchievementRepository() *MockAchievementRepository {
+	return &MockAchievementRepository{
+		factKeys:    make(map[factKey]struct{}),
+		unlockedIdx: make(map[unlockedKey]struct{}),
+	}
+}
+
+func (m *MockAchievementRepository) RecordFact(ctx context.Context, fact achievement.Fact) (bool, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := factKey{userID: fact.UserID, questID: fact.QuestID, kind: fact.Kind}
+	if _, exists := m.factKeys[key]; exists {
+		return false, nil
+	}
+	m.factKeys[key] = struct{}{}
+	m.facts = append(m.facts, fact)
+	return true, nil
+}
+
+func (m *MockAchievementRepository) FindFacts(ctx context.Context, userID uuid.UUID) ([]achievement.Fact, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []achievement.Fact
+	for _, f := range m.facts {
+		if f.UserID == userID {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAchievementRepository) Unlock(ctx context.Context, unlocked achievement.Unlocked) (bool, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := unlockedKey{userID: unlocked.UserID, code: unlocked.Code}
+	if _, exists := m.unlockedIdx[key]; exists {
+		return false, nil
+	}
+	m.unlockedIdx[key] = struct{}{}
+	m.unlocked = append(m.unlocked, unlocked)
+	return true, nil
+}
+
+func (m *MockAchievementRepository) FindUnlocked(ctx context.Context, userID uuid.UUID) ([]achievement.Unlocked, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []achievement.Unlocked
+	for _, u := range m.unlocked {
+		if u.UserID == userID {
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
+// Clear removes all facts and unlocked achievements (for test cleanup)
+func (m *MockAchievementRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.facts = nil
+	m.factKeys = make(map[factKey]struct{})
+	m.unlocked = nil
+	m.unlockedIdx = make(map[unlockedKey]struct{})
+}
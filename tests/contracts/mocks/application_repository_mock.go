@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// MockApplicationRepository is an in-memory implementation of ApplicationRepository for contract testing
+type MockApplicationRepository struct {
+	applications map[uuid.UUID]quest.Application
+	mu           sync.RWMutex
+}
+
+func NewMockApplicationRepository() *MockApplicationRepository {
+	return &MockApplicationRepository{
+		applications: make(map[uuid.UUID]quest.Application),
+	}
+}
+
+func (m *MockApplicationRepository) GetByID(ctx context.Context, applicationID uuid.UUID) (quest.Application, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	a, exists := m.applications[applicationID]
+	if !exists {
+		return quest.Application{}, fmt.Errorf("application %s: %w", applicationID, ports.ErrApplicationNotFound)
+	}
+	return a, nil
+}
+
+func (m *MockApplicationRepository) FindByQuest(ctx context.Context, questID uuid.UUID) ([]quest.Application, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]quest.Application, 0)
+	for _, a := range m.applications {
+		if a.QuestID == questID {
+			result = append(result, a)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (m *MockApplicationRepository) Save(ctx context.Context, application quest.Application) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.applications[application.ID] = application
+	return nil
+}
+
+// Clear removes all applications (for test cleanup)
+func (m *MockApplicationRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.applications = make(map[uuid.UUID]quest.Application)
+}
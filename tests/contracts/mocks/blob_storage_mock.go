@@ -0,0 +1,76 @@
+package mocks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"quest-manager/internal/core/ports"
+)
+
+// MockBlobStorage is an in-memory BlobStorage for contract testing
+type MockBlobStorage struct {
+	blobs  map[string][]byte
+	PutErr error
+	mu     sync.RWMutex
+}
+
+func NewMockBlobStorage() *MockBlobStorage {
+	return &MockBlobStorage{
+		blobs: make(map[string][]byte),
+	}
+}
+
+func (m *MockBlobStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.PutErr != nil {
+		return m.PutErr
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *MockBlobStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, exists := m.blobs[key]
+	if !exists {
+		return nil, fmt.Errorf("blob %s: %w", key, ports.ErrBlobNotFound)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.blobs, key)
+	return nil
+}
+
+// Helper methods for testing
+
+// Len returns how many blobs are stored.
+func (m *MockBlobStorage) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.blobs)
+}
+
+func (m *MockBlobStorage) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blobs = make(map[string][]byte)
+	m.PutErr = nil
+}
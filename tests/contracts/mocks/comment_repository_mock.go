@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// MockCommentRepository is an in-memory implementation of CommentRepository for contract testing
+type MockCommentRepository struct {
+	comments map[uuid.UUID]quest.Comment
+	mu       sync.RWMutex
+}
+
+func NewMockCommentRepository() *MockCommentRepository {
+	return &MockCommentRepository{
+		comments: make(map[uuid.UUID]quest.Comment),
+	}
+}
+
+func (m *MockCommentRepository) GetByID(ctx context.Context, commentID uuid.UUID) (quest.Comment, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	c, exists := m.comments[commentID]
+	if !exists {
+		return quest.Comment{}, fmt.Errorf("comment %s: %w", commentID, ports.ErrCommentNotFound)
+	}
+	return c, nil
+}
+
+func (m *MockCommentRepository) FindByQuest(ctx context.Context, questID uuid.UUID, includePrivate bool, limit, offset int) ([]quest.Comment, int, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	visible := make([]quest.Comment, 0)
+	for _, c := range m.comments {
+		if c.QuestID == questID && (includePrivate || c.Visibility == quest.CommentPublic) {
+			visible = append(visible, c)
+		}
+	}
+	sort.Slice(visible, func(i, j int) bool {
+		return visible[i].CreatedAt.Before(visible[j].CreatedAt)
+	})
+
+	total := len(visible)
+	if offset >= total {
+		return []quest.Comment{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return visible[offset:end], total, nil
+}
+
+func (m *MockCommentRepository) Save(ctx context.Context, comment quest.Comment) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.comments[comment.ID] = comment
+	return nil
+}
+
+// Clear removes all comments (for test cleanup)
+func (m *MockCommentRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.comments = make(map[uuid.UUID]quest.Comment)
+}
@@ -3,8 +3,11 @@ package mocks
 import (
 	"context"
 
+	"quest-manager/internal/core/application/eventhandlers"
 	"quest-manager/internal/core/application/usecases/commands"
 	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/ledger"
 	"quest-manager/internal/core/ports"
 	"quest-manager/internal/pkg/ddd"
 )
@@ -12,21 +15,80 @@ import (
 // ContractDIContainer provides mocked dependencies for contract testing
 type ContractDIContainer struct {
 	// Repositories
-	QuestRepository    ports.QuestRepository
-	LocationRepository ports.LocationRepository
-	EventPublisher     ports.EventPublisher
-	UnitOfWork         ports.UnitOfWork
+	QuestRepository       ports.QuestRepository
+	LocationRepository    ports.LocationRepository
+	UserRepository        ports.UserRepository
+	ApplicationRepository ports.ApplicationRepository
+	LedgerRepository      ports.LedgerRepository
+	EventPublisher        ports.EventPublisher
+	// EventDispatcher runs the event handlers, e.g. ledger rewards, on events published to it
+	EventDispatcher *eventhandlers.Dispatcher
+	UnitOfWork      ports.UnitOfWork
+	Geocoder        *MockGeocoder
+	BlobStorage     *MockBlobStorage
+	// NotificationChannel records the notifications delivered by the notification handler
+	NotificationChannel *MockNotificationChannel
+	// QuestTemplateRepository also records the occurrences the scheduler created quests for
+	QuestTemplateRepository *MockQuestTemplateRepository
 
 	// Command Handlers
 	CreateQuestHandler       commands.CreateQuestCommandHandler
 	AssignQuestHandler       commands.AssignQuestCommandHandler
 	ChangeQuestStatusHandler commands.ChangeQuestStatusCommandHandler
+	UpdateUserProfileHandler commands.UpdateUserProfileCommandHandler
+	ApplyToQuestHandler      commands.ApplyToQuestCommandHandler
+	ReviewApplicationHandler commands.ReviewApplicationCommandHandler
+
+	SubmitCompletionEvidenceHandler commands.SubmitCompletionEvidenceCommandHandler
+	ReviewCompletionHandler         commands.ReviewCompletionCommandHandler
+	SubmitReviewHandler             commands.SubmitReviewCommandHandler
+	AddQuestCommentHandler          commands.AddQuestCommentCommandHandler
+	EditQuestCommentHandler         commands.EditQuestCommentCommandHandler
+
+	MarkNotificationReadHandler          commands.MarkNotificationReadCommandHandler
+	MarkAllNotificationsReadHandler      commands.MarkAllNotificationsReadCommandHandler
+	UpdateNotificationPreferencesHandler commands.UpdateNotificationPreferencesCommandHandler
+
+	CreateQuestTemplateHandler     commands.CreateQuestTemplateCommandHandler
+	UpdateQuestTemplateHandler     commands.UpdateQuestTemplateCommandHandler
+	DeleteQuestTemplateHandler     commands.DeleteQuestTemplateCommandHandler
+	CreateQuestFromTemplateHandler commands.CreateQuestFromTemplateCommandHandler
+	CreateScheduledQuestsHandler   commands.CreateScheduledQuestsCommandHandler
+
+	AddQuestPrerequisiteHandler    commands.AddQuestPrerequisiteCommandHandler
+	RemoveQuestPrerequisiteHandler commands.RemoveQuestPrerequisiteCommandHandler
 
 	// Query Handlers
 	ListQuestsHandler           queries.ListQuestsQueryHandler
 	GetQuestByIDHandler         queries.GetQuestByIDQueryHandler
 	SearchQuestsByRadiusHandler queries.SearchQuestsByRadiusQueryHandler
+	SearchQuestsByAreaHandler   queries.SearchQuestsByAreaQueryHandler
+	GetQuestTileHandler         queries.GetQuestTileQueryHandler
 	ListAssignedQuestsHandler   queries.ListAssignedQuestsQueryHandler
+	RecommendQuestsHandler      queries.RecommendQuestsQueryHandler
+
+	AutocompleteLocationsHandler queries.AutocompleteLocationsQueryHandler
+	GetUserProfileHandler        queries.GetUserProfileQueryHandler
+	ListQuestApplicationsHandler queries.ListQuestApplicationsQueryHandler
+
+	ListCompletionEvidenceHandler queries.ListCompletionEvidenceQueryHandler
+	GetEvidenceAttachmentHandler  queries.GetEvidenceAttachmentQueryHandler
+
+	GetBalanceHandler        queries.GetBalanceQueryHandler
+	ListLedgerEntriesHandler queries.ListLedgerEntriesQueryHandler
+	GetLeaderboardHandler    queries.GetLeaderboardQueryHandler
+	ListAchievementsHandler  queries.ListAchievementsQueryHandler
+	ListQuestReviewsHandler  queries.ListQuestReviewsQueryHandler
+	GetReputationsHandler    queries.GetReputationsQueryHandler
+	ListQuestCommentsHandler queries.ListQuestCommentsQueryHandler
+
+	ListNotificationsHandler          queries.ListNotificationsQueryHandler
+	GetNotificationPreferencesHandler queries.GetNotificationPreferencesQueryHandler
+
+	GetQuestTemplateHandler   queries.GetQuestTemplateQueryHandler
+	ListQuestTemplatesHandler queries.ListQuestTemplatesQueryHandler
+
+	GetQuestDependenciesHandler queries.GetQuestDependenciesQueryHandler
 }
 
 // NewContractDIContainer creates a new DI container with mocked dependencies
@@ -36,32 +98,149 @@ func NewContractDIContainer() *ContractDIContainer {
 	locationRepo := NewMockLocationRepository()
 	eventPublisher := &MockEventPublisher{}
 	unitOfWork := NewMockUnitOfWork()
+	geocoder := NewMockGeocoder()
+	blobStorage := NewMockBlobStorage()
+	notificationChannel := NewMockNotificationChannel()
+
+	// Events go to the mock publisher and then to the subscribed handlers, as in the app
+	dispatcher, err := eventhandlers.NewDispatcher(eventPublisher)
+	if err != nil {
+		panic(err)
+	}
+	dispatcher.Subscribe("quest.status_changed",
+		eventhandlers.NewQuestRewardHandler(unitOfWork, dispatcher, ledger.DefaultRewardFormula()))
+	dispatcher.Subscribe("ledger.points_credited", eventhandlers.NewLeaderboardHandler(unitOfWork))
+	achievementHandler := eventhandlers.NewAchievementHandler(unitOfWork, dispatcher, achievement.DefaultDefinitions())
+	dispatcher.Subscribe("quest.assigned", achievementHandler)
+	dispatcher.Subscribe("quest.status_changed", achievementHandler)
+	notificationHandler := eventhandlers.NewNotificationHandler(unitOfWork, notificationChannel)
+	dispatcher.Subscribe("quest.assigned", notificationHandler)
+	dispatcher.Subscribe("quest.status_changed", notificationHandler)
+	dispatcher.Subscribe("quest.comment_added", notificationHandler)
+	dispatcher.Subscribe("quest.status_changed", eventhandlers.NewPrerequisiteHandler(unitOfWork, dispatcher))
 
 	// Create command handlers with mocked dependencies
-	createQuestHandler := commands.NewCreateQuestCommandHandler(unitOfWork, eventPublisher)
-	assignQuestHandler := commands.NewAssignQuestCommandHandler(unitOfWork, eventPublisher)
-	changeQuestStatusHandler := commands.NewChangeQuestStatusCommandHandler(unitOfWork, eventPublisher)
+	createQuestHandler := commands.NewCreateQuestCommandHandler(unitOfWork, dispatcher, geocoder)
+	assignQuestHandler := commands.NewAssignQuestCommandHandler(unitOfWork, dispatcher)
+	changeQuestStatusHandler := commands.NewChangeQuestStatusCommandHandler(unitOfWork, dispatcher)
+	updateUserProfileHandler := commands.NewUpdateUserProfileCommandHandler(unitOfWork, dispatcher)
+	applyToQuestHandler := commands.NewApplyToQuestCommandHandler(unitOfWork, dispatcher)
+	reviewApplicationHandler := commands.NewReviewApplicationCommandHandler(unitOfWork, dispatcher)
+	submitCompletionEvidenceHandler := commands.NewSubmitCompletionEvidenceCommandHandler(unitOfWork, dispatcher, blobStorage)
+	reviewCompletionHandler := commands.NewReviewCompletionCommandHandler(unitOfWork, dispatcher)
+	submitReviewHandler := commands.NewSubmitReviewCommandHandler(unitOfWork, dispatcher)
+	addQuestCommentHandler := commands.NewAddQuestCommentCommandHandler(unitOfWork, dispatcher)
+	editQuestCommentHandler := commands.NewEditQuestCommentCommandHandler(unitOfWork)
+	markNotificationReadHandler := commands.NewMarkNotificationReadCommandHandler(unitOfWork)
+	markAllNotificationsReadHandler := commands.NewMarkAllNotificationsReadCommandHandler(unitOfWork)
+	updateNotificationPreferencesHandler := commands.NewUpdateNotificationPreferencesCommandHandler(unitOfWork)
+	createQuestTemplateHandler := commands.NewCreateQuestTemplateCommandHandler(unitOfWork)
+	updateQuestTemplateHandler := commands.NewUpdateQuestTemplateCommandHandler(unitOfWork)
+	deleteQuestTemplateHandler := commands.NewDeleteQuestTemplateCommandHandler(unitOfWork)
+	createQuestFromTemplateHandler := commands.NewCreateQuestFromTemplateCommandHandler(unitOfWork, createQuestHandler)
+	createScheduledQuestsHandler := commands.NewCreateScheduledQuestsCommandHandler(unitOfWork, dispatcher, geocoder)
+	addQuestPrerequisiteHandler := commands.NewAddQuestPrerequisiteCommandHandler(unitOfWork)
+	removeQuestPrerequisiteHandler := commands.NewRemoveQuestPrerequisiteCommandHandler(unitOfWork)
 
 	// Create query handlers with mocked dependencies
+	questRepo.UseReviews(unitOfWork.ReviewRepository())
 	listQuestsHandler := queries.NewListQuestsQueryHandler(questRepo)
 	getQuestByIDHandler := queries.NewGetQuestByIDQueryHandler(questRepo)
 	searchQuestsByRadiusHandler := queries.NewSearchQuestsByRadiusQueryHandler(questRepo)
+	searchQuestsByAreaHandler := queries.NewSearchQuestsByAreaQueryHandler(questRepo)
+	getQuestTileHandler := queries.NewGetQuestTileQueryHandler(questRepo)
 	listAssignedQuestsHandler := queries.NewListAssignedQuestsQueryHandler(questRepo)
+	recommendQuestsHandler := queries.NewRecommendQuestsQueryHandler(questRepo, unitOfWork.UserRepository())
+	autocompleteLocationsHandler := queries.NewAutocompleteLocationsQueryHandler(locationRepo)
+	// Profiles are read from the unit of work so queries see what commands saved
+	getUserProfileHandler := queries.NewGetUserProfileQueryHandler(unitOfWork.UserRepository())
+	listQuestApplicationsHandler := queries.NewListQuestApplicationsQueryHandler(unitOfWork.QuestRepository(), unitOfWork.ApplicationRepository())
+	listCompletionEvidenceHandler := queries.NewListCompletionEvidenceQueryHandler(unitOfWork.QuestRepository(), unitOfWork.EvidenceRepository())
+	getEvidenceAttachmentHandler := queries.NewGetEvidenceAttachmentQueryHandler(unitOfWork.QuestRepository(), unitOfWork.EvidenceRepository(), blobStorage)
+	getBalanceHandler := queries.NewGetBalanceQueryHandler(unitOfWork.LedgerRepository())
+	listLedgerEntriesHandler := queries.NewListLedgerEntriesQueryHandler(unitOfWork.LedgerRepository())
+	getLeaderboardHandler := queries.NewGetLeaderboardQueryHandler(unitOfWork.LeaderboardRepository())
+	listAchievementsHandler := queries.NewListAchievementsQueryHandler(unitOfWork.AchievementRepository(), achievement.DefaultDefinitions())
+	listQuestReviewsHandler := queries.NewListQuestReviewsQueryHandler(unitOfWork.QuestRepository(), unitOfWork.ReviewRepository())
+	getReputationsHandler := queries.NewGetReputationsQueryHandler(unitOfWork.ReviewRepository())
+	listQuestCommentsHandler := queries.NewListQuestCommentsQueryHandler(unitOfWork.QuestRepository(), unitOfWork.CommentRepository())
+	listNotificationsHandler := queries.NewListNotificationsQueryHandler(unitOfWork.NotificationRepository())
+	getNotificationPreferencesHandler := queries.NewGetNotificationPreferencesQueryHandler(unitOfWork.NotificationRepository())
+	getQuestTemplateHandler := queries.NewGetQuestTemplateQueryHandler(unitOfWork.QuestTemplateRepository())
+	listQuestTemplatesHandler := queries.NewListQuestTemplatesQueryHandler(unitOfWork.QuestTemplateRepository())
+	getQuestDependenciesHandler := queries.NewGetQuestDependenciesQueryHandler(unitOfWork.QuestRepository())
 
 	return &ContractDIContainer{
-		QuestRepository:    questRepo,
-		LocationRepository: locationRepo,
-		EventPublisher:     eventPublisher,
-		UnitOfWork:         unitOfWork,
+		QuestRepository:       questRepo,
+		LocationRepository:    locationRepo,
+		UserRepository:        unitOfWork.UserRepository(),
+		ApplicationRepository: unitOfWork.ApplicationRepository(),
+		LedgerRepository:      unitOfWork.LedgerRepository(),
+		EventPublisher:        eventPublisher,
+		EventDispatcher:       dispatcher,
+		UnitOfWork:            unitOfWork,
+		Geocoder:              geocoder,
+		BlobStorage:           blobStorage,
+		NotificationChannel:   notificationChannel,
+
+		QuestTemplateRepository: unitOfWork.QuestTemplateRepository().(*MockQuestTemplateRepository),
 
 		CreateQuestHandler:       createQuestHandler,
 		AssignQuestHandler:       assignQuestHandler,
 		ChangeQuestStatusHandler: changeQuestStatusHandler,
+		UpdateUserProfileHandler: updateUserProfileHandler,
+		ApplyToQuestHandler:      applyToQuestHandler,
+		ReviewApplicationHandler: reviewApplicationHandler,
+
+		SubmitCompletionEvidenceHandler: submitCompletionEvidenceHandler,
+		ReviewCompletionHandler:         reviewCompletionHandler,
+		SubmitReviewHandler:             submitReviewHandler,
+		AddQuestCommentHandler:          addQuestCommentHandler,
+		EditQuestCommentHandler:         editQuestCommentHandler,
+
+		MarkNotificationReadHandler:          markNotificationReadHandler,
+		MarkAllNotificationsReadHandler:      markAllNotificationsReadHandler,
+		UpdateNotificationPreferencesHandler: updateNotificationPreferencesHandler,
+
+		CreateQuestTemplateHandler:     createQuestTemplateHandler,
+		UpdateQuestTemplateHandler:     updateQuestTemplateHandler,
+		DeleteQuestTemplateHandler:     deleteQuestTemplateHandler,
+		CreateQuestFromTemplateHandler: createQuestFromTemplateHandler,
+		CreateScheduledQuestsHandler:   createScheduledQuestsHandler,
+
+		AddQuestPrerequisiteHandler:    addQuestPrerequisiteHandler,
+		RemoveQuestPrerequisiteHandler: removeQuestPrerequisiteHandler,
 
 		ListQuestsHandler:           listQuestsHandler,
 		GetQuestByIDHandler:         getQuestByIDHandler,
 		SearchQuestsByRadiusHandler: searchQuestsByRadiusHandler,
+		SearchQuestsByAreaHandler:   searchQuestsByAreaHandler,
+		GetQuestTileHandler:         getQuestTileHandler,
 		ListAssignedQuestsHandler:   listAssignedQuestsHandler,
+		RecommendQuestsHandler:      recommendQuestsHandler,
+
+		AutocompleteLocationsHandler: autocompleteLocationsHandler,
+		GetUserProfileHandler:        getUserProfileHandler,
+		ListQuestApplicationsHandler: listQuestApplicationsHandler,
+
+		ListCompletionEvidenceHandler: listCompletionEvidenceHandler,
+		GetEvidenceAttachmentHandler:  getEvidenceAttachmentHandler,
+
+		GetBalanceHandler:        getBalanceHandler,
+		ListLedgerEntriesHandler: listLedgerEntriesHandler,
+		GetLeaderboardHandler:    getLeaderboardHandler,
+		ListAchievementsHandler:  listAchievementsHandler,
+		ListQuestReviewsHandler:  listQuestReviewsHandler,
+		GetReputationsHandler:    getReputationsHandler,
+		ListQuestCommentsHandler: listQuestCommentsHandler,
+
+		ListNotificationsHandler:          listNotificationsHandler,
+		GetNotificationPreferencesHandler: getNotificationPreferencesHandler,
+
+		GetQuestTemplateHandler:   getQuestTemplateHandler,
+		ListQuestTemplatesHandler: listQuestTemplatesHandler,
+
+		GetQuestDependenciesHandler: getQuestDependenciesHandler,
 	}
 }
 
@@ -78,6 +257,9 @@ func (c *ContractDIContainer) CleanupAll() {
 		mockEventPublisher.PublishAsyncEvents = nil
 		mockEventPublisher.PublishError = nil
 	}
+	c.Geocoder.Clear()
+	c.BlobStorage.Clear()
+	c.NotificationChannel.Clear()
 	if mockUnitOfWork, ok := c.UnitOfWork.(*MockUnitOfWork); ok {
 		mockUnitOfWork.ClearRepositories()
 		mockUnitOfWork.SetShouldFail(false)
@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// MockEvidenceRepository is an in-memory implementation of EvidenceRepository for contract testing
+type MockEvidenceRepository struct {
+	evidence map[uuid.UUID]quest.Evidence
+	mu       sync.RWMutex
+}
+
+func NewMockEvidenceRepository() *MockEvidenceRepository {
+	return &MockEvidenceRepository{
+		evidence: make(map[uuid.UUID]quest.Evidence),
+	}
+}
+
+func (m *MockEvidenceRepository) GetByID(ctx context.Context, evidenceID uuid.UUID) (quest.Evidence, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e, exists := m.evidence[evidenceID]
+	if !exists {
+		return quest.Evidence{}, fmt.Errorf("evidence %s: %w", evidenceID, ports.ErrEvidenceNotFound)
+	}
+	return e, nil
+}
+
+func (m *MockEvidenceRepository) FindByQuest(ctx context.Context, questID uuid.UUID) ([]quest.Evidence, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]quest.Evidence, 0)
+	for _, e := range m.evidence {
+		if e.QuestID == questID {
+			result = append(result, e)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SubmittedAt.Before(result[j].SubmittedAt)
+	})
+	return result, nil
+}
+
+func (m *MockEvidenceRepository) Save(ctx context.Context, evidence quest.Evidence) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evidence[evidence.ID] = evidence
+	return nil
+}
+
+// Clear removes all evidence (for test cleanup)
+func (m *MockEvidenceRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evidence = make(map[uuid.UUID]quest.Evidence)
+}
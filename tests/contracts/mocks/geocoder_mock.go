@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/ports"
+)
+
+// MockGeocoder is an in-memory Geocoder for contract testing.
+// Places are matched by exact address (case-insensitive) and exact coordinate.
+type MockGeocoder struct {
+	places []ports.GeocodeResult
+	calls  int
+	Err    error
+	mu     sync.RWMutex
+}
+
+func NewMockGeocoder() *MockGeocoder {
+	return &MockGeocoder{}
+}
+
+func (m *MockGeocoder) Geocode(ctx context.Context, address string) (ports.GeocodeResult, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+
+	if m.Err != nil {
+		return ports.GeocodeResult{}, m.Err
+	}
+	for _, p := range m.places {
+		if strings.EqualFold(p.Address, strings.TrimSpace(address)) {
+			return p, nil
+		}
+	}
+	return ports.GeocodeResult{}, ports.ErrGeocodeNotFound
+}
+
+func (m *MockGeocoder) ReverseGeocode(ctx context.Context, coordinate kernel.GeoCoordinate) (ports.GeocodeResult, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+
+	if m.Err != nil {
+		return ports.GeocodeResult{}, m.Err
+	}
+	for _, p := range m.places {
+		if p.Coordinate.Equals(coordinate) {
+			return p, nil
+		}
+	}
+	return ports.GeocodeResult{}, ports.ErrGeocodeNotFound
+}
+
+// Helper methods for testing
+func (m *MockGeocoder) AddPlace(address string, coordinate kernel.GeoCoordinate) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.places = append(m.places, ports.GeocodeResult{Coordinate: coordinate, Address: address})
+}
+
+// Calls returns how many lookups reached the geocoder (cache misses).
+func (m *MockGeocoder) Calls() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.calls
+}
+
+func (m *MockGeocoder) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.places = nil
+	m.calls = 0
+	m.Err = nil
+}
@@ -0,0 +1,91 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/leaderboard"
+
+	"github.com/google/uuid"
+)
+
+// MockLeaderboardRepository is an in-memory implementation of LeaderboardRepository for contract testing
+type MockLeaderboardRepository struct {
+	scores map[uuid.UUID]leaderboard.Score
+	mu     sync.RWMutex
+}
+
+func NewMockLeaderboardRepository() *MockLeaderboardRepository {
+	return &MockLeaderboardRepository{
+		scores: make(map[uuid.UUID]leaderboard.Score),
+	}
+}
+
+func (m *MockLeaderboardRepository) Record(ctx context.Context, score leaderboard.Score) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.scores[score.EntryID]; !exists {
+		m.scores[score.EntryID] = score
+	}
+	return nil
+}
+
+func (m *MockLeaderboardRepository) Top(ctx context.Context, filter leaderboard.Filter) ([]leaderboard.Standing, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	// Same selection and aggregation as leaderboardrepo.Repository.Top
+	totals := make(map[uuid.UUID]int)
+	for _, s := range m.scores {
+		if s.OccurredAt.Before(filter.Since) {
+			continue
+		}
+		if filter.Center != nil && (s.Location == nil || filter.Center.DistanceTo(*s.Location) > filter.RadiusKm) {
+			continue
+		}
+		switch {
+		case filter.Metric == leaderboard.MetricCompleted && s.Completion:
+			totals[s.UserID]++
+		case filter.Metric != leaderboard.MetricCompleted && s.Points != 0:
+			totals[s.UserID] += s.Points
+		}
+	}
+
+	standings := make([]leaderboard.Standing, 0, len(totals))
+	for userID, total := range totals {
+		standings = append(standings, leaderboard.Standing{UserID: userID, Score: total})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Score != standings[j].Score {
+			return standings[i].Score > standings[j].Score
+		}
+		return standings[i].UserID.String() < standings[j].UserID.String()
+	})
+	if filter.Limit > 0 && len(standings) > filter.Limit {
+		standings = standings[:filter.Limit]
+	}
+	return standings, nil
+}
+
+// Scores returns all recorded scores (for test assertions)
+func (m *MockLeaderboardRepository) Scores() []leaderboard.Score {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]leaderboard.Score, 0, len(m.scores))
+	for _, s := range m.scores {
+		result = append(result, s)
+	}
+	return result
+}
+
+// Clear removes all scores (for test cleanup)
+func (m *MockLeaderboardRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.scores = make(map[uuid.UUID]leaderboard.Score)
+}
@@ -0,0 +1,84 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/ledger"
+
+	"github.com/google/uuid"
+)
+
+// MockLedgerRepository is an in-memory implementation of LedgerRepository for contract testing
+type MockLedgerRepository struct {
+	entries []ledger.Entry
+	keys    map[string]struct{}
+	mu      sync.RWMutex
+}
+
+func NewMockLedgerRepository() *MockLedgerRepository {
+	return &MockLedgerRepository{
+		keys: make(map[string]struct{}),
+	}
+}
+
+func (m *MockLedgerRepository) Append(ctx context.Context, entry ledger.Entry) (bool, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.keys[entry.IdempotencyKey]; exists {
+		return false, nil
+	}
+	m.keys[entry.IdempotencyKey] = struct{}{}
+	m.entries = append(m.entries, entry)
+	return true, nil
+}
+
+func (m *MockLedgerRepository) Balance(ctx context.Context, userID uuid.UUID) (int, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	balance := 0
+	for _, e := range m.entries {
+		if e.UserID == userID {
+			balance += e.Points
+		}
+	}
+	return balance, nil
+}
+
+func (m *MockLedgerRepository) FindByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]ledger.Entry, int, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]ledger.Entry, 0)
+	// Walk backwards so that entries appended later come first on equal timestamps
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		if m.entries[i].UserID == userID {
+			result = append(result, m.entries[i])
+		}
+	}
+	// Newest first
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	total := len(result)
+	if offset >= total {
+		return []ledger.Entry{}, total, nil
+	}
+	end := min(offset+limit, total)
+	return result[offset:end], total, nil
+}
+
+// Clear removes all entries (for test cleanup)
+func (m *MockLedgerRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = nil
+	m.keys = make(map[string]struct{})
+}
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -70,6 +71,23 @@ func (m *MockLocationRepository) FindByBoundingBox(ctx context.Context, bbox ker
 	return result, nil
 }
 
+func (m *MockLocationRepository) FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64) ([]*location.Location, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*location.Location
+	for _, loc := range m.locations {
+		if center.DistanceTo(loc.Coordinate) <= radiusKm {
+			result = append(result, loc)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return center.DistanceTo(result[i].Coordinate) < center.DistanceTo(result[j].Coordinate)
+	})
+	return result, nil
+}
+
 func (m *MockLocationRepository) FindByName(ctx context.Context, namePattern string) ([]*location.Location, error) {
 	_ = ctx // unused in mock
 	m.mu.RLock()
@@ -79,18 +97,87 @@ func (m *MockLocationRepository) FindByName(ctx context.Context, namePattern str
 	namePattern = strings.ToLower(namePattern)
 
 	for _, loc := range m.locations {
-		if loc.Address != nil {
-			if strings.Contains(strings.ToLower(*loc.Address), namePattern) {
-				result = append(result, loc)
-			}
+		if m.textScore(loc, namePattern) > 0 {
+			result = append(result, loc)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockLocationRepository) FindByAddress(ctx context.Context, address string) ([]*location.Location, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*location.Location
+	address = strings.TrimSpace(address)
+	for _, loc := range m.locations {
+		if loc.Address != nil && strings.EqualFold(*loc.Address, address) {
+			result = append(result, loc)
 		}
 	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
 	return result, nil
 }
 
+func (m *MockLocationRepository) FindByCoordinate(ctx context.Context, coordinate kernel.GeoCoordinate) ([]*location.Location, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []*location.Location
+	for _, loc := range m.locations {
+		if loc.Coordinate.Equals(coordinate) {
+			result = append(result, loc)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (m *MockLocationRepository) SearchByText(ctx context.Context, query string, limit int) ([]location.SearchMatch, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []location.SearchMatch
+	query = strings.ToLower(query)
+
+	for _, loc := range m.locations {
+		if score := m.textScore(loc, query); score > 0 {
+			result = append(result, location.SearchMatch{Location: loc, Score: score})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Score > result[j].Score })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// textScore approximates trigram similarity: exact match 1, prefix 0.75, substring 0.5
+func (m *MockLocationRepository) textScore(loc *location.Location, query string) float64 {
+	best := 0.0
+	for _, field := range []*string{loc.Name, loc.Address} {
+		if field == nil {
+			continue
+		}
+		value := strings.ToLower(*field)
+		switch {
+		case value == query:
+			best = max(best, 1.0)
+		case strings.HasPrefix(value, query):
+			best = max(best, 0.75)
+		case strings.Contains(value, query):
+			best = max(best, 0.5)
+		}
+	}
+	return best
+}
+
 func (m *MockLocationRepository) isWithinBoundingBox(coord kernel.GeoCoordinate, bbox kernel.BoundingBox) bool {
-	return coord.Lat >= bbox.MinLat && coord.Lat <= bbox.MaxLat &&
-		coord.Lon >= bbox.MinLon && coord.Lon <= bbox.MaxLon
+	return bbox.Contains(coord)
 }
 
 // Helper methods for testing
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/notification"
+)
+
+// MockNotificationChannel records delivered notifications for contract testing
+type MockNotificationChannel struct {
+	Delivered []notification.Notification
+	// Err is returned by Deliver when set
+	Err error
+	mu  sync.Mutex
+}
+
+func NewMockNotificationChannel() *MockNotificationChannel {
+	return &MockNotificationChannel{}
+}
+
+func (m *MockNotificationChannel) Deliver(ctx context.Context, n notification.Notification) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Delivered = append(m.Delivered, n)
+	return nil
+}
+
+// Clear forgets delivered notifications and the error (for test cleanup)
+func (m *MockNotificationChannel) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Delivered = nil
+	m.Err = nil
+}
@@ -0,0 +1,138 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// MockNotificationRepository is an in-memory implementation of NotificationRepository for contract testing
+type MockNotificationRepository struct {
+	notifications map[uuid.UUID]notification.Notification
+	preferences   map[uuid.UUID]map[notification.Kind]bool
+	mu            sync.RWMutex
+}
+
+func NewMockNotificationRepository() *MockNotificationRepository {
+	return &MockNotificationRepository{
+		notifications: make(map[uuid.UUID]notification.Notification),
+		preferences:   make(map[uuid.UUID]map[notification.Kind]bool),
+	}
+}
+
+func (m *MockNotificationRepository) Save(ctx context.Context, n notification.Notification) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notifications[n.ID] = n
+	return nil
+}
+
+func (m *MockNotificationRepository) GetByID(ctx context.Context, notificationID uuid.UUID) (notification.Notification, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	n, exists := m.notifications[notificationID]
+	if !exists {
+		return notification.Notification{}, fmt.Errorf("notification %s: %w", notificationID, ports.ErrNotificationNotFound)
+	}
+	return n, nil
+}
+
+func (m *MockNotificationRepository) FindByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]notification.Notification, int, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	inbox := make([]notification.Notification, 0)
+	for _, n := range m.notifications {
+		if n.UserID == userID && (!unreadOnly || !n.IsRead()) {
+			inbox = append(inbox, n)
+		}
+	}
+	sort.Slice(inbox, func(i, j int) bool {
+		return inbox[i].CreatedAt.After(inbox[j].CreatedAt)
+	})
+
+	total := len(inbox)
+	if offset >= total {
+		return []notification.Notification{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return inbox[offset:end], total, nil
+}
+
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	count := 0
+	for _, n := range m.notifications {
+		if n.UserID == userID && !n.IsRead() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	marked := 0
+	for id, n := range m.notifications {
+		if n.UserID == userID && !n.IsRead() {
+			n.MarkRead(at)
+			m.notifications[id] = n
+			marked++
+		}
+	}
+	return marked, nil
+}
+
+func (m *MockNotificationRepository) Preferences(ctx context.Context, userID uuid.UUID) (notification.Preferences, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p := notification.DefaultPreferences(userID)
+	for kind, enabled := range m.preferences[userID] {
+		p.Enabled[kind] = enabled
+	}
+	return p, nil
+}
+
+func (m *MockNotificationRepository) SavePreferences(ctx context.Context, p notification.Preferences) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.preferences[p.UserID] == nil {
+		m.preferences[p.UserID] = make(map[notification.Kind]bool)
+	}
+	for kind, enabled := range p.Enabled {
+		m.preferences[p.UserID][kind] = enabled
+	}
+	return nil
+}
+
+// Clear removes all notifications and preferences (for test cleanup)
+func (m *MockNotificationRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notifications = make(map[uuid.UUID]notification.Notification)
+	m.preferences = make(map[uuid.UUID]map[notification.Kind]bool)
+}
@@ -3,23 +3,33 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+	"quest-manager/internal/pkg/ddd"
 
 	"github.com/google/uuid"
 )
 
 // MockQuestRepository is an in-memory implementation of QuestRepository for contract testing
 type MockQuestRepository struct {
-	quests map[uuid.UUID]quest.Quest
-	mu     sync.RWMutex
+	quests   map[uuid.UUID]quest.Quest
+	rowLocks map[uuid.UUID]*sync.Mutex
+	// reviews provide creator ratings for list filters, like the reviews table in SQL
+	reviews ports.ReviewRepository
+	// saveDelay widens the window between reading and saving a quest for concurrency tests
+	saveDelay time.Duration
+	mu        sync.RWMutex
 }
 
 func NewMockQuestRepository() *MockQuestRepository {
 	return &MockQuestRepository{
-		quests: make(map[uuid.UUID]quest.Quest),
+		quests:   make(map[uuid.UUID]quest.Quest),
+		rowLocks: make(map[uuid.UUID]*sync.Mutex),
 	}
 }
 
@@ -32,11 +42,61 @@ func (m *MockQuestRepository) GetByID(ctx context.Context, questID uuid.UUID) (q
 	if !exists {
 		return quest.Quest{}, fmt.Errorf("quest with id %s not found", questID.String())
 	}
-	return q, nil
+	return detached(q), nil
+}
+
+// detached copies the quest like a fresh database read: events and slices are not shared
+// with the stored quest or other readers
+func detached(q quest.Quest) quest.Quest {
+	q.BaseAggregate = ddd.NewBaseAggregate(q.ID())
+	q.Waypoints = append([]kernel.GeoCoordinate(nil), q.Waypoints...)
+	q.Participants = append([]quest.Participant(nil), q.Participants...)
+	q.Prerequisites = append([]quest.Prerequisite(nil), q.Prerequisites...)
+	return q
+}
+
+// GetByIDForUpdate does not lock outside a unit of work, there is no transaction to hold
+// the lock; MockUnitOfWork.QuestRepository locks the quest until commit or rollback.
+func (m *MockQuestRepository) GetByIDForUpdate(ctx context.Context, questID uuid.UUID) (quest.Quest, error) {
+	return m.GetByID(ctx, questID)
+}
+
+// lockRow blocks until the quest is not locked by another unit of work and returns the unlock function
+func (m *MockQuestRepository) lockRow(questID uuid.UUID) func() {
+	m.mu.Lock()
+	rowLock, exists := m.rowLocks[questID]
+	if !exists {
+		rowLock = &sync.Mutex{}
+		m.rowLocks[questID] = rowLock
+	}
+	m.mu.Unlock()
+
+	rowLock.Lock()
+	return rowLock.Unlock
+}
+
+// txQuestRepository is the quest repository of one MockUnitOfWork. Quests read for update stay
+// locked until the unit of work commits or rolls back, like SELECT ... FOR UPDATE.
+type txQuestRepository struct {
+	*MockQuestRepository
+	unitOfWork *MockUnitOfWork
+}
+
+func (r txQuestRepository) GetByIDForUpdate(ctx context.Context, questID uuid.UUID) (quest.Quest, error) {
+	if !r.unitOfWork.inTx {
+		return quest.Quest{}, fmt.Errorf("transaction is required")
+	}
+	r.unitOfWork.holdUntilEnd(r.lockRow(questID))
+	return r.GetByID(ctx, questID)
 }
 
 func (m *MockQuestRepository) Save(ctx context.Context, q quest.Quest) error {
 	_ = ctx // unused in mock
+	m.mu.RLock()
+	delay := m.saveDelay
+	m.mu.RUnlock()
+	time.Sleep(delay)
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.quests[q.ID()] = q
@@ -69,6 +129,43 @@ func (m *MockQuestRepository) FindByStatus(ctx context.Context, status quest.Sta
 	return result, nil
 }
 
+func (m *MockQuestRepository) FindByFilter(ctx context.Context, filter quest.ListFilter) ([]quest.Quest, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []quest.Quest
+	for _, q := range m.quests {
+		if filter.Status != nil && q.Status != *filter.Status {
+			continue
+		}
+		if filter.MaxRouteKm != nil && q.RouteDistanceKm() > *filter.MaxRouteKm {
+			continue
+		}
+		if filter.MinCreatorRating != nil && !m.creatorRatedAtLeast(ctx, q.Creator, *filter.MinCreatorRating) {
+			continue
+		}
+		result = append(result, q)
+	}
+	return result, nil
+}
+
+func (m *MockQuestRepository) creatorRatedAtLeast(ctx context.Context, creator string, minRating float64) bool {
+	creatorID, err := uuid.Parse(creator)
+	if err != nil || m.reviews == nil {
+		return false
+	}
+	reputations, err := m.reviews.Reputations(ctx, []uuid.UUID{creatorID})
+	return err == nil && reputations[creatorID].AtLeast(minRating)
+}
+
+// UseReviews sets the review repository the creator rating filter reads from
+func (m *MockQuestRepository) UseReviews(reviews ports.ReviewRepository) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reviews = reviews
+}
+
 func (m *MockQuestRepository) FindByBoundingBox(ctx context.Context, bbox kernel.BoundingBox) ([]quest.Quest, error) {
 	_ = ctx // unused in mock
 	m.mu.RLock()
@@ -84,6 +181,89 @@ func (m *MockQuestRepository) FindByBoundingBox(ctx context.Context, bbox kernel
 	return result, nil
 }
 
+func (m *MockQuestRepository) ClusterByTile(ctx context.Context, tile kernel.Tile, cellZoom int) ([]quest.TileCluster, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	clusters := make(map[kernel.Tile]*quest.TileCluster)
+	for _, q := range m.quests {
+		cell := kernel.TileForCoordinate(q.TargetLocation, cellZoom)
+		if !tile.Contains(cell) {
+			continue
+		}
+		c, ok := clusters[cell]
+		if !ok {
+			created := quest.NewTileCluster(cell)
+			c = &created
+			clusters[cell] = c
+		}
+		c.Add(q.Status, 1, q.TargetLocation)
+	}
+
+	result := make([]quest.TileCluster, 0, len(clusters))
+	for _, c := range clusters {
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].Cell, result[j].Cell
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+		return a.X < b.X
+	})
+	return result, nil
+}
+
+func (m *MockQuestRepository) FindWithinRadius(ctx context.Context, center kernel.GeoCoordinate, radiusKm float64, sortBy quest.RadiusSort, limit int) ([]quest.Quest, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []quest.Quest
+	for _, q := range m.quests {
+		if q.DistanceFrom(center) <= radiusKm {
+			result = append(result, q)
+		}
+	}
+	// Mirrors the ORDER BY of the SQL repositories
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch sortBy {
+		case quest.RadiusSortCreatedAt:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+		case quest.RadiusSortReward:
+			if a.Reward != b.Reward {
+				return a.Reward > b.Reward
+			}
+		}
+		if da, db := a.DistanceFrom(center), b.DistanceFrom(center); da != db {
+			return da < db
+		}
+		return a.ID().String() < b.ID().String()
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *MockQuestRepository) FindInPolygon(ctx context.Context, polygon kernel.Polygon, match quest.LocationMatch) ([]quest.Quest, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []quest.Quest
+	for _, q := range m.quests {
+		if match.Matches(q, polygon.Contains) {
+			result = append(result, q)
+		}
+	}
+	return result, nil
+}
+
 func (m *MockQuestRepository) FindByAssignee(ctx context.Context, userID uuid.UUID) ([]quest.Quest, error) {
 	_ = ctx // unused in mock
 	m.mu.RLock()
@@ -91,16 +271,86 @@ func (m *MockQuestRepository) FindByAssignee(ctx context.Context, userID uuid.UU
 
 	var result []quest.Quest
 	for _, q := range m.quests {
-		if q.Assignee != nil && *q.Assignee == userID {
+		if q.IsParticipant(userID) {
+			result = append(result, q)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockQuestRepository) FindDependents(ctx context.Context, prerequisiteID uuid.UUID) ([]quest.Quest, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []quest.Quest
+	for _, q := range m.quests {
+		if q.HasPrerequisite(prerequisiteID) {
 			result = append(result, q)
 		}
 	}
+	sortByCreation(result)
 	return result, nil
 }
 
+// LockLinkedByPrerequisites is a no-op: the mock serializes every access with its mutex
+func (m *MockQuestRepository) LockLinkedByPrerequisites(ctx context.Context, questIDs ...uuid.UUID) error {
+	_ = ctx // unused in mock
+	return nil
+}
+
+func (m *MockQuestRepository) FindLinkedByPrerequisites(ctx context.Context, questID uuid.UUID) ([]quest.Quest, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := []quest.Quest{}
+	if _, exists := m.quests[questID]; !exists {
+		return result, nil
+	}
+
+	// Walk the links in both directions
+	linked := map[uuid.UUID]bool{questID: true}
+	pending := []uuid.UUID{questID}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		var neighbours []uuid.UUID
+		for _, p := range m.quests[current].Prerequisites {
+			neighbours = append(neighbours, p.QuestID)
+		}
+		for id, q := range m.quests {
+			if q.HasPrerequisite(current) {
+				neighbours = append(neighbours, id)
+			}
+		}
+		for _, id := range neighbours {
+			if _, exists := m.quests[id]; exists && !linked[id] {
+				linked[id] = true
+				pending = append(pending, id)
+			}
+		}
+	}
+
+	for id := range linked {
+		result = append(result, m.quests[id])
+	}
+	sortByCreation(result)
+	return result, nil
+}
+
+// sortByCreation orders quests oldest first, like the database repository
+func sortByCreation(quests []quest.Quest) {
+	sort.Slice(quests, func(i, j int) bool {
+		if !quests[i].CreatedAt.Equal(quests[j].CreatedAt) {
+			return quests[i].CreatedAt.Before(quests[j].CreatedAt)
+		}
+		return quests[i].ID().String() < quests[j].ID().String()
+	})
+}
+
 func (m *MockQuestRepository) isWithinBoundingBox(coord kernel.GeoCoordinate, bbox kernel.BoundingBox) bool {
-	return coord.Lat >= bbox.MinLat && coord.Lat <= bbox.MaxLat &&
-		coord.Lon >= bbox.MinLon && coord.Lon <= bbox.MaxLon
+	return bbox.Contains(coord)
 }
 
 // Helper methods for testing
@@ -108,6 +358,15 @@ func (m *MockQuestRepository) Clear() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.quests = make(map[uuid.UUID]quest.Quest)
+	m.saveDelay = 0
+}
+
+// DelaySaves makes every Save wait for delay before storing the quest, so that concurrent
+// read-modify-write cycles overlap unless the quest is locked
+func (m *MockQuestRepository) DelaySaves(delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saveDelay = delay
 }
 
 func (m *MockQuestRepository) Count() int {
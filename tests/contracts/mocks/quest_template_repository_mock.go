@@ -0,0 +1,194 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+type occurrenceKey struct {
+	templateID uuid.UUID
+	at         int64
+}
+
+// MockQuestTemplateRepository is an in-memory implementation of QuestTemplateRepository for contract testing
+type MockQuestTemplateRepository struct {
+	templates   map[uuid.UUID]template.QuestTemplate
+	occurrences map[occurrenceKey]template.Occurrence
+	// saveOccurrenceErr is returned by the next SaveOccurrence call
+	saveOccurrenceErr error
+	mu                sync.RWMutex
+}
+
+func NewMockQuestTemplateRepository() *MockQuestTemplateRepository {
+	return &MockQuestTemplateRepository{
+		templates:   make(map[uuid.UUID]template.QuestTemplate),
+		occurrences: make(map[occurrenceKey]template.Occurrence),
+	}
+}
+
+func (m *MockQuestTemplateRepository) Save(ctx context.Context, t *template.QuestTemplate) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.templates[t.ID()] = *t
+	return nil
+}
+
+func (m *MockQuestTemplateRepository) GetByID(ctx context.Context, templateID uuid.UUID) (*template.QuestTemplate, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	t, exists := m.templates[templateID]
+	if !exists {
+		return nil, fmt.Errorf("quest template %s: %w", templateID, ports.ErrQuestTemplateNotFound)
+	}
+	return &t, nil
+}
+
+func (m *MockQuestTemplateRepository) FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]*template.QuestTemplate, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*template.QuestTemplate, 0)
+	for _, t := range m.templates {
+		if t.OwnerID == ownerID {
+			t := t
+			result = append(result, &t)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (m *MockQuestTemplateRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*template.QuestTemplate, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*template.QuestTemplate, 0)
+	for _, t := range m.templates {
+		if _, due := t.DueOccurrence(now); due {
+			t := t
+			result = append(result, &t)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NextOccurrence.Before(*result[j].NextOccurrence)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *MockQuestTemplateRepository) Delete(ctx context.Context, templateID uuid.UUID) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.templates[templateID]; !exists {
+		return fmt.Errorf("quest template %s: %w", templateID, ports.ErrQuestTemplateNotFound)
+	}
+	delete(m.templates, templateID)
+	for key := range m.occurrences {
+		if key.templateID == templateID {
+			delete(m.occurrences, key)
+		}
+	}
+	return nil
+}
+
+func (m *MockQuestTemplateRepository) AddOccurrence(ctx context.Context, o template.Occurrence) (bool, error) {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := occurrenceKey{templateID: o.TemplateID, at: o.At.UnixNano()}
+	if _, exists := m.occurrences[key]; exists {
+		return false, nil
+	}
+	m.occurrences[key] = o
+	return true, nil
+}
+
+func (m *MockQuestTemplateRepository) SaveOccurrence(ctx context.Context, o template.Occurrence) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.saveOccurrenceErr; err != nil {
+		m.saveOccurrenceErr = nil
+		return err
+	}
+
+	key := occurrenceKey{templateID: o.TemplateID, at: o.At.UnixNano()}
+	if _, exists := m.occurrences[key]; exists {
+		m.occurrences[key] = o
+	}
+	return nil
+}
+
+func (m *MockQuestTemplateRepository) FindRetryableOccurrences(ctx context.Context, now time.Time, limit int) ([]template.Occurrence, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]template.Occurrence, 0)
+	for _, o := range m.occurrences {
+		if o.Retryable(now) {
+			result = append(result, o)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].At.Before(result[j].At)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// Occurrences returns the occurrences of the template, earliest first
+func (m *MockQuestTemplateRepository) Occurrences(templateID uuid.UUID) []template.Occurrence {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]template.Occurrence, 0)
+	for key, o := range m.occurrences {
+		if key.templateID == templateID {
+			result = append(result, o)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].At.Before(result[j].At)
+	})
+	return result
+}
+
+// FailNextSaveOccurrence makes the next SaveOccurrence call return err
+func (m *MockQuestTemplateRepository) FailNextSaveOccurrence(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saveOccurrenceErr = err
+}
+
+// Clear removes all templates and occurrences
+func (m *MockQuestTemplateRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.templates = make(map[uuid.UUID]template.QuestTemplate)
+	m.occurrences = make(map[occurrenceKey]template.Occurrence)
+	m.saveOccurrenceErr = nil
+}
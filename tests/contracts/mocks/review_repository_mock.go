@@ -0,0 +1,76 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/review"
+
+	"github.com/google/uuid"
+)
+
+// MockReviewRepository is an in-memory implementation of ReviewRepository for contract testing
+type MockReviewRepository struct {
+	reviews []review.Review
+	mu      sync.RWMutex
+}
+
+func NewMockReviewRepository() *MockReviewRepository {
+	return &MockReviewRepository{}
+}
+
+func (m *MockReviewRepository) FindByQuest(ctx context.Context, questID uuid.UUID) ([]review.Review, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []review.Review
+	for _, r := range m.reviews {
+		if r.QuestID == questID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockReviewRepository) Save(ctx context.Context, r review.Review) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Same unique constraint as the database: one review per quest, reviewer and reviewee
+	for _, existing := range m.reviews {
+		if existing.QuestID == r.QuestID && existing.ReviewerID == r.ReviewerID && existing.RevieweeID == r.RevieweeID {
+			return fmt.Errorf("review of %s by %s for quest %s already exists", r.RevieweeID, r.ReviewerID, r.QuestID)
+		}
+	}
+	m.reviews = append(m.reviews, r)
+	return nil
+}
+
+func (m *MockReviewRepository) Reputations(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]review.Reputation, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make(map[uuid.UUID]review.Reputation, len(userIDs))
+	for _, userID := range userIDs {
+		sum, count := 0, 0
+		for _, r := range m.reviews {
+			if r.RevieweeID == userID {
+				sum += r.Rating
+				count++
+			}
+		}
+		result[userID] = review.NewReputation(userID, sum, count)
+	}
+	return result, nil
+}
+
+// Clear removes all reviews (for test cleanup)
+func (m *MockReviewRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reviews = nil
+}
@@ -11,19 +11,55 @@ import (
 type MockUnitOfWork struct {
 	questRepo    ports.QuestRepository
 	locationRepo ports.LocationRepository
+	userRepo     ports.UserRepository
+	appRepo      ports.ApplicationRepository
+	evidenceRepo ports.EvidenceRepository
+	ledgerRepo   ports.LedgerRepository
+	boardRepo    ports.LeaderboardRepository
+	achieveRepo  ports.AchievementRepository
+	reviewRepo   ports.ReviewRepository
+	commentRepo  ports.CommentRepository
+	notifyRepo   ports.NotificationRepository
+	templateRepo ports.QuestTemplateRepository
+	afterCommit  []func()
+	unlocks      []func()
 	inTx         bool
 	shouldFail   bool
 }
 
 func NewMockUnitOfWork() *MockUnitOfWork {
+	questRepo := NewMockQuestRepository()
+	reviewRepo := NewMockReviewRepository()
+	questRepo.UseReviews(reviewRepo)
 	return &MockUnitOfWork{
-		questRepo:    NewMockQuestRepository(),
+		questRepo:    questRepo,
 		locationRepo: NewMockLocationRepository(),
+		userRepo:     NewMockUserRepository(),
+		appRepo:      NewMockApplicationRepository(),
+		evidenceRepo: NewMockEvidenceRepository(),
+		ledgerRepo:   NewMockLedgerRepository(),
+		boardRepo:    NewMockLeaderboardRepository(),
+		achieveRepo:  NewMockAchievementRepository(),
+		reviewRepo:   reviewRepo,
+		commentRepo:  NewMockCommentRepository(),
+		notifyRepo:   NewMockNotificationRepository(),
+		templateRepo: NewMockQuestTemplateRepository(),
 		inTx:         false,
 		shouldFail:   false,
 	}
 }
 
+// NewMockUnitOfWorkSharing returns a unit of work over the repositories of other with its own
+// transaction, like a second connection to the same database.
+func NewMockUnitOfWorkSharing(other *MockUnitOfWork) *MockUnitOfWork {
+	uow := *other
+	uow.afterCommit = nil
+	uow.unlocks = nil
+	uow.inTx = false
+	uow.shouldFail = false
+	return &uow
+}
+
 func (m *MockUnitOfWork) Begin(ctx context.Context) error {
 	_ = ctx // unused in mock
 	if m.shouldFail {
@@ -33,6 +69,7 @@ func (m *MockUnitOfWork) Begin(ctx context.Context) error {
 		return fmt.Errorf("transaction already in progress")
 	}
 	m.inTx = true
+	m.afterCommit = nil
 	return nil
 }
 
@@ -45,6 +82,13 @@ func (m *MockUnitOfWork) Commit(ctx context.Context) error {
 		return fmt.Errorf("no transaction to commit")
 	}
 	m.inTx = false
+	m.releaseLocks()
+
+	callbacks := m.afterCommit
+	m.afterCommit = nil
+	for _, fn := range callbacks {
+		fn()
+	}
 	return nil
 }
 
@@ -56,10 +100,36 @@ func (m *MockUnitOfWork) Rollback() error {
 		return fmt.Errorf("no transaction to rollback")
 	}
 	m.inTx = false
+	m.releaseLocks()
+	m.afterCommit = nil
 	return nil
 }
 
+// holdUntilEnd keeps a lock taken inside the transaction until it commits or rolls back
+func (m *MockUnitOfWork) holdUntilEnd(unlock func()) {
+	m.unlocks = append(m.unlocks, unlock)
+}
+
+func (m *MockUnitOfWork) releaseLocks() {
+	unlocks := m.unlocks
+	m.unlocks = nil
+	for _, unlock := range unlocks {
+		unlock()
+	}
+}
+
+func (m *MockUnitOfWork) AfterCommit(fn func()) {
+	if !m.inTx {
+		fn()
+		return
+	}
+	m.afterCommit = append(m.afterCommit, fn)
+}
+
 func (m *MockUnitOfWork) QuestRepository() ports.QuestRepository {
+	if mockQuestRepo, ok := m.questRepo.(*MockQuestRepository); ok {
+		return txQuestRepository{MockQuestRepository: mockQuestRepo, unitOfWork: m}
+	}
 	return m.questRepo
 }
 
@@ -67,7 +137,54 @@ func (m *MockUnitOfWork) LocationRepository() ports.LocationRepository {
 	return m.locationRepo
 }
 
+func (m *MockUnitOfWork) UserRepository() ports.UserRepository {
+	return m.userRepo
+}
+
+func (m *MockUnitOfWork) ApplicationRepository() ports.ApplicationRepository {
+	return m.appRepo
+}
+
+func (m *MockUnitOfWork) EvidenceRepository() ports.EvidenceRepository {
+	return m.evidenceRepo
+}
+
+func (m *MockUnitOfWork) LedgerRepository() ports.LedgerRepository {
+	return m.ledgerRepo
+}
+
+func (m *MockUnitOfWork) LeaderboardRepository() ports.LeaderboardRepository {
+	return m.boardRepo
+}
+
+func (m *MockUnitOfWork) AchievementRepository() ports.AchievementRepository {
+	return m.achieveRepo
+}
+
+func (m *MockUnitOfWork) ReviewRepository() ports.ReviewRepository {
+	return m.reviewRepo
+}
+
+func (m *MockUnitOfWork) CommentRepository() ports.CommentRepository {
+	return m.commentRepo
+}
+
+func (m *MockUnitOfWork) NotificationRepository() ports.NotificationRepository {
+	return m.notifyRepo
+}
+
+func (m *MockUnitOfWork) QuestTemplateRepository() ports.QuestTemplateRepository {
+	return m.templateRepo
+}
+
 // Helper methods for testing
+
+// MockQuestRepository returns the in-memory quest repository shared by the units of work
+func (m *MockUnitOfWork) MockQuestRepository() *MockQuestRepository {
+	mockQuestRepo, _ := m.questRepo.(*MockQuestRepository)
+	return mockQuestRepo
+}
+
 func (m *MockUnitOfWork) SetShouldFail(shouldFail bool) {
 	m.shouldFail = shouldFail
 }
@@ -83,4 +200,34 @@ func (m *MockUnitOfWork) ClearRepositories() {
 	if mockLocationRepo, ok := m.locationRepo.(*MockLocationRepository); ok {
 		mockLocationRepo.Clear()
 	}
+	if mockUserRepo, ok := m.userRepo.(*MockUserRepository); ok {
+		mockUserRepo.Clear()
+	}
+	if mockAppRepo, ok := m.appRepo.(*MockApplicationRepository); ok {
+		mockAppRepo.Clear()
+	}
+	if mockEvidenceRepo, ok := m.evidenceRepo.(*MockEvidenceRepository); ok {
+		mockEvidenceRepo.Clear()
+	}
+	if mockLedgerRepo, ok := m.ledgerRepo.(*MockLedgerRepository); ok {
+		mockLedgerRepo.Clear()
+	}
+	if mockBoardRepo, ok := m.boardRepo.(*MockLeaderboardRepository); ok {
+		mockBoardRepo.Clear()
+	}
+	if mockAchieveRepo, ok := m.achieveRepo.(*MockAchievementRepository); ok {
+		mockAchieveRepo.Clear()
+	}
+	if mockReviewRepo, ok := m.reviewRepo.(*MockReviewRepository); ok {
+		mockReviewRepo.Clear()
+	}
+	if mockCommentRepo, ok := m.commentRepo.(*MockCommentRepository); ok {
+		mockCommentRepo.Clear()
+	}
+	if mockNotifyRepo, ok := m.notifyRepo.(*MockNotificationRepository); ok {
+		mockNotifyRepo.Clear()
+	}
+	if mockTemplateRepo, ok := m.templateRepo.(*MockQuestTemplateRepository); ok {
+		mockTemplateRepo.Clear()
+	}
 }
@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// MockUserRepository is an in-memory implementation of UserRepository for contract testing
+type MockUserRepository struct {
+	users map[uuid.UUID]*user.User
+	mu    sync.RWMutex
+}
+
+func NewMockUserRepository() *MockUserRepository {
+	return &MockUserRepository{
+		users: make(map[uuid.UUID]*user.User),
+	}
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	_ = ctx // unused in mock
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	u, exists := m.users[userID]
+	if !exists {
+		return nil, fmt.Errorf("user %s: %w", userID, ports.ErrUserNotFound)
+	}
+	return u, nil
+}
+
+func (m *MockUserRepository) Save(ctx context.Context, u *user.User) error {
+	_ = ctx // unused in mock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.users[u.ID()] = u
+	return nil
+}
+
+// Clear removes all users (for test cleanup)
+func (m *MockUserRepository) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.users = make(map[uuid.UUID]*user.User)
+}
@@ -0,0 +1,286 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"quest-manager/internal/core/application/eventhandlers"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// NotificationContractSuite defines contract tests for notifications written from quest events
+type NotificationContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	assignee  uuid.UUID
+	ctx       context.Context
+}
+
+func (s *NotificationContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+}
+
+func (s *NotificationContractSuite) SetupTest() {
+	s.container.CleanupAll()
+	s.creator = uuid.New()
+	s.assignee = uuid.New()
+}
+
+func TestNotificationContract(t *testing.T) {
+	suite.Run(t, new(NotificationContractSuite))
+}
+
+func (s *NotificationContractSuite) createQuest() quest.Quest {
+	return createQuest(&s.Suite, s.container, s.creator, withTitle("Fence"))
+}
+
+func (s *NotificationContractSuite) assign(questID uuid.UUID) {
+	assignQuest(&s.Suite, s.container, questID, s.assignee)
+}
+
+func (s *NotificationContractSuite) inbox(userID uuid.UUID) queries.NotificationPage {
+	page, err := s.container.ListNotificationsHandler.Handle(s.ctx, queries.ListNotificationsQuery{UserID: userID})
+	s.Require().NoError(err)
+	return page
+}
+
+func (s *NotificationContractSuite) kinds(page queries.NotificationPage) []notification.Kind {
+	kinds := make([]notification.Kind, 0, len(page.Notifications))
+	for _, n := range page.Notifications {
+		kinds = append(kinds, n.Kind)
+	}
+	return kinds
+}
+
+func (s *NotificationContractSuite) TestAssignNotifiesCreator() {
+	created := s.createQuest()
+
+	s.assign(created.ID())
+
+	page := s.inbox(s.creator)
+	s.Equal(1, page.Total)
+	s.Equal(1, page.Unread)
+	s.Require().Len(page.Notifications, 1)
+	n := page.Notifications[0]
+	s.Equal(notification.KindQuestAssigned, n.Kind)
+	s.Equal(created.ID(), n.QuestID)
+	s.Equal(`Your quest "Fence" was taken`, n.Message)
+
+	// The assignee took the quest and is not told about it
+	s.Equal(0, s.inbox(s.assignee).Total)
+
+	s.Require().Len(s.container.NotificationChannel.Delivered, 1)
+	s.Equal(n.ID, s.container.NotificationChannel.Delivered[0].ID)
+}
+
+func (s *NotificationContractSuite) TestStatusChangeNotifiesCreatorAndParticipants() {
+	created := s.createQuest()
+	s.assign(created.ID())
+
+	_, err := s.container.ChangeQuestStatusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: s.assignee, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+
+	page := s.inbox(s.creator)
+	s.Equal([]notification.Kind{notification.KindQuestStatusChanged, notification.KindQuestAssigned}, s.kinds(page))
+	s.Equal(`Quest "Fence" is now in_progress`, page.Notifications[0].Message)
+	// The assignee started the quest and is not told about it
+	s.Equal(0, s.inbox(s.assignee).Total)
+
+	_, err = s.container.ChangeQuestStatusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: s.creator, Status: quest.StatusDeclined,
+	})
+	s.Require().NoError(err)
+
+	s.Equal([]notification.Kind{notification.KindQuestStatusChanged}, s.kinds(s.inbox(s.assignee)))
+	s.Equal(2, s.inbox(s.creator).Total, "The creator declined the quest and is not told about it")
+}
+
+func (s *NotificationContractSuite) TestReopenNotifiesReleasedParticipants() {
+	created := s.createQuest()
+	s.assign(created.ID())
+
+	// Act - the creator reposts the quest, which releases the assignee
+	result, err := s.container.ChangeQuestStatusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: s.creator, Status: quest.StatusPosted,
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Empty(result.Participants)
+	page := s.inbox(s.assignee)
+	s.Require().Len(page.Notifications, 1)
+	s.Equal(notification.KindQuestStatusChanged, page.Notifications[0].Kind)
+	s.Equal(`Quest "Fence" is now posted`, page.Notifications[0].Message)
+	s.Equal([]notification.Kind{notification.KindQuestAssigned}, s.kinds(s.inbox(s.creator)))
+}
+
+func (s *NotificationContractSuite) TestCommentsNotifyMembersButNotTheAuthor() {
+	created := s.createQuest()
+	stranger := uuid.New()
+
+	// A public question reaches the creator only
+	_, err := s.container.AddQuestCommentHandler.Handle(s.ctx, commands.AddQuestCommentCommand{
+		QuestID: created.ID(), UserID: stranger, Body: "Is a ladder needed?",
+	})
+	s.Require().NoError(err)
+	s.Equal([]notification.Kind{notification.KindQuestCommentAdded}, s.kinds(s.inbox(s.creator)))
+	s.Equal(0, s.inbox(stranger).Total)
+
+	// A private message of the creator reaches the assignee
+	s.assign(created.ID())
+	_, err = s.container.AddQuestCommentHandler.Handle(s.ctx, commands.AddQuestCommentCommand{
+		QuestID: created.ID(), UserID: s.creator, Body: "Gate code is 1234",
+	})
+	s.Require().NoError(err)
+	s.Equal([]notification.Kind{notification.KindQuestCommentAdded}, s.kinds(s.inbox(s.assignee)))
+	// The creator is not notified about their own comment
+	s.Equal([]notification.Kind{notification.KindQuestAssigned, notification.KindQuestCommentAdded}, s.kinds(s.inbox(s.creator)))
+}
+
+func (s *NotificationContractSuite) TestPreferencesTurnKindsOff() {
+	preferences, err := s.container.UpdateNotificationPreferencesHandler.Handle(s.ctx, commands.UpdateNotificationPreferencesCommand{
+		UserID:  s.creator,
+		Enabled: map[string]bool{string(notification.KindQuestAssigned): false},
+	})
+	s.Require().NoError(err)
+	s.False(preferences.Receives(notification.KindQuestAssigned))
+	s.True(preferences.Receives(notification.KindQuestCommentAdded))
+
+	created := s.createQuest()
+	s.assign(created.ID())
+
+	s.Equal(0, s.inbox(s.creator).Total)
+	s.Empty(s.container.NotificationChannel.Delivered)
+
+	// Kinds missing from an update keep their setting
+	_, err = s.container.UpdateNotificationPreferencesHandler.Handle(s.ctx, commands.UpdateNotificationPreferencesCommand{
+		UserID:  s.creator,
+		Enabled: map[string]bool{string(notification.KindQuestCommentAdded): false},
+	})
+	s.Require().NoError(err)
+	preferences, err = s.container.GetNotificationPreferencesHandler.Handle(s.ctx, s.creator)
+	s.Require().NoError(err)
+	s.False(preferences.Receives(notification.KindQuestAssigned))
+	s.False(preferences.Receives(notification.KindQuestCommentAdded))
+	s.True(preferences.Receives(notification.KindQuestStatusChanged))
+}
+
+func (s *NotificationContractSuite) TestUnknownPreferenceIsValidationError() {
+	_, err := s.container.UpdateNotificationPreferencesHandler.Handle(s.ctx, commands.UpdateNotificationPreferencesCommand{
+		UserID:  s.creator,
+		Enabled: map[string]bool{"quest_deleted": false},
+	})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
+
+func (s *NotificationContractSuite) TestDeliveryErrorKeepsNotification() {
+	s.container.NotificationChannel.Err = fmt.Errorf("smtp unavailable")
+	created := s.createQuest()
+
+	s.assign(created.ID())
+
+	s.Equal(1, s.inbox(s.creator).Total)
+}
+
+func (s *NotificationContractSuite) TestDeliveryWaitsForCommit() {
+	created := s.createQuest()
+	channel := mocks.NewMockNotificationChannel()
+	handler := eventhandlers.NewNotificationHandler(s.container.UnitOfWork, channel)
+	assigned := quest.NewQuestAssigned(created.ID(), s.assignee)
+
+	// Rolled back: the notification is never delivered
+	s.Require().NoError(s.container.UnitOfWork.Begin(s.ctx))
+	s.Require().NoError(handler.Handle(s.ctx, assigned))
+	s.Empty(channel.Delivered, "delivery must wait for the commit")
+	s.Require().NoError(s.container.UnitOfWork.Rollback())
+	s.Empty(channel.Delivered)
+
+	// Committed: delivered after the commit
+	s.Require().NoError(s.container.UnitOfWork.Begin(s.ctx))
+	s.Require().NoError(handler.Handle(s.ctx, assigned))
+	s.Empty(channel.Delivered, "delivery must wait for the commit")
+	s.Require().NoError(s.container.UnitOfWork.Commit(s.ctx))
+	s.Require().Len(channel.Delivered, 1)
+	s.Equal(s.creator, channel.Delivered[0].UserID)
+}
+
+func (s *NotificationContractSuite) TestMarkRead() {
+	created := s.createQuest()
+	s.assign(created.ID())
+	n := s.inbox(s.creator).Notifications[0]
+
+	// Notifications of other users are not found
+	_, err := s.container.MarkNotificationReadHandler.Handle(s.ctx, commands.MarkNotificationReadCommand{
+		NotificationID: n.ID, UserID: s.assignee,
+	})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	read, err := s.container.MarkNotificationReadHandler.Handle(s.ctx, commands.MarkNotificationReadCommand{
+		NotificationID: n.ID, UserID: s.creator,
+	})
+	s.Require().NoError(err)
+	s.True(read.IsRead())
+
+	page := s.inbox(s.creator)
+	s.Equal(1, page.Total)
+	s.Equal(0, page.Unread)
+
+	_, err = s.container.MarkNotificationReadHandler.Handle(s.ctx, commands.MarkNotificationReadCommand{
+		NotificationID: uuid.New(), UserID: s.creator,
+	})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *NotificationContractSuite) TestMarkAllReadAndUnreadOnly() {
+	for i := 0; i < 3; i++ {
+		_, err := s.container.AddQuestCommentHandler.Handle(s.ctx, commands.AddQuestCommentCommand{
+			QuestID: s.createQuest().ID(), UserID: uuid.New(), Body: "Is a ladder needed?",
+		})
+		s.Require().NoError(err)
+	}
+
+	page, err := s.container.ListNotificationsHandler.Handle(s.ctx, queries.ListNotificationsQuery{
+		UserID: s.creator, UnreadOnly: true, Limit: 2,
+	})
+	s.Require().NoError(err)
+	s.Equal(3, page.Total)
+	s.Equal(3, page.Unread)
+	s.Len(page.Notifications, 2)
+
+	marked, err := s.container.MarkAllNotificationsReadHandler.Handle(s.ctx, commands.MarkAllNotificationsReadCommand{UserID: s.creator})
+	s.Require().NoError(err)
+	s.Equal(3, marked)
+
+	page, err = s.container.ListNotificationsHandler.Handle(s.ctx, queries.ListNotificationsQuery{UserID: s.creator, UnreadOnly: true})
+	s.Require().NoError(err)
+	s.Equal(0, page.Total)
+	s.Equal(0, page.Unread)
+	s.Equal(3, s.inbox(s.creator).Total)
+}
+
+func (s *NotificationContractSuite) TestListValidatesPage() {
+	_, err := s.container.ListNotificationsHandler.Handle(s.ctx, queries.ListNotificationsQuery{
+		UserID: s.creator, Limit: queries.MaxNotificationPageSize + 1,
+	})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+
+	_, err = s.container.ListNotificationsHandler.Handle(s.ctx, queries.ListNotificationsQuery{UserID: s.creator, Offset: -1})
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
@@ -7,7 +7,9 @@ import (
 
 	"quest-manager/internal/core/application/usecases/queries"
 	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/location"
 	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/user"
 	"quest-manager/internal/pkg/errs"
 	"quest-manager/tests/contracts/mocks"
 
@@ -58,7 +60,7 @@ func (s *ListQuestsQueryHandlerContractSuite) TestHandleValidQuery() {
 	s.Require().NoError(err)
 
 	// Contract: Handler should return a list of quests without error
-	result, err := s.handler.Handle(s.ctx, nil) // nil status means all quests
+	result, err := s.handler.Handle(s.ctx, queries.ListQuestsQuery{}) // no filters means all quests
 	s.Require().NoError(err, "Handle should succeed with valid query")
 
 	// Contract: Result should contain the created quest
@@ -98,7 +100,7 @@ func (s *ListQuestsQueryHandlerContractSuite) TestHandleWithStatusFilter() {
 
 	// Contract: Handler should return only quests with the specified status
 	createdStatus := quest.StatusCreated
-	result, err := s.handler.Handle(s.ctx, &createdStatus)
+	result, err := s.handler.Handle(s.ctx, queries.ListQuestsQuery{Status: &createdStatus})
 	s.Require().NoError(err, "Handle should succeed with status filter")
 
 	// Contract: All returned quests should have the specified status
@@ -117,6 +119,38 @@ func (s *ListQuestsQueryHandlerContractSuite) TestHandleWithStatusFilter() {
 	s.Assert().True(found, "Should include the created quest with matching status")
 }
 
+func (s *ListQuestsQueryHandlerContractSuite) TestHandleWithMaxRouteFilter() {
+	berlin := kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954}
+	potsdam := kernel.GeoCoordinate{Lat: 52.390569, Lon: 13.064473}
+
+	// Short route: target and execution ~1km apart
+	short, err := quest.NewQuest("Short Route", "Quest with a short route", "easy", 3, 45,
+		berlin, kernel.GeoCoordinate{Lat: 52.529, Lon: 13.404954}, "test-creator", []string{}, []string{})
+	s.Require().NoError(err)
+
+	// Long route: ~27km to Potsdam, extended by a waypoint detour
+	long, err := quest.NewQuest("Long Route", "Quest with a long route", "easy", 3, 45,
+		berlin, potsdam, "test-creator", []string{}, []string{})
+	s.Require().NoError(err)
+	s.Require().NoError(long.SetWaypoints([]kernel.GeoCoordinate{{Lat: 52.6, Lon: 13.2}}))
+
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, short))
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, long))
+
+	// Contract: only quests with a route of at most MaxRouteKm are returned
+	maxRouteKm := 10.0
+	result, err := s.handler.Handle(s.ctx, queries.ListQuestsQuery{MaxRouteKm: &maxRouteKm})
+	s.Require().NoError(err)
+
+	s.Require().Len(result, 1)
+	s.Assert().Equal(short.ID(), result[0].ID())
+
+	// Contract: non-positive limit is a validation error
+	zero := 0.0
+	_, err = s.handler.Handle(s.ctx, queries.ListQuestsQuery{MaxRouteKm: &zero})
+	s.Assert().Error(err)
+}
+
 // GetQuestByIDQueryHandlerContractSuite defines contract tests for GetQuestByIDQueryHandler
 type GetQuestByIDQueryHandlerContractSuite struct {
 	suite.Suite
@@ -224,13 +258,13 @@ func (s *SearchQuestsByRadiusQueryHandlerContractSuite) TestHandleValidQuery() {
 	center := kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0} // Same as target location
 	radiusKm := 1.0                                      // 1km radius
 
-	result, err := s.handler.Handle(s.ctx, center, radiusKm)
+	result, err := s.handler.Handle(s.ctx, queries.SearchQuestsByRadiusQuery{Center: center, RadiusKm: radiusKm})
 	s.Require().NoError(err, "Handle should succeed with valid query")
 
 	// Contract: Result should include the created quest within radius
 	found := false
 	for _, returnedQuest := range result {
-		if returnedQuest.ID() == q.ID() {
+		if returnedQuest.Quest.ID() == q.ID() {
 			found = true
 			break
 		}
@@ -238,6 +272,312 @@ func (s *SearchQuestsByRadiusQueryHandlerContractSuite) TestHandleValidQuery() {
 	s.Assert().True(found, "Should include the quest within radius")
 }
 
+func (s *SearchQuestsByRadiusQueryHandlerContractSuite) TestHandleOrdersByDistance() {
+	center := kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0}
+	offsets := []float64{0.05, 0.01, 0.2, 5.0} // last one is ~550km away, outside radius
+
+	ids := make([]uuid.UUID, len(offsets))
+	for i, offset := range offsets {
+		coord := kernel.GeoCoordinate{Lat: center.Lat + offset, Lon: center.Lon}
+		q, err := quest.NewQuest(
+			"Distance Order Quest",
+			"Quest for distance ordering",
+			"easy",
+			1,
+			30,
+			coord,
+			coord,
+			"test-creator",
+			[]string{},
+			[]string{},
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+		ids[i] = q.ID()
+	}
+
+	result, err := s.handler.Handle(s.ctx, queries.SearchQuestsByRadiusQuery{Center: center, RadiusKm: 50.0})
+	s.Require().NoError(err)
+
+	// Contract: quests outside the radius are excluded, the rest are ordered closest first
+	s.Require().Len(result, 3)
+	s.Assert().Equal(ids[1], result[0].Quest.ID())
+	s.Assert().Equal(ids[0], result[1].Quest.ID())
+	s.Assert().Equal(ids[2], result[2].Quest.ID())
+
+	// Contract: distances to both locations are reported in kilometers
+	s.Assert().InDelta(center.DistanceTo(result[0].Quest.TargetLocation), result[0].TargetDistanceKm, 1e-9)
+	s.Assert().InDelta(center.DistanceTo(result[0].Quest.ExecutionLocation), result[0].ExecutionDistanceKm, 1e-9)
+}
+
+func (s *SearchQuestsByRadiusQueryHandlerContractSuite) TestHandleSortByRewardWithLimit() {
+	center := kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0}
+	rewards := []int{2, 5, 3}
+
+	ids := make([]uuid.UUID, len(rewards))
+	for i, reward := range rewards {
+		coord := kernel.GeoCoordinate{Lat: center.Lat + float64(i+1)*0.01, Lon: center.Lon}
+		q, err := quest.NewQuest(
+			"Reward Order Quest",
+			"Quest for reward ordering",
+			"easy",
+			reward,
+			30,
+			coord,
+			coord,
+			"test-creator",
+			[]string{},
+			[]string{},
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+		ids[i] = q.ID()
+	}
+
+	result, err := s.handler.Handle(s.ctx, queries.SearchQuestsByRadiusQuery{
+		Center:   center,
+		RadiusKm: 50.0,
+		SortBy:   queries.RadiusSortReward,
+		Limit:    2,
+	})
+	s.Require().NoError(err)
+
+	// Contract: highest reward first, truncated to limit
+	s.Require().Len(result, 2)
+	s.Assert().Equal(ids[1], result[0].Quest.ID())
+	s.Assert().Equal(ids[2], result[1].Quest.ID())
+}
+
+func (s *SearchQuestsByRadiusQueryHandlerContractSuite) TestHandleInvalidSortAndLimit() {
+	center := kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0}
+
+	_, err := s.handler.Handle(s.ctx, queries.SearchQuestsByRadiusQuery{Center: center, RadiusKm: 1, SortBy: "title"})
+	s.Assert().Error(err, "Unknown sort should be rejected")
+
+	_, err = s.handler.Handle(s.ctx, queries.SearchQuestsByRadiusQuery{Center: center, RadiusKm: 1, Limit: queries.MaxRadiusSearchLimit + 1})
+	s.Assert().Error(err, "Limit above maximum should be rejected")
+}
+
+// SearchQuestsByAreaQueryHandlerContractSuite defines contract tests for SearchQuestsByAreaQueryHandler
+type SearchQuestsByAreaQueryHandlerContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	ctx       context.Context
+	handler   queries.SearchQuestsByAreaQueryHandler
+}
+
+func (s *SearchQuestsByAreaQueryHandlerContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+	s.handler = s.container.SearchQuestsByAreaHandler
+}
+
+func (s *SearchQuestsByAreaQueryHandlerContractSuite) SetupTest() {
+	// Clear all mock repositories before each test
+	s.container.CleanupAll()
+}
+
+func (s *SearchQuestsByAreaQueryHandlerContractSuite) saveQuest(target, execution kernel.GeoCoordinate) quest.Quest {
+	q, err := quest.NewQuest(
+		"Area Search Quest",
+		"Quest for area search testing",
+		"easy",
+		1,
+		30,
+		target,
+		execution,
+		"test-creator",
+		[]string{},
+		[]string{},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+	return q
+}
+
+func questIDs(quests []quest.Quest) []uuid.UUID {
+	ids := make([]uuid.UUID, len(quests))
+	for i, q := range quests {
+		ids[i] = q.ID()
+	}
+	return ids
+}
+
+func (s *SearchQuestsByAreaQueryHandlerContractSuite) TestHandlePolygonMatchModes() {
+	inside := kernel.GeoCoordinate{Lat: 55.75, Lon: 37.6}
+	outside := kernel.GeoCoordinate{Lat: 59.9, Lon: 30.3}
+
+	targetInside := s.saveQuest(inside, outside)
+	executionInside := s.saveQuest(outside, inside)
+	s.saveQuest(outside, outside)
+
+	polygon, err := kernel.NewPolygon([]kernel.GeoCoordinate{
+		{Lat: 55.7, Lon: 37.5}, {Lat: 55.7, Lon: 37.7}, {Lat: 55.8, Lon: 37.7}, {Lat: 55.8, Lon: 37.5},
+	})
+	s.Require().NoError(err)
+
+	// Contract: target mode matches only the target location
+	result, err := s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{Polygon: &polygon, Match: quest.LocationMatchTarget})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]uuid.UUID{targetInside.ID()}, questIDs(result))
+
+	// Contract: execution mode matches only the execution location
+	result, err = s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{Polygon: &polygon, Match: quest.LocationMatchExecution})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]uuid.UUID{executionInside.ID()}, questIDs(result))
+
+	// Contract: default mode matches either location
+	result, err = s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{Polygon: &polygon})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]uuid.UUID{targetInside.ID(), executionInside.ID()}, questIDs(result))
+}
+
+func (s *SearchQuestsByAreaQueryHandlerContractSuite) TestHandleBoundingBoxAcrossAntimeridian() {
+	fiji := kernel.GeoCoordinate{Lat: -17.7, Lon: 178.5}
+	samoa := kernel.GeoCoordinate{Lat: -13.8, Lon: -172.1}
+	greenwich := kernel.GeoCoordinate{Lat: 51.48, Lon: 0.0}
+
+	fijiQuest := s.saveQuest(fiji, fiji)
+	samoaQuest := s.saveQuest(samoa, greenwich)
+	s.saveQuest(greenwich, greenwich)
+
+	bbox := kernel.BoundingBox{MinLat: -20, MaxLat: -10, MinLon: 175, MaxLon: -170}
+
+	result, err := s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{BoundingBox: &bbox})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]uuid.UUID{fijiQuest.ID(), samoaQuest.ID()}, questIDs(result))
+
+	// Contract: the match mode is applied to bounding box searches as well
+	result, err = s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{BoundingBox: &bbox, Match: quest.LocationMatchExecution})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]uuid.UUID{fijiQuest.ID()}, questIDs(result))
+}
+
+func (s *SearchQuestsByAreaQueryHandlerContractSuite) TestHandleValidation() {
+	polygon, err := kernel.NewPolygon([]kernel.GeoCoordinate{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 1}, {Lat: 1, Lon: 1}})
+	s.Require().NoError(err)
+	bbox := kernel.BoundingBox{MinLat: 0, MaxLat: 1, MinLon: 0, MaxLon: 1}
+
+	var validationErr *errs.DomainValidationError
+
+	_, err = s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{})
+	s.Assert().True(errors.As(err, &validationErr), "Missing area should be rejected")
+
+	_, err = s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{Polygon: &polygon, BoundingBox: &bbox})
+	s.Assert().True(errors.As(err, &validationErr), "Polygon and bbox together should be rejected")
+
+	_, err = s.handler.Handle(s.ctx, queries.SearchQuestsByAreaQuery{BoundingBox: &bbox, Match: "nearest"})
+	s.Assert().True(errors.As(err, &validationErr), "Unknown match mode should be rejected")
+}
+
+// GetQuestTileQueryHandlerContractSuite defines contract tests for GetQuestTileQueryHandler
+type GetQuestTileQueryHandlerContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	ctx       context.Context
+	handler   queries.GetQuestTileQueryHandler
+}
+
+func (s *GetQuestTileQueryHandlerContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+	s.handler = s.container.GetQuestTileHandler
+}
+
+func (s *GetQuestTileQueryHandlerContractSuite) SetupTest() {
+	// Clear all mock repositories before each test
+	s.container.CleanupAll()
+}
+
+func (s *GetQuestTileQueryHandlerContractSuite) saveQuest(target, execution kernel.GeoCoordinate, status quest.Status) {
+	q, err := quest.NewQuest(
+		"Tile Quest",
+		"Quest for tile clustering",
+		"easy",
+		1,
+		30,
+		target,
+		execution,
+		"test-creator",
+		[]string{},
+		[]string{},
+	)
+	s.Require().NoError(err)
+	if status == quest.StatusPosted {
+		s.Require().NoError(q.ChangeStatus(quest.StatusPosted))
+	}
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+}
+
+func (s *GetQuestTileQueryHandlerContractSuite) TestHandleClustersByCell() {
+	berlinA := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.405}
+	berlinB := kernel.GeoCoordinate{Lat: 52.521, Lon: 13.406}
+	spandau := kernel.GeoCoordinate{Lat: 52.535, Lon: 13.2}
+	paris := kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522}
+
+	s.saveQuest(berlinA, berlinA, quest.StatusCreated)
+	s.saveQuest(berlinB, berlinB, quest.StatusPosted)
+	s.saveQuest(spandau, spandau, quest.StatusPosted)
+	s.saveQuest(paris, berlinA, quest.StatusCreated) // only execution location in the tile
+
+	tile := kernel.TileForCoordinate(berlinA, 8)
+	result, err := s.handler.Handle(s.ctx, queries.GetQuestTileQuery{Tile: tile})
+	s.Require().NoError(err)
+
+	// Contract: default resolution, one cluster per non-empty cell, clustered by target location
+	s.Assert().Equal(queries.DefaultTileResolution, result.Resolution)
+	s.Require().Len(result.Clusters, 2)
+
+	var berlin, other quest.TileCluster
+	for _, c := range result.Clusters {
+		s.Assert().Equal(tile.Z+queries.DefaultTileResolution, c.Cell.Z)
+		s.Assert().True(tile.Contains(c.Cell))
+		if c.Cell == kernel.TileForCoordinate(berlinA, c.Cell.Z) {
+			berlin = c
+		} else {
+			other = c
+		}
+	}
+
+	s.Assert().Equal(2, berlin.Count)
+	s.Assert().Equal(1, berlin.StatusCounts[quest.StatusCreated])
+	s.Assert().Equal(1, berlin.StatusCounts[quest.StatusPosted])
+	s.Assert().InDelta(52.5205, berlin.Center.Lat, 1e-9)
+	s.Assert().InDelta(13.4055, berlin.Center.Lon, 1e-9)
+	s.Assert().Equal(1, other.Count)
+}
+
+func (s *GetQuestTileQueryHandlerContractSuite) TestHandleCountsQuestOnceAcrossTiles() {
+	// Exactly on the boundary between tiles 1/0/0 and 1/1/0
+	s.saveQuest(kernel.GeoCoordinate{Lat: 10, Lon: 0}, kernel.GeoCoordinate{Lat: 10, Lon: 0}, quest.StatusCreated)
+
+	total := 0
+	for x := 0; x < 2; x++ {
+		for y := 0; y < 2; y++ {
+			tile, err := kernel.NewTile(1, x, y)
+			s.Require().NoError(err)
+			result, err := s.handler.Handle(s.ctx, queries.GetQuestTileQuery{Tile: tile, Resolution: 1})
+			s.Require().NoError(err)
+			for _, c := range result.Clusters {
+				total += c.Count
+			}
+		}
+	}
+
+	// Contract: every quest belongs to exactly one tile
+	s.Assert().Equal(1, total)
+}
+
+func (s *GetQuestTileQueryHandlerContractSuite) TestHandleInvalidResolution() {
+	var validationErr *errs.DomainValidationError
+
+	_, err := s.handler.Handle(s.ctx, queries.GetQuestTileQuery{Resolution: queries.MaxTileResolution + 1})
+	s.Assert().True(errors.As(err, &validationErr))
+
+	_, err = s.handler.Handle(s.ctx, queries.GetQuestTileQuery{Resolution: -1})
+	s.Assert().True(errors.As(err, &validationErr))
+}
+
 // ListAssignedQuestsQueryHandlerContractSuite defines contract tests for ListAssignedQuestsQueryHandler
 type ListAssignedQuestsQueryHandlerContractSuite struct {
 	suite.Suite
@@ -305,5 +645,302 @@ func TestQueryHandlerContracts(t *testing.T) {
 	suite.Run(t, new(ListQuestsQueryHandlerContractSuite))
 	suite.Run(t, new(GetQuestByIDQueryHandlerContractSuite))
 	suite.Run(t, new(SearchQuestsByRadiusQueryHandlerContractSuite))
+	suite.Run(t, new(SearchQuestsByAreaQueryHandlerContractSuite))
+	suite.Run(t, new(GetQuestTileQueryHandlerContractSuite))
 	suite.Run(t, new(ListAssignedQuestsQueryHandlerContractSuite))
 }
+
+// AutocompleteLocationsQueryHandlerContractSuite defines contract tests for AutocompleteLocationsQueryHandler
+type AutocompleteLocationsQueryHandlerContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	ctx       context.Context
+	handler   queries.AutocompleteLocationsQueryHandler
+}
+
+func (s *AutocompleteLocationsQueryHandlerContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+	s.handler = s.container.AutocompleteLocationsHandler
+}
+
+func (s *AutocompleteLocationsQueryHandlerContractSuite) SetupTest() {
+	// Clear all mock repositories before each test
+	s.container.CleanupAll()
+}
+
+func (s *AutocompleteLocationsQueryHandlerContractSuite) saveLocation(name, address string) *location.Location {
+	loc, err := location.NewNamedLocation(kernel.GeoCoordinate{Lat: 55.75, Lon: 37.61}, &name, &address)
+	s.Require().NoError(err)
+	s.Require().NoError(s.container.LocationRepository.Save(s.ctx, loc))
+	return loc
+}
+
+func (s *AutocompleteLocationsQueryHandlerContractSuite) TestHandleRanksMatches() {
+	exact := s.saveLocation("Park", "Somewhere 1")
+	prefix := s.saveLocation("Parkside Cafe", "Somewhere 2")
+	byAddress := s.saveLocation("Museum", "Near the park entrance")
+	s.saveLocation("Library", "Main street 5")
+
+	// Contract: Handler returns matches by name or address, best match first
+	result, err := s.handler.Handle(s.ctx, "park", 0)
+	s.Require().NoError(err)
+	s.Require().Len(result, 3)
+	s.Assert().Equal(exact.ID(), result[0].Location.ID())
+	s.Assert().Equal(prefix.ID(), result[1].Location.ID())
+	s.Assert().Equal(byAddress.ID(), result[2].Location.ID())
+	s.Assert().GreaterOrEqual(result[0].Score, result[1].Score)
+}
+
+func (s *AutocompleteLocationsQueryHandlerContractSuite) TestHandleRespectsLimit() {
+	for i := 0; i < 5; i++ {
+		s.saveLocation("Station "+string(rune('A'+i)), "Railway")
+	}
+
+	result, err := s.handler.Handle(s.ctx, "station", 2)
+	s.Require().NoError(err)
+	s.Assert().Len(result, 2)
+}
+
+func (s *AutocompleteLocationsQueryHandlerContractSuite) TestHandleValidation() {
+	// Contract: Too short query is a validation error
+	_, err := s.handler.Handle(s.ctx, " a ", 10)
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Assert().Equal("q", validationErr.Field)
+
+	// Contract: Limit above maximum is a validation error
+	_, err = s.handler.Handle(s.ctx, "park", queries.MaxAutocompleteLimit+1)
+	s.Require().True(errors.As(err, &validationErr))
+	s.Assert().Equal("limit", validationErr.Field)
+}
+
+func TestAutocompleteLocationsQueryHandlerContract(t *testing.T) {
+	suite.Run(t, new(AutocompleteLocationsQueryHandlerContractSuite))
+}
+
+// RecommendQuestsQueryHandlerContractSuite defines contract tests for RecommendQuestsQueryHandler
+type RecommendQuestsQueryHandlerContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	ctx       context.Context
+	handler   queries.RecommendQuestsQueryHandler
+	userID    uuid.UUID
+	position  kernel.GeoCoordinate
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+	s.handler = s.container.RecommendQuestsHandler
+	s.userID = uuid.New()
+	s.position = kernel.GeoCoordinate{Lat: 52.520, Lon: 13.405}
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) SetupTest() {
+	// Clear all mock repositories before each test
+	s.container.CleanupAll()
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) saveQuest(
+	title string, difficulty string, reward int, location kernel.GeoCoordinate, skills []string, status quest.Status,
+) quest.Quest {
+	q, err := quest.NewQuest(title, "Quest for recommendations", difficulty, reward, 30,
+		location, location, "test-creator", []string{}, skills)
+	s.Require().NoError(err)
+	switch status {
+	case quest.StatusPosted:
+		s.Require().NoError(q.ChangeStatus(quest.StatusPosted))
+	case quest.StatusCompleted:
+		s.Require().NoError(q.AssignTo(s.userID))
+		s.Require().NoError(q.ChangeStatus(quest.StatusInProgress))
+		s.Require().NoError(q.ChangeStatus(quest.StatusCompleted))
+	}
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+	return q
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleOnlyPostedQuestsInRadius() {
+	posted := s.saveQuest("Posted", "easy", 1, s.position, nil, quest.StatusPosted)
+	s.saveQuest("Draft", "easy", 1, s.position, nil, quest.StatusCreated)
+	s.saveQuest("Far away", "easy", 1, kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522}, nil, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, Position: &s.position})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal(posted.ID(), results[0].Quest.ID())
+	s.InDelta(1.0, results[0].Scores.Distance, 1e-9)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleRanksBySkillOverlap() {
+	near := kernel.GeoCoordinate{Lat: 52.521, Lon: 13.405}
+	matching := s.saveQuest("Photo walk", "easy", 3, near, []string{"Photography", "navigation"}, quest.StatusPosted)
+	missing := s.saveQuest("Diving", "easy", 3, near, []string{"diving"}, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{
+		UserID:   s.userID,
+		Position: &s.position,
+		Skills:   []string{"photography"},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Equal(matching.ID(), results[0].Quest.ID())
+	s.Equal([]string{"Photography"}, results[0].MatchedRequirements)
+	s.Equal([]string{"navigation"}, results[0].MissingRequirements)
+	s.InDelta(0.5, results[0].Scores.Skills, 1e-9)
+	s.Equal(missing.ID(), results[1].Quest.ID())
+	s.InDelta(0.0, results[1].Scores.Skills, 1e-9)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleMatchesCompletedDifficulty() {
+	s.saveQuest("Done 1", "hard", 1, kernel.GeoCoordinate{Lat: 40, Lon: 40}, nil, quest.StatusCompleted)
+	s.saveQuest("Done 2", "hard", 1, kernel.GeoCoordinate{Lat: 40, Lon: 40}, nil, quest.StatusCompleted)
+	hard := s.saveQuest("Hard", "hard", 1, s.position, nil, quest.StatusPosted)
+	easy := s.saveQuest("Easy", "easy", 1, s.position, nil, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, Position: &s.position})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Equal(hard.ID(), results[0].Quest.ID())
+	s.Equal(2, results[0].CompletedAtDifficulty)
+	s.InDelta(1.0, results[0].Scores.Difficulty, 1e-9)
+	s.Equal(easy.ID(), results[1].Quest.ID())
+	s.InDelta(0.0, results[1].Scores.Difficulty, 1e-9)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleScoreIsWeightedSum() {
+	s.saveQuest("Rewarding", "medium", 5, kernel.GeoCoordinate{Lat: 52.565, Lon: 13.405}, nil, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, Position: &s.position})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	r := results[0]
+	s.InDelta(1.0, r.Scores.Reward, 1e-9)
+	s.InDelta(0.5, r.Scores.Difficulty, 1e-9)
+	s.InDelta(1-r.DistanceKm/queries.DefaultRecommendRadiusKm, r.Scores.Distance, 1e-9)
+	expected := queries.RecommendWeightDistance*r.Scores.Distance +
+		queries.RecommendWeightSkills*r.Scores.Skills +
+		queries.RecommendWeightDifficulty*r.Scores.Difficulty +
+		queries.RecommendWeightReward*r.Scores.Reward +
+		queries.RecommendWeightHistory*r.Scores.History
+	s.InDelta(expected, r.Score, 1e-9)
+	s.InDelta(0.0, r.Scores.History, 1e-9)
+	s.Nil(r.NearestPastQuestKm)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleRanksNearPastQuestLocations() {
+	// Both candidates are as far from the position, one of them next to a quest the user did before
+	familiar := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.450}
+	unknown := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.360}
+	s.saveQuest("Done before", "easy", 1, kernel.GeoCoordinate{Lat: 52.521, Lon: 13.450}, nil, quest.StatusCompleted)
+	near := s.saveQuest("Near history", "easy", 1, familiar, nil, quest.StatusPosted)
+	other := s.saveQuest("Elsewhere", "easy", 1, unknown, nil, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, Position: &s.position})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Equal(near.ID(), results[0].Quest.ID())
+	s.Require().NotNil(results[0].NearestPastQuestKm)
+	s.InDelta(0.11, *results[0].NearestPastQuestKm, 0.01)
+	s.InDelta(1-*results[0].NearestPastQuestKm/queries.DefaultRecommendRadiusKm, results[0].Scores.History, 1e-9)
+	s.Equal(other.ID(), results[1].Quest.ID())
+	s.Less(results[1].Scores.History, results[0].Scores.History)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) saveProfile(profile user.Profile) {
+	u, err := user.NewUser(s.userID, profile)
+	s.Require().NoError(err)
+	s.Require().NoError(s.container.UserRepository.Save(s.ctx, u))
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleDefaultsToProfile() {
+	home := kernel.GeoCoordinate{Lat: 48.137, Lon: 11.575}
+	radius := 30.0
+	s.saveProfile(user.Profile{
+		DisplayName:       "Explorer",
+		Skills:            []string{"climbing"},
+		Equipment:         []string{"rope"},
+		HomeLocation:      &home,
+		MaxTravelRadiusKm: &radius,
+	})
+	// About 22 km from home: outside the default radius, inside the travel radius
+	climbing := s.saveQuest("Climbing", "easy", 1, kernel.GeoCoordinate{Lat: 48.337, Lon: 11.575}, []string{"climbing"}, quest.StatusPosted)
+	s.saveQuest("Near the query position", "easy", 1, s.position, nil, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal(climbing.ID(), results[0].Quest.ID())
+	s.Equal([]string{"climbing"}, results[0].MatchedRequirements)
+	s.InDelta(1.0, results[0].Scores.Skills, 1e-9)
+	s.InDelta(1-results[0].DistanceKm/radius, results[0].Scores.Distance, 1e-9)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleQueryOverridesProfile() {
+	home := kernel.GeoCoordinate{Lat: 48.137, Lon: 11.575}
+	s.saveProfile(user.Profile{DisplayName: "Explorer", Skills: []string{"climbing"}, HomeLocation: &home})
+	photo := s.saveQuest("Photo walk", "easy", 1, s.position, []string{"photography"}, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{
+		UserID:   s.userID,
+		Position: &s.position,
+		Skills:   []string{"photography"},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal(photo.ID(), results[0].Quest.ID())
+	s.InDelta(1.0, results[0].Scores.Skills, 1e-9)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleCapsProfileRadius() {
+	home := s.position
+	radius := 500.0
+	s.saveProfile(user.Profile{DisplayName: "Traveller", HomeLocation: &home, MaxTravelRadiusKm: &radius})
+	// About 167 km from home
+	q := s.saveQuest("Day trip", "easy", 1, kernel.GeoCoordinate{Lat: 52.520, Lon: 15.870}, nil, quest.StatusPosted)
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID})
+
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal(q.ID(), results[0].Quest.ID())
+	s.InDelta(1-results[0].DistanceKm/queries.MaxRecommendRadiusKm, results[0].Scores.Distance, 1e-9)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleExcludesOwnQuests() {
+	q, err := quest.NewQuest("Mine", "Own quest", "easy", 1, 30, s.position, s.position, s.userID.String(), []string{}, []string{})
+	s.Require().NoError(err)
+	s.Require().NoError(q.ChangeStatus(quest.StatusPosted))
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+
+	results, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, Position: &s.position})
+
+	s.Require().NoError(err)
+	s.Empty(results)
+}
+
+func (s *RecommendQuestsQueryHandlerContractSuite) TestHandleValidation() {
+	var domainErr *errs.DomainValidationError
+
+	_, err := s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, RadiusKm: queries.MaxRecommendRadiusKm + 1})
+	s.True(errors.As(err, &domainErr))
+
+	_, err = s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID, Position: &s.position, Limit: queries.MaxRecommendLimit + 1})
+	s.True(errors.As(err, &domainErr))
+
+	// Without a profile the position has to be given
+	_, err = s.handler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: s.userID})
+	s.True(errors.As(err, &domainErr))
+}
+
+func TestRecommendQuestsQueryHandlerContract(t *testing.T) {
+	suite.Run(t, new(RecommendQuestsQueryHandlerContractSuite))
+}
@@ -0,0 +1,267 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// QuestApplicationHandlersContractSuite defines contract tests for the application workflow handlers
+type QuestApplicationHandlersContractSuite struct {
+	suite.Suite
+	container     *mocks.ContractDIContainer
+	applyHandler  commands.ApplyToQuestCommandHandler
+	reviewHandler commands.ReviewApplicationCommandHandler
+	listHandler   queries.ListQuestApplicationsQueryHandler
+	creator       uuid.UUID
+	ctx           context.Context
+}
+
+func (s *QuestApplicationHandlersContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.applyHandler = s.container.ApplyToQuestHandler
+	s.reviewHandler = s.container.ReviewApplicationHandler
+	s.listHandler = s.container.ListQuestApplicationsHandler
+	s.creator = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *QuestApplicationHandlersContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestQuestApplicationHandlersContract(t *testing.T) {
+	suite.Run(t, new(QuestApplicationHandlersContractSuite))
+}
+
+func (s *QuestApplicationHandlersContractSuite) createQuest(mode string) quest.Quest {
+	return createQuest(&s.Suite, s.container, s.creator, withAssignmentMode(mode))
+}
+
+func (s *QuestApplicationHandlersContractSuite) apply(questID uuid.UUID) quest.Application {
+	application, err := s.applyHandler.Handle(s.ctx, commands.ApplyToQuestCommand{
+		QuestID: questID,
+		UserID:  uuid.New(),
+		Message: "I'd like to help",
+	})
+	s.Require().NoError(err)
+	return application
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestApplySubmitsApplication() {
+	created := s.createQuest("review")
+	applicant := uuid.New()
+
+	application, err := s.applyHandler.Handle(s.ctx, commands.ApplyToQuestCommand{
+		QuestID: created.ID(),
+		UserID:  applicant,
+		Message: "I know the area",
+	})
+
+	// Contract: A pending application is stored and quest.application_submitted is published
+	s.Require().NoError(err)
+	s.Equal(applicant, application.ApplicantID)
+	s.Equal(quest.ApplicationStatusPending, application.Status)
+
+	stored, err := s.container.ApplicationRepository.GetByID(s.ctx, application.ID)
+	s.Require().NoError(err)
+	s.Equal("I know the area", stored.Message)
+
+	events := s.container.EventPublisher.(*mocks.MockEventPublisher).PublishedEvents
+	s.Equal("quest.application_submitted", events[len(events)-1].GetName())
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestApplyTwiceFails() {
+	created := s.createQuest("review")
+	applicant := uuid.New()
+	cmd := commands.ApplyToQuestCommand{QuestID: created.ID(), UserID: applicant}
+
+	_, err := s.applyHandler.Handle(s.ctx, cmd)
+	s.Require().NoError(err)
+
+	// Contract: A user applies only once per quest
+	_, err = s.applyHandler.Handle(s.ctx, cmd)
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestApplyToFirstComeQuestFails() {
+	created := s.createQuest("")
+
+	// Contract: Only quests in review mode accept applications
+	_, err := s.applyHandler.Handle(s.ctx, commands.ApplyToQuestCommand{QuestID: created.ID(), UserID: uuid.New()})
+
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestApplyToMissingQuest() {
+	_, err := s.applyHandler.Handle(s.ctx, commands.ApplyToQuestCommand{QuestID: uuid.New(), UserID: uuid.New()})
+
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestDirectAssignmentOfReviewQuestFails() {
+	created := s.createQuest("review")
+
+	// Contract: Review quests cannot be taken with the assign command
+	_, err := s.container.AssignQuestHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Equal("assignment", validationErr.Field)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestListApplicationsForCreator() {
+	created := s.createQuest("review")
+	first := s.apply(created.ID())
+	second := s.apply(created.ID())
+
+	applications, err := s.listHandler.Handle(s.ctx, queries.ListQuestApplicationsQuery{
+		QuestID: created.ID(),
+		UserID:  s.creator,
+	})
+
+	// Contract: The creator sees all applications, oldest first
+	s.Require().NoError(err)
+	s.Require().Len(applications, 2)
+	s.Equal(first.ID, applications[0].ID)
+	s.Equal(second.ID, applications[1].ID)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestListApplicationsForOtherUserIsForbidden() {
+	created := s.createQuest("review")
+	s.apply(created.ID())
+
+	_, err := s.listHandler.Handle(s.ctx, queries.ListQuestApplicationsQuery{
+		QuestID: created.ID(),
+		UserID:  uuid.New(),
+	})
+
+	var forbidden *errs.ForbiddenError
+	s.True(errors.As(err, &forbidden), "expected ForbiddenError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestAcceptAssignsApplicant() {
+	created := s.createQuest("review")
+	application := s.apply(created.ID())
+	other := s.apply(created.ID())
+
+	result, err := s.reviewHandler.Handle(s.ctx, commands.ReviewApplicationCommand{
+		QuestID:       created.ID(),
+		ApplicationID: application.ID,
+		UserID:        s.creator,
+		Decision:      commands.ApplicationDecisionAccept,
+	})
+
+	// Contract: Accepting assigns the quest to the applicant
+	s.Require().NoError(err)
+	s.Equal(quest.ApplicationStatusAccepted, result.Application.Status)
+	s.Equal(quest.StatusAssigned, result.QuestStatus)
+
+	stored, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, created.ID())
+	s.Require().NoError(err)
+	s.Require().NotNil(stored.Assignee)
+	s.Equal(application.ApplicantID, *stored.Assignee)
+
+	// Achievements unlocked by the assignment are published after the quest events
+	events := s.container.EventPublisher.(*mocks.MockEventPublisher).PublishedEvents
+	var questEvents []string
+	for _, e := range events {
+		if strings.HasPrefix(e.GetName(), "quest.") {
+			questEvents = append(questEvents, e.GetName())
+		}
+	}
+	s.Require().NotEmpty(questEvents)
+	s.Equal("quest.application_accepted", questEvents[len(questEvents)-1])
+
+	// Contract: Other applications stay pending but can no longer be accepted
+	pending, err := s.container.ApplicationRepository.GetByID(s.ctx, other.ID)
+	s.Require().NoError(err)
+	s.Equal(quest.ApplicationStatusPending, pending.Status)
+
+	_, err = s.reviewHandler.Handle(s.ctx, commands.ReviewApplicationCommand{
+		QuestID:       created.ID(),
+		ApplicationID: other.ID,
+		UserID:        s.creator,
+		Decision:      commands.ApplicationDecisionAccept,
+	})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestRejectApplication() {
+	created := s.createQuest("review")
+	application := s.apply(created.ID())
+
+	result, err := s.reviewHandler.Handle(s.ctx, commands.ReviewApplicationCommand{
+		QuestID:       created.ID(),
+		ApplicationID: application.ID,
+		UserID:        s.creator,
+		Decision:      commands.ApplicationDecisionReject,
+	})
+
+	// Contract: Rejecting leaves the quest open
+	s.Require().NoError(err)
+	s.Equal(quest.ApplicationStatusRejected, result.Application.Status)
+	s.Equal(quest.StatusCreated, result.QuestStatus)
+
+	stored, err := s.container.ApplicationRepository.GetByID(s.ctx, application.ID)
+	s.Require().NoError(err)
+	s.Equal(quest.ApplicationStatusRejected, stored.Status)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestReviewByOtherUserIsForbidden() {
+	created := s.createQuest("review")
+	application := s.apply(created.ID())
+
+	_, err := s.reviewHandler.Handle(s.ctx, commands.ReviewApplicationCommand{
+		QuestID:       created.ID(),
+		ApplicationID: application.ID,
+		UserID:        application.ApplicantID,
+		Decision:      commands.ApplicationDecisionAccept,
+	})
+
+	var forbidden *errs.ForbiddenError
+	s.True(errors.As(err, &forbidden), "expected ForbiddenError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestReviewApplicationOfAnotherQuest() {
+	created := s.createQuest("review")
+	otherQuest := s.createQuest("review")
+	application := s.apply(otherQuest.ID())
+
+	// Contract: Applications are looked up within the quest
+	_, err := s.reviewHandler.Handle(s.ctx, commands.ReviewApplicationCommand{
+		QuestID:       created.ID(),
+		ApplicationID: application.ID,
+		UserID:        s.creator,
+		Decision:      commands.ApplicationDecisionReject,
+	})
+
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestApplicationHandlersContractSuite) TestReviewUnknownDecision() {
+	_, err := s.reviewHandler.Handle(s.ctx, commands.ReviewApplicationCommand{
+		QuestID:       uuid.New(),
+		ApplicationID: uuid.New(),
+		UserID:        s.creator,
+		Decision:      "postpone",
+	})
+
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
@@ -0,0 +1,209 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// QuestCommentContractSuite defines contract tests for the comment thread of a quest
+type QuestCommentContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	assignee  uuid.UUID
+	ctx       context.Context
+}
+
+func (s *QuestCommentContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+}
+
+func (s *QuestCommentContractSuite) SetupTest() {
+	s.container.CleanupAll()
+	s.creator = uuid.New()
+	s.assignee = uuid.New()
+}
+
+func TestQuestCommentContract(t *testing.T) {
+	suite.Run(t, new(QuestCommentContractSuite))
+}
+
+func (s *QuestCommentContractSuite) createQuest() quest.Quest {
+	return createQuest(&s.Suite, s.container, s.creator)
+}
+
+func (s *QuestCommentContractSuite) assign(questID uuid.UUID) {
+	assignQuest(&s.Suite, s.container, questID, s.assignee)
+}
+
+func (s *QuestCommentContractSuite) add(questID, userID uuid.UUID, body, visibility string) (quest.Comment, error) {
+	return s.container.AddQuestCommentHandler.Handle(s.ctx, commands.AddQuestCommentCommand{
+		QuestID:    questID,
+		UserID:     userID,
+		Body:       body,
+		Visibility: visibility,
+	})
+}
+
+func (s *QuestCommentContractSuite) list(questID, userID uuid.UUID) queries.CommentPage {
+	page, err := s.container.ListQuestCommentsHandler.Handle(s.ctx, queries.ListQuestCommentsQuery{QuestID: questID, UserID: userID})
+	s.Require().NoError(err)
+	return page
+}
+
+func (s *QuestCommentContractSuite) TestPublicQuestionThenPrivateThread() {
+	created := s.createQuest()
+	stranger := uuid.New()
+
+	_, err := s.add(created.ID(), stranger, "Is a ladder needed?", "")
+	s.Require().NoError(err)
+	s.assign(created.ID())
+	_, err = s.add(created.ID(), s.assignee, "Gate code?", "")
+	s.Require().NoError(err)
+	_, err = s.add(created.ID(), s.creator, "1234", "private")
+	s.Require().NoError(err)
+
+	// Members see the whole thread, other users only the public comments
+	page := s.list(created.ID(), s.creator)
+	s.Equal(3, page.Total)
+	s.Require().Len(page.Comments, 3)
+	s.Equal("Is a ladder needed?", page.Comments[0].Body)
+
+	page = s.list(created.ID(), stranger)
+	s.Equal(1, page.Total)
+	s.Require().Len(page.Comments, 1)
+	s.Equal(quest.CommentPublic, page.Comments[0].Visibility)
+}
+
+func (s *QuestCommentContractSuite) TestAddPublishesCommentAdded() {
+	created := s.createQuest()
+
+	comment, err := s.add(created.ID(), uuid.New(), "Is a ladder needed?", "public")
+	s.Require().NoError(err)
+
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var events []quest.CommentAdded
+	for _, e := range publisher.PublishedEvents {
+		if c, ok := e.(quest.CommentAdded); ok {
+			events = append(events, c)
+		}
+	}
+	s.Require().Len(events, 1)
+	s.Equal(created.ID(), events[0].GetAggregateID())
+	s.Equal(comment.ID, events[0].CommentID)
+}
+
+func (s *QuestCommentContractSuite) TestAddErrors() {
+	created := s.createQuest()
+	s.assign(created.ID())
+
+	_, err := s.add(created.ID(), uuid.New(), "Hi", "private")
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "expected ForbiddenError, got %v", err)
+
+	_, err = s.add(created.ID(), s.assignee, "Hi", "public")
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+
+	_, err = s.add(uuid.New(), s.assignee, "Hi", "")
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestCommentContractSuite) TestPagination() {
+	created := s.createQuest()
+	for i := 0; i < 5; i++ {
+		_, err := s.add(created.ID(), uuid.New(), fmt.Sprintf("Question %d", i), "")
+		s.Require().NoError(err)
+	}
+
+	page, err := s.container.ListQuestCommentsHandler.Handle(s.ctx, queries.ListQuestCommentsQuery{
+		QuestID: created.ID(), UserID: s.creator, Limit: 2, Offset: 4,
+	})
+	s.Require().NoError(err)
+	s.Equal(5, page.Total)
+	s.Len(page.Comments, 1)
+
+	_, err = s.container.ListQuestCommentsHandler.Handle(s.ctx, queries.ListQuestCommentsQuery{
+		QuestID: created.ID(), UserID: s.creator, Limit: queries.MaxCommentPageSize + 1,
+	})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+
+	_, err = s.container.ListQuestCommentsHandler.Handle(s.ctx, queries.ListQuestCommentsQuery{QuestID: uuid.New()})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestCommentContractSuite) TestEditByAuthor() {
+	created := s.createQuest()
+	author := uuid.New()
+	comment, err := s.add(created.ID(), author, "Is a ladder needed?", "")
+	s.Require().NoError(err)
+
+	edited, err := s.container.EditQuestCommentHandler.Handle(s.ctx, commands.EditQuestCommentCommand{
+		QuestID: created.ID(), CommentID: comment.ID, UserID: author, Body: "Is a tall ladder needed?",
+	})
+	s.Require().NoError(err)
+	s.Equal("Is a tall ladder needed?", edited.Body)
+	s.NotNil(edited.EditedAt)
+
+	page := s.list(created.ID(), author)
+	s.Require().Len(page.Comments, 1)
+	s.Equal("Is a tall ladder needed?", page.Comments[0].Body)
+}
+
+func (s *QuestCommentContractSuite) TestEditErrors() {
+	created := s.createQuest()
+	author := uuid.New()
+	comment, err := s.add(created.ID(), author, "Is a ladder needed?", "")
+	s.Require().NoError(err)
+
+	_, err = s.container.EditQuestCommentHandler.Handle(s.ctx, commands.EditQuestCommentCommand{
+		QuestID: created.ID(), CommentID: comment.ID, UserID: uuid.New(), Body: "Changed",
+	})
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "expected ForbiddenError, got %v", err)
+
+	_, err = s.container.EditQuestCommentHandler.Handle(s.ctx, commands.EditQuestCommentCommand{
+		QuestID: created.ID(), CommentID: uuid.New(), UserID: author, Body: "Changed",
+	})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	// A comment is addressed through its own quest only
+	other := s.createQuest()
+	_, err = s.container.EditQuestCommentHandler.Handle(s.ctx, commands.EditQuestCommentCommand{
+		QuestID: other.ID(), CommentID: comment.ID, UserID: author, Body: "Changed",
+	})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestCommentContractSuite) TestEditAfterWindowIsValidationError() {
+	created := s.createQuest()
+	author := uuid.New()
+	comment, err := s.add(created.ID(), author, "Is a ladder needed?", "")
+	s.Require().NoError(err)
+
+	// Backdate the comment past the edit window
+	comment.CreatedAt = comment.CreatedAt.Add(-quest.CommentEditWindow - 1)
+	s.Require().NoError(s.container.UnitOfWork.CommentRepository().Save(s.ctx, comment))
+
+	_, err = s.container.EditQuestCommentHandler.Handle(s.ctx, commands.EditQuestCommentCommand{
+		QuestID: created.ID(), CommentID: comment.ID, UserID: author, Body: "Changed",
+	})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
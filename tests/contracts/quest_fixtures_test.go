@@ -0,0 +1,99 @@
+package contracts
+
+import (
+	"context"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// fixtureLocation is the target and execution location of fixture quests unless an option moves them
+var fixtureLocation = kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+
+// questOption adjusts the command creating a fixture quest
+type questOption func(cmd *commands.CreateQuestCommand)
+
+func withTitle(title string) questOption {
+	return func(cmd *commands.CreateQuestCommand) { cmd.Title = title }
+}
+
+func withDifficulty(difficulty string) questOption {
+	return func(cmd *commands.CreateQuestCommand) { cmd.Difficulty = difficulty }
+}
+
+func withReward(reward int) questOption {
+	return func(cmd *commands.CreateQuestCommand) { cmd.Reward = reward }
+}
+
+// withLocation places both the target and the execution location at location
+func withLocation(location kernel.GeoCoordinate) questOption {
+	return withLocations(location, location)
+}
+
+func withLocations(target, execution kernel.GeoCoordinate) questOption {
+	return func(cmd *commands.CreateQuestCommand) {
+		cmd.TargetLocation = &target
+		cmd.ExecutionLocation = &execution
+	}
+}
+
+func withCapacity(capacity, quorum int) questOption {
+	return func(cmd *commands.CreateQuestCommand) {
+		cmd.Capacity = capacity
+		cmd.CompletionQuorum = quorum
+	}
+}
+
+func withAssignmentMode(mode string) questOption {
+	return func(cmd *commands.CreateQuestCommand) { cmd.AssignmentMode = mode }
+}
+
+func withGeofence(radius int) questOption {
+	return func(cmd *commands.CreateQuestCommand) { cmd.GeofenceRadius = radius }
+}
+
+// createQuest creates an easy quest of the creator for one participant at fixtureLocation
+// through CreateQuestHandler; options adjust the command.
+func createQuest(s *suite.Suite, container *mocks.ContractDIContainer, creator uuid.UUID, options ...questOption) quest.Quest {
+	cmd := commands.CreateQuestCommand{
+		Title:           "Contract Quest",
+		Description:     "Fix the fence",
+		Difficulty:      "easy",
+		Reward:          1,
+		DurationMinutes: 30,
+		Creator:         creator.String(),
+	}
+	withLocation(fixtureLocation)(&cmd)
+	for _, option := range options {
+		option(&cmd)
+	}
+
+	created, err := container.CreateQuestHandler.Handle(context.Background(), cmd)
+	s.Require().NoError(err)
+	return created
+}
+
+// assignQuest assigns the quest to the participants in turn
+func assignQuest(s *suite.Suite, container *mocks.ContractDIContainer, questID uuid.UUID, participants ...uuid.UUID) {
+	for _, participant := range participants {
+		_, err := container.AssignQuestHandler.Handle(context.Background(), commands.AssignQuestCommand{ID: questID, UserID: participant})
+		s.Require().NoError(err)
+	}
+}
+
+// completeQuest lets the participant start the assigned quest and the creator complete it
+func completeQuest(s *suite.Suite, container *mocks.ContractDIContainer, questID, participant, creator uuid.UUID) {
+	_, err := container.ChangeQuestStatusHandler.Handle(context.Background(), commands.ChangeQuestStatusCommand{
+		QuestID: questID, UserID: participant, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = container.ChangeQuestStatusHandler.Handle(context.Background(), commands.ChangeQuestStatusCommand{
+		QuestID: questID, UserID: creator, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,209 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// QuestPrerequisiteContractSuite defines contract tests for prerequisite quests and the dependency graph
+type QuestPrerequisiteContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	ctx       context.Context
+}
+
+func (s *QuestPrerequisiteContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+}
+
+func (s *QuestPrerequisiteContractSuite) SetupTest() {
+	s.container.CleanupAll()
+	s.creator = uuid.New()
+}
+
+func TestQuestPrerequisiteContract(t *testing.T) {
+	suite.Run(t, new(QuestPrerequisiteContractSuite))
+}
+
+func (s *QuestPrerequisiteContractSuite) createQuest(title string) quest.Quest {
+	return createQuest(&s.Suite, s.container, s.creator, withTitle(title))
+}
+
+func (s *QuestPrerequisiteContractSuite) addPrerequisite(questID, prerequisiteID uuid.UUID) (quest.Quest, error) {
+	return s.container.AddQuestPrerequisiteHandler.Handle(s.ctx, commands.AddQuestPrerequisiteCommand{
+		QuestID:        questID,
+		PrerequisiteID: prerequisiteID,
+		UserID:         s.creator,
+	})
+}
+
+// complete assigns the quest to a participant, who starts it, and the creator completes it
+func (s *QuestPrerequisiteContractSuite) complete(questID uuid.UUID) {
+	participant := uuid.New()
+	assignQuest(&s.Suite, s.container, questID, participant)
+	completeQuest(&s.Suite, s.container, questID, participant, s.creator)
+}
+
+func (s *QuestPrerequisiteContractSuite) getQuest(questID uuid.UUID) quest.Quest {
+	q, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, questID)
+	s.Require().NoError(err)
+	return q
+}
+
+func (s *QuestPrerequisiteContractSuite) TestAddAndRemove() {
+	first := s.createQuest("Find the map")
+	second := s.createQuest("Follow the map")
+
+	updated, err := s.addPrerequisite(second.ID(), first.ID())
+	s.Require().NoError(err)
+	s.Equal([]quest.Prerequisite{{QuestID: first.ID(), Completed: false}}, updated.Prerequisites)
+	s.Equal(updated.Prerequisites, s.getQuest(second.ID()).Prerequisites)
+
+	updated, err = s.container.RemoveQuestPrerequisiteHandler.Handle(s.ctx, commands.RemoveQuestPrerequisiteCommand{
+		QuestID: second.ID(), PrerequisiteID: first.ID(), UserID: s.creator,
+	})
+	s.Require().NoError(err)
+	s.Empty(updated.Prerequisites)
+	s.Empty(s.getQuest(second.ID()).Prerequisites)
+}
+
+func (s *QuestPrerequisiteContractSuite) TestAddErrors() {
+	first := s.createQuest("Find the map")
+	second := s.createQuest("Follow the map")
+
+	_, err := s.container.AddQuestPrerequisiteHandler.Handle(s.ctx, commands.AddQuestPrerequisiteCommand{
+		QuestID: second.ID(), PrerequisiteID: first.ID(), UserID: uuid.New(),
+	})
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "expected ForbiddenError, got %v", err)
+
+	_, err = s.addPrerequisite(second.ID(), uuid.New())
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	_, err = s.addPrerequisite(uuid.New(), first.ID())
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	_, err = s.addPrerequisite(second.ID(), second.ID())
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+
+	_, err = s.container.RemoveQuestPrerequisiteHandler.Handle(s.ctx, commands.RemoveQuestPrerequisiteCommand{
+		QuestID: second.ID(), PrerequisiteID: first.ID(), UserID: s.creator,
+	})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestPrerequisiteContractSuite) TestAddRejectsCycles() {
+	first := s.createQuest("Find the map")
+	second := s.createQuest("Follow the map")
+	third := s.createQuest("Open the chest")
+
+	_, err := s.addPrerequisite(second.ID(), first.ID())
+	s.Require().NoError(err)
+	_, err = s.addPrerequisite(third.ID(), second.ID())
+	s.Require().NoError(err)
+
+	_, err = s.addPrerequisite(first.ID(), third.ID())
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Contains(err.Error(), quest.ErrPrerequisiteCycle.Error())
+	s.Empty(s.getQuest(first.ID()).Prerequisites)
+}
+
+func (s *QuestPrerequisiteContractSuite) TestIncompletePrerequisitesBlockAssignment() {
+	first := s.createQuest("Find the map")
+	second := s.createQuest("Follow the map")
+	_, err := s.addPrerequisite(second.ID(), first.ID())
+	s.Require().NoError(err)
+
+	_, err = s.container.AssignQuestHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: second.ID(), UserID: uuid.New()})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Contains(err.Error(), quest.ErrPrerequisitesIncomplete.Error())
+
+	_, err = s.container.ChangeQuestStatusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+		QuestID: second.ID(), UserID: s.creator, Status: quest.StatusPosted,
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), quest.ErrPrerequisitesIncomplete.Error())
+	s.Equal(quest.StatusCreated, s.getQuest(second.ID()).Status)
+}
+
+func (s *QuestPrerequisiteContractSuite) TestLastCompletedPrerequisitePostsQuest() {
+	first := s.createQuest("Find the map")
+	second := s.createQuest("Find the key")
+	third := s.createQuest("Open the chest")
+	_, err := s.addPrerequisite(third.ID(), first.ID())
+	s.Require().NoError(err)
+	_, err = s.addPrerequisite(third.ID(), second.ID())
+	s.Require().NoError(err)
+
+	s.complete(first.ID())
+	dependent := s.getQuest(third.ID())
+	s.Equal(quest.StatusCreated, dependent.Status)
+	s.Equal([]quest.Prerequisite{
+		{QuestID: first.ID(), Completed: true},
+		{QuestID: second.ID(), Completed: false},
+	}, dependent.Prerequisites)
+
+	s.complete(second.ID())
+	dependent = s.getQuest(third.ID())
+	s.Equal(quest.StatusPosted, dependent.Status)
+	s.True(dependent.PrerequisitesCompleted())
+
+	// The status change of the dependent quest is published like any other
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var posted []quest.QuestStatusChanged
+	for _, e := range publisher.PublishedEvents {
+		if c, ok := e.(quest.QuestStatusChanged); ok && c.GetAggregateID() == third.ID() {
+			posted = append(posted, c)
+		}
+	}
+	s.Require().Len(posted, 1)
+	s.Equal(quest.StatusPosted, posted[0].NewStatus)
+
+	_, err = s.container.AssignQuestHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: third.ID(), UserID: uuid.New()})
+	s.NoError(err)
+}
+
+func (s *QuestPrerequisiteContractSuite) TestDependencyGraph() {
+	first := s.createQuest("Find the map")
+	second := s.createQuest("Follow the map")
+	third := s.createQuest("Open the chest")
+	unrelated := s.createQuest("Water the plants")
+	_, err := s.addPrerequisite(second.ID(), first.ID())
+	s.Require().NoError(err)
+	_, err = s.addPrerequisite(third.ID(), second.ID())
+	s.Require().NoError(err)
+
+	// The graph is the same from every quest of the chain
+	for _, id := range []uuid.UUID{first.ID(), second.ID(), third.ID()} {
+		quests, err := s.container.GetQuestDependenciesHandler.Handle(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().Len(quests, 3)
+		s.Equal(first.ID(), quests[0].ID())
+		s.Equal(second.ID(), quests[1].ID())
+		s.Equal(third.ID(), quests[2].ID())
+	}
+
+	quests, err := s.container.GetQuestDependenciesHandler.Handle(s.ctx, unrelated.ID())
+	s.Require().NoError(err)
+	s.Require().Len(quests, 1)
+	s.Empty(quests[0].Prerequisites)
+
+	_, err = s.container.GetQuestDependenciesHandler.Handle(s.ctx, uuid.New())
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
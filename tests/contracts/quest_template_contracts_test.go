@@ -0,0 +1,315 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// QuestTemplateContractSuite defines contract tests for quest templates and their schedules
+type QuestTemplateContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	owner     uuid.UUID
+	ctx       context.Context
+}
+
+func (s *QuestTemplateContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+}
+
+func (s *QuestTemplateContractSuite) SetupTest() {
+	s.container.CleanupAll()
+	s.owner = uuid.New()
+}
+
+func TestQuestTemplateContract(t *testing.T) {
+	suite.Run(t, new(QuestTemplateContractSuite))
+}
+
+func (s *QuestTemplateContractSuite) spec() template.Spec {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	return template.Spec{
+		Title:             "Water the plants",
+		Description:       "Water every plant in the office",
+		Difficulty:        "easy",
+		Reward:            1,
+		DurationMinutes:   15,
+		TargetLocation:    &location,
+		ExecutionLocation: &location,
+		Equipment:         []string{"watering can"},
+	}
+}
+
+func (s *QuestTemplateContractSuite) createTemplate(rule *string, start *time.Time) *template.QuestTemplate {
+	created, err := s.container.CreateQuestTemplateHandler.Handle(s.ctx, commands.CreateQuestTemplateCommand{
+		OwnerID:         s.owner,
+		Name:            "Plants",
+		Quest:           s.spec(),
+		RecurrenceRule:  rule,
+		RecurrenceStart: start,
+	})
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *QuestTemplateContractSuite) TestCreateGetAndList() {
+	created := s.createTemplate(nil, nil)
+	s.Equal(s.owner, created.OwnerID)
+	s.Nil(created.Recurrence)
+	s.Nil(created.NextOccurrence)
+
+	found, err := s.container.GetQuestTemplateHandler.Handle(s.ctx, queries.GetQuestTemplateQuery{TemplateID: created.ID(), UserID: s.owner})
+	s.Require().NoError(err)
+	s.Equal("Plants", found.Name)
+	s.Equal(s.spec(), found.Quest)
+
+	// Templates are private to their owner
+	_, err = s.container.GetQuestTemplateHandler.Handle(s.ctx, queries.GetQuestTemplateQuery{TemplateID: created.ID(), UserID: uuid.New()})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	list, err := s.container.ListQuestTemplatesHandler.Handle(s.ctx, s.owner)
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Equal(created.ID(), list[0].ID())
+
+	list, err = s.container.ListQuestTemplatesHandler.Handle(s.ctx, uuid.New())
+	s.Require().NoError(err)
+	s.Empty(list)
+}
+
+func (s *QuestTemplateContractSuite) TestInvalidTemplateIsValidationError() {
+	rule := "FREQ=HOURLY"
+	_, err := s.container.CreateQuestTemplateHandler.Handle(s.ctx, commands.CreateQuestTemplateCommand{
+		OwnerID: s.owner, Name: "Plants", Quest: s.spec(), RecurrenceRule: &rule,
+	})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+
+	spec := s.spec()
+	spec.Reward = 10
+	_, err = s.container.CreateQuestTemplateHandler.Handle(s.ctx, commands.CreateQuestTemplateCommand{
+		OwnerID: s.owner, Name: "Plants", Quest: spec,
+	})
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
+
+func (s *QuestTemplateContractSuite) TestUpdateAndDeleteByOwnerOnly() {
+	created := s.createTemplate(nil, nil)
+	stranger := uuid.New()
+	var notFound *errs.NotFoundError
+
+	_, err := s.container.UpdateQuestTemplateHandler.Handle(s.ctx, commands.UpdateQuestTemplateCommand{
+		TemplateID: created.ID(), UserID: stranger, Name: "Mine", Quest: s.spec(),
+	})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	err = s.container.DeleteQuestTemplateHandler.Handle(s.ctx, commands.DeleteQuestTemplateCommand{TemplateID: created.ID(), UserID: stranger})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	rule := "FREQ=WEEKLY"
+	updated, err := s.container.UpdateQuestTemplateHandler.Handle(s.ctx, commands.UpdateQuestTemplateCommand{
+		TemplateID: created.ID(), UserID: s.owner, Name: "Weekly plants", Quest: s.spec(), RecurrenceRule: &rule,
+	})
+	s.Require().NoError(err)
+	s.Equal("Weekly plants", updated.Name)
+	s.Require().NotNil(updated.Recurrence)
+	s.Equal(template.FrequencyWeekly, updated.Recurrence.Frequency)
+	s.NotNil(updated.NextOccurrence)
+
+	err = s.container.DeleteQuestTemplateHandler.Handle(s.ctx, commands.DeleteQuestTemplateCommand{TemplateID: created.ID(), UserID: s.owner})
+	s.Require().NoError(err)
+
+	_, err = s.container.GetQuestTemplateHandler.Handle(s.ctx, queries.GetQuestTemplateQuery{TemplateID: created.ID(), UserID: s.owner})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestTemplateContractSuite) TestCreateQuestFromTemplateWithOverrides() {
+	created := s.createTemplate(nil, nil)
+	title := "Water the plants on the second floor"
+	reward := 3
+	other := kernel.GeoCoordinate{Lat: 55.75, Lon: 37.62}
+
+	q, err := s.container.CreateQuestFromTemplateHandler.Handle(s.ctx, commands.CreateQuestFromTemplateCommand{
+		TemplateID: created.ID(),
+		UserID:     s.owner,
+		Overrides: commands.QuestOverrides{
+			Title:          &title,
+			Reward:         &reward,
+			TargetLocation: &commands.LocationOverride{Coordinate: &other},
+		},
+	})
+
+	s.Require().NoError(err)
+	s.Equal(title, q.Title)
+	s.Equal(reward, q.Reward)
+	s.Equal("Water every plant in the office", q.Description)
+	s.Equal(s.owner.String(), q.Creator)
+	s.Equal(other, q.TargetLocation)
+	s.Equal([]string{"watering can"}, q.Equipment)
+
+	// Quests are created from own templates only
+	_, err = s.container.CreateQuestFromTemplateHandler.Handle(s.ctx, commands.CreateQuestFromTemplateCommand{
+		TemplateID: created.ID(), UserID: uuid.New(),
+	})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *QuestTemplateContractSuite) TestScheduledQuestsAreCreatedOncePerOccurrence() {
+	rule := "FREQ=DAILY;COUNT=5"
+	start := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	created := s.createTemplate(&rule, &start)
+	s.Require().NotNil(created.NextOccurrence)
+	s.Equal(start, *created.NextOccurrence)
+
+	// Nothing is due before the first occurrence
+	quests, err := s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: start.Add(-time.Minute)})
+	s.Require().NoError(err)
+	s.Empty(quests)
+
+	// Occurrences missed since the last run are all created
+	now := start.AddDate(0, 0, 2)
+	quests, err = s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: now})
+	s.Require().NoError(err)
+	s.Require().Len(quests, 3)
+	for _, q := range quests {
+		s.Equal("Water the plants", q.Title)
+		s.Equal(s.owner.String(), q.Creator)
+	}
+
+	occurrences := s.container.QuestTemplateRepository.Occurrences(created.ID())
+	s.Require().Len(occurrences, 3)
+	s.Equal(start, occurrences[0].At)
+	s.Require().NotNil(occurrences[0].QuestID)
+	s.Equal(quests[0].ID(), *occurrences[0].QuestID)
+
+	// A repeated run at the same time creates nothing
+	quests, err = s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: now})
+	s.Require().NoError(err)
+	s.Empty(quests)
+	s.Len(s.container.QuestTemplateRepository.Occurrences(created.ID()), 3)
+
+	found, err := s.container.GetQuestTemplateHandler.Handle(s.ctx, queries.GetQuestTemplateQuery{TemplateID: created.ID(), UserID: s.owner})
+	s.Require().NoError(err)
+	s.Require().NotNil(found.NextOccurrence)
+	s.Equal(start.AddDate(0, 0, 3), *found.NextOccurrence)
+}
+
+func (s *QuestTemplateContractSuite) TestFailedScheduledQuestIsRetried() {
+	rule := "FREQ=DAILY;COUNT=1"
+	start := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	address := "Tverskaya 1, Moscow"
+	spec := s.spec()
+	spec.TargetLocation = nil
+	spec.TargetAddress = &address
+	created, err := s.container.CreateQuestTemplateHandler.Handle(s.ctx, commands.CreateQuestTemplateCommand{
+		OwnerID:         s.owner,
+		Name:            "Plants",
+		Quest:           spec,
+		RecurrenceRule:  &rule,
+		RecurrenceStart: &start,
+	})
+	s.Require().NoError(err)
+
+	// The address cannot be geocoded yet, so the quest is not created
+	quests, err := s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: start})
+	s.Require().NoError(err)
+	s.Empty(quests)
+	occurrences := s.container.QuestTemplateRepository.Occurrences(created.ID())
+	s.Require().Len(occurrences, 1)
+	s.Nil(occurrences[0].QuestID)
+
+	// The claim of the failed run has not expired yet
+	s.container.Geocoder.AddPlace(address, kernel.GeoCoordinate{Lat: 55.7579, Lon: 37.6136})
+	quests, err = s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: start.Add(time.Minute)})
+	s.Require().NoError(err)
+	s.Empty(quests)
+
+	// Act - a later run retries the occurrence
+	retryAt := start.Add(template.OccurrenceClaimTimeout)
+	quests, err = s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: retryAt})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(quests, 1)
+	occurrences = s.container.QuestTemplateRepository.Occurrences(created.ID())
+	s.Require().Len(occurrences, 1)
+	s.Require().NotNil(occurrences[0].QuestID)
+	s.Equal(quests[0].ID(), *occurrences[0].QuestID)
+	s.Equal(retryAt, occurrences[0].ClaimedAt)
+
+	// The occurrence is not retried again
+	quests, err = s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: retryAt.Add(template.OccurrenceClaimTimeout)})
+	s.Require().NoError(err)
+	s.Empty(quests)
+}
+
+func (s *QuestTemplateContractSuite) TestScheduledQuestRolledBackWithFailedOccurrence() {
+	rule := "FREQ=DAILY;COUNT=1"
+	start := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	created := s.createTemplate(&rule, &start)
+	s.container.QuestTemplateRepository.FailNextSaveOccurrence(errors.New("connection reset"))
+
+	// Act - the quest cannot be recorded on its occurrence
+	quests, err := s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: start})
+
+	// Assert - the quest is rolled back with the occurrence, so the retry is the only quest
+	var infraErr *errs.InfrastructureError
+	s.Require().True(errors.As(err, &infraErr), "expected InfrastructureError, got %v", err)
+	s.Empty(quests)
+	occurrences := s.container.QuestTemplateRepository.Occurrences(created.ID())
+	s.Require().Len(occurrences, 1)
+	s.Nil(occurrences[0].QuestID)
+	s.False(s.container.UnitOfWork.(*mocks.MockUnitOfWork).IsInTransaction(), "Transaction should be rolled back")
+	s.Empty(s.questsCreated(), "Rolled back quest should not be published")
+
+	retryAt := start.Add(template.OccurrenceClaimTimeout)
+	quests, err = s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: retryAt})
+	s.Require().NoError(err)
+	s.Require().Len(quests, 1)
+	occurrences = s.container.QuestTemplateRepository.Occurrences(created.ID())
+	s.Require().NotNil(occurrences[0].QuestID)
+	s.Equal(quests[0].ID(), *occurrences[0].QuestID)
+	s.Equal([]uuid.UUID{quests[0].ID()}, s.questsCreated())
+}
+
+// questsCreated returns the IDs of the quests published as created
+func (s *QuestTemplateContractSuite) questsCreated() []uuid.UUID {
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var ids []uuid.UUID
+	for _, e := range publisher.PublishAsyncEvents {
+		if created, ok := e.(quest.QuestCreated); ok {
+			ids = append(ids, created.GetAggregateID())
+		}
+	}
+	return ids
+}
+
+func (s *QuestTemplateContractSuite) TestScheduleEndsAfterCount() {
+	rule := "FREQ=WEEKLY;COUNT=2"
+	start := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	created := s.createTemplate(&rule, &start)
+
+	quests, err := s.container.CreateScheduledQuestsHandler.Handle(s.ctx, commands.CreateScheduledQuestsCommand{Now: start.AddDate(0, 2, 0)})
+
+	s.Require().NoError(err)
+	s.Len(quests, 2)
+	found, err := s.container.GetQuestTemplateHandler.Handle(s.ctx, queries.GetQuestTemplateQuery{TemplateID: created.ID(), UserID: s.owner})
+	s.Require().NoError(err)
+	s.Nil(found.NextOccurrence)
+}
@@ -0,0 +1,247 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/review"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// ReviewContractSuite defines contract tests for reviews between the creator and the participants
+type ReviewContractSuite struct {
+	suite.Suite
+	container *mocks.ContractDIContainer
+	creator   uuid.UUID
+	assignee  uuid.UUID
+	ctx       context.Context
+}
+
+func (s *ReviewContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.ctx = context.Background()
+}
+
+func (s *ReviewContractSuite) SetupTest() {
+	s.container.CleanupAll()
+	s.creator = uuid.New()
+	s.assignee = uuid.New()
+}
+
+func TestReviewContract(t *testing.T) {
+	suite.Run(t, new(ReviewContractSuite))
+}
+
+// assignedQuest creates a quest of the creator and assigns it to the assignee
+func (s *ReviewContractSuite) assignedQuest() quest.Quest {
+	return s.questWith(s.assignee)
+}
+
+// questWith creates a quest of the creator with room for the participants and assigns it to all of them
+func (s *ReviewContractSuite) questWith(participants ...uuid.UUID) quest.Quest {
+	created := createQuest(&s.Suite, s.container, s.creator, withCapacity(len(participants), 0))
+	assignQuest(&s.Suite, s.container, created.ID(), participants...)
+	return created
+}
+
+// completedQuest creates, assigns and completes a quest
+func (s *ReviewContractSuite) completedQuest() quest.Quest {
+	return s.completedQuestWith(s.assignee)
+}
+
+// completedQuestWith creates a quest taken by the participants, the first one starts it and the creator completes it
+func (s *ReviewContractSuite) completedQuestWith(participants ...uuid.UUID) quest.Quest {
+	created := s.questWith(participants...)
+	completeQuest(&s.Suite, s.container, created.ID(), participants[0], s.creator)
+	return created
+}
+
+func (s *ReviewContractSuite) submit(questID, userID, revieweeID uuid.UUID, rating int) (review.Review, error) {
+	return s.container.SubmitReviewHandler.Handle(s.ctx, commands.SubmitReviewCommand{
+		QuestID:    questID,
+		UserID:     userID,
+		RevieweeID: revieweeID,
+		Rating:     rating,
+		Comment:    "Thanks!",
+	})
+}
+
+func (s *ReviewContractSuite) TestBothSidesReviewEachOnce() {
+	completed := s.completedQuest()
+
+	byCreator, err := s.submit(completed.ID(), s.creator, s.assignee, 5)
+	s.Require().NoError(err)
+	s.Equal(s.assignee, byCreator.RevieweeID)
+
+	byAssignee, err := s.submit(completed.ID(), s.assignee, s.creator, 4)
+	s.Require().NoError(err)
+	s.Equal(s.creator, byAssignee.RevieweeID)
+
+	_, err = s.submit(completed.ID(), s.creator, s.assignee, 1)
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+
+	reviews, err := s.container.ListQuestReviewsHandler.Handle(s.ctx, completed.ID())
+	s.Require().NoError(err)
+	s.Len(reviews, 2)
+}
+
+func (s *ReviewContractSuite) TestTeamQuestCreatorReviewsEveryParticipant() {
+	second, third := uuid.New(), uuid.New()
+	completed := s.completedQuestWith(s.assignee, second, third)
+
+	for _, p := range []uuid.UUID{s.assignee, second, third} {
+		r, err := s.submit(completed.ID(), s.creator, p, 5)
+		s.Require().NoError(err)
+		s.Equal(p, r.RevieweeID)
+	}
+
+	// Participants other than the first one review the creator
+	_, err := s.submit(completed.ID(), third, s.creator, 4)
+	s.Require().NoError(err)
+
+	reviews, err := s.container.ListQuestReviewsHandler.Handle(s.ctx, completed.ID())
+	s.Require().NoError(err)
+	s.Len(reviews, 4)
+
+	reputations, err := s.container.GetReputationsHandler.Handle(s.ctx, []uuid.UUID{s.creator, second, third})
+	s.Require().NoError(err)
+	s.Equal(1, reputations[s.creator].ReviewCount)
+	s.Equal(5.0, reputations[second].AverageRating)
+	s.Equal(1, reputations[second].ReviewCount)
+	s.Equal(1, reputations[third].ReviewCount)
+}
+
+func (s *ReviewContractSuite) TestParticipantsCannotReviewEachOther() {
+	second := uuid.New()
+	completed := s.completedQuestWith(s.assignee, second)
+
+	_, err := s.submit(completed.ID(), second, s.assignee, 1)
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Contains(err.Error(), "participants can review only the creator of the quest")
+
+	reviews, err := s.container.ListQuestReviewsHandler.Handle(s.ctx, completed.ID())
+	s.Require().NoError(err)
+	s.Empty(reviews)
+}
+
+func (s *ReviewContractSuite) TestRevieweeMustTakePart() {
+	completed := s.completedQuest()
+
+	_, err := s.submit(completed.ID(), s.creator, uuid.New(), 5)
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Contains(err.Error(), "reviewee must be the creator or a participant of the quest")
+}
+
+func (s *ReviewContractSuite) TestSubmitPublishesReviewSubmitted() {
+	completed := s.completedQuest()
+
+	submitted, err := s.submit(completed.ID(), s.creator, s.assignee, 5)
+	s.Require().NoError(err)
+
+	publisher := s.container.EventPublisher.(*mocks.MockEventPublisher)
+	var events []review.ReviewSubmitted
+	for _, e := range publisher.PublishedEvents {
+		if r, ok := e.(review.ReviewSubmitted); ok {
+			events = append(events, r)
+		}
+	}
+	s.Require().Len(events, 1)
+	s.Equal(submitted.ID(), events[0].GetAggregateID())
+	s.Equal(5, events[0].Rating)
+}
+
+func (s *ReviewContractSuite) TestQuestNotCompletedIsValidationError() {
+	assigned := s.assignedQuest()
+
+	_, err := s.submit(assigned.ID(), s.creator, s.assignee, 5)
+
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
+
+func (s *ReviewContractSuite) TestStrangerIsForbidden() {
+	completed := s.completedQuest()
+
+	_, err := s.submit(completed.ID(), uuid.New(), s.creator, 5)
+
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "expected ForbiddenError, got %v", err)
+}
+
+func (s *ReviewContractSuite) TestUnknownQuestIsNotFound() {
+	_, err := s.submit(uuid.New(), s.creator, s.assignee, 5)
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	_, err = s.container.ListQuestReviewsHandler.Handle(s.ctx, uuid.New())
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *ReviewContractSuite) TestReputationsAggregateReceivedReviews() {
+	first := s.completedQuest()
+	second := s.completedQuest()
+	_, err := s.submit(first.ID(), s.assignee, s.creator, 5)
+	s.Require().NoError(err)
+	_, err = s.submit(second.ID(), s.assignee, s.creator, 4)
+	s.Require().NoError(err)
+
+	stranger := uuid.New()
+	reputations, err := s.container.GetReputationsHandler.Handle(s.ctx, []uuid.UUID{s.creator, stranger})
+	s.Require().NoError(err)
+
+	s.Equal(4.5, reputations[s.creator].AverageRating)
+	s.Equal(2, reputations[s.creator].ReviewCount)
+	s.Equal(0, reputations[stranger].ReviewCount)
+}
+
+func (s *ReviewContractSuite) TestListQuestsFiltersByCreatorRating() {
+	rated := s.completedQuest()
+	_, err := s.submit(rated.ID(), s.assignee, s.creator, 4)
+	s.Require().NoError(err)
+
+	// Quest of a creator without reviews is excluded by the filter
+	ratedCreator := s.creator
+	s.creator = uuid.New()
+	unrated := s.assignedQuest()
+	s.creator = ratedCreator
+
+	// Quest queries read from the standalone quest repository
+	for _, q := range []quest.Quest{rated, unrated} {
+		stored, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, q.ID())
+		s.Require().NoError(err)
+		s.Require().NoError(s.container.QuestRepository.Save(s.ctx, stored))
+	}
+
+	minRating := 4.0
+	quests, err := s.container.ListQuestsHandler.Handle(s.ctx, queries.ListQuestsQuery{MinCreatorRating: &minRating})
+	s.Require().NoError(err)
+	ids := make([]uuid.UUID, 0, len(quests))
+	for _, q := range quests {
+		ids = append(ids, q.ID())
+	}
+	s.Contains(ids, rated.ID())
+	s.NotContains(ids, unrated.ID())
+
+	tooHigh := 4.5
+	quests, err = s.container.ListQuestsHandler.Handle(s.ctx, queries.ListQuestsQuery{MinCreatorRating: &tooHigh})
+	s.Require().NoError(err)
+	s.Empty(quests)
+
+	invalid := 6.0
+	_, err = s.container.ListQuestsHandler.Handle(s.ctx, queries.ListQuestsQuery{MinCreatorRating: &invalid})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+}
@@ -0,0 +1,199 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// TeamQuestHandlersContractSuite defines contract tests for quests taken by several participants
+type TeamQuestHandlersContractSuite struct {
+	suite.Suite
+	container     *mocks.ContractDIContainer
+	assignHandler commands.AssignQuestCommandHandler
+	statusHandler commands.ChangeQuestStatusCommandHandler
+	creator       uuid.UUID
+	ctx           context.Context
+}
+
+func (s *TeamQuestHandlersContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.assignHandler = s.container.AssignQuestHandler
+	s.statusHandler = s.container.ChangeQuestStatusHandler
+	s.creator = uuid.New()
+	s.ctx = context.Background()
+}
+
+func (s *TeamQuestHandlersContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestTeamQuestHandlersContract(t *testing.T) {
+	suite.Run(t, new(TeamQuestHandlersContractSuite))
+}
+
+func (s *TeamQuestHandlersContractSuite) createQuest(capacity, quorum int) quest.Quest {
+	return createQuest(&s.Suite, s.container, s.creator, withCapacity(capacity, quorum))
+}
+
+func (s *TeamQuestHandlersContractSuite) join(questID uuid.UUID) uuid.UUID {
+	userID := uuid.New()
+	_, err := s.assignHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: questID, UserID: userID})
+	s.Require().NoError(err)
+	return userID
+}
+
+func (s *TeamQuestHandlersContractSuite) changeStatus(questID, userID uuid.UUID, status quest.Status) (commands.ChangeQuestStatusResult, error) {
+	return s.statusHandler.Handle(s.ctx, commands.ChangeQuestStatusCommand{
+		QuestID: questID,
+		UserID:  userID,
+		Status:  status,
+	})
+}
+
+func (s *TeamQuestHandlersContractSuite) TestCreateRejectsInvalidCapacity() {
+	_, err := s.container.CreateQuestHandler.Handle(s.ctx, commands.CreateQuestCommand{
+		Title:             "Team Quest",
+		Description:       "Community clean-up",
+		Difficulty:        "easy",
+		Reward:            2,
+		DurationMinutes:   120,
+		Creator:           s.creator.String(),
+		TargetLocation:    &kernel.GeoCoordinate{Lat: 50.0, Lon: 10.0},
+		ExecutionLocation: &kernel.GeoCoordinate{Lat: 51.0, Lon: 11.0},
+		Capacity:          2,
+		CompletionQuorum:  3,
+	})
+
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "Quorum above capacity should be a validation error")
+	s.Equal("capacity", validationErr.Field)
+}
+
+func (s *TeamQuestHandlersContractSuite) TestAssignAppendsParticipantsUntilCapacity() {
+	created := s.createQuest(2, 0)
+	first := s.join(created.ID())
+
+	result, err := s.assignHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusAssigned), result.Status)
+	s.Len(result.Participants, 2)
+	s.Equal(first, result.Participants[0].UserID)
+
+	_, err = s.assignHandler.Handle(s.ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "Joining a full quest should be a validation error")
+}
+
+func (s *TeamQuestHandlersContractSuite) TestConcurrentJoinsKeepEveryParticipant() {
+	q := s.createQuest(2, 1)
+	uow, ok := s.container.UnitOfWork.(*mocks.MockUnitOfWork)
+	s.Require().True(ok)
+	// Both joins would read the quest before either saves it, unless the quest is locked
+	uow.MockQuestRepository().DelaySaves(50 * time.Millisecond)
+
+	// Each join runs in its own unit of work over the same repositories, like two requests
+	joiners := []uuid.UUID{uuid.New(), uuid.New()}
+	joinErrs := make([]error, len(joiners))
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, userID := range joiners {
+		handler := commands.NewAssignQuestCommandHandler(mocks.NewMockUnitOfWorkSharing(uow), nil)
+		wg.Add(1)
+		go func(i int, userID uuid.UUID) {
+			defer wg.Done()
+			<-start
+			_, joinErrs[i] = handler.Handle(s.ctx, commands.AssignQuestCommand{ID: q.ID(), UserID: userID})
+		}(i, userID)
+	}
+	close(start)
+	wg.Wait()
+
+	for _, err := range joinErrs {
+		s.Require().NoError(err)
+	}
+	stored, err := s.container.UnitOfWork.QuestRepository().GetByID(s.ctx, q.ID())
+	s.Require().NoError(err)
+	s.ElementsMatch(joiners, stored.ParticipantIDs())
+}
+
+func (s *TeamQuestHandlersContractSuite) TestStartByParticipant() {
+	created := s.createQuest(2, 0)
+	participant := s.join(created.ID())
+
+	result, err := s.changeStatus(created.ID(), participant, quest.StatusInProgress)
+
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusInProgress), result.Status)
+	s.Require().Len(result.Participants, 1)
+	s.NotNil(result.Participants[0].StartedAt)
+}
+
+func (s *TeamQuestHandlersContractSuite) TestStartByNonParticipantIsForbidden() {
+	created := s.createQuest(2, 0)
+	s.join(created.ID())
+
+	_, err := s.changeStatus(created.ID(), s.creator, quest.StatusInProgress)
+
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "Only participants should start the quest")
+}
+
+func (s *TeamQuestHandlersContractSuite) TestCompleteByParticipantRequiresEvidence() {
+	created := s.createQuest(2, 1)
+	participant := s.join(created.ID())
+	_, err := s.changeStatus(created.ID(), participant, quest.StatusInProgress)
+	s.Require().NoError(err)
+
+	_, err = s.changeStatus(created.ID(), participant, quest.StatusCompleted)
+
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "Participants should complete through evidence")
+}
+
+func (s *TeamQuestHandlersContractSuite) TestPendingReviewIsNotSetDirectly() {
+	created := s.createQuest(2, 1)
+	participant := s.join(created.ID())
+	_, err := s.changeStatus(created.ID(), participant, quest.StatusInProgress)
+	s.Require().NoError(err)
+
+	_, err = s.changeStatus(created.ID(), s.creator, quest.StatusPendingReview)
+
+	var validationErr *errs.DomainValidationError
+	s.True(errors.As(err, &validationErr), "Review should only follow submitted evidence")
+}
+
+func (s *TeamQuestHandlersContractSuite) TestCompleteByCreator() {
+	created := s.createQuest(3, 3)
+	participant := s.join(created.ID())
+	_, err := s.changeStatus(created.ID(), participant, quest.StatusInProgress)
+	s.Require().NoError(err)
+
+	result, err := s.changeStatus(created.ID(), s.creator, quest.StatusCompleted)
+
+	s.Require().NoError(err)
+	s.Equal(string(quest.StatusCompleted), result.Status)
+}
+
+func (s *TeamQuestHandlersContractSuite) TestCompleteByOutsiderIsForbidden() {
+	created := s.createQuest(2, 0)
+	participant := s.join(created.ID())
+	_, err := s.changeStatus(created.ID(), participant, quest.StatusInProgress)
+	s.Require().NoError(err)
+
+	_, err = s.changeStatus(created.ID(), uuid.New(), quest.StatusCompleted)
+
+	var forbiddenErr *errs.ForbiddenError
+	s.True(errors.As(err, &forbiddenErr), "Outsiders should not complete the quest")
+}
@@ -207,3 +207,27 @@ func (s *UnitOfWorkContractSuite) TestConcurrentTransactions() {
 	// Skip this test if we're using a mock implementation that doesn't support concurrency
 	s.T().Skip("Skipping concurrent transactions test to avoid race conditions in CI")
 }
+
+func (s *UnitOfWorkContractSuite) TestAfterCommitRunsOnlyOnCommit() {
+	calls := 0
+
+	// Contract: Callbacks wait for the commit of the transaction they were registered in
+	s.Require().NoError(s.unitOfWork.Begin(s.ctx))
+	s.unitOfWork.AfterCommit(func() { calls++ })
+	s.unitOfWork.AfterCommit(func() { calls++ })
+	s.Equal(0, calls, "callbacks must not run before commit")
+	s.Require().NoError(s.unitOfWork.Commit(s.ctx))
+	s.Equal(2, calls)
+
+	// Contract: Rollback drops the callbacks, later commits do not run them
+	s.Require().NoError(s.unitOfWork.Begin(s.ctx))
+	s.unitOfWork.AfterCommit(func() { calls++ })
+	s.Require().NoError(s.unitOfWork.Rollback())
+	s.Require().NoError(s.unitOfWork.Begin(s.ctx))
+	s.Require().NoError(s.unitOfWork.Commit(s.ctx))
+	s.Equal(2, calls, "callbacks of a rolled back transaction must not run")
+
+	// Contract: Outside a transaction the callback runs right away
+	s.unitOfWork.AfterCommit(func() { calls++ })
+	s.Equal(3, calls)
+}
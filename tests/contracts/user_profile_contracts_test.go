@@ -0,0 +1,171 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/pkg/errs"
+	"quest-manager/tests/contracts/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// UserProfileHandlersContractSuite defines contract tests for the profile command and query handlers
+type UserProfileHandlersContractSuite struct {
+	suite.Suite
+	container     *mocks.ContractDIContainer
+	updateHandler commands.UpdateUserProfileCommandHandler
+	getHandler    queries.GetUserProfileQueryHandler
+	ctx           context.Context
+}
+
+func (s *UserProfileHandlersContractSuite) SetupSuite() {
+	s.container = mocks.NewContractDIContainer()
+	s.updateHandler = s.container.UpdateUserProfileHandler
+	s.getHandler = s.container.GetUserProfileHandler
+	s.ctx = context.Background()
+}
+
+func (s *UserProfileHandlersContractSuite) SetupTest() {
+	s.container.CleanupAll()
+}
+
+func TestUserProfileHandlersContract(t *testing.T) {
+	suite.Run(t, new(UserProfileHandlersContractSuite))
+}
+
+func (s *UserProfileHandlersContractSuite) TestGetMissingProfile() {
+	// Contract: Users without a saved profile get NotFoundError
+	_, err := s.getHandler.Handle(s.ctx, uuid.New())
+
+	var notFound *errs.NotFoundError
+	s.Require().True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *UserProfileHandlersContractSuite) TestUpdateCreatesProfile() {
+	// Contract: The first update creates the profile and raises user.created
+	userID := uuid.New()
+	radius := 15.0
+	home := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+
+	saved, err := s.updateHandler.Handle(s.ctx, commands.UpdateUserProfileCommand{
+		UserID:            userID,
+		DisplayName:       "  Alice  ",
+		Skills:            []string{"Photography", "photography", "navigation"},
+		Equipment:         []string{"camera"},
+		HomeLocation:      &home,
+		MaxTravelRadiusKm: &radius,
+	})
+	s.Require().NoError(err)
+	s.Equal(userID, saved.ID())
+	s.Equal("Alice", saved.DisplayName)
+	s.Equal([]string{"Photography", "navigation"}, saved.Skills)
+
+	loaded, err := s.getHandler.Handle(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Equal([]string{"camera"}, loaded.Equipment)
+	s.Require().NotNil(loaded.HomeLocation)
+	s.Equal(home, *loaded.HomeLocation)
+	s.Require().NotNil(loaded.MaxTravelRadiusKm)
+	s.Equal(radius, *loaded.MaxTravelRadiusKm)
+
+	events := s.container.EventPublisher.(*mocks.MockEventPublisher).PublishedEvents
+	s.Require().Len(events, 1)
+	s.Equal("user.created", events[0].GetName())
+}
+
+func (s *UserProfileHandlersContractSuite) TestUpdateReplacesProfile() {
+	// Contract: Later updates replace every field and keep the creation time
+	userID := uuid.New()
+	home := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	created, err := s.updateHandler.Handle(s.ctx, commands.UpdateUserProfileCommand{
+		UserID:       userID,
+		DisplayName:  "Alice",
+		Skills:       []string{"climbing"},
+		HomeLocation: &home,
+	})
+	s.Require().NoError(err)
+	createdAt := created.CreatedAt
+
+	updated, err := s.updateHandler.Handle(s.ctx, commands.UpdateUserProfileCommand{
+		UserID:      userID,
+		DisplayName: "Alice B.",
+	})
+	s.Require().NoError(err)
+	s.Equal("Alice B.", updated.DisplayName)
+	s.Empty(updated.Skills)
+	s.Nil(updated.HomeLocation, "omitted home location is cleared")
+	s.Equal(createdAt, updated.CreatedAt)
+
+	events := s.container.EventPublisher.(*mocks.MockEventPublisher).PublishedEvents
+	s.Require().Len(events, 2)
+	s.Equal("user.profile_updated", events[1].GetName())
+}
+
+func (s *UserProfileHandlersContractSuite) TestUpdateInvalidProfile() {
+	// Contract: Invalid profiles return DomainValidationError and nothing is stored
+	userID := uuid.New()
+	radius := user.MaxTravelRadiusLimitKm + 1
+
+	testCases := []commands.UpdateUserProfileCommand{
+		{UserID: userID, DisplayName: "   "},
+		{UserID: userID, DisplayName: "Alice", Skills: []string{""}},
+		{UserID: userID, DisplayName: "Alice", Equipment: []string{"rope, 20m"}},
+		{UserID: userID, DisplayName: "Alice", MaxTravelRadiusKm: &radius},
+		{UserID: userID, DisplayName: "Alice", HomeLocation: &kernel.GeoCoordinate{Lat: 91, Lon: 0}},
+	}
+
+	for _, cmd := range testCases {
+		_, err := s.updateHandler.Handle(s.ctx, cmd)
+		var validationErr *errs.DomainValidationError
+		s.True(errors.As(err, &validationErr), "expected DomainValidationError for %+v, got %v", cmd, err)
+	}
+
+	_, err := s.getHandler.Handle(s.ctx, userID)
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound))
+}
+
+func (s *UserProfileHandlersContractSuite) TestSavedProfileChangesRecommendations() {
+	// Contract: Recommendations are ranked on the saved profile
+	userID := uuid.New()
+	home := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.405}
+	diving := s.savePostedQuest("Diving", kernel.GeoCoordinate{Lat: 52.521, Lon: 13.405}, []string{"diving"})
+	climbing := s.savePostedQuest("Climbing", kernel.GeoCoordinate{Lat: 52.530, Lon: 13.405}, []string{"climbing"})
+
+	before, err := s.container.RecommendQuestsHandler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: userID, Position: &home})
+	s.Require().NoError(err)
+	s.Require().Len(before, 2)
+	s.Equal(diving.ID(), before[0].Quest.ID(), "without skills the nearer quest comes first")
+
+	_, err = s.updateHandler.Handle(s.ctx, commands.UpdateUserProfileCommand{
+		UserID:       userID,
+		DisplayName:  "Alice",
+		Skills:       []string{"Climbing"},
+		HomeLocation: &home,
+	})
+	s.Require().NoError(err)
+
+	after, err := s.container.RecommendQuestsHandler.Handle(s.ctx, queries.RecommendQuestsQuery{UserID: userID})
+	s.Require().NoError(err)
+	s.Require().Len(after, 2)
+	s.Equal(climbing.ID(), after[0].Quest.ID(), "the declared skill outweighs the distance")
+	s.Equal([]string{"climbing"}, after[0].MatchedRequirements)
+	s.Equal(diving.ID(), after[1].Quest.ID())
+}
+
+func (s *UserProfileHandlersContractSuite) savePostedQuest(title string, location kernel.GeoCoordinate, skills []string) quest.Quest {
+	q, err := quest.NewQuest(title, "Quest for recommendations", "easy", 3, 30,
+		location, location, "test-creator", []string{}, skills)
+	s.Require().NoError(err)
+	s.Require().NoError(q.ChangeStatus(quest.StatusPosted))
+	s.Require().NoError(s.container.QuestRepository.Save(s.ctx, q))
+	return q
+}
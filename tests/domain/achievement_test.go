@@ -0,0 +1,120 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for achievement definitions, facts and progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func completedFact(difficulty quest.Difficulty, locationKey string) achievement.Fact {
+	return achievement.Fact{
+		UserID:      uuid.New(),
+		QuestID:     uuid.New(),
+		Kind:        achievement.FactCompleted,
+		Difficulty:  difficulty,
+		LocationKey: locationKey,
+		OccurredAt:  time.Now(),
+	}
+}
+
+func TestDefinition_Validate(t *testing.T) {
+	valid := achievement.Definition{Code: "hard_worker", Title: "Hard Worker", Criterion: achievement.CriterionCompletedQuests, Count: 10, Difficulty: quest.DifficultyHard}
+	assert.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(d *achievement.Definition)
+	}{
+		{name: "empty code", modify: func(d *achievement.Definition) { d.Code = "" }},
+		{name: "uppercase code", modify: func(d *achievement.Definition) { d.Code = "Hard" }},
+		{name: "blank title", modify: func(d *achievement.Definition) { d.Title = "  " }},
+		{name: "unknown criterion", modify: func(d *achievement.Definition) { d.Criterion = "visited_tiles" }},
+		{name: "zero count", modify: func(d *achievement.Definition) { d.Count = 0 }},
+		{name: "unknown difficulty", modify: func(d *achievement.Definition) { d.Difficulty = "extreme" }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := valid
+			tc.modify(&d)
+			assert.Error(t, d.Validate())
+		})
+	}
+}
+
+func TestValidateDefinitions_DuplicateCode(t *testing.T) {
+	assert.NoError(t, achievement.ValidateDefinitions(achievement.DefaultDefinitions()))
+
+	definitions := append(achievement.DefaultDefinitions(), achievement.DefaultDefinitions()[0])
+	assert.ErrorContains(t, achievement.ValidateDefinitions(definitions), "duplicate code")
+}
+
+func TestDefinition_ProgressCountsMatchingDifficulty(t *testing.T) {
+	d := achievement.Definition{Code: "hard_pair", Title: "Hard Pair", Criterion: achievement.CriterionCompletedQuests, Count: 2, Difficulty: quest.DifficultyHard}
+	facts := []achievement.Fact{
+		completedFact(quest.DifficultyHard, "ucfv0j3"),
+		completedFact(quest.DifficultyEasy, "ucfv0j3"),
+	}
+
+	assert.Equal(t, 1, d.Progress(facts))
+	assert.False(t, d.IsMetBy(facts))
+
+	facts = append(facts, completedFact(quest.DifficultyHard, "u33dc0c"), completedFact(quest.DifficultyHard, "u09tvw0"))
+	assert.Equal(t, 2, d.Progress(facts), "progress is capped at the count")
+	assert.True(t, d.IsMetBy(facts))
+}
+
+func TestDefinition_DistinctLocations(t *testing.T) {
+	d := achievement.Definition{Code: "traveller", Title: "Traveller", Criterion: achievement.CriterionDistinctLocations, Count: 2}
+	facts := []achievement.Fact{
+		completedFact(quest.DifficultyEasy, "ucfv0j3"),
+		completedFact(quest.DifficultyHard, "ucfv0j3"),
+	}
+	assert.Equal(t, 1, d.Progress(facts))
+
+	assigned := completedFact(quest.DifficultyEasy, "u33dc0c")
+	assigned.Kind = achievement.FactAssigned
+	assert.Equal(t, 1, d.Progress(append(facts, assigned)), "assigned quests do not count as visited")
+
+	assert.True(t, d.IsMetBy(append(facts, completedFact(quest.DifficultyEasy, "u33dc0c"))))
+}
+
+func TestNewQuestFact_LocationKey(t *testing.T) {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	q, err := quest.NewQuest("Walk", "Walk around", "hard", 2, 30, location, location, uuid.New().String(), nil, nil)
+	assert.NoError(t, err)
+	userID := uuid.New()
+
+	fact := achievement.NewQuestFact(userID, q, achievement.FactCompleted, time.Now())
+
+	assert.Equal(t, userID, fact.UserID)
+	assert.Equal(t, q.ID(), fact.QuestID)
+	assert.Equal(t, quest.DifficultyHard, fact.Difficulty)
+	assert.Equal(t, location.Geohash(achievement.LocationGeohashPrecision), fact.LocationKey)
+
+	nearby := kernel.GeoCoordinate{Lat: 55.7559, Lon: 37.6174}
+	q.ExecutionLocation = nearby
+	assert.Equal(t, fact.LocationKey, achievement.NewQuestFact(userID, q, achievement.FactCompleted, time.Now()).LocationKey,
+		"coordinates about 10 m apart are the same location")
+}
+
+func TestNewAchievementUnlocked(t *testing.T) {
+	userID := uuid.New()
+	definition := achievement.DefaultDefinitions()[0]
+	unlocked := achievement.Unlock(userID, definition, time.Now())
+
+	event := achievement.NewAchievementUnlocked(unlocked)
+
+	assert.Equal(t, "user.achievement_unlocked", event.GetName())
+	assert.Equal(t, userID, event.GetAggregateID())
+	assert.Equal(t, definition.Code, event.Code)
+	assert.Equal(t, definition.Title, event.Title)
+}
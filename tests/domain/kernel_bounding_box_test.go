@@ -0,0 +1,170 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Bounding box correctness near the antimeridian and the poles,
+// including property-based checks against brute-force DistanceTo
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+func TestBoundingBoxForRadius_CrossesAntimeridian(t *testing.T) {
+	fiji := kernel.GeoCoordinate{Lat: -17.7134, Lon: 178.0650}
+	bbox := fiji.BoundingBoxForRadius(500)
+
+	assert.True(t, bbox.CrossesAntimeridian())
+	assert.Greater(t, bbox.MinLon, bbox.MaxLon)
+	assert.Len(t, bbox.LongitudeRanges(), 2)
+
+	// Point on the other side of the dateline (~150km east)
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: -17.7, Lon: -179.5}))
+	// Far away on the same latitude
+	assert.False(t, bbox.Contains(kernel.GeoCoordinate{Lat: -17.7, Lon: 0}))
+}
+
+func TestBoundingBoxForRadius_WestOfAntimeridian(t *testing.T) {
+	chukotka := kernel.GeoCoordinate{Lat: 65.0, Lon: -179.5}
+	bbox := chukotka.BoundingBoxForRadius(100)
+
+	assert.True(t, bbox.CrossesAntimeridian())
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: 65.0, Lon: 179.8}))
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: 65.0, Lon: -179.0}))
+}
+
+func TestBoundingBoxForRadius_RegularBoxHasSingleRange(t *testing.T) {
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	bbox := moscow.BoundingBoxForRadius(10)
+
+	assert.False(t, bbox.CrossesAntimeridian())
+	assert.Equal(t, []kernel.LongitudeRange{{Min: bbox.MinLon, Max: bbox.MaxLon}}, bbox.LongitudeRanges())
+}
+
+func TestBoundingBoxForRadius_ReachingPoleCoversAllLongitudes(t *testing.T) {
+	tests := []struct {
+		name   string
+		center kernel.GeoCoordinate
+	}{
+		{"north", kernel.GeoCoordinate{Lat: 89.5, Lon: 45}},
+		{"south", kernel.GeoCoordinate{Lat: -89.5, Lon: -120}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bbox := tt.center.BoundingBoxForRadius(100)
+
+			assert.GreaterOrEqual(t, bbox.MinLat, -90.0)
+			assert.LessOrEqual(t, bbox.MaxLat, 90.0)
+			assert.Equal(t, -180.0, bbox.MinLon)
+			assert.Equal(t, 180.0, bbox.MaxLon)
+			assert.True(t, bbox.MaxLat == 90.0 || bbox.MinLat == -90.0, "pole must be inside the box")
+		})
+	}
+}
+
+func TestBoundingBox_ContainsEdges(t *testing.T) {
+	bbox := kernel.BoundingBox{MinLat: 10, MaxLat: 20, MinLon: 170, MaxLon: -170}
+
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: 10, Lon: 170}))
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: 20, Lon: -170}))
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: 15, Lon: 180}))
+	assert.True(t, bbox.Contains(kernel.GeoCoordinate{Lat: 15, Lon: -180}))
+	assert.False(t, bbox.Contains(kernel.GeoCoordinate{Lat: 15, Lon: 0}))
+	assert.False(t, bbox.Contains(kernel.GeoCoordinate{Lat: 21, Lon: 175}))
+}
+
+// radiusScenario is a random search circle used by the property-based tests.
+// Centers are biased towards the poles and the antimeridian, where boxes break.
+type radiusScenario struct {
+	Center   kernel.GeoCoordinate
+	RadiusKm float64
+}
+
+func (radiusScenario) Generate(r *rand.Rand, _ int) reflect.Value {
+	lat := r.Float64()*180 - 90
+	if r.Intn(4) == 0 {
+		lat = math.Copysign(80+r.Float64()*10, lat)
+	}
+	lon := r.Float64()*360 - 180
+	if r.Intn(4) == 0 {
+		lon = math.Copysign(175+r.Float64()*5, lon)
+	}
+	// Log-uniform radius between 0.1 and 5000 km
+	radius := 0.1 * math.Pow(50000, r.Float64())
+
+	return reflect.ValueOf(radiusScenario{
+		Center:   kernel.GeoCoordinate{Lat: lat, Lon: lon},
+		RadiusKm: radius,
+	})
+}
+
+func quickConfig() *quick.Config {
+	return &quick.Config{MaxCount: 2000, Rand: rand.New(rand.NewSource(29))}
+}
+
+func TestBoundingBoxForRadius_Property_StaysWithinValidRanges(t *testing.T) {
+	property := func(sc radiusScenario) bool {
+		bbox := sc.Center.BoundingBoxForRadius(sc.RadiusKm)
+		return bbox.MinLat >= -90 && bbox.MaxLat <= 90 && bbox.MinLat <= bbox.MaxLat &&
+			bbox.MinLon >= -180 && bbox.MinLon <= 180 &&
+			bbox.MaxLon >= -180 && bbox.MaxLon <= 180 &&
+			bbox.Contains(sc.Center)
+	}
+
+	assert.NoError(t, quick.Check(property, quickConfig()))
+}
+
+func TestBoundingBoxForRadius_Property_ContainsPointsInsideCircle(t *testing.T) {
+	// Points generated at a random bearing and distance strictly inside the circle
+	property := func(sc radiusScenario, bearingSeed, distanceSeed uint16) bool {
+		bearing := float64(bearingSeed) / math.MaxUint16 * 2 * math.Pi
+		distance := sc.RadiusKm * 0.999 * float64(distanceSeed) / math.MaxUint16
+		point := destination(sc.Center, bearing, distance)
+
+		return sc.Center.BoundingBoxForRadius(sc.RadiusKm).Contains(point)
+	}
+
+	assert.NoError(t, quick.Check(property, quickConfig()))
+}
+
+func TestBoundingBoxForRadius_Property_AgreesWithBruteForceDistance(t *testing.T) {
+	// Brute force: every point of a global grid within the radius must be inside the box
+	property := func(sc radiusScenario) bool {
+		bbox := sc.Center.BoundingBoxForRadius(sc.RadiusKm)
+		for lat := -90.0; lat <= 90; lat += 2.5 {
+			for lon := -180.0; lon <= 180; lon += 2.5 {
+				p := kernel.GeoCoordinate{Lat: lat, Lon: lon}
+				if sc.Center.DistanceTo(p) <= sc.RadiusKm && !bbox.Contains(p) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 300, Rand: rand.New(rand.NewSource(29))}))
+}
+
+// destination returns the point reached from start by travelling distanceKm along bearing (radians).
+func destination(start kernel.GeoCoordinate, bearing, distanceKm float64) kernel.GeoCoordinate {
+	const earthRadiusKm = 6371.0
+	angular := distanceKm / earthRadiusKm
+	lat1 := start.Lat * math.Pi / 180
+	lon1 := start.Lon * math.Pi / 180
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(bearing))
+	lon2 := lon1 + math.Atan2(
+		math.Sin(bearing)*math.Sin(angular)*math.Cos(lat1),
+		math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2),
+	)
+
+	lon := math.Mod(lon2*180/math.Pi+540, 360) - 180
+	return kernel.GeoCoordinate{Lat: lat2 * 180 / math.Pi, Lon: lon}
+}
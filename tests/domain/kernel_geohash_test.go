@@ -0,0 +1,158 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for geohash encoding, neighbors and radius coverage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+func TestGeoCoordinate_Geohash(t *testing.T) {
+	tests := []struct {
+		name      string
+		coord     kernel.GeoCoordinate
+		precision int
+		expected  string
+	}{
+		{"Reference point", kernel.GeoCoordinate{Lat: 57.64911, Lon: 10.40744}, 11, "u4pruydqqvj"},
+		{"Short prefix", kernel.GeoCoordinate{Lat: 57.64911, Lon: 10.40744}, 3, "u4p"},
+		{"Southwest corner", kernel.GeoCoordinate{Lat: -90, Lon: -180}, 4, "0000"},
+		{"Northeast corner", kernel.GeoCoordinate{Lat: 90, Lon: 180}, 4, "zzzz"},
+		{"Precision below range is clamped", kernel.GeoCoordinate{Lat: 57.64911, Lon: 10.40744}, 0, "u"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.coord.Geohash(tt.precision))
+		})
+	}
+}
+
+func TestGeoCoordinate_Geohash_ClampsPrecision(t *testing.T) {
+	hash := kernel.GeoCoordinate{Lat: 57.64911, Lon: 10.40744}.Geohash(20)
+	assert.Len(t, hash, kernel.GeohashMaxPrecision)
+	assert.True(t, strings.HasPrefix(hash, "u4pruydqqvj"))
+}
+
+func TestDecodeGeohash_RoundTrip(t *testing.T) {
+	coord := kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954}
+	hash := coord.Geohash(kernel.GeohashMaxPrecision)
+
+	decoded, err := kernel.DecodeGeohash(hash)
+	assert.NoError(t, err)
+	assert.InDelta(t, coord.Lat, decoded.Lat, 1e-6)
+	assert.InDelta(t, coord.Lon, decoded.Lon, 1e-6)
+
+	bbox, err := kernel.GeohashBoundingBox(hash[:5])
+	assert.NoError(t, err)
+	assert.True(t, bbox.Contains(coord))
+}
+
+func TestDecodeGeohash_Invalid(t *testing.T) {
+	for _, hash := range []string{"", "u4pa", "u4pruydqqvj7u"} {
+		_, err := kernel.DecodeGeohash(hash)
+		assert.Error(t, err, hash)
+	}
+}
+
+func TestGeohashNeighbors(t *testing.T) {
+	neighbors, err := kernel.GeohashNeighbors("dqcjq")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dqcjw", "dqcjx", "dqcjr", "dqcjp", "dqcjn", "dqcjj", "dqcjm", "dqcjt"}, neighbors)
+}
+
+func TestGeohashNeighbors_Antimeridian(t *testing.T) {
+	hash := kernel.GeoCoordinate{Lat: 0.1, Lon: 179.99}.Geohash(4)
+	neighbors, err := kernel.GeohashNeighbors(hash)
+	assert.NoError(t, err)
+
+	east, err := kernel.DecodeGeohash(neighbors[2])
+	assert.NoError(t, err)
+	assert.Less(t, east.Lon, -179.0)
+}
+
+func TestGeohashNeighbors_Pole(t *testing.T) {
+	neighbors, err := kernel.GeohashNeighbors(kernel.GeoCoordinate{Lat: 89.99, Lon: 0}.Geohash(3))
+	assert.NoError(t, err)
+	assert.Len(t, neighbors, 5, "cells north of the pole are omitted")
+}
+
+func TestGeoCoordinate_GeohashesForRadius_CoverCircle(t *testing.T) {
+	tests := []struct {
+		name     string
+		center   kernel.GeoCoordinate
+		radiusKm float64
+	}{
+		{"Berlin 1km", kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954}, 1},
+		{"Berlin 25km", kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954}, 25},
+		{"Equator 100km", kernel.GeoCoordinate{Lat: 0, Lon: 0}, 100},
+		{"Antimeridian 50km", kernel.GeoCoordinate{Lat: -17.7, Lon: 179.9}, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefixes := tt.center.GeohashesForRadius(tt.radiusKm)
+			assert.NotEmpty(t, prefixes)
+
+			// Points on the circle in every direction must fall into one of the prefixes
+			bbox := tt.center.BoundingBoxForRadius(tt.radiusKm)
+			edges := []kernel.GeoCoordinate{
+				{Lat: bbox.MaxLat, Lon: tt.center.Lon},
+				{Lat: bbox.MinLat, Lon: tt.center.Lon},
+				{Lat: tt.center.Lat, Lon: bbox.MinLon},
+				{Lat: tt.center.Lat, Lon: bbox.MaxLon},
+			}
+			for _, edge := range edges {
+				assert.True(t, hasGeohashPrefix(edge.Geohash(kernel.GeohashMaxPrecision), prefixes), "%+v", edge)
+			}
+		})
+	}
+}
+
+func TestGeoCoordinate_GeohashPrecisionForRadius(t *testing.T) {
+	berlin := kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954}
+
+	assert.Greater(t, berlin.GeohashPrecisionForRadius(1), berlin.GeohashPrecisionForRadius(50))
+	assert.Equal(t, 0, berlin.GeohashPrecisionForRadius(5000), "radius larger than a top-level cell")
+	assert.Equal(t, 0, kernel.GeoCoordinate{Lat: 89.9, Lon: 0}.GeohashPrecisionForRadius(50), "circle reaches the pole")
+	assert.Nil(t, kernel.GeoCoordinate{Lat: 89.9, Lon: 0}.GeohashesForRadius(50))
+}
+
+func TestBoundingBox_Geohashes(t *testing.T) {
+	bbox := kernel.BoundingBox{MinLat: 52.3, MaxLat: 52.7, MinLon: 13.0, MaxLon: 13.8}
+
+	prefixes := bbox.Geohashes(16)
+	assert.NotEmpty(t, prefixes)
+	assert.LessOrEqual(t, len(prefixes), 16)
+
+	corners := []kernel.GeoCoordinate{
+		{Lat: bbox.MinLat, Lon: bbox.MinLon},
+		{Lat: bbox.MinLat, Lon: bbox.MaxLon},
+		{Lat: bbox.MaxLat, Lon: bbox.MinLon},
+		{Lat: bbox.MaxLat, Lon: bbox.MaxLon},
+		{Lat: 52.5, Lon: 13.4},
+	}
+	for _, c := range corners {
+		assert.True(t, hasGeohashPrefix(c.Geohash(kernel.GeohashMaxPrecision), prefixes), "%+v", c)
+	}
+}
+
+func TestBoundingBox_Geohashes_TooLarge(t *testing.T) {
+	world := kernel.BoundingBox{MinLat: -90, MaxLat: 90, MinLon: -180, MaxLon: 180}
+	assert.Nil(t, world.Geohashes(8))
+	assert.Len(t, world.Geohashes(32), 32)
+}
+
+func hasGeohashPrefix(hash string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(hash, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for polygon validation and point-in-polygon checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+func square(minLat, minLon, maxLat, maxLon float64) []kernel.GeoCoordinate {
+	return []kernel.GeoCoordinate{
+		{Lat: minLat, Lon: minLon},
+		{Lat: minLat, Lon: maxLon},
+		{Lat: maxLat, Lon: maxLon},
+		{Lat: maxLat, Lon: minLon},
+	}
+}
+
+func TestNewPolygon_ClosedAndOpenRingsAreEquivalent(t *testing.T) {
+	open := square(0, 0, 10, 10)
+	closed := append(square(0, 0, 10, 10), kernel.GeoCoordinate{Lat: 0, Lon: 0})
+
+	openPolygon, err := kernel.NewPolygon(open)
+	assert.NoError(t, err)
+	closedPolygon, err := kernel.NewPolygon(closed)
+	assert.NoError(t, err)
+
+	assert.Equal(t, openPolygon.Exterior(), closedPolygon.Exterior())
+	assert.Len(t, closedPolygon.Exterior(), 4)
+}
+
+func TestNewPolygon_Invalid(t *testing.T) {
+	tooMany := make([]kernel.GeoCoordinate, kernel.MaxPolygonVertices+1)
+	for i := range tooMany {
+		tooMany[i] = kernel.GeoCoordinate{Lat: float64(i%80) * 0.5, Lon: float64(i) * 0.1}
+	}
+
+	tests := []struct {
+		name     string
+		exterior []kernel.GeoCoordinate
+		holes    [][]kernel.GeoCoordinate
+	}{
+		{"Too few vertices", []kernel.GeoCoordinate{{Lat: 0, Lon: 0}, {Lat: 1, Lon: 1}}, nil},
+		{"Closed triangle missing vertex", []kernel.GeoCoordinate{{Lat: 0, Lon: 0}, {Lat: 1, Lon: 1}, {Lat: 0, Lon: 0}}, nil},
+		{"Latitude out of range", square(0, 0, 91, 10), nil},
+		{"Longitude out of range", square(0, 0, 10, 181), nil},
+		{"Crosses the antimeridian", square(-20, -170, -10, 175), nil},
+		{"Invalid hole", square(0, 0, 10, 10), [][]kernel.GeoCoordinate{{{Lat: 1, Lon: 1}, {Lat: 2, Lon: 2}}}},
+		{"Too many vertices", tooMany, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := kernel.NewPolygon(tt.exterior, tt.holes...)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewPolygon_RejectsAntimeridianCrossing(t *testing.T) {
+	// Fiji to Samoa: the edges between 175 and -170 cross the antimeridian
+	exterior := []kernel.GeoCoordinate{
+		{Lat: -20, Lon: 175},
+		{Lat: -20, Lon: -170},
+		{Lat: -10, Lon: -170},
+		{Lat: -10, Lon: 175},
+	}
+	_, err := kernel.NewPolygon(exterior)
+	assert.ErrorIs(t, err, kernel.ErrPolygonCrossesAntimeridian)
+
+	_, err = kernel.NewPolygon(square(0, 0, 10, 10), square(1, -179, 2, 179))
+	assert.ErrorIs(t, err, kernel.ErrPolygonCrossesAntimeridian)
+
+	// Wide polygons are fine as long as every edge spans less than 180 degrees
+	_, err = kernel.NewPolygon([]kernel.GeoCoordinate{
+		{Lat: 0, Lon: -170}, {Lat: 0, Lon: 0}, {Lat: 0, Lon: 170}, {Lat: 10, Lon: 170}, {Lat: 10, Lon: 0}, {Lat: 10, Lon: -170},
+	})
+	assert.NoError(t, err)
+}
+
+func TestPolygon_Contains(t *testing.T) {
+	// Square 0..10 with a hole 4..6
+	polygon, err := kernel.NewPolygon(square(0, 0, 10, 10), square(4, 4, 6, 6))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		point    kernel.GeoCoordinate
+		expected bool
+	}{
+		{"Inside", kernel.GeoCoordinate{Lat: 2, Lon: 2}, true},
+		{"Outside", kernel.GeoCoordinate{Lat: 11, Lon: 5}, false},
+		{"Vertex", kernel.GeoCoordinate{Lat: 0, Lon: 0}, true},
+		{"Exterior edge", kernel.GeoCoordinate{Lat: 10, Lon: 5}, true},
+		{"Inside hole", kernel.GeoCoordinate{Lat: 5, Lon: 5}, false},
+		{"Hole edge", kernel.GeoCoordinate{Lat: 4, Lon: 5}, true},
+		{"Same latitude as vertex, outside", kernel.GeoCoordinate{Lat: 0, Lon: -1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, polygon.Contains(tt.point))
+		})
+	}
+}
+
+func TestPolygon_ContainsConcave(t *testing.T) {
+	// U shape: notch between lon 3 and 7 above lat 3
+	polygon, err := kernel.NewPolygon([]kernel.GeoCoordinate{
+		{Lat: 0, Lon: 0}, {Lat: 0, Lon: 10}, {Lat: 10, Lon: 10}, {Lat: 10, Lon: 7},
+		{Lat: 3, Lon: 7}, {Lat: 3, Lon: 3}, {Lat: 10, Lon: 3}, {Lat: 10, Lon: 0},
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, polygon.Contains(kernel.GeoCoordinate{Lat: 8, Lon: 1}), "left arm")
+	assert.True(t, polygon.Contains(kernel.GeoCoordinate{Lat: 8, Lon: 9}), "right arm")
+	assert.True(t, polygon.Contains(kernel.GeoCoordinate{Lat: 1, Lon: 5}), "base")
+	assert.False(t, polygon.Contains(kernel.GeoCoordinate{Lat: 8, Lon: 5}), "notch")
+}
+
+func TestPolygon_BoundingBox(t *testing.T) {
+	polygon, err := kernel.NewPolygon([]kernel.GeoCoordinate{
+		{Lat: 55.7, Lon: 37.5}, {Lat: 55.65, Lon: 37.7}, {Lat: 55.8, Lon: 37.65},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, kernel.BoundingBox{MinLat: 55.65, MaxLat: 55.8, MinLon: 37.5, MaxLon: 37.7}, polygon.BoundingBox())
+}
@@ -0,0 +1,106 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for slippy map tile math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+)
+
+func TestNewTile_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		z, x, y int
+		valid   bool
+	}{
+		{"World tile", 0, 0, 0, true},
+		{"Last tile at zoom 3", 3, 7, 7, true},
+		{"Max zoom", kernel.MaxTileZoom, 0, 0, true},
+		{"Negative zoom", -1, 0, 0, false},
+		{"Zoom too deep", kernel.MaxTileZoom + 1, 0, 0, false},
+		{"X out of range", 3, 8, 0, false},
+		{"Y out of range", 3, 0, 8, false},
+		{"Negative x", 3, -1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := kernel.NewTile(tt.z, tt.x, tt.y)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTileForCoordinate_KnownTiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		coord    kernel.GeoCoordinate
+		z        int
+		expected kernel.Tile
+	}{
+		{"Berlin", kernel.GeoCoordinate{Lat: 52.52, Lon: 13.405}, 10, kernel.Tile{Z: 10, X: 550, Y: 335}},
+		{"North-west quadrant", kernel.GeoCoordinate{Lat: 10, Lon: -10}, 1, kernel.Tile{Z: 1, X: 0, Y: 0}},
+		{"South-east quadrant", kernel.GeoCoordinate{Lat: -10, Lon: 10}, 1, kernel.Tile{Z: 1, X: 1, Y: 1}},
+		{"Longitude 180 in last column", kernel.GeoCoordinate{Lat: 0.5, Lon: 180}, 2, kernel.Tile{Z: 2, X: 3, Y: 1}},
+		{"North pole in top row", kernel.GeoCoordinate{Lat: 90, Lon: 0}, 4, kernel.Tile{Z: 4, X: 8, Y: 0}},
+		{"South pole in bottom row", kernel.GeoCoordinate{Lat: -90, Lon: 0}, 4, kernel.Tile{Z: 4, X: 8, Y: 15}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, kernel.TileForCoordinate(tt.coord, tt.z))
+		})
+	}
+}
+
+func TestTile_BoundingBox(t *testing.T) {
+	world := kernel.Tile{Z: 0, X: 0, Y: 0}.BoundingBox()
+	assert.Equal(t, kernel.BoundingBox{MinLat: -90, MaxLat: 90, MinLon: -180, MaxLon: 180}, world)
+
+	// Inner tile uses Web Mercator bounds
+	bbox := kernel.Tile{Z: 2, X: 2, Y: 1}.BoundingBox()
+	assert.InDelta(t, 0.0, bbox.MinLon, 1e-9)
+	assert.InDelta(t, 90.0, bbox.MaxLon, 1e-9)
+	assert.InDelta(t, 0.0, bbox.MinLat, 1e-9)
+	assert.InDelta(t, 66.51326044311186, bbox.MaxLat, 1e-9)
+
+	// Every coordinate lies in the bounding box of its tile
+	for _, c := range []kernel.GeoCoordinate{
+		{Lat: 52.52, Lon: 13.405}, {Lat: -33.8688, Lon: 151.2093}, {Lat: 89.9, Lon: -179.9}, {Lat: -89.9, Lon: 179.9},
+	} {
+		for z := 0; z <= kernel.MaxTileZoom; z += 3 {
+			tile := kernel.TileForCoordinate(c, z)
+			assert.True(t, tile.BoundingBox().Contains(c), "%v in tile %s", c, tile)
+		}
+	}
+}
+
+func TestTile_Contains(t *testing.T) {
+	parent := kernel.Tile{Z: 3, X: 5, Y: 2}
+
+	assert.True(t, parent.Contains(parent))
+	assert.True(t, parent.Contains(kernel.Tile{Z: 4, X: 10, Y: 5}))
+	assert.True(t, parent.Contains(kernel.Tile{Z: 6, X: 47, Y: 23}))
+	assert.False(t, parent.Contains(kernel.Tile{Z: 4, X: 12, Y: 5}))
+	assert.False(t, parent.Contains(kernel.Tile{Z: 2, X: 2, Y: 1}), "ancestor is not contained")
+}
+
+func TestTile_Project(t *testing.T) {
+	tile := kernel.Tile{Z: 1, X: 1, Y: 0}
+
+	x, y := tile.Project(kernel.GeoCoordinate{Lat: 0, Lon: 0}, 4096)
+	assert.Equal(t, 0, x, "west edge")
+	assert.Equal(t, 4096, y, "equator is the bottom edge")
+
+	x, y = tile.Project(kernel.GeoCoordinate{Lat: kernel.MaxMercatorLatitude, Lon: 90}, 4096)
+	assert.Equal(t, 2048, x)
+	assert.InDelta(t, 0, y, 1)
+}
@@ -0,0 +1,101 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for leaderboard periods, scores and ranking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+)
+
+func TestLeaderboardPeriod_Start(t *testing.T) {
+	// Thursday, 15 October 2026, in UTC+3
+	now := time.Date(2026, time.October, 15, 1, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	testCases := []struct {
+		name     string
+		period   leaderboard.Period
+		now      time.Time
+		expected time.Time
+	}{
+		{name: "day in UTC", period: leaderboard.PeriodDay, now: now, expected: time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC)},
+		{name: "week from Monday", period: leaderboard.PeriodWeek, now: now, expected: time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)},
+		{name: "week on Sunday", period: leaderboard.PeriodWeek, now: time.Date(2026, time.October, 18, 23, 0, 0, 0, time.UTC), expected: time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)},
+		{name: "week on Monday", period: leaderboard.PeriodWeek, now: time.Date(2026, time.October, 12, 8, 0, 0, 0, time.UTC), expected: time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)},
+		{name: "month", period: leaderboard.PeriodMonth, now: now, expected: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.period.Start(tc.now))
+		})
+	}
+}
+
+func TestLeaderboardPeriodAndMetric_IsValid(t *testing.T) {
+	assert.True(t, leaderboard.PeriodDay.IsValid())
+	assert.True(t, leaderboard.PeriodWeek.IsValid())
+	assert.True(t, leaderboard.PeriodMonth.IsValid())
+	assert.False(t, leaderboard.Period("year").IsValid())
+
+	assert.True(t, leaderboard.MetricCompleted.IsValid())
+	assert.True(t, leaderboard.MetricPoints.IsValid())
+	assert.False(t, leaderboard.Metric("reward").IsValid())
+}
+
+func TestNewQuestCompletionScore(t *testing.T) {
+	entryID, userID, questID := uuid.New(), uuid.New(), uuid.New()
+	location := kernel.GeoCoordinate{Lat: 55.75, Lon: 37.61}
+	occurredAt := time.Now()
+
+	score, err := leaderboard.NewQuestCompletionScore(entryID, userID, questID, 30, location, occurredAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entryID, score.EntryID)
+	assert.Equal(t, userID, score.UserID)
+	if assert.NotNil(t, score.QuestID) {
+		assert.Equal(t, questID, *score.QuestID)
+	}
+	assert.True(t, score.Completion)
+	assert.Equal(t, &location, score.Location)
+	assert.Equal(t, 30, score.Points)
+}
+
+func TestNewScore_Invalid(t *testing.T) {
+	_, err := leaderboard.NewScore(uuid.Nil, uuid.New(), 10, time.Now())
+	assert.EqualError(t, err, "ledger entry is required")
+
+	_, err = leaderboard.NewScore(uuid.New(), uuid.Nil, 10, time.Now())
+	assert.EqualError(t, err, "user is required")
+}
+
+func TestScore_PointsOnlyDoNotCountAsCompleted(t *testing.T) {
+	score, err := leaderboard.NewScore(uuid.New(), uuid.New(), 5, time.Now())
+
+	assert.NoError(t, err)
+	assert.False(t, score.Completion)
+	assert.Nil(t, score.Location)
+	assert.Equal(t, 5, score.Points)
+}
+
+func TestAssignRanks_TiesShareRank(t *testing.T) {
+	standings := []leaderboard.Standing{
+		{UserID: uuid.New(), Score: 5},
+		{UserID: uuid.New(), Score: 3},
+		{UserID: uuid.New(), Score: 3},
+		{UserID: uuid.New(), Score: 1},
+	}
+
+	leaderboard.AssignRanks(standings)
+
+	assert.Equal(t, 1, standings[0].Rank)
+	assert.Equal(t, 2, standings[1].Rank)
+	assert.Equal(t, 2, standings[2].Rank)
+	assert.Equal(t, 4, standings[3].Rank)
+}
@@ -0,0 +1,95 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for ledger entries and the reward formula
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/ledger"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func TestNewQuestReward(t *testing.T) {
+	userID := uuid.New()
+	questID := uuid.New()
+
+	entry, err := ledger.NewQuestReward(userID, questID, 30)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, entry.ID)
+	assert.Equal(t, userID, entry.UserID)
+	assert.Equal(t, ledger.KindQuestReward, entry.Kind)
+	assert.Equal(t, 30, entry.Points)
+	if assert.NotNil(t, entry.QuestID) {
+		assert.Equal(t, questID, *entry.QuestID)
+	}
+	assert.Equal(t, ledger.QuestRewardKey(questID, userID), entry.IdempotencyKey)
+	assert.False(t, entry.CreatedAt.IsZero())
+}
+
+func TestNewQuestReward_Invalid(t *testing.T) {
+	_, err := ledger.NewQuestReward(uuid.Nil, uuid.New(), 10)
+	assert.EqualError(t, err, "user is required")
+
+	_, err = ledger.NewQuestReward(uuid.New(), uuid.New(), 0)
+	assert.EqualError(t, err, "points must be greater than 0")
+}
+
+func TestQuestRewardKey_UniquePerQuestAndUser(t *testing.T) {
+	questID := uuid.New()
+	userID := uuid.New()
+
+	assert.Equal(t, ledger.QuestRewardKey(questID, userID), ledger.QuestRewardKey(questID, userID))
+	assert.NotEqual(t, ledger.QuestRewardKey(questID, userID), ledger.QuestRewardKey(questID, uuid.New()))
+	assert.NotEqual(t, ledger.QuestRewardKey(questID, userID), ledger.QuestRewardKey(uuid.New(), userID))
+}
+
+func TestRewardFormula_Points(t *testing.T) {
+	formula := ledger.DefaultRewardFormula()
+
+	testCases := []struct {
+		name       string
+		reward     int
+		difficulty quest.Difficulty
+		expected   int
+	}{
+		{name: "easy", reward: 1, difficulty: quest.DifficultyEasy, expected: 10},
+		{name: "medium", reward: 3, difficulty: quest.DifficultyMedium, expected: 45},
+		{name: "hard", reward: 5, difficulty: quest.DifficultyHard, expected: 100},
+		{name: "unknown difficulty uses multiplier 1", reward: 2, difficulty: quest.Difficulty("legendary"), expected: 20},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formula.Points(tc.reward, tc.difficulty))
+		})
+	}
+}
+
+func TestRewardFormula_PointsAtLeastOne(t *testing.T) {
+	formula := ledger.DefaultRewardFormula()
+	formula.PointsPerReward = 1
+	formula.DifficultyMultipliers[quest.DifficultyEasy] = 0.1
+
+	assert.Equal(t, 1, formula.Points(1, quest.DifficultyEasy))
+}
+
+func TestRewardFormula_Validate(t *testing.T) {
+	assert.NoError(t, ledger.DefaultRewardFormula().Validate())
+
+	noPoints := ledger.DefaultRewardFormula()
+	noPoints.PointsPerReward = 0
+	assert.EqualError(t, noPoints.Validate(), "points per reward must be greater than 0")
+
+	negativeMultiplier := ledger.DefaultRewardFormula()
+	negativeMultiplier.DifficultyMultipliers[quest.DifficultyHard] = -1
+	assert.EqualError(t, negativeMultiplier.Validate(), `multiplier for difficulty "hard" must be greater than 0`)
+
+	missingMultiplier := ledger.DefaultRewardFormula()
+	delete(missingMultiplier.DifficultyMultipliers, quest.DifficultyMedium)
+	assert.EqualError(t, missingMultiplier.Validate(), `multiplier for difficulty "medium" must be greater than 0`)
+}
@@ -4,6 +4,7 @@ package domain
 // Tests for domain model business rules and validation logic
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -220,3 +221,78 @@ func TestLocation_TimestampBehavior(t *testing.T) {
 	assert.True(t, loc.UpdatedAt.After(originalUpdatedAt), "UpdatedAt should change after update")
 	assert.True(t, loc.UpdatedAt.After(createdAt), "UpdatedAt should be after CreatedAt")
 }
+
+func TestNewNamedLocation_Success(t *testing.T) {
+	coordinate := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	name := "  Red Square  "
+	address := "Red Square, Moscow"
+
+	loc, err := location.NewNamedLocation(coordinate, &name, &address)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, loc.Name)
+	assert.Equal(t, "Red Square", *loc.Name, "Name should be trimmed")
+	assert.Equal(t, address, *loc.Address)
+}
+
+func TestNewNamedLocation_InvalidName(t *testing.T) {
+	coordinate := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+
+	tests := []struct {
+		name        string
+		value       string
+		errContains string
+	}{
+		{"Empty name", "", "must not be empty"},
+		{"Whitespace only", "   ", "must not be empty"},
+		{"Too long", strings.Repeat("a", location.MaxNameLength+1), "too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := location.NewNamedLocation(coordinate, &tt.value, nil)
+			assert.Error(t, err)
+			assert.Nil(t, loc)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestNewLocation_HasNoName(t *testing.T) {
+	coordinate := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+
+	loc, err := location.NewLocation(coordinate, nil)
+
+	assert.NoError(t, err)
+	assert.Nil(t, loc.Name)
+}
+
+func TestLocation_Rename(t *testing.T) {
+	coordinate := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	loc, err := location.NewLocation(coordinate, nil)
+	assert.NoError(t, err)
+	loc.ClearDomainEvents()
+
+	name := "Gorky Park"
+	err = loc.Rename(&name)
+	assert.NoError(t, err)
+	assert.Equal(t, name, *loc.Name)
+
+	events := loc.GetDomainEvents()
+	assert.Len(t, events, 1)
+	updated, ok := events[0].(location.LocationUpdated)
+	assert.True(t, ok)
+	assert.Equal(t, name, *updated.Name)
+
+	// Removing the name is allowed
+	err = loc.Rename(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, loc.Name)
+
+	// Invalid names are rejected and keep the previous state
+	loc.Name = &name
+	empty := " "
+	err = loc.Rename(&empty)
+	assert.Error(t, err)
+	assert.Equal(t, name, *loc.Name)
+}
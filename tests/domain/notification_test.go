@@ -0,0 +1,94 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for notifications and notification preferences
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/notification"
+)
+
+func TestNotification_New(t *testing.T) {
+	userID, questID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	n, err := notification.New(userID, notification.KindQuestAssigned, questID, "Your quest \"Fence\" was taken", now)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, n.ID)
+	assert.Equal(t, userID, n.UserID)
+	assert.Equal(t, notification.KindQuestAssigned, n.Kind)
+	assert.Equal(t, questID, n.QuestID)
+	assert.Equal(t, now, n.CreatedAt)
+	assert.False(t, n.IsRead())
+}
+
+func TestNotification_New_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		userID  uuid.UUID
+		kind    notification.Kind
+		message string
+		wantErr string
+	}{
+		{name: "no user", userID: uuid.Nil, kind: notification.KindQuestAssigned, message: "Hi", wantErr: "user is required"},
+		{name: "unknown kind", userID: uuid.New(), kind: "quest_deleted", message: "Hi", wantErr: `unknown notification kind "quest_deleted"`},
+		{name: "no message", userID: uuid.New(), kind: notification.KindQuestAssigned, wantErr: "message is required"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := notification.New(tc.userID, tc.kind, uuid.New(), tc.message, time.Now())
+
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNotification_MarkRead_KeepsFirstTime(t *testing.T) {
+	n, err := notification.New(uuid.New(), notification.KindQuestCommentAdded, uuid.New(), "New comment", time.Now())
+	assert.NoError(t, err)
+	first := time.Now()
+
+	n.MarkRead(first)
+	n.MarkRead(first.Add(time.Hour))
+
+	assert.True(t, n.IsRead())
+	if assert.NotNil(t, n.ReadAt) {
+		assert.Equal(t, first, *n.ReadAt)
+	}
+}
+
+func TestNotification_Messages(t *testing.T) {
+	q := createValidQuest(t)
+	q.Title = "Fence"
+
+	assert.Equal(t, `Your quest "Fence" was taken`, notification.QuestAssignedMessage(*q))
+	assert.Equal(t, `Quest "Fence" is now completed`, notification.QuestStatusChangedMessage(*q, "completed"))
+	assert.Equal(t, `New comment on quest "Fence"`, notification.QuestCommentAddedMessage(*q))
+}
+
+func TestPreferences_ReceiveEveryKindByDefault(t *testing.T) {
+	p := notification.DefaultPreferences(uuid.New())
+
+	for _, kind := range notification.Kinds() {
+		assert.True(t, p.Receives(kind), kind)
+	}
+}
+
+func TestNewPreferences(t *testing.T) {
+	p, err := notification.NewPreferences(uuid.New(), map[notification.Kind]bool{
+		notification.KindQuestStatusChanged: false,
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, p.Receives(notification.KindQuestStatusChanged))
+	assert.True(t, p.Receives(notification.KindQuestAssigned))
+
+	_, err = notification.NewPreferences(uuid.New(), map[notification.Kind]bool{"quest_deleted": true})
+	assert.EqualError(t, err, `unknown notification kind "quest_deleted"`)
+}
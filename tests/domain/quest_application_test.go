@@ -0,0 +1,191 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for the quest application workflow (review assignment mode)
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func newReviewQuest(t *testing.T) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	q, err := quest.NewQuest("Review", "Quest assigned by review", "medium", 3, 60,
+		location, location, uuid.New().String(), nil, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, q.SetAssignmentMode("review"))
+	q.ClearDomainEvents()
+	return q
+}
+
+func TestNewQuest_AssignmentModeFirstComeByDefault(t *testing.T) {
+	q := newEligibilityQuest(t, nil, nil)
+
+	assert.Equal(t, quest.AssignmentFirstCome, q.AssignmentMode)
+}
+
+func TestQuest_SetAssignmentMode(t *testing.T) {
+	q := newEligibilityQuest(t, nil, nil)
+
+	assert.NoError(t, q.SetAssignmentMode("review"))
+	assert.Equal(t, quest.AssignmentReview, q.AssignmentMode)
+
+	assert.NoError(t, q.SetAssignmentMode(""))
+	assert.Equal(t, quest.AssignmentFirstCome, q.AssignmentMode)
+
+	assert.Error(t, q.SetAssignmentMode("lottery"))
+	assert.Equal(t, quest.AssignmentFirstCome, q.AssignmentMode)
+}
+
+func TestQuest_Apply_Success(t *testing.T) {
+	q := newReviewQuest(t)
+	applicant := uuid.New()
+
+	application, err := q.Apply(applicant, "  I know the area  ", nil)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, application.ID)
+	assert.Equal(t, q.ID(), application.QuestID)
+	assert.Equal(t, applicant, application.ApplicantID)
+	assert.Equal(t, "I know the area", application.Message)
+	assert.Equal(t, quest.ApplicationStatusPending, application.Status)
+	assert.Nil(t, q.Assignee, "applying must not assign the quest")
+
+	events := q.GetDomainEvents()
+	assert.Len(t, events, 1)
+	submitted, ok := events[0].(quest.ApplicationSubmitted)
+	assert.True(t, ok)
+	assert.Equal(t, "quest.application_submitted", submitted.GetName())
+	assert.Equal(t, application.ID, submitted.ApplicationID)
+	assert.Equal(t, applicant, submitted.ApplicantID)
+}
+
+func TestQuest_Apply_FirstComeQuestRejectsApplications(t *testing.T) {
+	q := newEligibilityQuest(t, nil, nil)
+
+	_, err := q.Apply(uuid.New(), "", nil)
+
+	assert.Error(t, err)
+}
+
+func TestQuest_Apply_Rules(t *testing.T) {
+	applicant := uuid.New()
+
+	t.Run("creator cannot apply", func(t *testing.T) {
+		q := newReviewQuest(t)
+		creator := uuid.MustParse(q.Creator)
+		_, err := q.Apply(creator, "", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("only once per user", func(t *testing.T) {
+		q := newReviewQuest(t)
+		first, err := q.Apply(applicant, "", nil)
+		assert.NoError(t, err)
+
+		_, err = q.Apply(applicant, "again", []quest.Application{first})
+		assert.Error(t, err)
+	})
+
+	t.Run("message too long", func(t *testing.T) {
+		q := newReviewQuest(t)
+		_, err := q.Apply(applicant, strings.Repeat("a", quest.MaxApplicationMessageLength+1), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("quest not open", func(t *testing.T) {
+		q := newReviewQuest(t)
+		assert.NoError(t, q.AssignTo(uuid.New()))
+		_, err := q.Apply(applicant, "", nil)
+		assert.EqualError(t, err, "quest accepts applications only if status is 'created' or 'posted'")
+	})
+
+	t.Run("team quest not open", func(t *testing.T) {
+		q := newReviewQuest(t)
+		assert.NoError(t, q.SetCapacity(2, 0))
+		q.Status = quest.StatusCompleted
+		_, err := q.Apply(applicant, "", nil)
+		assert.EqualError(t, err, "quest accepts applications only if status is 'created', 'posted' or 'assigned'")
+	})
+
+	t.Run("team quest full", func(t *testing.T) {
+		q := newReviewQuest(t)
+		assert.NoError(t, q.SetCapacity(2, 0))
+		assert.NoError(t, q.AssignTo(uuid.New()))
+		assert.NoError(t, q.AssignTo(uuid.New()))
+		_, err := q.Apply(applicant, "", nil)
+		assert.EqualError(t, err, "quest has reached its capacity of 2 participants")
+	})
+}
+
+func TestQuest_AcceptApplication_AssignsApplicant(t *testing.T) {
+	q := newReviewQuest(t)
+	applicant := uuid.New()
+	application, err := q.Apply(applicant, "", nil)
+	assert.NoError(t, err)
+	q.ClearDomainEvents()
+
+	err = q.AcceptApplication(&application)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quest.ApplicationStatusAccepted, application.Status)
+	if assert.NotNil(t, q.Assignee) {
+		assert.Equal(t, applicant, *q.Assignee)
+	}
+	assert.Equal(t, quest.StatusAssigned, q.Status)
+
+	names := make([]string, 0)
+	for _, e := range q.GetDomainEvents() {
+		names = append(names, e.GetName())
+	}
+	assert.Equal(t, []string{"quest.assigned", "quest.status_changed", "quest.application_accepted"}, names)
+}
+
+func TestQuest_AcceptApplication_SecondAcceptFails(t *testing.T) {
+	q := newReviewQuest(t)
+	first, err := q.Apply(uuid.New(), "", nil)
+	assert.NoError(t, err)
+	second, err := q.Apply(uuid.New(), "", []quest.Application{first})
+	assert.NoError(t, err)
+	assert.NoError(t, q.AcceptApplication(&first))
+
+	err = q.AcceptApplication(&second)
+
+	assert.Error(t, err, "quest is already assigned")
+	assert.Equal(t, quest.ApplicationStatusPending, second.Status)
+}
+
+func TestQuest_RejectApplication(t *testing.T) {
+	q := newReviewQuest(t)
+	application, err := q.Apply(uuid.New(), "", nil)
+	assert.NoError(t, err)
+	q.ClearDomainEvents()
+
+	assert.NoError(t, q.RejectApplication(&application))
+
+	assert.Equal(t, quest.ApplicationStatusRejected, application.Status)
+	assert.Nil(t, q.Assignee)
+	events := q.GetDomainEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "quest.application_rejected", events[0].GetName())
+
+	// Decided applications cannot be reviewed again
+	assert.Error(t, q.RejectApplication(&application))
+	assert.Error(t, q.AcceptApplication(&application))
+}
+
+func TestQuest_ReviewApplicationOfAnotherQuest(t *testing.T) {
+	q := newReviewQuest(t)
+	other := newReviewQuest(t)
+	application, err := other.Apply(uuid.New(), "", nil)
+	assert.NoError(t, err)
+
+	assert.Error(t, q.AcceptApplication(&application))
+	assert.Error(t, q.RejectApplication(&application))
+}
@@ -0,0 +1,178 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for the comment thread of a quest: visibility, validation and editing
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func TestQuest_AddComment_PublicWhileOpen(t *testing.T) {
+	q := createValidQuest(t)
+	q.Status = quest.StatusPosted
+	authorID := uuid.New()
+
+	comment, err := q.AddComment(authorID, "  Is a ladder needed?  ", "")
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, comment.ID)
+	assert.Equal(t, q.ID(), comment.QuestID)
+	assert.Equal(t, authorID, comment.AuthorID)
+	assert.Equal(t, "Is a ladder needed?", comment.Body)
+	assert.Equal(t, quest.CommentPublic, comment.Visibility)
+	assert.False(t, comment.CreatedAt.IsZero())
+	assert.Nil(t, comment.EditedAt)
+
+	events := q.GetDomainEvents()
+	if assert.NotEmpty(t, events) {
+		event, ok := events[len(events)-1].(quest.CommentAdded)
+		if assert.True(t, ok) {
+			assert.Equal(t, "quest.comment_added", event.GetName())
+			assert.Equal(t, q.ID(), event.GetAggregateID())
+			assert.Equal(t, comment.ID, event.CommentID)
+			assert.Equal(t, authorID, event.AuthorID)
+			assert.Equal(t, quest.CommentPublic, event.Visibility)
+		}
+	}
+}
+
+func TestQuest_AddComment_PrivateAfterAssignment(t *testing.T) {
+	creatorID, assigneeID := uuid.New(), uuid.New()
+	q := createValidQuest(t)
+	q.Creator = creatorID.String()
+	assert.NoError(t, q.AssignTo(assigneeID))
+
+	// Without visibility, comments after assignment are private
+	byAssignee, err := q.AddComment(assigneeID, "On my way", "")
+	assert.NoError(t, err)
+	assert.Equal(t, quest.CommentPrivate, byAssignee.Visibility)
+
+	byCreator, err := q.AddComment(creatorID, "Thanks", "private")
+	assert.NoError(t, err)
+	assert.Equal(t, quest.CommentPrivate, byCreator.Visibility)
+}
+
+func TestQuest_AddComment_Invalid(t *testing.T) {
+	creatorID, assigneeID := uuid.New(), uuid.New()
+
+	testCases := []struct {
+		name       string
+		assigned   bool
+		authorID   uuid.UUID
+		body       string
+		visibility string
+		wantErr    string
+	}{
+		{name: "empty body", authorID: uuid.New(), body: "   ", wantErr: "comment body is required"},
+		{
+			name:     "body too long",
+			authorID: uuid.New(),
+			body:     strings.Repeat("я", quest.MaxCommentLength+1),
+			wantErr:  "comment too long, maximum is 2000 characters",
+		},
+		{
+			name:       "unknown visibility",
+			authorID:   uuid.New(),
+			body:       "Hi",
+			visibility: "team",
+			wantErr:    "invalid comment visibility: must be one of 'public', 'private'",
+		},
+		{
+			name:       "private before assignment",
+			authorID:   creatorID,
+			body:       "Hi",
+			visibility: "private",
+			wantErr:    "private comments are allowed only after the quest is assigned",
+		},
+		{
+			name:       "public after assignment",
+			assigned:   true,
+			authorID:   assigneeID,
+			body:       "Hi",
+			visibility: "public",
+			wantErr:    "public comments are allowed only if status is 'created' or 'posted'",
+		},
+		{
+			name:     "stranger after assignment",
+			assigned: true,
+			authorID: uuid.New(),
+			body:     "Hi",
+			wantErr:  "only the creator and the participants can post private comments",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q := createValidQuest(t)
+			q.Creator = creatorID.String()
+			if tc.assigned {
+				assert.NoError(t, q.AssignTo(assigneeID))
+			}
+
+			_, err := q.AddComment(tc.authorID, tc.body, tc.visibility)
+
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestQuest_CanSeeComment(t *testing.T) {
+	creatorID, assigneeID, strangerID := uuid.New(), uuid.New(), uuid.New()
+	q := createValidQuest(t)
+	q.Creator = creatorID.String()
+	assert.NoError(t, q.AssignTo(assigneeID))
+
+	public := quest.Comment{Visibility: quest.CommentPublic}
+	private := quest.Comment{Visibility: quest.CommentPrivate}
+
+	assert.True(t, q.CanSeeComment(strangerID, public))
+	assert.False(t, q.CanSeeComment(strangerID, private))
+	assert.True(t, q.CanSeeComment(creatorID, private))
+	assert.True(t, q.CanSeeComment(assigneeID, private))
+}
+
+func TestQuest_EditComment(t *testing.T) {
+	q := createValidQuest(t)
+	authorID := uuid.New()
+	comment, err := q.AddComment(authorID, "Is a ladder needed?", "")
+	assert.NoError(t, err)
+
+	now := comment.CreatedAt.Add(quest.CommentEditWindow - time.Minute)
+	err = q.EditComment(&comment, authorID, " Is a tall ladder needed? ", now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Is a tall ladder needed?", comment.Body)
+	if assert.NotNil(t, comment.EditedAt) {
+		assert.Equal(t, now, *comment.EditedAt)
+	}
+}
+
+func TestQuest_EditComment_Invalid(t *testing.T) {
+	q := createValidQuest(t)
+	authorID := uuid.New()
+	comment, err := q.AddComment(authorID, "Is a ladder needed?", "")
+	assert.NoError(t, err)
+	inWindow := comment.CreatedAt.Add(time.Minute)
+
+	err = q.EditComment(&comment, uuid.New(), "Changed", inWindow)
+	assert.EqualError(t, err, "only the author can edit a comment")
+
+	err = q.EditComment(&comment, authorID, "Changed", comment.CreatedAt.Add(quest.CommentEditWindow+time.Second))
+	assert.EqualError(t, err, "comment can be edited only within 15 minutes after posting")
+
+	err = q.EditComment(&comment, authorID, "  ", inWindow)
+	assert.EqualError(t, err, "comment body is required")
+
+	other := createValidQuest(t)
+	err = other.EditComment(&comment, authorID, "Changed", inWindow)
+	assert.EqualError(t, err, "comment does not belong to this quest")
+
+	assert.Equal(t, "Is a ladder needed?", comment.Body)
+	assert.Nil(t, comment.EditedAt)
+}
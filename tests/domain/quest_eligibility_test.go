@@ -0,0 +1,84 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for quest eligibility checks against user capabilities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func newEligibilityQuest(t *testing.T, skills, equipment []string) quest.Quest {
+	execution := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	q, err := quest.NewQuest("Eligibility", "Quest with requirements", "easy", 1, 30,
+		execution, execution, "creator", equipment, skills)
+	assert.NoError(t, err)
+	return q
+}
+
+func TestNewQuest_EligibilityPolicyOffByDefault(t *testing.T) {
+	q := newEligibilityQuest(t, nil, nil)
+
+	assert.Equal(t, quest.EligibilityOff, q.EligibilityPolicy)
+}
+
+func TestQuest_SetEligibilityPolicy(t *testing.T) {
+	q := newEligibilityQuest(t, nil, nil)
+
+	assert.NoError(t, q.SetEligibilityPolicy("strict"))
+	assert.Equal(t, quest.EligibilityStrict, q.EligibilityPolicy)
+
+	assert.NoError(t, q.SetEligibilityPolicy(""))
+	assert.Equal(t, quest.EligibilityOff, q.EligibilityPolicy)
+
+	assert.Error(t, q.SetEligibilityPolicy("lenient"))
+	assert.Equal(t, quest.EligibilityOff, q.EligibilityPolicy)
+}
+
+func TestQuest_CheckEligibility_MissingRequirements(t *testing.T) {
+	q := newEligibilityQuest(t, []string{"Photography", "navigation", "photography"}, []string{"camera", "tripod"})
+
+	e := q.CheckEligibility(quest.Capabilities{
+		Skills:    []string{"PHOTOGRAPHY"},
+		Equipment: []string{" Camera "},
+	})
+
+	assert.False(t, e.Eligible())
+	assert.Equal(t, []string{"navigation"}, e.MissingSkills)
+	assert.Equal(t, []string{"tripod"}, e.MissingEquipment)
+	assert.Nil(t, e.DistanceKm, "distance is not checked without position and radius")
+}
+
+func TestQuest_CheckEligibility_AllRequirementsMet(t *testing.T) {
+	q := newEligibilityQuest(t, []string{"climbing"}, []string{"rope"})
+
+	e := q.CheckEligibility(quest.Capabilities{Skills: []string{"climbing", "diving"}, Equipment: []string{"rope"}})
+
+	assert.True(t, e.Eligible())
+	assert.Empty(t, e.MissingSkills)
+	assert.Empty(t, e.MissingEquipment)
+}
+
+func TestQuest_CheckEligibility_Distance(t *testing.T) {
+	q := newEligibilityQuest(t, nil, nil)
+	// Saint Petersburg is about 630 km from the execution location in Moscow
+	home := kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3351}
+
+	near := 1000.0
+	e := q.CheckEligibility(quest.Capabilities{Position: &home, MaxDistanceKm: &near})
+	assert.True(t, e.Eligible())
+	assert.NotNil(t, e.DistanceKm)
+
+	far := 100.0
+	e = q.CheckEligibility(quest.Capabilities{Position: &home, MaxDistanceKm: &far})
+	assert.False(t, e.Eligible())
+	assert.True(t, e.TooFar())
+	assert.InDelta(t, 634, *e.DistanceKm, 10)
+
+	e = q.CheckEligibility(quest.Capabilities{Position: &home})
+	assert.True(t, e.Eligible(), "no radius means no distance check")
+}
@@ -1,7 +1,7 @@
 package domain
 
 // DOMAIN EVENTS UNIT TESTS
-// Tests for quest domain events: quest.created and quest.assigned
+// Tests for quest domain events: quest.created, quest.assigned and quest.status_changed
 
 import (
 	"testing"
@@ -93,6 +93,45 @@ func TestQuest_ChangeStatus_DomainEvents(t *testing.T) {
 	}
 }
 
+func TestQuest_ChangeStatus_ReopenEventCarriesActorAndReleasedParticipants(t *testing.T) {
+	q := createValidQuestForEvents(t)
+	assigneeID := uuid.New()
+	actorID := uuid.New()
+	assert.NoError(t, q.AssignTo(assigneeID))
+	q.ClearDomainEvents()
+
+	// Act - reopen the quest on behalf of the actor
+	q.SetActor(actorID)
+	err := q.ChangeStatus(quest.StatusPosted)
+	assert.NoError(t, err)
+
+	// Assert - the event names the participants the quest no longer has
+	events := q.GetDomainEvents()
+	assert.Len(t, events, 1)
+	if assert.IsType(t, quest.QuestStatusChanged{}, events[0]) {
+		evt := events[0].(quest.QuestStatusChanged)
+		assert.Equal(t, &actorID, evt.ActorID)
+		assert.Equal(t, []uuid.UUID{assigneeID}, evt.ReleasedParticipantIDs)
+	}
+	assert.Empty(t, q.Participants)
+}
+
+func TestQuest_ChangeStatus_EventWithoutActor(t *testing.T) {
+	q := createValidQuestForEvents(t)
+	assert.NoError(t, q.AssignTo(uuid.New()))
+	q.ClearDomainEvents()
+
+	err := q.ChangeStatus(quest.StatusInProgress)
+	assert.NoError(t, err)
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 1) && assert.IsType(t, quest.QuestStatusChanged{}, events[0]) {
+		evt := events[0].(quest.QuestStatusChanged)
+		assert.Nil(t, evt.ActorID)
+		assert.Empty(t, evt.ReleasedParticipantIDs, "Only reopening releases participants")
+	}
+}
+
 func TestQuest_GetDomainEvents_Immutability(t *testing.T) {
 	q := createValidQuestForEvents(t)
 
@@ -0,0 +1,230 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for completion evidence and the creator's review
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+var evidencePosition = kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+
+func photo(name, contentType string, size int64) quest.Attachment {
+	return quest.Attachment{
+		ID:          uuid.New(),
+		FileName:    name,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  "quests/test/" + name,
+	}
+}
+
+// startedTeamQuest returns an in-progress quest whose participants have all started
+func startedTeamQuest(t *testing.T, creator uuid.UUID, quorum int, participants ...uuid.UUID) quest.Quest {
+	q := newTeamQuest(t, creator, len(participants), quorum)
+	for _, p := range participants {
+		assert.NoError(t, q.AssignTo(p))
+	}
+	for _, p := range participants {
+		assert.NoError(t, q.Start(p))
+	}
+	q.ClearDomainEvents()
+	return q
+}
+
+func TestQuest_SubmitEvidence_ReachingQuorumGoesToReview(t *testing.T) {
+	users := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	q := startedTeamQuest(t, uuid.New(), 2, users...)
+
+	first, err := q.SubmitEvidence(users[0], "  Collected four bags  ", evidencePosition, []quest.Attachment{photo("bags.jpg", "image/jpeg", 1024)})
+	assert.NoError(t, err)
+	assert.Equal(t, quest.StatusInProgress, q.Status, "One submission is below the quorum")
+	assert.Equal(t, "Collected four bags", first.Note)
+	assert.Equal(t, quest.EvidenceStatusPending, first.Status)
+	assert.Equal(t, q.ID(), first.QuestID)
+	assert.Equal(t, users[0], first.SubmittedBy)
+	assert.Len(t, first.Attachments, 1)
+
+	_, err = q.SubmitEvidence(users[0], "again", evidencePosition, nil)
+	assert.EqualError(t, err, "participant has already submitted completion evidence")
+
+	_, err = q.SubmitEvidence(users[1], "", evidencePosition, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, quest.StatusPendingReview, q.Status)
+	assert.Equal(t, 2, q.CompletionVotes())
+
+	_, err = q.SubmitEvidence(users[2], "", evidencePosition, nil)
+	assert.EqualError(t, err, "completion evidence can only be submitted if status is 'in_progress'")
+}
+
+func TestQuest_SubmitEvidence_RaisesParticipantCompletedEvent(t *testing.T) {
+	participant := uuid.New()
+	q := startedTeamQuest(t, uuid.New(), 1, participant)
+
+	evidence, err := q.SubmitEvidence(participant, "Done", evidencePosition, nil)
+	assert.NoError(t, err)
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 2) {
+		event, ok := events[0].(quest.QuestParticipantCompleted)
+		if assert.True(t, ok) {
+			assert.Equal(t, "quest.participant_completed", event.GetName())
+			assert.Equal(t, participant, event.UserID)
+			assert.Equal(t, evidence.ID, event.EvidenceID)
+		}
+		assert.Equal(t, "quest.status_changed", events[1].GetName())
+	}
+}
+
+func TestQuest_SubmitEvidence_ParticipantMustStartFirst(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 1)
+	first, second := uuid.New(), uuid.New()
+	assert.NoError(t, q.AssignTo(first))
+	assert.NoError(t, q.AssignTo(second))
+	assert.NoError(t, q.Start(first))
+
+	_, err := q.SubmitEvidence(second, "", evidencePosition, nil)
+
+	assert.EqualError(t, err, "participant has to start the quest before completing it")
+	assert.Equal(t, quest.StatusInProgress, q.Status)
+}
+
+func TestQuest_SubmitEvidence_NonParticipant(t *testing.T) {
+	q := startedTeamQuest(t, uuid.New(), 1, uuid.New())
+
+	_, err := q.SubmitEvidence(uuid.New(), "", evidencePosition, nil)
+
+	assert.ErrorIs(t, err, quest.ErrNotParticipant)
+}
+
+func TestQuest_SubmitEvidence_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		note        string
+		attachments []quest.Attachment
+		expectedErr string
+	}{
+		{
+			name:        "note too long",
+			note:        strings.Repeat("a", quest.MaxEvidenceNoteLength+1),
+			expectedErr: "evidence note too long, maximum is 2000 characters",
+		},
+		{
+			name: "too many photos",
+			attachments: []quest.Attachment{
+				photo("1.jpg", "image/jpeg", 1), photo("2.jpg", "image/jpeg", 1), photo("3.jpg", "image/jpeg", 1),
+				photo("4.jpg", "image/jpeg", 1), photo("5.jpg", "image/jpeg", 1), photo("6.jpg", "image/jpeg", 1),
+			},
+			expectedErr: "too many attachments, maximum is 5",
+		},
+		{
+			name:        "not an image",
+			attachments: []quest.Attachment{photo("report.pdf", "application/pdf", 100)},
+			expectedErr: `attachment "report.pdf" must be a JPEG, PNG or WebP image`,
+		},
+		{
+			name:        "photo too large",
+			attachments: []quest.Attachment{photo("huge.png", "image/png", quest.MaxAttachmentSize+1)},
+			expectedErr: `attachment "huge.png" must be between 1 byte and 5 MB`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			participant := uuid.New()
+			q := startedTeamQuest(t, uuid.New(), 1, participant)
+
+			_, err := q.SubmitEvidence(participant, tc.note, evidencePosition, tc.attachments)
+
+			assert.EqualError(t, err, tc.expectedErr)
+			assert.Equal(t, 0, q.CompletionVotes())
+			assert.Empty(t, q.GetDomainEvents())
+		})
+	}
+}
+
+func TestQuest_ApproveCompletion(t *testing.T) {
+	participant := uuid.New()
+	q := startedTeamQuest(t, uuid.New(), 1, participant)
+	evidence, err := q.SubmitEvidence(participant, "Done", evidencePosition, nil)
+	assert.NoError(t, err)
+	q.ClearDomainEvents()
+
+	assert.NoError(t, q.ApproveCompletion([]*quest.Evidence{&evidence}))
+
+	assert.Equal(t, quest.StatusCompleted, q.Status)
+	assert.Equal(t, quest.EvidenceStatusApproved, evidence.Status)
+	assert.NotNil(t, evidence.ReviewedAt)
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "quest.status_changed", events[0].GetName())
+		approved, ok := events[1].(quest.CompletionApproved)
+		if assert.True(t, ok) {
+			assert.Equal(t, "quest.completion_approved", approved.GetName())
+			assert.Equal(t, []uuid.UUID{evidence.ID}, approved.EvidenceIDs)
+		}
+	}
+}
+
+func TestQuest_ApproveCompletion_RequiresReview(t *testing.T) {
+	q := startedTeamQuest(t, uuid.New(), 1, uuid.New())
+
+	err := q.ApproveCompletion(nil)
+
+	assert.EqualError(t, err, "completion can only be approved if status is 'pending_review'")
+	assert.Equal(t, quest.StatusInProgress, q.Status)
+}
+
+func TestQuest_RejectCompletion_BackToWork(t *testing.T) {
+	users := []uuid.UUID{uuid.New(), uuid.New()}
+	q := startedTeamQuest(t, uuid.New(), 2, users...)
+	first, err := q.SubmitEvidence(users[0], "Done", evidencePosition, nil)
+	assert.NoError(t, err)
+	second, err := q.SubmitEvidence(users[1], "Done too", evidencePosition, nil)
+	assert.NoError(t, err)
+	q.ClearDomainEvents()
+
+	assert.NoError(t, q.RejectCompletion([]*quest.Evidence{&first, &second}, " The bins are still full "))
+
+	assert.Equal(t, quest.StatusInProgress, q.Status)
+	assert.Equal(t, 0, q.CompletionVotes(), "Participants have to submit new evidence")
+	for _, e := range []quest.Evidence{first, second} {
+		assert.Equal(t, quest.EvidenceStatusRejected, e.Status)
+		assert.Equal(t, "The bins are still full", e.RejectionReason)
+	}
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 2) {
+		rejected, ok := events[1].(quest.CompletionRejected)
+		if assert.True(t, ok) {
+			assert.Equal(t, "quest.completion_rejected", rejected.GetName())
+			assert.Equal(t, "The bins are still full", rejected.Reason)
+			assert.Len(t, rejected.EvidenceIDs, 2)
+		}
+	}
+
+	// A participant can submit again after the rejection
+	_, err = q.SubmitEvidence(users[0], "Emptied the bins", evidencePosition, nil)
+	assert.NoError(t, err)
+}
+
+func TestQuest_RejectCompletion_ReasonRequired(t *testing.T) {
+	participant := uuid.New()
+	q := startedTeamQuest(t, uuid.New(), 1, participant)
+	evidence, err := q.SubmitEvidence(participant, "Done", evidencePosition, nil)
+	assert.NoError(t, err)
+
+	err = q.RejectCompletion([]*quest.Evidence{&evidence}, "   ")
+
+	assert.EqualError(t, err, "rejection reason is required")
+	assert.Equal(t, quest.StatusPendingReview, q.Status)
+	assert.Equal(t, quest.EvidenceStatusPending, evidence.Status)
+}
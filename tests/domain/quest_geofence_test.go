@@ -0,0 +1,102 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for the quest geofence and positions in status change events
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func TestNewQuest_NoGeofenceByDefault(t *testing.T) {
+	q := createValidQuest(t)
+
+	assert.Equal(t, 0, q.GeofenceRadius)
+	assert.False(t, q.HasGeofence())
+}
+
+func TestQuest_SetGeofenceRadius(t *testing.T) {
+	testCases := []struct {
+		name        string
+		radius      int
+		expectedErr string
+	}{
+		{name: "disabled", radius: 0},
+		{name: "on-site radius", radius: 150},
+		{name: "maximum radius", radius: quest.MaxGeofenceRadius},
+		{name: "negative radius", radius: -1, expectedErr: "geofence radius must be between 0 and 10000 meters"},
+		{name: "radius too large", radius: quest.MaxGeofenceRadius + 1, expectedErr: "geofence radius must be between 0 and 10000 meters"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q := createValidQuest(t)
+
+			err := q.SetGeofenceRadius(tc.radius)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				assert.Equal(t, 0, q.GeofenceRadius)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.radius, q.GeofenceRadius)
+			assert.Equal(t, tc.radius > 0, q.HasGeofence())
+		})
+	}
+}
+
+func TestQuest_ReportPosition_RecordedInStatusChangedEvent(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 1, 1)
+	participant := uuid.New()
+	assert.NoError(t, q.AssignTo(participant))
+	q.ClearDomainEvents()
+	position := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+
+	q.ReportPosition(position)
+	assert.NoError(t, q.Start(participant))
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 1) {
+		statusChanged, ok := events[0].(quest.QuestStatusChanged)
+		assert.True(t, ok)
+		assert.Equal(t, quest.StatusInProgress, statusChanged.NewStatus)
+		if assert.NotNil(t, statusChanged.Position) {
+			assert.Equal(t, position, *statusChanged.Position)
+		}
+	}
+}
+
+func TestQuest_ChangeStatus_WithoutReportedPosition(t *testing.T) {
+	q := createValidQuest(t)
+	q.ClearDomainEvents()
+
+	assert.NoError(t, q.ChangeStatus(quest.StatusPosted))
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 1) {
+		statusChanged, ok := events[0].(quest.QuestStatusChanged)
+		assert.True(t, ok)
+		assert.Nil(t, statusChanged.Position)
+	}
+}
+
+func TestQuest_ReportPosition_UsedByNextStatusChangeOnly(t *testing.T) {
+	q := createValidQuest(t)
+	q.ClearDomainEvents()
+
+	q.ReportPosition(kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176})
+	assert.NoError(t, q.ChangeStatus(quest.StatusPosted))
+	assert.NoError(t, q.ChangeStatus(quest.StatusCreated))
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 2) {
+		assert.NotNil(t, events[0].(quest.QuestStatusChanged).Position)
+		assert.Nil(t, events[1].(quest.QuestStatusChanged).Position)
+	}
+}
@@ -0,0 +1,208 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for team quests: capacity, participants and completion by the creator
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func newTeamQuest(t *testing.T, creator uuid.UUID, capacity, quorum int) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	q, err := quest.NewQuest("Clean-up", "Community park clean-up", "easy", 2, 120,
+		location, location, creator.String(), nil, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, q.SetCapacity(capacity, quorum))
+	q.ClearDomainEvents()
+	return q
+}
+
+func TestNewQuest_SingleParticipantByDefault(t *testing.T) {
+	q := createValidQuest(t)
+
+	assert.Equal(t, 1, q.Capacity)
+	assert.Equal(t, 1, q.CompletionQuorum)
+	assert.Empty(t, q.Participants)
+}
+
+func TestQuest_SetCapacity(t *testing.T) {
+	testCases := []struct {
+		name           string
+		capacity       int
+		quorum         int
+		expectedQuorum int
+		expectedErr    string
+	}{
+		{name: "zero capacity means one", capacity: 0, quorum: 0, expectedQuorum: 1},
+		{name: "majority quorum by default", capacity: 5, quorum: 0, expectedQuorum: 3},
+		{name: "explicit quorum", capacity: 4, quorum: 4, expectedQuorum: 4},
+		{name: "capacity too large", capacity: quest.MaxCapacity + 1, expectedErr: "capacity must be between 1 and 100"},
+		{name: "negative capacity", capacity: -1, expectedErr: "capacity must be between 1 and 100"},
+		{name: "quorum above capacity", capacity: 2, quorum: 3, expectedErr: "completion quorum must be between 1 and the capacity"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q := createValidQuest(t)
+
+			err := q.SetCapacity(tc.capacity, tc.quorum)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedQuorum, q.CompletionQuorum)
+		})
+	}
+}
+
+func TestQuest_AssignTo_TeamQuestFillsUpToCapacity(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 0)
+	first, second := uuid.New(), uuid.New()
+
+	assert.NoError(t, q.AssignTo(first))
+	assert.Equal(t, quest.StatusAssigned, q.Status)
+	assert.NoError(t, q.AssignTo(second))
+	assert.Equal(t, quest.StatusAssigned, q.Status)
+
+	assert.Len(t, q.Participants, 2)
+	assert.Equal(t, first, *q.Assignee, "Assignee should stay the first participant")
+	assert.True(t, q.IsFull())
+
+	err := q.AssignTo(uuid.New())
+	assert.EqualError(t, err, "quest has reached its capacity of 2 participants")
+
+	// Every join raises quest.assigned, the status changes only once
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 3) {
+		assert.Equal(t, "quest.assigned", events[0].GetName())
+		assert.Equal(t, "quest.status_changed", events[1].GetName())
+		assert.Equal(t, "quest.assigned", events[2].GetName())
+	}
+}
+
+func TestQuest_AssignTo_TeamQuestRejectsSameUserTwice(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 3, 0)
+	userID := uuid.New()
+	assert.NoError(t, q.AssignTo(userID))
+
+	err := q.AssignTo(userID)
+
+	assert.EqualError(t, err, "user is already a participant of this quest")
+	assert.Len(t, q.Participants, 1)
+}
+
+func TestQuest_Start_FirstParticipantMovesQuestInProgress(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 0)
+	first, second := uuid.New(), uuid.New()
+	assert.NoError(t, q.AssignTo(first))
+	assert.NoError(t, q.AssignTo(second))
+
+	assert.NoError(t, q.Start(first))
+	assert.Equal(t, quest.StatusInProgress, q.Status)
+
+	// Later participants join the work without another status change
+	assert.NoError(t, q.Start(second))
+	assert.Equal(t, quest.StatusInProgress, q.Status)
+	for _, p := range q.Participants {
+		assert.NotNil(t, p.StartedAt)
+	}
+
+	assert.EqualError(t, q.Start(first), "participant has already started the quest")
+}
+
+func TestQuest_Start_NonParticipant(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 0)
+	assert.NoError(t, q.AssignTo(uuid.New()))
+
+	err := q.Start(uuid.New())
+
+	assert.ErrorIs(t, err, quest.ErrNotParticipant)
+	assert.Equal(t, quest.StatusAssigned, q.Status)
+}
+
+func TestQuest_Complete_ParticipantSubmitsEvidence(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 1)
+	participant := uuid.New()
+	assert.NoError(t, q.AssignTo(participant))
+	assert.NoError(t, q.Start(participant))
+
+	err := q.Complete(participant)
+
+	assert.EqualError(t, err, "participants complete a quest by submitting completion evidence")
+	assert.Equal(t, quest.StatusInProgress, q.Status)
+}
+
+func TestQuest_Complete_ByCreator(t *testing.T) {
+	creator := uuid.New()
+	q := newTeamQuest(t, creator, 3, 3)
+	participant := uuid.New()
+	assert.NoError(t, q.AssignTo(participant))
+	assert.NoError(t, q.Start(participant))
+
+	assert.NoError(t, q.Complete(creator))
+
+	assert.Equal(t, quest.StatusCompleted, q.Status)
+	assert.Equal(t, 0, q.CompletionVotes())
+}
+
+func TestQuest_Complete_NonParticipant(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 0)
+	participant := uuid.New()
+	assert.NoError(t, q.AssignTo(participant))
+	assert.NoError(t, q.Start(participant))
+
+	err := q.Complete(uuid.New())
+
+	assert.ErrorIs(t, err, quest.ErrNotParticipant)
+}
+
+func TestQuest_Complete_RequiresInProgress(t *testing.T) {
+	creator := uuid.New()
+	q := newTeamQuest(t, creator, 2, 0)
+	assert.NoError(t, q.AssignTo(uuid.New()))
+
+	err := q.Complete(creator)
+
+	assert.EqualError(t, err, "quest can only be completed if status is 'in_progress'")
+}
+
+func TestQuest_ChangeStatus_RepostingReleasesParticipants(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 0)
+	assert.NoError(t, q.AssignTo(uuid.New()))
+	assert.NoError(t, q.AssignTo(uuid.New()))
+
+	assert.NoError(t, q.ChangeStatus(quest.StatusPosted))
+
+	assert.Empty(t, q.Participants)
+	assert.Nil(t, q.Assignee)
+	assert.NoError(t, q.AssignTo(uuid.New()), "Reposted quest should take participants again")
+}
+
+func TestQuest_RewardRecipients_TeamParticipants(t *testing.T) {
+	q := newTeamQuest(t, uuid.New(), 2, 0)
+	first, second := uuid.New(), uuid.New()
+	assert.NoError(t, q.AssignTo(first))
+	assert.NoError(t, q.AssignTo(second))
+
+	assert.Equal(t, []uuid.UUID{first, second}, q.RewardRecipients())
+}
+
+func TestQuest_RewardRecipients_CreatorCompletingOwnQuest(t *testing.T) {
+	creator := uuid.New()
+	q := newTeamQuest(t, creator, 1, 0)
+	assert.NoError(t, q.AssignTo(creator))
+	assert.NoError(t, q.Start(creator))
+	assert.NoError(t, q.Complete(creator))
+
+	assert.Equal(t, quest.StatusCompleted, q.Status)
+	assert.Equal(t, []uuid.UUID{creator}, q.ParticipantIDs())
+	assert.Empty(t, q.RewardRecipients(), "Creator should not earn points from their own quest")
+}
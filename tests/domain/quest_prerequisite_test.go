@@ -0,0 +1,202 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for prerequisite quests: cycle detection, blocking and unblocking of dependent quests
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func TestQuest_AddPrerequisite(t *testing.T) {
+	q := createValidQuest(t)
+	first := createValidQuest(t)
+	second := createValidQuest(t)
+	second.Status = quest.StatusCompleted
+
+	require.NoError(t, q.AddPrerequisite(*first, quest.NewDependencyGraph(nil)))
+	require.NoError(t, q.AddPrerequisite(*second, quest.NewDependencyGraph(nil)))
+
+	// Prerequisites keep the order they were added in, completed quests count as done
+	assert.Equal(t, []quest.Prerequisite{
+		{QuestID: first.ID(), Completed: false},
+		{QuestID: second.ID(), Completed: true},
+	}, q.Prerequisites)
+	assert.True(t, q.HasPrerequisite(first.ID()))
+	assert.False(t, q.PrerequisitesCompleted())
+}
+
+func TestQuest_AddPrerequisite_Validation(t *testing.T) {
+	other := createValidQuest(t)
+
+	tests := []struct {
+		name    string
+		prepare func(q *quest.Quest) quest.Quest
+		wantErr string
+	}{
+		{
+			name: "itself",
+			prepare: func(q *quest.Quest) quest.Quest {
+				return *q
+			},
+			wantErr: "quest cannot be its own prerequisite",
+		},
+		{
+			name: "already added",
+			prepare: func(q *quest.Quest) quest.Quest {
+				require.NoError(t, q.AddPrerequisite(*other, quest.NewDependencyGraph(nil)))
+				return *other
+			},
+			wantErr: "quest is already a prerequisite of this quest",
+		},
+		{
+			name: "too many",
+			prepare: func(q *quest.Quest) quest.Quest {
+				for i := 0; i < quest.MaxPrerequisites; i++ {
+					require.NoError(t, q.AddPrerequisite(*createValidQuest(t), quest.NewDependencyGraph(nil)))
+				}
+				return *other
+			},
+			wantErr: "too many prerequisites, maximum is 20",
+		},
+		{
+			name: "assigned quest",
+			prepare: func(q *quest.Quest) quest.Quest {
+				require.NoError(t, q.AssignTo(uuid.New()))
+				return *other
+			},
+			wantErr: "prerequisites can only be changed if status is 'created' or 'posted'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := createValidQuest(t)
+			prerequisite := tt.prepare(q)
+
+			err := q.AddPrerequisite(prerequisite, quest.NewDependencyGraph(nil))
+
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestQuest_AddPrerequisite_DetectsCycles(t *testing.T) {
+	a := createValidQuest(t)
+	b := createValidQuest(t)
+	c := createValidQuest(t)
+
+	// c depends on b, b depends on a
+	require.NoError(t, b.AddPrerequisite(*a, quest.NewDependencyGraph(nil)))
+	require.NoError(t, c.AddPrerequisite(*b, quest.NewDependencyGraph([]quest.Quest{*a, *b})))
+	graph := quest.NewDependencyGraph([]quest.Quest{*a, *b, *c})
+
+	// a depending on c closes the chain
+	err := a.AddPrerequisite(*c, graph)
+	assert.ErrorIs(t, err, quest.ErrPrerequisiteCycle)
+	assert.Empty(t, a.Prerequisites)
+
+	// Direct cycle
+	err = a.AddPrerequisite(*b, graph)
+	assert.ErrorIs(t, err, quest.ErrPrerequisiteCycle)
+
+	// Skipping a link is not a cycle
+	assert.NoError(t, c.AddPrerequisite(*a, graph))
+}
+
+func TestDependencyGraph_DependsOn(t *testing.T) {
+	a := createValidQuest(t)
+	b := createValidQuest(t)
+	c := createValidQuest(t)
+	require.NoError(t, b.AddPrerequisite(*a, quest.NewDependencyGraph(nil)))
+	require.NoError(t, c.AddPrerequisite(*b, quest.NewDependencyGraph(nil)))
+
+	graph := quest.NewDependencyGraph([]quest.Quest{*a, *b, *c})
+
+	assert.True(t, graph.DependsOn(c.ID(), a.ID()))
+	assert.True(t, graph.DependsOn(b.ID(), a.ID()))
+	assert.False(t, graph.DependsOn(a.ID(), c.ID()))
+	assert.False(t, graph.DependsOn(a.ID(), uuid.New()))
+}
+
+func TestQuest_RemovePrerequisite(t *testing.T) {
+	q := createValidQuest(t)
+	prerequisite := createValidQuest(t)
+	require.NoError(t, q.AddPrerequisite(*prerequisite, quest.NewDependencyGraph(nil)))
+
+	require.NoError(t, q.RemovePrerequisite(prerequisite.ID()))
+	assert.Empty(t, q.Prerequisites)
+	assert.True(t, q.PrerequisitesCompleted())
+
+	err := q.RemovePrerequisite(prerequisite.ID())
+	assert.EqualError(t, err, "quest is not a prerequisite of this quest")
+}
+
+func TestQuest_IncompletePrerequisites_BlockAssignmentAndStatusChanges(t *testing.T) {
+	q := createValidQuest(t)
+	prerequisite := createValidQuest(t)
+	require.NoError(t, q.AddPrerequisite(*prerequisite, quest.NewDependencyGraph(nil)))
+
+	assert.ErrorIs(t, q.AssignTo(uuid.New()), quest.ErrPrerequisitesIncomplete)
+	assert.ErrorIs(t, q.ChangeStatus(quest.StatusPosted), quest.ErrPrerequisitesIncomplete)
+	assert.Equal(t, quest.StatusCreated, q.Status)
+	assert.Empty(t, q.Participants)
+}
+
+func TestQuest_IncompletePrerequisites_AllowTakingPostedQuestBack(t *testing.T) {
+	q := createValidQuest(t)
+	q.Status = quest.StatusPosted
+	prerequisite := createValidQuest(t)
+	require.NoError(t, q.AddPrerequisite(*prerequisite, quest.NewDependencyGraph(nil)))
+
+	assert.ErrorIs(t, q.ChangeStatus(quest.StatusAssigned), quest.ErrPrerequisitesIncomplete)
+	assert.NoError(t, q.ChangeStatus(quest.StatusCreated))
+}
+
+func TestQuest_CompletePrerequisite_PostsQuestWithLastPrerequisite(t *testing.T) {
+	q := createValidQuest(t)
+	first := createValidQuest(t)
+	second := createValidQuest(t)
+	require.NoError(t, q.AddPrerequisite(*first, quest.NewDependencyGraph(nil)))
+	require.NoError(t, q.AddPrerequisite(*second, quest.NewDependencyGraph(nil)))
+	q.ClearDomainEvents()
+
+	assert.True(t, q.CompletePrerequisite(first.ID()))
+	assert.Equal(t, quest.StatusCreated, q.Status)
+	assert.Empty(t, q.GetDomainEvents())
+
+	assert.True(t, q.CompletePrerequisite(second.ID()))
+	assert.Equal(t, quest.StatusPosted, q.Status)
+	assert.True(t, q.PrerequisitesCompleted())
+
+	events := q.GetDomainEvents()
+	if assert.Len(t, events, 1) {
+		event, ok := events[0].(quest.QuestStatusChanged)
+		if assert.True(t, ok) {
+			assert.Equal(t, quest.StatusCreated, event.OldStatus)
+			assert.Equal(t, quest.StatusPosted, event.NewStatus)
+		}
+	}
+
+	// Completion is recorded once, unknown quests are ignored
+	assert.False(t, q.CompletePrerequisite(second.ID()))
+	assert.False(t, q.CompletePrerequisite(uuid.New()))
+	assert.NoError(t, q.AssignTo(uuid.New()))
+}
+
+func TestQuest_CompletePrerequisite_KeepsPostedQuestPosted(t *testing.T) {
+	q := createValidQuest(t)
+	prerequisite := createValidQuest(t)
+	q.Status = quest.StatusPosted
+	require.NoError(t, q.AddPrerequisite(*prerequisite, quest.NewDependencyGraph(nil)))
+	q.ClearDomainEvents()
+
+	assert.True(t, q.CompletePrerequisite(prerequisite.ID()))
+	assert.Equal(t, quest.StatusPosted, q.Status)
+	assert.Empty(t, q.GetDomainEvents())
+}
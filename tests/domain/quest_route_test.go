@@ -0,0 +1,71 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for quest routes: waypoints, route distance and travel time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func newRouteQuest(t *testing.T, target, execution kernel.GeoCoordinate) quest.Quest {
+	q, err := quest.NewQuest("Route", "Route quest", "easy", 1, 60, target, execution, "creator", []string{}, []string{})
+	assert.NoError(t, err)
+	return q
+}
+
+func TestQuest_Route_WithoutWaypoints(t *testing.T) {
+	target := kernel.GeoCoordinate{Lat: 0, Lon: 0}
+	execution := kernel.GeoCoordinate{Lat: 0, Lon: 1}
+	q := newRouteQuest(t, target, execution)
+
+	assert.Empty(t, q.Waypoints)
+	assert.Equal(t, []kernel.GeoCoordinate{target, execution}, q.Route())
+	// One degree of longitude on the equator
+	assert.InDelta(t, 111.19, q.RouteDistanceKm(), 0.01)
+	assert.Equal(t, 1335, q.EstimatedTravelMinutes())
+}
+
+func TestQuest_Route_WithWaypoints(t *testing.T) {
+	target := kernel.GeoCoordinate{Lat: 0, Lon: 0}
+	execution := kernel.GeoCoordinate{Lat: 0, Lon: 0}
+	q := newRouteQuest(t, target, execution)
+
+	waypoints := []kernel.GeoCoordinate{{Lat: 0, Lon: 1}, {Lat: 1, Lon: 1}}
+	assert.NoError(t, q.SetWaypoints(waypoints))
+
+	assert.Equal(t, []kernel.GeoCoordinate{target, waypoints[0], waypoints[1], execution}, q.Route())
+	expected := target.DistanceTo(waypoints[0]) + waypoints[0].DistanceTo(waypoints[1]) + waypoints[1].DistanceTo(execution)
+	assert.InDelta(t, expected, q.RouteDistanceKm(), 1e-9)
+}
+
+func TestQuest_Route_SameLocations(t *testing.T) {
+	point := kernel.GeoCoordinate{Lat: 52.52, Lon: 13.405}
+	q := newRouteQuest(t, point, point)
+
+	assert.Zero(t, q.RouteDistanceKm())
+	assert.Zero(t, q.EstimatedTravelMinutes())
+}
+
+func TestQuest_SetWaypoints_TooMany(t *testing.T) {
+	q := newRouteQuest(t, kernel.GeoCoordinate{}, kernel.GeoCoordinate{})
+
+	err := q.SetWaypoints(make([]kernel.GeoCoordinate, quest.MaxWaypoints+1))
+
+	assert.Error(t, err)
+	assert.Empty(t, q.Waypoints, "waypoints should be unchanged")
+}
+
+func TestQuest_SetWaypoints_CopiesInput(t *testing.T) {
+	q := newRouteQuest(t, kernel.GeoCoordinate{}, kernel.GeoCoordinate{})
+	waypoints := []kernel.GeoCoordinate{{Lat: 1, Lon: 1}}
+
+	assert.NoError(t, q.SetWaypoints(waypoints))
+	waypoints[0] = kernel.GeoCoordinate{Lat: 2, Lon: 2}
+
+	assert.Equal(t, kernel.GeoCoordinate{Lat: 1, Lon: 1}, q.Waypoints[0])
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func TestIsValidStatus(t *testing.T) {
-	validStatuses := []string{"created", "posted", "assigned", "in_progress", "declined", "completed"}
+	validStatuses := []string{"created", "posted", "assigned", "in_progress", "pending_review", "declined", "completed"}
 	invalidStatuses := []string{"", "invalid", "CREATED", "unknown", "pending"}
 
 	for _, status := range validStatuses {
@@ -44,6 +44,9 @@ func TestQuest_ChangeStatus_ValidTransitions(t *testing.T) {
 		{"assigned to posted", quest.StatusAssigned, quest.StatusPosted},
 		{"in_progress to completed", quest.StatusInProgress, quest.StatusCompleted},
 		{"in_progress to declined", quest.StatusInProgress, quest.StatusDeclined},
+		{"in_progress to pending_review", quest.StatusInProgress, quest.StatusPendingReview},
+		{"pending_review to completed", quest.StatusPendingReview, quest.StatusCompleted},
+		{"pending_review to in_progress", quest.StatusPendingReview, quest.StatusInProgress},
 		{"declined to posted", quest.StatusDeclined, quest.StatusPosted},
 	}
 
@@ -82,6 +85,8 @@ func TestQuest_ChangeStatus_InvalidTransitions(t *testing.T) {
 		{"in_progress to created", quest.StatusInProgress, quest.StatusCreated},
 		{"in_progress to posted", quest.StatusInProgress, quest.StatusPosted},
 		{"in_progress to assigned", quest.StatusInProgress, quest.StatusAssigned},
+		{"pending_review to declined", quest.StatusPendingReview, quest.StatusDeclined},
+		{"pending_review to posted", quest.StatusPendingReview, quest.StatusPosted},
 		{"completed to any", quest.StatusCompleted, quest.StatusCreated},
 		{"declined to assigned", quest.StatusDeclined, quest.StatusAssigned},
 		{"declined to in_progress", quest.StatusDeclined, quest.StatusInProgress},
@@ -0,0 +1,254 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for quest templates and their recurrence rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/template"
+)
+
+var templateStart = time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC) // a Monday
+
+func validTemplateSpec() template.Spec {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	return template.Spec{
+		Title:             "Water the plants",
+		Description:       "Water every plant in the office",
+		Difficulty:        "easy",
+		Reward:            1,
+		DurationMinutes:   15,
+		TargetLocation:    &location,
+		ExecutionLocation: &location,
+	}
+}
+
+func mustParseRecurrence(t *testing.T, rule string) template.Recurrence {
+	t.Helper()
+	r, err := template.ParseRecurrence(rule, templateStart)
+	require.NoError(t, err)
+	return r
+}
+
+// occurrences lists the occurrences of the recurrence from its start, up to limit
+func occurrences(r template.Recurrence, limit int) []time.Time {
+	var result []time.Time
+	after := r.Start.Add(-time.Nanosecond)
+	for len(result) < limit {
+		next, ok := r.Next(after)
+		if !ok {
+			break
+		}
+		result = append(result, next)
+		after = next
+	}
+	return result
+}
+
+func TestParseRecurrence(t *testing.T) {
+	r := mustParseRecurrence(t, "RRULE:freq=weekly;INTERVAL=2;COUNT=3")
+
+	assert.Equal(t, template.FrequencyWeekly, r.Frequency)
+	assert.Equal(t, 2, r.Interval)
+	assert.Equal(t, 3, r.Count)
+	assert.Nil(t, r.Until)
+	assert.Equal(t, templateStart, r.Start)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=3", r.String())
+}
+
+func TestParseRecurrence_UntilDateIncludesWholeDay(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=DAILY;UNTIL=20260107")
+
+	assert.Equal(t, time.Date(2026, time.January, 7, 23, 59, 59, 0, time.UTC), *r.Until)
+	assert.Len(t, occurrences(r, 10), 3)
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rule    string
+		wantErr string
+	}{
+		{name: "no frequency", rule: "COUNT=3", wantErr: "FREQ is required"},
+		{name: "unsupported frequency", rule: "FREQ=HOURLY", wantErr: `unsupported FREQ "HOURLY": must be one of DAILY, WEEKLY, MONTHLY`},
+		{name: "unsupported part", rule: "FREQ=WEEKLY;BYDAY=MO", wantErr: "unsupported rule part BYDAY: only FREQ, INTERVAL, COUNT and UNTIL are supported"},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: "INTERVAL must be between 1 and 1000"},
+		{name: "zero count", rule: "FREQ=DAILY;COUNT=0", wantErr: `invalid COUNT "0": must be a positive number`},
+		{name: "count and until", rule: "FREQ=DAILY;COUNT=2;UNTIL=20260201", wantErr: "COUNT and UNTIL cannot be combined"},
+		{name: "until before start", rule: "FREQ=DAILY;UNTIL=20251231", wantErr: "UNTIL cannot be before the recurrence start"},
+		{name: "bad until", rule: "FREQ=DAILY;UNTIL=tomorrow", wantErr: `invalid UNTIL "tomorrow": must be like 20261231T090000Z or 20261231`},
+		{name: "part given twice", rule: "FREQ=DAILY;FREQ=WEEKLY", wantErr: "rule part FREQ is given twice"},
+		{name: "part without value", rule: "FREQ", wantErr: `invalid rule part "FREQ"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := template.ParseRecurrence(tc.rule, templateStart)
+
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestRecurrence_Next_Daily(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=DAILY;INTERVAL=3;COUNT=3")
+
+	assert.Equal(t, []time.Time{
+		templateStart,
+		templateStart.AddDate(0, 0, 3),
+		templateStart.AddDate(0, 0, 6),
+	}, occurrences(r, 10))
+}
+
+func TestRecurrence_Next_WeeklyFromLaterTime(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=WEEKLY")
+
+	// Long after the start, the next Monday 09:00 is found
+	next, ok := r.Next(time.Date(2027, time.March, 10, 12, 0, 0, 0, time.UTC))
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2027, time.March, 15, 9, 0, 0, 0, time.UTC), next)
+}
+
+func TestRecurrence_Next_CountLimitsOccurrencesFromLaterTime(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=WEEKLY;COUNT=2")
+
+	_, ok := r.Next(templateStart.AddDate(0, 0, 7))
+
+	assert.False(t, ok, "the second occurrence is the last one")
+}
+
+func TestRecurrence_Next_MonthlySkipsMissingDays(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 18, 0, 0, 0, time.UTC)
+	r, err := template.ParseRecurrence("FREQ=MONTHLY;COUNT=3", start)
+	require.NoError(t, err)
+
+	assert.Equal(t, []time.Time{
+		start,
+		time.Date(2026, time.March, 31, 18, 0, 0, 0, time.UTC),
+		time.Date(2026, time.May, 31, 18, 0, 0, 0, time.UTC),
+	}, occurrences(r, 10))
+}
+
+func TestRecurrence_Next_Until(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=WEEKLY;UNTIL=20260119T090000Z")
+
+	assert.Len(t, occurrences(r, 10), 3, "UNTIL includes an occurrence at the same time")
+}
+
+func TestNewQuestTemplate(t *testing.T) {
+	ownerID := uuid.New()
+	r := mustParseRecurrence(t, "FREQ=WEEKLY")
+	now := templateStart.Add(time.Hour)
+
+	tmpl, err := template.NewQuestTemplate(ownerID, "  Plants  ", validTemplateSpec(), &r, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Plants", tmpl.Name)
+	assert.True(t, tmpl.IsOwner(ownerID))
+	assert.False(t, tmpl.IsOwner(uuid.New()))
+	require.NotNil(t, tmpl.NextOccurrence)
+	assert.Equal(t, templateStart.AddDate(0, 0, 7), *tmpl.NextOccurrence, "occurrences before now are not scheduled")
+}
+
+func TestNewQuestTemplate_WithoutRecurrenceHasNoSchedule(t *testing.T) {
+	tmpl, err := template.NewQuestTemplate(uuid.New(), "Plants", validTemplateSpec(), nil, time.Now())
+
+	require.NoError(t, err)
+	assert.Nil(t, tmpl.NextOccurrence)
+	_, due := tmpl.DueOccurrence(time.Now().Add(24 * time.Hour))
+	assert.False(t, due)
+}
+
+func TestNewQuestTemplate_AddressOnlyLocations(t *testing.T) {
+	spec := validTemplateSpec()
+	address := "Red Square, Moscow"
+	spec.TargetLocation, spec.TargetAddress = nil, &address
+
+	_, err := template.NewQuestTemplate(uuid.New(), "Plants", spec, nil, time.Now())
+
+	assert.NoError(t, err)
+}
+
+func TestNewQuestTemplate_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		owner   uuid.UUID
+		tmpl    string
+		modify  func(*template.Spec)
+		wantErr string
+	}{
+		{name: "no owner", owner: uuid.Nil, tmpl: "Plants", wantErr: "template owner is required"},
+		{name: "no name", owner: uuid.New(), tmpl: " ", wantErr: "template name is required"},
+		{name: "no title", owner: uuid.New(), tmpl: "Plants", modify: func(s *template.Spec) { s.Title = "" }, wantErr: "quest title is required"},
+		{name: "no target location", owner: uuid.New(), tmpl: "Plants", modify: func(s *template.Spec) { s.TargetLocation = nil }, wantErr: "target location requires coordinates or an address"},
+		{name: "bad difficulty", owner: uuid.New(), tmpl: "Plants", modify: func(s *template.Spec) { s.Difficulty = "epic" }, wantErr: "invalid difficulty: must be one of 'easy', 'medium', 'hard'"},
+		{name: "bad reward", owner: uuid.New(), tmpl: "Plants", modify: func(s *template.Spec) { s.Reward = 9 }, wantErr: "reward must be between 1 and 5"},
+		{name: "quorum above capacity", owner: uuid.New(), tmpl: "Plants", modify: func(s *template.Spec) { s.Capacity, s.CompletionQuorum = 2, 3 }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := validTemplateSpec()
+			if tc.modify != nil {
+				tc.modify(&spec)
+			}
+
+			_, err := template.NewQuestTemplate(tc.owner, tc.tmpl, spec, nil, time.Now())
+
+			if tc.wantErr == "" {
+				assert.Error(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestQuestTemplate_AdvanceOccurrence(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=DAILY;COUNT=2")
+	tmpl, err := template.NewQuestTemplate(uuid.New(), "Plants", validTemplateSpec(), &r, templateStart)
+	require.NoError(t, err)
+
+	at, due := tmpl.DueOccurrence(templateStart)
+	assert.True(t, due)
+	assert.Equal(t, templateStart, at)
+
+	tmpl.AdvanceOccurrence(templateStart)
+	_, due = tmpl.DueOccurrence(templateStart)
+	assert.False(t, due, "the next occurrence is a day later")
+	assert.Equal(t, templateStart.AddDate(0, 0, 1), *tmpl.NextOccurrence)
+
+	tmpl.AdvanceOccurrence(templateStart)
+	assert.Nil(t, tmpl.NextOccurrence, "COUNT=2 has no third occurrence")
+}
+
+func TestOccurrence_Retryable(t *testing.T) {
+	o := template.Occurrence{TemplateID: uuid.New(), At: templateStart, CreatedAt: templateStart, ClaimedAt: templateStart}
+
+	assert.False(t, o.Retryable(templateStart), "the run that claimed it is still creating the quest")
+	assert.False(t, o.Retryable(templateStart.Add(template.OccurrenceClaimTimeout-time.Second)))
+	assert.True(t, o.Retryable(templateStart.Add(template.OccurrenceClaimTimeout)))
+
+	questID := uuid.New()
+	o.QuestID = &questID
+	assert.False(t, o.Retryable(templateStart.Add(template.OccurrenceClaimTimeout)), "the quest is created")
+}
+
+func TestQuestTemplate_UpdateRestartsSchedule(t *testing.T) {
+	r := mustParseRecurrence(t, "FREQ=DAILY")
+	tmpl, err := template.NewQuestTemplate(uuid.New(), "Plants", validTemplateSpec(), &r, templateStart)
+	require.NoError(t, err)
+	later := templateStart.AddDate(0, 0, 10).Add(time.Hour)
+
+	err = tmpl.Update("Plants", validTemplateSpec(), &r, later)
+
+	require.NoError(t, err)
+	assert.Equal(t, templateStart.AddDate(0, 0, 11), *tmpl.NextOccurrence)
+	assert.Equal(t, later, tmpl.UpdatedAt)
+}
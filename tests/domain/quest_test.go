@@ -236,6 +236,32 @@ func TestNewQuest_ValidDurationBoundaries(t *testing.T) {
 	}
 }
 
+func TestQuest_DistanceFrom_UsesNearestLocation(t *testing.T) {
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	spb := kernel.GeoCoordinate{Lat: 59.9311, Lon: 30.3609}
+
+	q, err := quest.NewQuest(
+		"Test Quest",
+		"Test description",
+		"medium",
+		3,
+		60,
+		spb,
+		moscow,
+		"test-creator",
+		[]string{},
+		[]string{},
+	)
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 0.0, q.DistanceFrom(moscow), 1e-9)
+	assert.InDelta(t, 0.0, q.DistanceFrom(spb), 1e-9)
+
+	// Tver lies between both cities and is closer to Moscow
+	tver := kernel.GeoCoordinate{Lat: 56.8587, Lon: 35.9176}
+	assert.InDelta(t, tver.DistanceTo(moscow), q.DistanceFrom(tver), 1e-9)
+}
+
 // Helper function to create a valid quest for testing
 func createValidQuest(t *testing.T) *quest.Quest {
 	targetLocation := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
@@ -0,0 +1,27 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for merging quest counts into map tile clusters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func TestTileCluster_Add(t *testing.T) {
+	cluster := quest.NewTileCluster(kernel.Tile{Z: 9, X: 275, Y: 167})
+
+	cluster.Add(quest.StatusPosted, 3, kernel.GeoCoordinate{Lat: 52.5, Lon: 13.4})
+	cluster.Add(quest.StatusCompleted, 1, kernel.GeoCoordinate{Lat: 52.9, Lon: 13.0})
+	cluster.Add(quest.StatusPosted, 0, kernel.GeoCoordinate{Lat: 0, Lon: 0})
+
+	// The center is weighted by the number of quests
+	assert.Equal(t, 4, cluster.Count)
+	assert.InDelta(t, 52.6, cluster.Center.Lat, 1e-9)
+	assert.InDelta(t, 13.3, cluster.Center.Lon, 1e-9)
+	assert.Equal(t, map[quest.Status]int{quest.StatusPosted: 3, quest.StatusCompleted: 1}, cluster.StatusCounts)
+}
@@ -0,0 +1,237 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for reviews between the creator and the participants and reputation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/review"
+)
+
+// completedQuestForReview returns a completed quest of creatorID taken by the participants
+func completedQuestForReview(t *testing.T, creatorID uuid.UUID, participants ...uuid.UUID) quest.Quest {
+	q := createValidQuest(t)
+	q.Creator = creatorID.String()
+	require.NoError(t, q.SetCapacity(len(participants), 0))
+	for _, p := range participants {
+		require.NoError(t, q.AssignTo(p))
+	}
+	q.Status = quest.StatusCompleted
+	return *q
+}
+
+func TestNewReview_CreatorReviewsAssignee(t *testing.T) {
+	creatorID, assigneeID := uuid.New(), uuid.New()
+	q := completedQuestForReview(t, creatorID, assigneeID)
+
+	r, err := review.NewReview(q, creatorID, assigneeID, 5, "  Great job  ", nil)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, r.ID())
+	assert.Equal(t, q.ID(), r.QuestID)
+	assert.Equal(t, creatorID, r.ReviewerID)
+	assert.Equal(t, assigneeID, r.RevieweeID)
+	assert.Equal(t, 5, r.Rating)
+	assert.Equal(t, "Great job", r.Comment)
+	assert.False(t, r.CreatedAt.IsZero())
+
+	events := r.GetDomainEvents()
+	if assert.Len(t, events, 1) {
+		event, ok := events[0].(review.ReviewSubmitted)
+		if assert.True(t, ok) {
+			assert.Equal(t, "review.submitted", event.GetName())
+			assert.Equal(t, r.ID(), event.GetAggregateID())
+			assert.Equal(t, assigneeID, event.RevieweeID)
+			assert.Equal(t, 5, event.Rating)
+		}
+	}
+}
+
+func TestNewReview_AssigneeReviewsCreator(t *testing.T) {
+	creatorID, assigneeID := uuid.New(), uuid.New()
+	q := completedQuestForReview(t, creatorID, assigneeID)
+
+	r, err := review.NewReview(q, assigneeID, creatorID, 3, "", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, creatorID, r.RevieweeID)
+}
+
+func TestNewReview_TeamQuest(t *testing.T) {
+	creatorID, first, second, third := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	q := completedQuestForReview(t, creatorID, first, second, third)
+	require.Len(t, q.Participants, 3)
+
+	// The creator reviews every participant, not only the first one
+	var existing []review.Review
+	for _, p := range []uuid.UUID{first, second, third} {
+		r, err := review.NewReview(q, creatorID, p, 5, "", existing)
+		require.NoError(t, err)
+		assert.Equal(t, p, r.RevieweeID)
+		existing = append(existing, *r)
+	}
+
+	// Every participant reviews the creator
+	for _, p := range []uuid.UUID{first, second, third} {
+		r, err := review.NewReview(q, p, creatorID, 4, "", existing)
+		require.NoError(t, err)
+		existing = append(existing, *r)
+	}
+
+	// Each pair once
+	_, err := review.NewReview(q, second, creatorID, 2, "", existing)
+	assert.EqualError(t, err, "user has already reviewed this user for the quest")
+}
+
+func TestTakesPart(t *testing.T) {
+	creatorID, first, second := uuid.New(), uuid.New(), uuid.New()
+	q := completedQuestForReview(t, creatorID, first, second)
+
+	assert.True(t, review.TakesPart(q, creatorID))
+	assert.True(t, review.TakesPart(q, first))
+	assert.True(t, review.TakesPart(q, second))
+	assert.False(t, review.TakesPart(q, uuid.New()))
+
+	q.Creator = "test-creator"
+	assert.True(t, review.TakesPart(q, second))
+	assert.False(t, review.TakesPart(q, uuid.Nil))
+}
+
+func TestNewReview_Invalid(t *testing.T) {
+	creatorID, assigneeID, teammateID := uuid.New(), uuid.New(), uuid.New()
+
+	testCases := []struct {
+		name       string
+		modify     func(q *quest.Quest)
+		reviewerID uuid.UUID
+		revieweeID uuid.UUID
+		rating     int
+		comment    string
+		existing   func(q quest.Quest) []review.Review
+		wantErr    string
+	}{
+		{
+			name:       "quest not completed",
+			modify:     func(q *quest.Quest) { q.Status = quest.StatusInProgress },
+			reviewerID: creatorID,
+			revieweeID: assigneeID,
+			rating:     5,
+			wantErr:    "quest can be reviewed only if status is 'completed'",
+		},
+		{
+			name:       "stranger",
+			reviewerID: uuid.New(),
+			revieweeID: creatorID,
+			rating:     5,
+			wantErr:    "only the creator and the participants can review the quest",
+		},
+		{
+			name:       "stranger as reviewee",
+			reviewerID: creatorID,
+			revieweeID: uuid.New(),
+			rating:     5,
+			wantErr:    "reviewee must be the creator or a participant of the quest",
+		},
+		{
+			name:       "creator without user ID",
+			modify:     func(q *quest.Quest) { q.Creator = "test-creator" },
+			reviewerID: assigneeID,
+			revieweeID: uuid.Nil,
+			rating:     5,
+			wantErr:    "reviewee must be the creator or a participant of the quest",
+		},
+		{
+			name:       "participant reviews participant",
+			reviewerID: teammateID,
+			revieweeID: assigneeID,
+			rating:     5,
+			wantErr:    "participants can review only the creator of the quest",
+		},
+		{
+			name:       "participant reviews participant of a quest without creator user ID",
+			modify:     func(q *quest.Quest) { q.Creator = "test-creator" },
+			reviewerID: teammateID,
+			revieweeID: assigneeID,
+			rating:     5,
+			wantErr:    "participants can review only the creator of the quest",
+		},
+		{
+			name:       "self review",
+			reviewerID: teammateID,
+			revieweeID: teammateID,
+			rating:     5,
+			wantErr:    "user cannot review themselves",
+		},
+		{name: "rating too low", reviewerID: creatorID, revieweeID: assigneeID, rating: 0, wantErr: "rating must be between 1 and 5"},
+		{name: "rating too high", reviewerID: creatorID, revieweeID: assigneeID, rating: 6, wantErr: "rating must be between 1 and 5"},
+		{
+			name:       "comment too long",
+			reviewerID: creatorID,
+			revieweeID: assigneeID,
+			rating:     4,
+			comment:    strings.Repeat("я", review.MaxCommentLength+1),
+			wantErr:    "review comment too long, maximum is 1000 characters",
+		},
+		{
+			name:       "already reviewed",
+			reviewerID: creatorID,
+			revieweeID: assigneeID,
+			rating:     4,
+			existing: func(q quest.Quest) []review.Review {
+				r, err := review.NewReview(q, creatorID, assigneeID, 5, "", nil)
+				assert.NoError(t, err)
+				return []review.Review{*r}
+			},
+			wantErr: "user has already reviewed this user for the quest",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q := completedQuestForReview(t, creatorID, assigneeID, teammateID)
+			if tc.modify != nil {
+				tc.modify(&q)
+			}
+			var existing []review.Review
+			if tc.existing != nil {
+				existing = tc.existing(q)
+			}
+
+			_, err := review.NewReview(q, tc.reviewerID, tc.revieweeID, tc.rating, tc.comment, existing)
+
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNewReview_OtherSideStillCanReview(t *testing.T) {
+	creatorID, assigneeID := uuid.New(), uuid.New()
+	q := completedQuestForReview(t, creatorID, assigneeID)
+
+	first, err := review.NewReview(q, creatorID, assigneeID, 5, "", nil)
+	assert.NoError(t, err)
+
+	_, err = review.NewReview(q, assigneeID, creatorID, 4, "", []review.Review{*first})
+	assert.NoError(t, err)
+}
+
+func TestNewReputation(t *testing.T) {
+	userID := uuid.New()
+
+	reputation := review.NewReputation(userID, 14, 3)
+	assert.Equal(t, userID, reputation.UserID)
+	assert.Equal(t, 4.67, reputation.AverageRating)
+	assert.Equal(t, 3, reputation.ReviewCount)
+	assert.True(t, reputation.AtLeast(4.5))
+	assert.False(t, reputation.AtLeast(4.7))
+
+	empty := review.NewReputation(userID, 0, 0)
+	assert.Equal(t, 0.0, empty.AverageRating)
+	assert.False(t, empty.AtLeast(1))
+}
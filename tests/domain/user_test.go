@@ -0,0 +1,136 @@
+package domain
+
+// DOMAIN LAYER UNIT TESTS
+// Tests for user profile validation and normalization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/user"
+)
+
+func TestNewUser_Success(t *testing.T) {
+	id := uuid.New()
+	home := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	radius := 25.0
+
+	u, err := user.NewUser(id, user.Profile{
+		DisplayName:       " Alice ",
+		Skills:            []string{" Photography ", "PHOTOGRAPHY", "navigation"},
+		Equipment:         []string{"camera"},
+		HomeLocation:      &home,
+		MaxTravelRadiusKm: &radius,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, u.ID())
+	assert.Equal(t, "Alice", u.DisplayName)
+	assert.Equal(t, []string{"Photography", "navigation"}, u.Skills)
+	assert.Equal(t, []string{"camera"}, u.Equipment)
+	assert.Equal(t, home, *u.HomeLocation)
+	assert.Equal(t, radius, *u.MaxTravelRadiusKm)
+	assert.False(t, u.CreatedAt.IsZero())
+	assert.Equal(t, u.CreatedAt, u.UpdatedAt)
+
+	events := u.GetDomainEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "user.created", events[0].GetName())
+}
+
+func TestNewUser_MinimalProfile(t *testing.T) {
+	u, err := user.NewUser(uuid.New(), user.Profile{DisplayName: "Bob"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, u.Skills)
+	assert.NotNil(t, u.Skills)
+	assert.Empty(t, u.Equipment)
+	assert.Nil(t, u.HomeLocation)
+	assert.Nil(t, u.MaxTravelRadiusKm)
+}
+
+func TestNewUser_Validation(t *testing.T) {
+	tooFar := user.MaxTravelRadiusLimitKm + 1
+	zero := 0.0
+	manySkills := make([]string, user.MaxListItems+1)
+	for i := range manySkills {
+		manySkills[i] = "skill-" + strings.Repeat("x", i+1)
+	}
+
+	testCases := []struct {
+		name    string
+		id      uuid.UUID
+		profile user.Profile
+	}{
+		{"nil id", uuid.Nil, user.Profile{DisplayName: "Alice"}},
+		{"empty display name", uuid.New(), user.Profile{DisplayName: "  "}},
+		{"display name too long", uuid.New(), user.Profile{DisplayName: strings.Repeat("a", user.MaxDisplayNameLength+1)}},
+		{"empty skill", uuid.New(), user.Profile{DisplayName: "Alice", Skills: []string{" "}}},
+		{"skill with comma", uuid.New(), user.Profile{DisplayName: "Alice", Skills: []string{"a,b"}}},
+		{"too many skills", uuid.New(), user.Profile{DisplayName: "Alice", Skills: manySkills}},
+		{"equipment too long", uuid.New(), user.Profile{DisplayName: "Alice", Equipment: []string{strings.Repeat("e", user.MaxListItemLength+1)}}},
+		{"zero radius", uuid.New(), user.Profile{DisplayName: "Alice", MaxTravelRadiusKm: &zero}},
+		{"radius too large", uuid.New(), user.Profile{DisplayName: "Alice", MaxTravelRadiusKm: &tooFar}},
+		{"invalid home", uuid.New(), user.Profile{DisplayName: "Alice", HomeLocation: &kernel.GeoCoordinate{Lat: 0, Lon: 181}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := user.NewUser(tc.id, tc.profile)
+			assert.Error(t, err)
+			assert.Nil(t, u)
+		})
+	}
+}
+
+func TestUser_UpdateProfile(t *testing.T) {
+	u, err := user.NewUser(uuid.New(), user.Profile{DisplayName: "Alice", Skills: []string{"climbing"}})
+	assert.NoError(t, err)
+	u.ClearDomainEvents()
+	createdAt := u.CreatedAt
+
+	err = u.UpdateProfile(user.Profile{DisplayName: "Alice B.", Equipment: []string{"rope"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice B.", u.DisplayName)
+	assert.Empty(t, u.Skills)
+	assert.Equal(t, []string{"rope"}, u.Equipment)
+	assert.Equal(t, createdAt, u.CreatedAt)
+	assert.False(t, u.UpdatedAt.Before(createdAt))
+
+	events := u.GetDomainEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "user.profile_updated", events[0].GetName())
+}
+
+func TestUser_UpdateProfile_InvalidKeepsProfile(t *testing.T) {
+	u, err := user.NewUser(uuid.New(), user.Profile{DisplayName: "Alice", Skills: []string{"climbing"}})
+	assert.NoError(t, err)
+	u.ClearDomainEvents()
+
+	err = u.UpdateProfile(user.Profile{DisplayName: "", Skills: []string{"diving"}})
+
+	assert.Error(t, err)
+	assert.Equal(t, "Alice", u.DisplayName)
+	assert.Equal(t, []string{"climbing"}, u.Skills)
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestUser_HasSkillAndEquipment(t *testing.T) {
+	u, err := user.NewUser(uuid.New(), user.Profile{
+		DisplayName: "Alice",
+		Skills:      []string{"Photography"},
+		Equipment:   []string{"Camera"},
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, u.HasSkill("photography"))
+	assert.True(t, u.HasSkill(" PHOTOGRAPHY "))
+	assert.False(t, u.HasSkill("diving"))
+	assert.True(t, u.HasEquipment("camera"))
+	assert.False(t, u.HasEquipment("rope"))
+}
@@ -116,11 +116,11 @@ func (a *QuestE2EAssertions) VerifyLocationsInDatabase(ctx context.Context, ques
 	var targetLocationFound, executionLocationFound bool
 	for _, loc := range allLocations {
 		if a.coordinatesMatch(loc.Coordinate.Lat, loc.Coordinate.Lon,
-			float64(questRequest.TargetLocation.Latitude), float64(questRequest.TargetLocation.Longitude)) {
+			float64(*questRequest.TargetLocation.Latitude), float64(*questRequest.TargetLocation.Longitude)) {
 			targetLocationFound = true
 		}
 		if a.coordinatesMatch(loc.Coordinate.Lat, loc.Coordinate.Lon,
-			float64(questRequest.ExecutionLocation.Latitude), float64(questRequest.ExecutionLocation.Longitude)) {
+			float64(*questRequest.ExecutionLocation.Latitude), float64(*questRequest.ExecutionLocation.Longitude)) {
 			executionLocationFound = true
 		}
 	}
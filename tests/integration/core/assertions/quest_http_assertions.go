@@ -114,6 +114,20 @@ func (a *QuestHTTPAssertions) QuestHTTPListSuccessfully(listResp *casesteps.HTTP
 	return quests
 }
 
+// QuestHTTPGeoJSONSuccessfully verifies a GeoJSON quest response and parses the FeatureCollection
+func (a *QuestHTTPAssertions) QuestHTTPGeoJSONSuccessfully(resp *casesteps.HTTPResponse, err error) v1.QuestFeatureCollection {
+	a.assert.NoError(err, "HTTP request should not fail")
+	a.assert.Equal(http.StatusOK, resp.StatusCode, "Should return 200 OK")
+	a.assert.Equal("application/geo+json", resp.Headers.Get("Content-Type"), "Should return GeoJSON")
+
+	var collection v1.QuestFeatureCollection
+	parseErr := json.Unmarshal([]byte(resp.Body), &collection)
+	a.assert.NoError(parseErr, "Response should be valid GeoJSON")
+	a.assert.Equal(v1.FeatureCollection, collection.Type, "Should be a FeatureCollection")
+
+	return collection
+}
+
 // QuestHTTPErrorResponse verifies HTTP error response
 // Eliminates boilerplate for error checking
 func (a *QuestHTTPAssertions) QuestHTTPErrorResponse(resp *casesteps.HTTPResponse, err error, expectedStatus int, expectedMessage string) {
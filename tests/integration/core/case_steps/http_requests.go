@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -85,6 +88,17 @@ func ExecuteHTTPRequest(ctx context.Context, handler http.Handler, req HTTPReque
 	}, nil
 }
 
+// WithAccept возвращает копию запроса с заголовком Accept (для content negotiation)
+func WithAccept(req HTTPRequest, accept string) HTTPRequest {
+	headers := make(map[string]string, len(req.Headers)+1)
+	for k, v := range req.Headers {
+		headers[k] = v
+	}
+	headers["Accept"] = accept
+	req.Headers = headers
+	return req
+}
+
 // CreateQuestHTTPRequest создает HTTP запрос для создания квеста
 func CreateQuestHTTPRequest(questData interface{}) HTTPRequest {
 	return HTTPRequest{
@@ -148,6 +162,24 @@ func ListQuestsHTTPRequest(status string) HTTPRequest {
 	}
 }
 
+// ListQuestsByMaxRouteHTTPRequest создает HTTP запрос для получения квестов с маршрутом не длиннее maxRouteKm
+func ListQuestsByMaxRouteHTTPRequest(maxRouteKm float64) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     fmt.Sprintf("/api/v1/quests?max_route_km=%g", maxRouteKm),
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// ListQuestsByCreatorRatingHTTPRequest создает HTTP запрос для получения квестов создателей со средней оценкой не ниже minRating
+func ListQuestsByCreatorRatingHTTPRequest(minRating float64) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     fmt.Sprintf("/api/v1/quests?min_creator_rating=%g", minRating),
+		Headers: withAuthHeader(nil),
+	}
+}
+
 // ListAssignedQuestsHTTPRequest создает HTTP запрос для получения квестов назначенных аутентифицированному пользователю
 // User ID теперь берется из JWT токена, поэтому не передается в query параметрах
 func ListAssignedQuestsHTTPRequest() HTTPRequest {
@@ -168,6 +200,460 @@ func SearchQuestsByRadiusHTTPRequest(lat, lon, radiusKm float32) HTTPRequest {
 	}
 }
 
+// SearchQuestsByRadiusSortedHTTPRequest создает HTTP запрос для поиска по радиусу с сортировкой и лимитом
+func SearchQuestsByRadiusSortedHTTPRequest(lat, lon, radiusKm float32, sort string, limit int) HTTPRequest {
+	reqURL := fmt.Sprintf("/api/v1/quests/search-radius?lat=%f&lon=%f&radius_km=%f", lat, lon, radiusKm)
+	if sort != "" {
+		reqURL += "&sort=" + url.QueryEscape(sort)
+	}
+	if limit > 0 {
+		reqURL += fmt.Sprintf("&limit=%d", limit)
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// SearchQuestsByAreaHTTPRequest создает HTTP запрос для поиска квестов в области (polygon или bbox)
+// Пустые значения параметров не добавляются в запрос
+func SearchQuestsByAreaHTTPRequest(polygon, bbox, match string) HTTPRequest {
+	params := url.Values{}
+	if polygon != "" {
+		params.Set("polygon", polygon)
+	}
+	if bbox != "" {
+		params.Set("bbox", bbox)
+	}
+	if match != "" {
+		params.Set("match", match)
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/search-area?" + params.Encode(),
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetQuestTileHTTPRequest создает HTTP запрос для получения кластеров квестов тайла z/x/y
+// rawQuery добавляется как есть (например "resolution=2&format=mvt")
+func GetQuestTileHTTPRequest(z, x, y int, rawQuery string) HTTPRequest {
+	reqURL := fmt.Sprintf("/api/v1/quests/tiles/%d/%d/%d", z, x, y)
+	if rawQuery != "" {
+		reqURL += "?" + rawQuery
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// AutocompleteLocationsHTTPRequest создает HTTP запрос для автодополнения локаций
+func AutocompleteLocationsHTTPRequest(query string, limit int) HTTPRequest {
+	reqURL := "/api/v1/locations/autocomplete?q=" + url.QueryEscape(query)
+	if limit > 0 {
+		reqURL += fmt.Sprintf("&limit=%d", limit)
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// RecommendQuestsHTTPRequest создает HTTP запрос для получения рекомендованных квестов рядом с позицией
+// Пустые skills не добавляются в запрос
+func RecommendQuestsHTTPRequest(lat, lon float32, skills []string) HTTPRequest {
+	reqURL := fmt.Sprintf("/api/v1/quests/recommended?lat=%f&lon=%f", lat, lon)
+	if len(skills) > 0 {
+		reqURL += "&skills=" + url.QueryEscape(strings.Join(skills, ","))
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// RecommendQuestsByProfileHTTPRequest создает HTTP запрос рекомендаций без параметров:
+// позиция, радиус, навыки и снаряжение берутся из профиля пользователя
+func RecommendQuestsByProfileHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/recommended",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetMyProfileHTTPRequest создает HTTP запрос для получения профиля аутентифицированного пользователя
+func GetMyProfileHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/me/profile",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// UpdateMyProfileHTTPRequest создает HTTP запрос для сохранения профиля аутентифицированного пользователя
+func UpdateMyProfileHTTPRequest(profile interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "PUT",
+		URL:         "/api/v1/me/profile",
+		Body:        profile,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// GetMyBalanceHTTPRequest создает HTTP запрос для получения баланса очков аутентифицированного пользователя
+func GetMyBalanceHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/me/balance",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// ListMyAchievementsHTTPRequest создает HTTP запрос для получения достижений аутентифицированного пользователя
+func ListMyAchievementsHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/me/achievements",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetLeaderboardHTTPRequest создает HTTP запрос для получения лидерборда
+// Пустой rawQuery не добавляется в запрос
+func GetLeaderboardHTTPRequest(rawQuery string) HTTPRequest {
+	reqURL := "/api/v1/leaderboards"
+	if rawQuery != "" {
+		reqURL += "?" + rawQuery
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// ListMyLedgerHTTPRequest создает HTTP запрос для получения страницы журнала начислений
+// Пустой rawQuery не добавляется в запрос
+func ListMyLedgerHTTPRequest(rawQuery string) HTTPRequest {
+	reqURL := "/api/v1/me/ledger"
+	if rawQuery != "" {
+		reqURL += "?" + rawQuery
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// ApplyToQuestHTTPRequest создает HTTP запрос для подачи заявки на квест
+func ApplyToQuestHTTPRequest(questID uuid.UUID, application interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/applications",
+		Body:        application,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// ListQuestApplicationsHTTPRequest создает HTTP запрос для получения заявок на квест
+func ListQuestApplicationsHTTPRequest(questID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/" + questID.String() + "/applications",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// SubmitQuestReviewHTTPRequest создает HTTP запрос для отзыва о создателе или участнике завершенного квеста
+func SubmitQuestReviewHTTPRequest(questID uuid.UUID, reviewRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/reviews",
+		Body:        reviewRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// ListQuestReviewsHTTPRequest создает HTTP запрос для получения отзывов по квесту
+func ListQuestReviewsHTTPRequest(questID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/" + questID.String() + "/reviews",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// AddQuestCommentHTTPRequest создает HTTP запрос для добавления комментария к квесту
+func AddQuestCommentHTTPRequest(questID uuid.UUID, commentRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/comments",
+		Body:        commentRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// ListQuestCommentsHTTPRequest создает HTTP запрос для получения комментариев к квесту
+// Пустой rawQuery не добавляется в запрос
+func ListQuestCommentsHTTPRequest(questID uuid.UUID, rawQuery string) HTTPRequest {
+	reqURL := "/api/v1/quests/" + questID.String() + "/comments"
+	if rawQuery != "" {
+		reqURL += "?" + rawQuery
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// EditQuestCommentHTTPRequest создает HTTP запрос для редактирования комментария к квесту
+func EditQuestCommentHTTPRequest(questID, commentID uuid.UUID, commentRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "PATCH",
+		URL:         "/api/v1/quests/" + questID.String() + "/comments/" + commentID.String(),
+		Body:        commentRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// AddQuestPrerequisiteHTTPRequest создает HTTP запрос для добавления предварительного условия квеста
+func AddQuestPrerequisiteHTTPRequest(questID uuid.UUID, prerequisiteRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/prerequisites",
+		Body:        prerequisiteRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// RemoveQuestPrerequisiteHTTPRequest создает HTTP запрос для удаления предварительного условия квеста
+func RemoveQuestPrerequisiteHTTPRequest(questID, prerequisiteID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "DELETE",
+		URL:     "/api/v1/quests/" + questID.String() + "/prerequisites/" + prerequisiteID.String(),
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetQuestDependenciesHTTPRequest создает HTTP запрос для получения графа зависимостей квеста
+func GetQuestDependenciesHTTPRequest(questID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/" + questID.String() + "/dependencies",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// ListMyNotificationsHTTPRequest создает HTTP запрос для получения уведомлений текущего пользователя
+// Пустой rawQuery не добавляется в запрос
+func ListMyNotificationsHTTPRequest(rawQuery string) HTTPRequest {
+	reqURL := "/api/v1/me/notifications"
+	if rawQuery != "" {
+		reqURL += "?" + rawQuery
+	}
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     reqURL,
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// MarkNotificationReadHTTPRequest создает HTTP запрос для отметки уведомления прочитанным
+func MarkNotificationReadHTTPRequest(notificationID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "POST",
+		URL:     "/api/v1/me/notifications/" + notificationID.String() + "/read",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// MarkAllNotificationsReadHTTPRequest создает HTTP запрос для отметки всех уведомлений прочитанными
+func MarkAllNotificationsReadHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "POST",
+		URL:     "/api/v1/me/notifications/read-all",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetMyNotificationPreferencesHTTPRequest создает HTTP запрос для получения настроек уведомлений
+func GetMyNotificationPreferencesHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/me/notification-preferences",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// UpdateMyNotificationPreferencesHTTPRequest создает HTTP запрос для изменения настроек уведомлений
+func UpdateMyNotificationPreferencesHTTPRequest(preferencesRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "PUT",
+		URL:         "/api/v1/me/notification-preferences",
+		Body:        preferencesRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// CreateQuestTemplateHTTPRequest создает HTTP запрос для создания шаблона квеста
+func CreateQuestTemplateHTTPRequest(templateRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quest-templates",
+		Body:        templateRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// ListQuestTemplatesHTTPRequest создает HTTP запрос для получения шаблонов текущего пользователя
+func ListQuestTemplatesHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quest-templates",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetQuestTemplateHTTPRequest создает HTTP запрос для получения шаблона квеста
+func GetQuestTemplateHTTPRequest(templateID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quest-templates/" + templateID.String(),
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// UpdateQuestTemplateHTTPRequest создает HTTP запрос для замены шаблона квеста
+func UpdateQuestTemplateHTTPRequest(templateID uuid.UUID, templateRequest interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "PUT",
+		URL:         "/api/v1/quest-templates/" + templateID.String(),
+		Body:        templateRequest,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// DeleteQuestTemplateHTTPRequest создает HTTP запрос для удаления шаблона квеста
+func DeleteQuestTemplateHTTPRequest(templateID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "DELETE",
+		URL:     "/api/v1/quest-templates/" + templateID.String(),
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// CreateQuestFromTemplateHTTPRequest создает HTTP запрос для создания квеста из шаблона
+// При overridesRequest == nil запрос отправляется без тела
+func CreateQuestFromTemplateHTTPRequest(templateID uuid.UUID, overridesRequest interface{}) HTTPRequest {
+	req := HTTPRequest{
+		Method:  "POST",
+		URL:     "/api/v1/quest-templates/" + templateID.String() + "/quests",
+		Headers: withAuthHeader(nil),
+	}
+	if overridesRequest != nil {
+		req.Body = overridesRequest
+		req.ContentType = "application/json"
+	}
+	return req
+}
+
+// ReviewApplicationHTTPRequest создает HTTP запрос для принятия ("accept") или отклонения ("reject") заявки
+func ReviewApplicationHTTPRequest(questID, applicationID uuid.UUID, decision string) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/applications/" + applicationID.String() + "/" + decision,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
+// EvidencePhoto описывает фото, прикладываемое к доказательству выполнения
+type EvidencePhoto struct {
+	FileName string
+	Content  []byte
+}
+
+// SubmitEvidenceHTTPRequest создает multipart HTTP запрос на отправку доказательства выполнения квеста
+func SubmitEvidenceHTTPRequest(questID uuid.UUID, note, latitude, longitude string, photos ...EvidencePhoto) (HTTPRequest, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	fields := [][2]string{{"note", note}, {"latitude", latitude}, {"longitude", longitude}}
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return HTTPRequest{}, err
+		}
+	}
+	for _, photo := range photos {
+		part, err := writer.CreateFormFile("photos", photo.FileName)
+		if err != nil {
+			return HTTPRequest{}, err
+		}
+		if _, err := part.Write(photo.Content); err != nil {
+			return HTTPRequest{}, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return HTTPRequest{}, err
+	}
+
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/evidence",
+		Body:        body.String(),
+		Headers:     withAuthHeader(nil),
+		ContentType: writer.FormDataContentType(),
+	}, nil
+}
+
+// ListEvidenceHTTPRequest создает HTTP запрос для получения доказательств выполнения квеста
+func ListEvidenceHTTPRequest(questID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/" + questID.String() + "/evidence",
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// GetEvidenceAttachmentHTTPRequest создает HTTP запрос для скачивания фото доказательства
+func GetEvidenceAttachmentHTTPRequest(questID, evidenceID, attachmentID uuid.UUID) HTTPRequest {
+	return HTTPRequest{
+		Method:  "GET",
+		URL:     "/api/v1/quests/" + questID.String() + "/evidence/" + evidenceID.String() + "/attachments/" + attachmentID.String(),
+		Headers: withAuthHeader(nil),
+	}
+}
+
+// ReviewCompletionHTTPRequest создает HTTP запрос для подтверждения ("approve") или отклонения ("reject") выполнения
+func ReviewCompletionHTTPRequest(questID uuid.UUID, decision string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      "POST",
+		URL:         "/api/v1/quests/" + questID.String() + "/completion/" + decision,
+		Body:        body,
+		Headers:     withAuthHeader(nil),
+		ContentType: "application/json",
+	}
+}
+
 // ChangeQuestStatusHTTPRequest создает HTTP запрос для изменения статуса квеста
 func ChangeQuestStatusHTTPRequest(questID uuid.UUID, statusRequest interface{}) HTTPRequest {
 	return HTTPRequest{
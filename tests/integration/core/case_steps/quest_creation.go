@@ -53,7 +53,7 @@ func CreateQuestStep(
 
 	// Make sure execution location is slightly different from target to get different addresses
 	if questData.TargetLocation.Equals(questData.ExecutionLocation) {
-		cmd.ExecutionLocation = kernel.GeoCoordinate{
+		cmd.ExecutionLocation = &kernel.GeoCoordinate{
 			Lat: questData.ExecutionLocation.Latitude() + 0.001,
 			Lon: questData.ExecutionLocation.Longitude() + 0.001,
 		}
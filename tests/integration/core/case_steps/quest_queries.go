@@ -26,7 +26,7 @@ func ListQuestsStep(
 	handler queries.ListQuestsQueryHandler,
 	status *quest.Status,
 ) ([]quest.Quest, error) {
-	return handler.Handle(ctx, status)
+	return handler.Handle(ctx, queries.ListQuestsQuery{Status: status})
 }
 
 // ListAssignedQuestsStep gets list of quests assigned to a user
@@ -45,5 +45,36 @@ func SearchQuestsByRadiusStep(
 	center kernel.GeoCoordinate,
 	radiusKm float32,
 ) ([]quest.Quest, error) {
-	return handler.Handle(ctx, center, float64(radiusKm))
+	results, err := handler.Handle(ctx, queries.SearchQuestsByRadiusQuery{
+		Center:   center,
+		RadiusKm: float64(radiusKm),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quests := make([]quest.Quest, len(results))
+	for i, r := range results {
+		quests[i] = r.Quest
+	}
+	return quests, nil
+}
+
+// SearchQuestsByRadiusWithDistanceStep runs a radius search with sort/limit and returns results with distances
+func SearchQuestsByRadiusWithDistanceStep(
+	ctx context.Context,
+	handler queries.SearchQuestsByRadiusQueryHandler,
+	query queries.SearchQuestsByRadiusQuery,
+) ([]queries.QuestWithDistance, error) {
+	return handler.Handle(ctx, query)
+}
+
+// SearchQuestsInPolygonStep searches for quests whose selected locations lie inside the polygon
+func SearchQuestsInPolygonStep(
+	ctx context.Context,
+	handler queries.SearchQuestsByAreaQueryHandler,
+	polygon kernel.Polygon,
+	match quest.LocationMatch,
+) ([]quest.Quest, error) {
+	return handler.Handle(ctx, queries.SearchQuestsByAreaQuery{Polygon: &polygon, Match: match})
 }
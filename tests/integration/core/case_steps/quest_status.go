@@ -10,16 +10,18 @@ import (
 	"quest-manager/internal/core/ports"
 )
 
-// ChangeQuestStatusStep изменяет статус квеста
+// ChangeQuestStatusStep изменяет статус квеста от имени пользователя userID
 func ChangeQuestStatusStep(
 	ctx context.Context,
 	handler commands.ChangeQuestStatusCommandHandler,
 	questRepo ports.QuestRepository,
 	questID uuid.UUID,
+	userID uuid.UUID,
 	newStatus quest.Status,
 ) (quest.Quest, error) {
 	cmd := commands.ChangeQuestStatusCommand{
 		QuestID: questID,
+		UserID:  userID,
 		Status:  newStatus,
 	}
 
@@ -81,8 +81,8 @@ func (data QuestTestData) ToCreateCommand() commands.CreateQuestCommand {
 		Difficulty:        data.Difficulty,
 		Reward:            data.Reward,
 		DurationMinutes:   data.DurationMinutes,
-		TargetLocation:    data.TargetLocation,
-		ExecutionLocation: data.ExecutionLocation,
+		TargetLocation:    &data.TargetLocation,
+		ExecutionLocation: &data.ExecutionLocation,
 		Creator:           data.Creator,
 		Equipment:         data.Equipment,
 		Skills:            data.Skills,
@@ -111,13 +111,13 @@ func (data QuestTestData) ToCreateQuestRequest() v1.CreateQuestRequest {
 		Difficulty:      v1.CreateQuestRequestDifficulty(data.Difficulty),
 		Reward:          data.Reward,
 		DurationMinutes: data.DurationMinutes,
-		TargetLocation: v1.Coordinate{
-			Latitude:  float32(data.TargetLocation.Lat),
-			Longitude: float32(data.TargetLocation.Lon),
+		TargetLocation: v1.LocationInput{
+			Latitude:  ptr(float32(data.TargetLocation.Lat)),
+			Longitude: ptr(float32(data.TargetLocation.Lon)),
 		},
-		ExecutionLocation: v1.Coordinate{
-			Latitude:  float32(data.ExecutionLocation.Lat),
-			Longitude: float32(data.ExecutionLocation.Lon),
+		ExecutionLocation: v1.LocationInput{
+			Latitude:  ptr(float32(data.ExecutionLocation.Lat)),
+			Longitude: ptr(float32(data.ExecutionLocation.Lon)),
 		},
 		Equipment: ptr(data.Equipment),
 		Skills:    ptr(data.Skills),
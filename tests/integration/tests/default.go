@@ -25,6 +25,9 @@ func (s *DefaultSuite) SetupSuite() {
 
 	// Run migrations
 	cmd.MustAutoMigrate(s.TestDIContainer.DB)
+	if s.TestDIContainer.UsePostGIS {
+		cmd.MustMigratePostGIS(s.TestDIContainer.DB)
+	}
 }
 
 // TearDownSuite cleans up resources after completing all tests in the suite
@@ -22,13 +22,13 @@ func (s *E2ESuite) TestCreateQuestThroughAPISuccess() {
 		Difficulty:      v1.CreateQuestRequestDifficultyMedium,
 		Reward:          3,
 		DurationMinutes: 60,
-		TargetLocation: v1.Coordinate{
-			Latitude:  55.7558,
-			Longitude: 37.6176,
+		TargetLocation: v1.LocationInput{
+			Latitude:  float32Ptr(55.7558),
+			Longitude: float32Ptr(37.6176),
 		},
-		ExecutionLocation: v1.Coordinate{
-			Latitude:  55.7520,
-			Longitude: 37.6175,
+		ExecutionLocation: v1.LocationInput{
+			Latitude:  float32Ptr(55.7520),
+			Longitude: float32Ptr(37.6175),
 		},
 		Equipment: &[]string{"passport", "camera"},
 		Skills:    &[]string{"sightseeing", "photography"},
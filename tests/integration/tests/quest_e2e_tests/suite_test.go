@@ -47,3 +47,7 @@ func (s *E2ESuite) TestE2ESmokeTest() {
 
 	fmt.Println("🎯 E2E Test Environment is ready!")
 }
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
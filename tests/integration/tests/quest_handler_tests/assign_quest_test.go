@@ -43,6 +43,7 @@ func (s *Suite) TestAssignQuestFromPostedStatus() {
 		s.TestDIContainer.ChangeQuestStatusHandler,
 		s.TestDIContainer.QuestRepository,
 		createdQuest.ID(),
+		uuid.New(),
 		quest.StatusPosted,
 	)
 	s.Require().NoError(err)
@@ -88,6 +89,7 @@ func (s *Suite) TestAssignQuestInvalidStatus() {
 		s.TestDIContainer.ChangeQuestStatusHandler,
 		s.TestDIContainer.QuestRepository,
 		createdQuest.ID(),
+		firstUserID,
 		quest.StatusInProgress,
 	)
 	s.Require().NoError(err)
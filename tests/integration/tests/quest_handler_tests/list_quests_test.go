@@ -6,6 +6,8 @@ package quest_handler_tests
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"quest-manager/internal/core/domain/model/quest"
 	"quest-manager/tests/integration/core/assertions"
 	casesteps "quest-manager/tests/integration/core/case_steps"
@@ -52,7 +54,7 @@ func (s *Suite) TestListQuestsWithValidStatus() {
 	// Change quest status for filtering test
 	targetStatus := quest.StatusPosted
 	_, err = casesteps.ChangeQuestStatusStep(ctx, s.TestDIContainer.ChangeQuestStatusHandler,
-		s.TestDIContainer.QuestRepository, createdQuests[0].ID(), targetStatus)
+		s.TestDIContainer.QuestRepository, createdQuests[0].ID(), uuid.New(), targetStatus)
 	s.Require().NoError(err)
 
 	// Leave other quests with default StatusCreated status
@@ -0,0 +1,48 @@
+package quest_handler_tests
+
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for searchQuestsByArea.Handle orchestration logic
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/tests/integration/core/assertions"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestSearchQuestsInPolygon() {
+	ctx := context.Background()
+	listAssertions := assertions.NewQuestListAssertions(s.Assert())
+
+	// Pre-condition - one quest with the target inside the polygon, one outside
+	inside := kernel.GeoCoordinate{Lat: 55.75, Lon: 37.6}
+	outside := kernel.GeoCoordinate{Lat: 59.9311, Lon: 30.3609}
+
+	insideQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Inside Quest", "Quest inside polygon", "easy", 1, 30, inside, outside))
+	s.Require().NoError(err)
+	outsideQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Outside Quest", "Quest outside polygon", "easy", 1, 30, outside, outside))
+	s.Require().NoError(err)
+
+	polygon, err := kernel.NewPolygon([]kernel.GeoCoordinate{
+		{Lat: 55.7, Lon: 37.5}, {Lat: 55.7, Lon: 37.7}, {Lat: 55.8, Lon: 37.7}, {Lat: 55.8, Lon: 37.5},
+	})
+	s.Require().NoError(err)
+
+	// Act
+	foundByTarget, err := casesteps.SearchQuestsInPolygonStep(ctx, s.TestDIContainer.SearchQuestsByAreaHandler,
+		polygon, quest.LocationMatchTarget)
+	s.Require().NoError(err)
+	foundByExecution, err := casesteps.SearchQuestsInPolygonStep(ctx, s.TestDIContainer.SearchQuestsByAreaHandler,
+		polygon, quest.LocationMatchExecution)
+	s.Require().NoError(err)
+
+	// Assert
+	listAssertions.QuestWithIDExists(foundByTarget, insideQuest.ID().String())
+	listAssertions.QuestWithIDNotExists(foundByTarget, outsideQuest.ID().String())
+	listAssertions.QuestWithIDNotExists(foundByExecution, insideQuest.ID().String())
+}
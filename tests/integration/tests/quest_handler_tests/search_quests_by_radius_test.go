@@ -6,6 +6,7 @@ package quest_handler_tests
 import (
 	"context"
 
+	"quest-manager/internal/core/application/usecases/queries"
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/tests/integration/core/assertions"
 	casesteps "quest-manager/tests/integration/core/case_steps"
@@ -154,3 +155,60 @@ func (s *Suite) TestSearchQuestsByRadiusWithTargetAndExecutionLocations() {
 	s.Require().NoError(err)
 	listAssertions.QuestWithIDExists(foundQuests, quest.ID().String())
 }
+
+func (s *Suite) TestSearchQuestsByRadiusSortAndLimit() {
+	ctx := context.Background()
+
+	// Pre-condition - quests at ~1km (reward 1), ~3km (reward 5) and ~2km (reward 3)
+	center := kernel.GeoCoordinate{Lat: 45.0, Lon: 15.0}
+	offsets := []struct {
+		latOffset float64
+		reward    int
+	}{
+		{0.009, 1},
+		{0.027, 5},
+		{0.018, 3},
+	}
+	ids := make([]string, len(offsets))
+	for i, o := range offsets {
+		loc := kernel.GeoCoordinate{Lat: center.Lat + o.latOffset, Lon: center.Lon}
+		data := testdatagenerators.SimpleQuestData(
+			"Sorted Quest", "Quest for sort testing", "easy", o.reward, 30, loc, loc)
+		q, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler, data)
+		s.Require().NoError(err)
+		ids[i] = q.ID().String()
+	}
+
+	// Act - default order is by distance
+	byDistance, err := casesteps.SearchQuestsByRadiusWithDistanceStep(ctx, s.TestDIContainer.SearchQuestsByRadiusHandler,
+		queries.SearchQuestsByRadiusQuery{Center: center, RadiusKm: 10})
+	s.Require().NoError(err)
+	s.Require().Len(byDistance, 3)
+	s.Equal(ids[0], byDistance[0].Quest.ID().String())
+	s.Equal(ids[2], byDistance[1].Quest.ID().String())
+	s.Equal(ids[1], byDistance[2].Quest.ID().String())
+	s.InDelta(1.0, byDistance[0].TargetDistanceKm, 0.05)
+	s.InDelta(byDistance[0].TargetDistanceKm, byDistance[0].ExecutionDistanceKm, 1e-9)
+
+	// Act - by reward with limit
+	byReward, err := casesteps.SearchQuestsByRadiusWithDistanceStep(ctx, s.TestDIContainer.SearchQuestsByRadiusHandler,
+		queries.SearchQuestsByRadiusQuery{Center: center, RadiusKm: 10, SortBy: queries.RadiusSortReward, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(byReward, 2)
+	s.Equal(ids[1], byReward[0].Quest.ID().String())
+	s.Equal(ids[2], byReward[1].Quest.ID().String())
+}
+
+func (s *Suite) TestSearchQuestsByRadiusInvalidSort() {
+	ctx := context.Background()
+
+	_, err := casesteps.SearchQuestsByRadiusWithDistanceStep(ctx, s.TestDIContainer.SearchQuestsByRadiusHandler,
+		queries.SearchQuestsByRadiusQuery{
+			Center:   kernel.GeoCoordinate{Lat: 45.0, Lon: 15.0},
+			RadiusKm: 10,
+			SortBy:   "title",
+		})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "sort")
+}
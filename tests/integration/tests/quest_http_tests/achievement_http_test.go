@@ -0,0 +1,103 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Achievements of the authenticated user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// completeQuestFor creates a quest at the location and completes it with the user as participant
+func (s *Suite) completeQuestFor(ctx context.Context, userID uuid.UUID, difficulty string, location kernel.GeoCoordinate) {
+	creator := uuid.New()
+	cmd := testdatagenerators.SimpleQuestData("Garden", "Weed the garden", difficulty, 1, 30, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: userID})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: userID, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: creator, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *Suite) listMyAchievements(ctx context.Context) map[string]v1.Achievement {
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListMyAchievementsHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var list []v1.Achievement
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &list))
+	byCode := make(map[string]v1.Achievement, len(list))
+	for _, a := range list {
+		byCode[a.Code] = a
+	}
+	return byCode
+}
+
+func (s *Suite) TestListMyAchievementsHTTP_NothingUnlocked() {
+	ctx := context.Background()
+
+	// Act
+	achievements := s.listMyAchievements(ctx)
+
+	// Assert - definitions from testdata/achievements.yaml, none unlocked
+	s.Len(achievements, 4)
+	for _, a := range achievements {
+		s.False(a.Unlocked, a.Code)
+		s.Nil(a.UnlockedAt, a.Code)
+		s.Equal(0, a.Progress, a.Code)
+	}
+	s.Equal(2, achievements["hard_pair"].Target)
+}
+
+func (s *Suite) TestListMyAchievementsHTTP_UnlockedByCompletedQuests() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+
+	// Pre-condition - a hard quest in Moscow
+	s.completeQuestFor(ctx, userID, "hard", kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173})
+
+	achievements := s.listMyAchievements(ctx)
+	s.True(achievements["first_steps"].Unlocked)
+	s.True(achievements["first_quest"].Unlocked)
+	s.False(achievements["hard_pair"].Unlocked)
+	s.Equal(1, achievements["hard_pair"].Progress)
+	s.Equal(1, achievements["traveller"].Progress)
+
+	// Act - a second hard quest in Saint Petersburg
+	s.completeQuestFor(ctx, userID, "hard", kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3351})
+	achievements = s.listMyAchievements(ctx)
+
+	// Assert
+	s.True(achievements["hard_pair"].Unlocked)
+	s.NotNil(achievements["hard_pair"].UnlockedAt)
+	s.Equal(2, achievements["hard_pair"].Progress)
+	s.True(achievements["traveller"].Unlocked)
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByAggregateID(ctx, userID)
+	s.Require().NoError(err)
+	unlocked := 0
+	for _, e := range events {
+		if e.EventType == "user.achievement_unlocked" {
+			unlocked++
+		}
+	}
+	s.Equal(4, unlocked, "each achievement is unlocked once")
+}
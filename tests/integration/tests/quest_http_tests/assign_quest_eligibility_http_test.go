@@ -0,0 +1,116 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Eligibility policy checks on assignment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/tests/integration/core/assertions"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+)
+
+// problemWithInvalidParams is a problem details response with the invalid_params extension
+type problemWithInvalidParams struct {
+	Status        int `json:"status"`
+	InvalidParams []struct {
+		Name   string   `json:"name"`
+		Reason string   `json:"reason"`
+		Values []string `json:"values"`
+	} `json:"invalid_params"`
+}
+
+func (s *Suite) createQuestWithEligibilityPolicy(ctx context.Context, policy string) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	data := testdatagenerators.SimpleQuestData("Rescue", "Quest with requirements", "hard", 5, 60, location, location)
+	data.Skills = []string{"climbing", "first aid"}
+	data.Equipment = []string{"rope"}
+
+	cmd := data.ToCreateCommand()
+	cmd.EligibilityPolicy = policy
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *Suite) TestAssignQuestHTTP_StrictEligibilityRejects() {
+	ctx := context.Background()
+	created := s.createQuestWithEligibilityPolicy(ctx, "strict")
+
+	skills := []string{"climbing"}
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateMyProfileHTTPRequest(v1.UpdateUserProfileRequest{DisplayName: "Climber", Skills: &skills}))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	// Act
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.AssignQuestHTTPRequest(created.ID()))
+
+	// Assert - 400 with the missing requirements
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+
+	var problem problemWithInvalidParams
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &problem))
+	s.Require().Len(problem.InvalidParams, 2)
+	s.Equal("skills", problem.InvalidParams[0].Name)
+	s.Equal([]string{"first aid"}, problem.InvalidParams[0].Values)
+	s.Equal("equipment", problem.InvalidParams[1].Name)
+	s.Equal([]string{"rope"}, problem.InvalidParams[1].Values)
+}
+
+func (s *Suite) TestAssignQuestHTTP_StrictEligibilityAccepts() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+	created := s.createQuestWithEligibilityPolicy(ctx, "strict")
+
+	skills := []string{"Climbing", "First Aid"}
+	equipment := []string{"rope"}
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateMyProfileHTTPRequest(v1.UpdateUserProfileRequest{DisplayName: "Rescuer", Skills: &skills, Equipment: &equipment}))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	// Act
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.AssignQuestHTTPRequest(created.ID()))
+
+	// Assert
+	result := httpAssertions.QuestHTTPAssignedSuccessfully(resp, err)
+	s.Nil(result.EligibilityWarnings)
+}
+
+func (s *Suite) TestAssignQuestHTTP_WarnEligibilityAssignsWithWarnings() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+	created := s.createQuestWithEligibilityPolicy(ctx, "warn")
+
+	// Act - no profile saved, so every requirement is missing
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.AssignQuestHTTPRequest(created.ID()))
+
+	// Assert
+	result := httpAssertions.QuestHTTPAssignedSuccessfully(resp, err)
+	s.Require().NotNil(result.EligibilityWarnings)
+	s.Len(*result.EligibilityWarnings, 2)
+}
+
+func (s *Suite) TestCreateQuestHTTP_WithEligibilityPolicy() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	questRequest := testdatagenerators.RandomCreateQuestRequest()
+	policy := v1.Warn
+	questRequest.EligibilityPolicy = &policy
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	created := httpAssertions.QuestHTTPCreatedSuccessfully(resp, err)
+	s.Equal(v1.Warn, created.EligibilityPolicy)
+}
@@ -0,0 +1,90 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Location autocomplete endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/location"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+)
+
+func (s *Suite) TestAutocompleteLocationsHTTP() {
+	ctx := context.Background()
+
+	// Pre-condition - save named locations
+	names := []string{"Bolshoi Theatre", "Bolshoi Kamenny Bridge", "Tretyakov Gallery"}
+	saved := make(map[string]*location.Location, len(names))
+	for i, name := range names {
+		n := name
+		loc, err := location.NewNamedLocation(kernel.GeoCoordinate{Lat: 55.75 + float64(i)*0.01, Lon: 37.61}, &n, nil)
+		s.Require().NoError(err)
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, loc))
+		saved[name] = loc
+	}
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AutocompleteLocationsHTTPRequest("bolshoi", 5))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var suggestions []v1.LocationSuggestion
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &suggestions))
+	s.Require().Len(suggestions, 2)
+	for _, sug := range suggestions {
+		s.Require().NotNil(sug.Name)
+		s.Contains(*sug.Name, "Bolshoi")
+		s.Greater(sug.Score, float32(0))
+	}
+	s.NotEqual(saved["Tretyakov Gallery"].ID(), suggestions[0].Id)
+}
+
+func (s *Suite) TestAutocompleteLocationsHTTP_QueryTooShort() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AutocompleteLocationsHTTPRequest("b", 0))
+
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *Suite) TestCreateQuestHTTP_WithLocationNames() {
+	ctx := context.Background()
+
+	// Pre-condition - quest request with named locations
+	targetName := "Pushkin Square"
+	execName := "Patriarch Ponds"
+	request := map[string]interface{}{
+		"title":            "Named locations quest",
+		"description":      "Quest with named locations",
+		"difficulty":       "easy",
+		"reward":           1,
+		"duration_minutes": 30,
+		"target_location": map[string]interface{}{
+			"latitude": 55.7655, "longitude": 37.6050, "name": targetName,
+		},
+		"execution_location": map[string]interface{}{
+			"latitude": 55.7636, "longitude": 37.5929, "name": execName,
+		},
+	}
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(request))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, createResp.StatusCode, createResp.Body)
+
+	// Assert - locations are searchable by their names
+	matches, err := s.TestDIContainer.LocationRepository.FindByName(ctx, "patriarch")
+	s.Require().NoError(err)
+	s.Require().Len(matches, 1)
+	s.Equal(execName, *matches[0].Name)
+}
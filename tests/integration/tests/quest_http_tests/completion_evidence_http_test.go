@@ -0,0 +1,196 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Completion with evidence: submission, review and photo download
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+
+	"github.com/google/uuid"
+)
+
+// pngPhoto starts with the PNG signature, which is enough for content type detection
+var pngPhoto = casesteps.EvidencePhoto{
+	FileName: "park.png",
+	Content:  []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01"),
+}
+
+// startTeamQuest creates a quest of creator and lets the participant join and start it
+func (s *Suite) startTeamQuest(ctx context.Context, creator, participant uuid.UUID) quest.Quest {
+	created := s.createTeamQuest(ctx, creator, 1, 1)
+	_, err := s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: participant})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(),
+		UserID:  participant,
+		Status:  quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	return created
+}
+
+// submitEvidence lets the participant submit evidence through the command handler
+func (s *Suite) submitEvidence(ctx context.Context, questID, participant uuid.UUID) {
+	_, err := s.TestDIContainer.SubmitCompletionEvidenceHandler.Handle(ctx, commands.SubmitCompletionEvidenceCommand{
+		QuestID:  questID,
+		UserID:   participant,
+		Note:     "Done",
+		Position: kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestSubmitEvidenceHTTP_WithPhotoThenDownload() {
+	ctx := context.Background()
+	created := s.startTeamQuest(ctx, uuid.New(), s.TestDIContainer.MockAuthClient.DefaultUserID)
+
+	// Act
+	req, err := casesteps.SubmitEvidenceHTTPRequest(created.ID(), "Collected four bags", "55.7558", "37.6173", pngPhoto)
+	s.Require().NoError(err)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	s.Require().NoError(err)
+
+	// Assert - the content type is detected from the content
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	var result v1.SubmitEvidenceResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(v1.QuestStatusPendingReview, result.QuestStatus)
+	s.Equal("Collected four bags", result.Evidence.Note)
+	s.Equal(v1.EvidenceStatusPending, result.Evidence.Status)
+	s.Require().Len(result.Evidence.Attachments, 1)
+	attachment := result.Evidence.Attachments[0]
+	s.Equal("park.png", attachment.FileName)
+	s.Equal("image/png", attachment.ContentType)
+
+	// Act - list and download as a participant
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListEvidenceHTTPRequest(created.ID()))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, listResp.StatusCode, listResp.Body)
+	var evidence []v1.CompletionEvidence
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &evidence))
+	s.Require().Len(evidence, 1)
+	s.Equal(result.Evidence.Id, evidence[0].Id)
+
+	downloadResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetEvidenceAttachmentHTTPRequest(created.ID(), result.Evidence.Id, attachment.Id))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, downloadResp.StatusCode, downloadResp.Body)
+	s.Equal("image/png", downloadResp.Headers.Get("Content-Type"))
+	s.Equal(string(pngPhoto.Content), downloadResp.Body)
+}
+
+func (s *Suite) TestSubmitEvidenceHTTP_RejectsNonImage() {
+	ctx := context.Background()
+	created := s.startTeamQuest(ctx, uuid.New(), s.TestDIContainer.MockAuthClient.DefaultUserID)
+
+	// Act
+	req, err := casesteps.SubmitEvidenceHTTPRequest(created.ID(), "Done", "55.7558", "37.6173",
+		casesteps.EvidencePhoto{FileName: "report.png", Content: []byte("%PDF-1.4 not a photo")})
+	s.Require().NoError(err)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestSubmitEvidenceHTTP_RequiresPosition() {
+	ctx := context.Background()
+	created := s.startTeamQuest(ctx, uuid.New(), s.TestDIContainer.MockAuthClient.DefaultUserID)
+
+	// Act
+	req, err := casesteps.SubmitEvidenceHTTPRequest(created.ID(), "Done", "55.7558", "")
+	s.Require().NoError(err)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestSubmitEvidenceHTTP_NonParticipantForbidden() {
+	ctx := context.Background()
+	created := s.startTeamQuest(ctx, uuid.New(), uuid.New())
+
+	// Act
+	req, err := casesteps.SubmitEvidenceHTTPRequest(created.ID(), "Done", "55.7558", "37.6173")
+	s.Require().NoError(err)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestApproveCompletionHTTP() {
+	ctx := context.Background()
+	participant := uuid.New()
+	created := s.startTeamQuest(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID, participant)
+	s.submitEvidence(ctx, created.ID(), participant)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewCompletionHTTPRequest(created.ID(), "approve", nil))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var result v1.ChangeQuestStatusResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(v1.QuestStatusCompleted, result.Status)
+}
+
+func (s *Suite) TestRejectCompletionHTTP() {
+	ctx := context.Background()
+	participant := uuid.New()
+	created := s.startTeamQuest(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID, participant)
+	s.submitEvidence(ctx, created.ID(), participant)
+
+	// Act - a reason is required
+	emptyResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewCompletionHTTPRequest(created.ID(), "reject", v1.RejectCompletionRequest{Reason: " "}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, emptyResp.StatusCode, emptyResp.Body)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewCompletionHTTPRequest(created.ID(), "reject", v1.RejectCompletionRequest{Reason: "The bins are still full"}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var result v1.ChangeQuestStatusResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(v1.QuestStatusInProgress, result.Status)
+
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListEvidenceHTTPRequest(created.ID()))
+	s.Require().NoError(err)
+	var evidence []v1.CompletionEvidence
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &evidence))
+	s.Require().Len(evidence, 1)
+	s.Equal(v1.EvidenceStatusRejected, evidence[0].Status)
+	s.Require().NotNil(evidence[0].RejectionReason)
+	s.Equal("The bins are still full", *evidence[0].RejectionReason)
+}
+
+func (s *Suite) TestApproveCompletionHTTP_NonCreatorForbidden() {
+	ctx := context.Background()
+	participant := uuid.New()
+	created := s.startTeamQuest(ctx, uuid.New(), participant)
+	s.submitEvidence(ctx, created.ID(), participant)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewCompletionHTTPRequest(created.ID(), "approve", nil))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+}
@@ -62,13 +62,13 @@ func (s *Suite) TestCreateQuestHTTPWithEmptyArrays() {
 		Difficulty:      v1.CreateQuestRequestDifficultyEasy,
 		Reward:          2,
 		DurationMinutes: 30,
-		TargetLocation: v1.Coordinate{
-			Latitude:  55.7558,
-			Longitude: 37.6176,
+		TargetLocation: v1.LocationInput{
+			Latitude:  float32Ptr(55.7558),
+			Longitude: float32Ptr(37.6176),
 		},
-		ExecutionLocation: v1.Coordinate{
-			Latitude:  55.7560,
-			Longitude: 37.6178,
+		ExecutionLocation: v1.LocationInput{
+			Latitude:  float32Ptr(55.7560),
+			Longitude: float32Ptr(37.6178),
 		},
 		Equipment: &[]string{}, // Empty array
 		Skills:    &[]string{}, // Empty array
@@ -272,15 +272,15 @@ func (s *Suite) TestCreateQuestHTTPWithLocationAddresses() {
 		Difficulty:      v1.CreateQuestRequestDifficultyMedium,
 		Reward:          3,
 		DurationMinutes: 60,
-		TargetLocation: v1.Coordinate{
+		TargetLocation: v1.LocationInput{
 			Address:   &targetAddress,
-			Latitude:  55.7558,
-			Longitude: 37.6176,
+			Latitude:  float32Ptr(55.7558),
+			Longitude: float32Ptr(37.6176),
 		},
-		ExecutionLocation: v1.Coordinate{
+		ExecutionLocation: v1.LocationInput{
 			Address:   &executionAddress,
-			Latitude:  55.7560,
-			Longitude: 37.6178,
+			Latitude:  float32Ptr(55.7560),
+			Longitude: float32Ptr(37.6178),
 		},
 		Equipment: &[]string{"map", "camera"},
 		Skills:    &[]string{"navigation", "photography"},
@@ -316,14 +316,14 @@ func (s *Suite) TestCreateQuestHTTPWithoutAddresses() {
 		Difficulty:      v1.CreateQuestRequestDifficultyEasy,
 		Reward:          2,
 		DurationMinutes: 45,
-		TargetLocation: v1.Coordinate{
-			Latitude:  55.7558,
-			Longitude: 37.6176,
+		TargetLocation: v1.LocationInput{
+			Latitude:  float32Ptr(55.7558),
+			Longitude: float32Ptr(37.6176),
 			// No Address field
 		},
-		ExecutionLocation: v1.Coordinate{
-			Latitude:  55.7560,
-			Longitude: 37.6178,
+		ExecutionLocation: v1.LocationInput{
+			Latitude:  float32Ptr(55.7560),
+			Longitude: float32Ptr(37.6178),
 			// No Address field
 		},
 		Equipment: &[]string{"notebook"},
@@ -363,15 +363,15 @@ func (s *Suite) TestCreateQuestHTTPWithSameLocations() {
 		Difficulty:      v1.CreateQuestRequestDifficultyMedium,
 		Reward:          3,
 		DurationMinutes: 60,
-		TargetLocation: v1.Coordinate{
+		TargetLocation: v1.LocationInput{
 			Address:   &sameAddress,
-			Latitude:  55.7558,
-			Longitude: 37.6176,
+			Latitude:  float32Ptr(55.7558),
+			Longitude: float32Ptr(37.6176),
 		},
-		ExecutionLocation: v1.Coordinate{
-			Address:   &sameAddress, // Same address
-			Latitude:  55.7558,      // Same coordinates
-			Longitude: 37.6176,
+		ExecutionLocation: v1.LocationInput{
+			Address:   &sameAddress,        // Same address
+			Latitude:  float32Ptr(55.7558), // Same coordinates
+			Longitude: float32Ptr(37.6176),
 		},
 		Equipment: &[]string{"camera"},
 		Skills:    &[]string{"photography"},
@@ -405,15 +405,15 @@ func (s *Suite) TestCreateQuestHTTPWithExistingLocationSameAddress() {
 		Difficulty:      v1.CreateQuestRequestDifficultyEasy,
 		Reward:          2,
 		DurationMinutes: 30,
-		TargetLocation: v1.Coordinate{
+		TargetLocation: v1.LocationInput{
 			Address:   &sharedAddress,
-			Latitude:  55.7520,
-			Longitude: 37.6175,
+			Latitude:  float32Ptr(55.7520),
+			Longitude: float32Ptr(37.6175),
 		},
-		ExecutionLocation: v1.Coordinate{
+		ExecutionLocation: v1.LocationInput{
 			Address:   &sharedAddress,
-			Latitude:  55.7520,
-			Longitude: 37.6175,
+			Latitude:  float32Ptr(55.7520),
+			Longitude: float32Ptr(37.6175),
 		},
 		Equipment: &[]string{},
 		Skills:    &[]string{},
@@ -437,15 +437,15 @@ func (s *Suite) TestCreateQuestHTTPWithExistingLocationSameAddress() {
 		Difficulty:      v1.CreateQuestRequestDifficultyMedium,
 		Reward:          3,
 		DurationMinutes: 45,
-		TargetLocation: v1.Coordinate{
-			Address:   &sharedAddress, // Same address
-			Latitude:  55.7520,        // Same coordinates
-			Longitude: 37.6175,
+		TargetLocation: v1.LocationInput{
+			Address:   &sharedAddress,      // Same address
+			Latitude:  float32Ptr(55.7520), // Same coordinates
+			Longitude: float32Ptr(37.6175),
 		},
-		ExecutionLocation: v1.Coordinate{
-			Address:   &sharedAddress, // Same address
-			Latitude:  55.7520,        // Same coordinates
-			Longitude: 37.6175,
+		ExecutionLocation: v1.LocationInput{
+			Address:   &sharedAddress,      // Same address
+			Latitude:  float32Ptr(55.7520), // Same coordinates
+			Longitude: float32Ptr(37.6175),
 		},
 		Equipment: &[]string{"map"},
 		Skills:    &[]string{"navigation"},
@@ -487,15 +487,15 @@ func (s *Suite) TestCreateQuestHTTPWithExistingLocationDifferentAddress() {
 		Difficulty:      v1.CreateQuestRequestDifficultyEasy,
 		Reward:          2,
 		DurationMinutes: 30,
-		TargetLocation: v1.Coordinate{
+		TargetLocation: v1.LocationInput{
 			Address:   &firstAddress,
-			Latitude:  55.7558, // Same coordinates
-			Longitude: 37.6176,
+			Latitude:  float32Ptr(55.7558), // Same coordinates
+			Longitude: float32Ptr(37.6176),
 		},
-		ExecutionLocation: v1.Coordinate{
+		ExecutionLocation: v1.LocationInput{
 			Address:   &firstAddress,
-			Latitude:  55.7558,
-			Longitude: 37.6176,
+			Latitude:  float32Ptr(55.7558),
+			Longitude: float32Ptr(37.6176),
 		},
 		Equipment: &[]string{},
 		Skills:    &[]string{},
@@ -520,15 +520,15 @@ func (s *Suite) TestCreateQuestHTTPWithExistingLocationDifferentAddress() {
 		Difficulty:      v1.CreateQuestRequestDifficultyMedium,
 		Reward:          3,
 		DurationMinutes: 45,
-		TargetLocation: v1.Coordinate{
-			Address:   &secondAddress, // Different address
-			Latitude:  55.7558,        // Same coordinates
-			Longitude: 37.6176,
+		TargetLocation: v1.LocationInput{
+			Address:   &secondAddress,      // Different address
+			Latitude:  float32Ptr(55.7558), // Same coordinates
+			Longitude: float32Ptr(37.6176),
 		},
-		ExecutionLocation: v1.Coordinate{
-			Address:   &secondAddress, // Different address
-			Latitude:  55.7558,        // Same coordinates
-			Longitude: 37.6176,
+		ExecutionLocation: v1.LocationInput{
+			Address:   &secondAddress,      // Different address
+			Latitude:  float32Ptr(55.7558), // Same coordinates
+			Longitude: float32Ptr(37.6176),
 		},
 		Equipment: &[]string{"guidebook"},
 		Skills:    &[]string{"history"},
@@ -0,0 +1,86 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// On-site quests: geofence radius and positions on status changes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) TestCreateQuestHTTP_GeofenceRadius() {
+	ctx := context.Background()
+	request := testdatagenerators.RandomCreateQuestRequest()
+	radius := 150
+	request.GeofenceRadius = &radius
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(request))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+
+	var created v1.Quest
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &created))
+	s.Equal(150, created.GeofenceRadius)
+}
+
+func (s *Suite) TestCreateQuestHTTP_GeofenceRadiusTooLarge() {
+	ctx := context.Background()
+	request := testdatagenerators.RandomCreateQuestRequest()
+	radius := 20000
+	request.GeofenceRadius = &radius
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(request))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestChangeQuestStatusHTTP_Geofence() {
+	ctx := context.Background()
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	cmd := testdatagenerators.SimpleQuestData("Garden", "Water the community garden", "easy", 2, 60, location, location).ToCreateCommand()
+	cmd.Creator = uuid.New().String()
+	cmd.GeofenceRadius = 100
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{
+		ID:     created.ID(),
+		UserID: s.TestDIContainer.MockAuthClient.DefaultUserID,
+	})
+	s.Require().NoError(err)
+
+	start := func(position *v1.Coordinate) *casesteps.HTTPResponse {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+			casesteps.ChangeQuestStatusHTTPRequest(created.ID(), v1.ChangeStatusRequest{Status: v1.QuestStatusInProgress, Position: position}))
+		s.Require().NoError(err)
+		return resp
+	}
+
+	// Act & Assert - a position is required and has to be near the target location
+	missing := start(nil)
+	s.Equal(http.StatusBadRequest, missing.StatusCode, missing.Body)
+
+	far := start(&v1.Coordinate{Latitude: 55.7658, Longitude: 37.6173})
+	s.Equal(http.StatusBadRequest, far.StatusCode, far.Body)
+
+	near := start(&v1.Coordinate{Latitude: 55.7559, Longitude: 37.6174})
+	s.Require().Equal(http.StatusOK, near.StatusCode, near.Body)
+
+	var result v1.ChangeQuestStatusResult
+	s.Require().NoError(json.Unmarshal([]byte(near.Body), &result))
+	s.Equal(v1.QuestStatusInProgress, result.Status)
+}
@@ -0,0 +1,114 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Content negotiation: GeoJSON export of quest lists
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/tests/integration/core/assertions"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestListQuestsHTTPGeoJSON() {
+	ctx := context.Background()
+
+	// Pre-condition - quest with different target and execution locations
+	target := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	execution := kernel.GeoCoordinate{Lat: 55.7601, Lon: 37.6186}
+	created, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("GeoJSON Quest", "Quest for GeoJSON export", "easy", 2, 30, target, execution))
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.WithAccept(casesteps.ListQuestsHTTPRequest(""), "application/geo+json"))
+
+	// Assert - one point feature per location, coordinates in [lon, lat] order
+	collection := assertions.NewQuestHTTPAssertions(s.Assert()).QuestHTTPGeoJSONSuccessfully(resp, err)
+
+	features := make(map[string]v1.QuestFeature)
+	for _, f := range collection.Features {
+		features[f.Id] = f
+	}
+
+	targetFeature, ok := features[created.ID().String()+":target"]
+	s.Require().True(ok, "Target feature should be present")
+	s.Equal(v1.Point, targetFeature.Geometry.Type)
+	s.Equal([]float64{target.Lon, target.Lat}, targetFeature.Geometry.Coordinates)
+	s.Equal(created.ID(), targetFeature.Properties.QuestId)
+	s.Equal(v1.QuestFeaturePropertiesLocationRoleTarget, targetFeature.Properties.LocationRole)
+	s.Equal("GeoJSON Quest", targetFeature.Properties.Title)
+	s.Equal(2, targetFeature.Properties.Reward)
+	s.Nil(targetFeature.Properties.DistanceKm)
+
+	executionFeature, ok := features[created.ID().String()+":execution"]
+	s.Require().True(ok, "Execution feature should be present")
+	s.Equal([]float64{execution.Lon, execution.Lat}, executionFeature.Geometry.Coordinates)
+	s.Equal(v1.QuestFeaturePropertiesLocationRoleExecution, executionFeature.Properties.LocationRole)
+}
+
+func (s *Suite) TestSearchQuestsByRadiusHTTPGeoJSON() {
+	ctx := context.Background()
+
+	center := kernel.GeoCoordinate{Lat: 48.8566, Lon: 2.3522}
+	near := kernel.GeoCoordinate{Lat: 48.8576, Lon: 2.3522}
+	far := kernel.GeoCoordinate{Lat: 48.8766, Lon: 2.3522}
+
+	farQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Far", "Far quest", "easy", 1, 30, far, far))
+	s.Require().NoError(err)
+	nearQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Near", "Near quest", "easy", 1, 30, near, near))
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.WithAccept(casesteps.SearchQuestsByRadiusHTTPRequest(
+			float32(center.Lat), float32(center.Lon), 5), "application/geo+json"))
+
+	// Assert - features follow the result order (closest first) and carry distances
+	collection := assertions.NewQuestHTTPAssertions(s.Assert()).QuestHTTPGeoJSONSuccessfully(resp, err)
+	s.Require().Len(collection.Features, 4)
+	s.Equal(nearQuest.ID(), collection.Features[0].Properties.QuestId)
+	s.Equal(farQuest.ID(), collection.Features[2].Properties.QuestId)
+	for _, f := range collection.Features {
+		s.Require().NotNil(f.Properties.DistanceKm)
+	}
+	s.InDelta(0.11, *collection.Features[0].Properties.DistanceKm, 0.01)
+}
+
+func (s *Suite) TestListQuestsHTTPContentNegotiation() {
+	ctx := context.Background()
+
+	_, err := casesteps.CreateMultipleRandomQuests(ctx, s.TestDIContainer.CreateQuestHandler, 1)
+	s.Require().NoError(err)
+
+	tests := []struct {
+		accept      string
+		contentType string
+	}{
+		{"", "application/json"},
+		{"application/json", "application/json"},
+		{"*/*", "application/json"},
+		{"text/html", "application/json"},
+		{"application/geo+json", "application/geo+json"},
+		{"application/geo+json, application/json;q=0.5", "application/geo+json"},
+		{"application/geo+json;q=0.5, application/json", "application/json"},
+		{"application/json;q=0, */*", "application/geo+json"},
+	}
+
+	for _, tt := range tests {
+		req := casesteps.ListQuestsHTTPRequest("")
+		if tt.accept != "" {
+			req = casesteps.WithAccept(req, tt.accept)
+		}
+
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+		s.Require().NoError(err)
+		s.Equal(tt.contentType, resp.Headers.Get("Content-Type"), "Accept: %q", tt.accept)
+	}
+}
@@ -0,0 +1,126 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Map tile clustering endpoint (JSON and Mapbox Vector Tile output)
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/domain/model/kernel"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) createTileQuests(ctx context.Context) kernel.Tile {
+	berlinA := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.405}
+	berlinB := kernel.GeoCoordinate{Lat: 52.521, Lon: 13.406}
+	spandau := kernel.GeoCoordinate{Lat: 52.535, Lon: 13.2}
+
+	for _, c := range []kernel.GeoCoordinate{berlinA, berlinB, spandau} {
+		_, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+			testdatagenerators.SimpleQuestData("Tile Quest", "Quest for tile clustering", "easy", 1, 30, c, c))
+		s.Require().NoError(err)
+	}
+	return kernel.TileForCoordinate(berlinA, 8)
+}
+
+func (s *Suite) TestGetQuestTileHTTPJSON() {
+	ctx := context.Background()
+	tile := s.createTileQuests(ctx)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetQuestTileHTTPRequest(tile.Z, tile.X, tile.Y, "resolution=3"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var result v1.QuestTile
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(tile.Z, result.Z)
+	s.Equal(3, result.Resolution)
+	s.Require().Len(result.Clusters, 2)
+
+	total := 0
+	for _, c := range result.Clusters {
+		s.Equal(tile.Z+3, c.Z)
+		s.Len(c.Bbox, 4)
+		s.Equal(c.Count, c.Statuses.Created, "all quests are newly created")
+		total += c.Count
+	}
+	s.Equal(3, total)
+}
+
+func (s *Suite) TestGetQuestTileHTTPVectorTile() {
+	ctx := context.Background()
+	tile := s.createTileQuests(ctx)
+
+	requests := []casesteps.HTTPRequest{
+		casesteps.GetQuestTileHTTPRequest(tile.Z, tile.X, tile.Y, "format=mvt"),
+		casesteps.WithAccept(casesteps.GetQuestTileHTTPRequest(tile.Z, tile.X, tile.Y, ""), "application/vnd.mapbox-vector-tile"),
+	}
+
+	for _, req := range requests {
+		// Act
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+		// Assert - a single "quests" layer with one point feature per cluster
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+		s.Equal("application/vnd.mapbox-vector-tile", resp.Headers.Get("Content-Type"))
+
+		name, features := s.decodeVectorTileLayer([]byte(resp.Body))
+		s.Equal("quests", name)
+		s.Equal(2, features)
+	}
+}
+
+func (s *Suite) TestGetQuestTileHTTPInvalidParams() {
+	ctx := context.Background()
+
+	for _, req := range []casesteps.HTTPRequest{
+		casesteps.GetQuestTileHTTPRequest(3, 8, 0, ""),             // x out of range for zoom
+		casesteps.GetQuestTileHTTPRequest(23, 0, 0, ""),            // zoom too deep
+		casesteps.GetQuestTileHTTPRequest(3, 0, 0, "resolution=7"), // resolution too fine
+		casesteps.GetQuestTileHTTPRequest(3, 0, 0, "format=png"),   // unknown format
+	} {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, req.URL)
+	}
+}
+
+// decodeVectorTileLayer returns the name and feature count of the single layer of an MVT
+func (s *Suite) decodeVectorTileLayer(tile []byte) (string, int) {
+	num, typ, n := protowire.ConsumeTag(tile)
+	s.Require().True(n > 0 && num == 3 && typ == protowire.BytesType, "tile must start with a layer")
+	layer, m := protowire.ConsumeBytes(tile[n:])
+	s.Require().True(m > 0)
+	s.Require().Len(tile, n+m, "tile must have exactly one layer")
+
+	var name string
+	features := 0
+	for len(layer) > 0 {
+		num, typ, n := protowire.ConsumeTag(layer)
+		s.Require().True(n > 0)
+		layer = layer[n:]
+
+		m := protowire.ConsumeFieldValue(num, typ, layer)
+		s.Require().True(m > 0)
+		switch num {
+		case 1:
+			v, _ := protowire.ConsumeString(layer)
+			name = v
+		case 2:
+			features++
+		}
+		layer = layer[m:]
+	}
+	return name, features
+}
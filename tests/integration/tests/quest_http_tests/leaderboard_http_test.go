@@ -0,0 +1,96 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Leaderboards by period, metric and area
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// completeQuestAt creates a quest executed at the location and completes it with the participant
+func (s *Suite) completeQuestAt(participant uuid.UUID, location kernel.GeoCoordinate) {
+	ctx := context.Background()
+	creator := uuid.New()
+	cmd := testdatagenerators.SimpleQuestData("Leaderboard", "Help the neighbourhood", "easy", 2, 60, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: participant})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: participant, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: creator, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestGetLeaderboardHTTP() {
+	ctx := context.Background()
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	petersburg := kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3351}
+	local, remote := uuid.New(), uuid.New()
+
+	// Pre-condition - one quest near Moscow, two in St Petersburg
+	s.completeQuestAt(local, moscow)
+	s.completeQuestAt(remote, petersburg)
+	s.completeQuestAt(remote, petersburg)
+
+	// Act
+	allResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetLeaderboardHTTPRequest("period=day&metric=points"))
+	s.Require().NoError(err)
+	areaResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetLeaderboardHTTPRequest("lat=55.7558&lon=37.6173&radius_km=50"))
+	s.Require().NoError(err)
+
+	// Assert - everywhere
+	s.Require().Equal(http.StatusOK, allResp.StatusCode, allResp.Body)
+	var all v1.Leaderboard
+	s.Require().NoError(json.Unmarshal([]byte(allResp.Body), &all))
+	s.Equal(v1.Day, all.Period)
+	s.Equal(v1.LeaderboardMetricPoints, all.Metric)
+	s.Require().Len(all.Standings, 2)
+	s.Equal(v1.LeaderboardStanding{Rank: 1, UserId: remote, Score: 40}, all.Standings[0])
+	s.Equal(v1.LeaderboardStanding{Rank: 2, UserId: local, Score: 20}, all.Standings[1])
+
+	// Assert - around Moscow, completed quests this week by default
+	s.Require().Equal(http.StatusOK, areaResp.StatusCode, areaResp.Body)
+	var area v1.Leaderboard
+	s.Require().NoError(json.Unmarshal([]byte(areaResp.Body), &area))
+	s.Equal(v1.Week, area.Period)
+	s.Equal(v1.LeaderboardMetricCompleted, area.Metric)
+	s.Require().Len(area.Standings, 1)
+	s.Equal(v1.LeaderboardStanding{Rank: 1, UserId: local, Score: 1}, area.Standings[0])
+}
+
+func (s *Suite) TestGetLeaderboardHTTP_InvalidParameters() {
+	ctx := context.Background()
+
+	for _, rawQuery := range []string{
+		"period=year",
+		"metric=reward",
+		"limit=101",
+		"lat=55.7558&lon=37.6173",
+		"radius_km=10",
+	} {
+		// Act
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetLeaderboardHTTPRequest(rawQuery))
+
+		// Assert
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, "%s: %s", rawQuery, resp.Body)
+	}
+}
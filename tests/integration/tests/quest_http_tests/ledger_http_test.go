@@ -0,0 +1,91 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Points balance and ledger of the authenticated user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) TestGetMyBalanceHTTP_Empty() {
+	ctx := context.Background()
+
+	// Act - nothing credited yet
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMyBalanceHTTPRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var balance v1.Balance
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &balance))
+	s.Equal(s.TestDIContainer.MockAuthClient.DefaultUserID, balance.UserId)
+	s.Equal(0, balance.Points)
+}
+
+func (s *Suite) TestLedgerHTTP_CompletedQuestCredited() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+
+	// Pre-condition - a hard quest with reward 3 completed by its creator
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	creator := uuid.New()
+	cmd := testdatagenerators.SimpleQuestData("Bridge", "Repair the footbridge", "hard", 3, 60, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: userID})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: userID, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: creator, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+
+	// Act
+	balanceResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMyBalanceHTTPRequest())
+	s.Require().NoError(err)
+	ledgerResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListMyLedgerHTTPRequest("limit=10"))
+	s.Require().NoError(err)
+
+	// Assert - reward 3 × 10 points × 2 for hard difficulty
+	s.Require().Equal(http.StatusOK, balanceResp.StatusCode, balanceResp.Body)
+	var balance v1.Balance
+	s.Require().NoError(json.Unmarshal([]byte(balanceResp.Body), &balance))
+	s.Equal(60, balance.Points)
+
+	s.Require().Equal(http.StatusOK, ledgerResp.StatusCode, ledgerResp.Body)
+	var page v1.LedgerPage
+	s.Require().NoError(json.Unmarshal([]byte(ledgerResp.Body), &page))
+	s.Equal(1, page.Total)
+	s.Require().Len(page.Entries, 1)
+	s.Equal(v1.QuestReward, page.Entries[0].Kind)
+	s.Equal(60, page.Entries[0].Points)
+	s.Require().NotNil(page.Entries[0].QuestId)
+	s.Equal(created.ID(), *page.Entries[0].QuestId)
+}
+
+func (s *Suite) TestListMyLedgerHTTP_InvalidLimit() {
+	ctx := context.Background()
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListMyLedgerHTTPRequest("limit=101"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
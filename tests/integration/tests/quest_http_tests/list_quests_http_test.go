@@ -75,7 +75,7 @@ func (s *Suite) TestListQuestsHTTPWithValidStatus() {
 	// Change quest status for filtering test
 	targetStatus := quest.StatusPosted
 	_, err = casesteps.ChangeQuestStatusStep(ctx, s.TestDIContainer.ChangeQuestStatusHandler,
-		s.TestDIContainer.QuestRepository, createdQuests[0].ID(), targetStatus)
+		s.TestDIContainer.QuestRepository, createdQuests[0].ID(), s.TestDIContainer.MockAuthClient.DefaultUserID, targetStatus)
 	s.Require().NoError(err)
 
 	// Leave other quests with default StatusCreated status
@@ -0,0 +1,122 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Notifications of the authenticated user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) listMyNotifications(ctx context.Context, rawQuery string) v1.NotificationPage {
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListMyNotificationsHTTPRequest(rawQuery))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var page v1.NotificationPage
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &page))
+	return page
+}
+
+func (s *Suite) TestNotificationsHTTP_AssignmentAndComment() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	created := s.questOf(ctx, userID)
+
+	// Pre-condition - someone asks a question and takes the quest of the user
+	_, err := s.TestDIContainer.AddQuestCommentHandler.Handle(ctx, commands.AddQuestCommentCommand{
+		QuestID: created.ID(), UserID: uuid.New(), Body: "Is a ladder needed?",
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+
+	// Act
+	page := s.listMyNotifications(ctx, "")
+
+	// Assert - newest first
+	s.Equal(2, page.Total)
+	s.Equal(2, page.Unread)
+	s.Require().Len(page.Notifications, 2)
+	s.Equal(v1.QuestAssigned, page.Notifications[0].Kind)
+	s.Equal(v1.QuestCommentAdded, page.Notifications[1].Kind)
+	s.Equal(created.ID(), page.Notifications[0].QuestId)
+	s.Nil(page.Notifications[0].ReadAt)
+}
+
+func (s *Suite) TestNotificationsHTTP_MarkRead() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	for i := 0; i < 2; i++ {
+		created := s.questOf(ctx, userID)
+		_, err := s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+		s.Require().NoError(err)
+	}
+	page := s.listMyNotifications(ctx, "")
+	s.Require().Len(page.Notifications, 2)
+
+	// Act - mark one read
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.MarkNotificationReadHTTPRequest(page.Notifications[0].Id))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var read v1.Notification
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &read))
+	s.NotNil(read.ReadAt)
+
+	unread := s.listMyNotifications(ctx, "unread_only=true")
+	s.Equal(1, unread.Total)
+	s.Equal(1, unread.Unread)
+
+	// Act - mark the rest read
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.MarkAllNotificationsReadHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var marked v1.MarkAllNotificationsReadResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &marked))
+	s.Equal(1, marked.Marked)
+	s.Equal(0, s.listMyNotifications(ctx, "").Unread)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.MarkNotificationReadHTTPRequest(uuid.New()))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListMyNotificationsHTTPRequest("limit=101"))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestNotificationPreferencesHTTP() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMyNotificationPreferencesHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var preferences v1.NotificationPreferences
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &preferences))
+	s.Equal(v1.NotificationPreferences{QuestAssigned: true, QuestStatusChanged: true, QuestCommentAdded: true}, preferences)
+
+	// Act - turn assignments off
+	off := false
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateMyNotificationPreferencesHTTPRequest(v1.UpdateNotificationPreferencesRequest{QuestAssigned: &off}))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &preferences))
+	s.False(preferences.QuestAssigned)
+	s.True(preferences.QuestCommentAdded)
+
+	// Assert - assignments of quests of the user are no longer notified
+	created := s.questOf(ctx, userID)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+	s.Equal(0, s.listMyNotifications(ctx, "").Total)
+}
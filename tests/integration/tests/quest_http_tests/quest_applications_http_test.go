@@ -0,0 +1,164 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Quest application workflow (review assignment mode)
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// createReviewQuest creates a quest in review mode on behalf of creator
+func (s *Suite) createReviewQuest(ctx context.Context, creator uuid.UUID) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	cmd := testdatagenerators.SimpleQuestData("Review", "Quest assigned by review", "medium", 3, 60, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	cmd.AssignmentMode = "review"
+
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *Suite) TestApplyToQuestHTTP() {
+	ctx := context.Background()
+	created := s.createReviewQuest(ctx, uuid.New())
+	message := "I know the area"
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ApplyToQuestHTTPRequest(created.ID(), v1.ApplyToQuestRequest{Message: &message}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+
+	var application v1.Application
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &application))
+	s.Equal(created.ID(), application.QuestId)
+	s.Equal(s.TestDIContainer.MockAuthClient.DefaultUserID, application.ApplicantId)
+	s.Equal(message, application.Message)
+	s.Equal(v1.ApplicationStatusPending, application.Status)
+}
+
+func (s *Suite) TestApplyToQuestHTTP_Twice() {
+	ctx := context.Background()
+	created := s.createReviewQuest(ctx, uuid.New())
+	request := casesteps.ApplyToQuestHTTPRequest(created.ID(), v1.ApplyToQuestRequest{})
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, request)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+
+	// Act
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, request)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestAssignQuestHTTP_ReviewModeRequiresApplication() {
+	ctx := context.Background()
+	created := s.createReviewQuest(ctx, uuid.New())
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.AssignQuestHTTPRequest(created.ID()))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestListQuestApplicationsHTTP_OnlyCreator() {
+	ctx := context.Background()
+	created := s.createReviewQuest(ctx, uuid.New())
+
+	// Act - the authenticated user is not the creator
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestApplicationsHTTPRequest(created.ID()))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestAcceptApplicationHTTP() {
+	ctx := context.Background()
+
+	// Pre-condition - quest of the authenticated user with two applications
+	created := s.createReviewQuest(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID)
+	accepted, err := s.TestDIContainer.ApplyToQuestHandler.Handle(ctx, commands.ApplyToQuestCommand{QuestID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ApplyToQuestHandler.Handle(ctx, commands.ApplyToQuestCommand{QuestID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestApplicationsHTTPRequest(created.ID()))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, listResp.StatusCode, listResp.Body)
+	var applications []v1.Application
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &applications))
+	s.Require().Len(applications, 2)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewApplicationHTTPRequest(created.ID(), accepted.ID, "accept"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var result v1.ReviewApplicationResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(v1.ApplicationStatusAccepted, result.Application.Status)
+	s.Equal(v1.QuestStatusAssigned, result.QuestStatus)
+
+	stored, err := s.TestDIContainer.QuestRepository.GetByID(ctx, created.ID())
+	s.Require().NoError(err)
+	s.Require().NotNil(stored.Assignee)
+	s.Equal(accepted.ApplicantID, *stored.Assignee)
+}
+
+func (s *Suite) TestRejectApplicationHTTP() {
+	ctx := context.Background()
+
+	// Pre-condition - quest of the authenticated user with an application
+	created := s.createReviewQuest(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID)
+	application, err := s.TestDIContainer.ApplyToQuestHandler.Handle(ctx, commands.ApplyToQuestCommand{QuestID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewApplicationHTTPRequest(created.ID(), application.ID, "reject"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var result v1.ReviewApplicationResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(v1.ApplicationStatusRejected, result.Application.Status)
+	s.Equal(v1.QuestStatusCreated, result.QuestStatus)
+}
+
+func (s *Suite) TestReviewApplicationHTTP_NotFound() {
+	ctx := context.Background()
+	created := s.createReviewQuest(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ReviewApplicationHTTPRequest(created.ID(), uuid.New(), "accept"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,174 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Comment thread of a quest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// questOf creates a quest of the creator
+func (s *Suite) questOf(ctx context.Context, creator uuid.UUID) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	cmd := testdatagenerators.SimpleQuestData("Fence", "Fix the fence", "easy", 1, 30, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *Suite) listQuestComments(ctx context.Context, questID uuid.UUID, rawQuery string) v1.QuestCommentPage {
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestCommentsHTTPRequest(questID, rawQuery))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var page v1.QuestCommentPage
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &page))
+	return page
+}
+
+func (s *Suite) TestAddQuestCommentHTTP_PublicQuestion() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	created := s.questOf(ctx, uuid.New())
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestCommentHTTPRequest(created.ID(), v1.AddQuestCommentRequest{Body: "Is a ladder needed?"}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	var comment v1.QuestComment
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &comment))
+	s.Equal(created.ID(), comment.QuestId)
+	s.Equal(userID, comment.AuthorId)
+	s.Equal("Is a ladder needed?", comment.Body)
+	s.Equal(v1.Public, comment.Visibility)
+	s.Nil(comment.EditedAt)
+
+	page := s.listQuestComments(ctx, created.ID(), "")
+	s.Equal(1, page.Total)
+	s.Require().Len(page.Comments, 1)
+	s.Equal(comment.Id, page.Comments[0].Id)
+
+	// The event is stored with the quest as aggregate
+	events, err := s.TestDIContainer.EventStorage.GetEventsByAggregateID(ctx, created.ID())
+	s.Require().NoError(err)
+	var names []string
+	for _, e := range events {
+		names = append(names, e.EventType)
+	}
+	s.Contains(names, "quest.comment_added")
+}
+
+func (s *Suite) TestQuestCommentsHTTP_PrivateThreadHiddenFromOthers() {
+	ctx := context.Background()
+	creator, assignee := uuid.New(), uuid.New()
+	created := s.questOf(ctx, creator)
+
+	// Pre-condition - a public question, then a private message after assignment
+	_, err := s.TestDIContainer.AddQuestCommentHandler.Handle(ctx, commands.AddQuestCommentCommand{
+		QuestID: created.ID(), UserID: uuid.New(), Body: "Is a ladder needed?",
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: assignee})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AddQuestCommentHandler.Handle(ctx, commands.AddQuestCommentCommand{
+		QuestID: created.ID(), UserID: creator, Body: "Gate code is 1234",
+	})
+	s.Require().NoError(err)
+
+	// Act & Assert - the authenticated user takes no part in the quest
+	page := s.listQuestComments(ctx, created.ID(), "")
+	s.Equal(1, page.Total)
+	s.Require().Len(page.Comments, 1)
+	s.Equal(v1.Public, page.Comments[0].Visibility)
+
+	private := v1.Private
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestCommentHTTPRequest(created.ID(), v1.AddQuestCommentRequest{Body: "Hi", Visibility: &private}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestCommentHTTPRequest(created.ID(), v1.AddQuestCommentRequest{Body: "Hi"}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestListQuestCommentsHTTP_Pagination() {
+	ctx := context.Background()
+	created := s.questOf(ctx, uuid.New())
+	for _, body := range []string{"First", "Second", "Third"} {
+		_, err := s.TestDIContainer.AddQuestCommentHandler.Handle(ctx, commands.AddQuestCommentCommand{
+			QuestID: created.ID(), UserID: uuid.New(), Body: body,
+		})
+		s.Require().NoError(err)
+	}
+
+	page := s.listQuestComments(ctx, created.ID(), "limit=2&offset=1")
+	s.Equal(3, page.Total)
+	s.Require().Len(page.Comments, 2)
+	s.Equal("Second", page.Comments[0].Body)
+	s.Equal("Third", page.Comments[1].Body)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestCommentsHTTPRequest(created.ID(), "limit=0"))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestCommentsHTTPRequest(uuid.New(), ""))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestEditQuestCommentHTTP() {
+	ctx := context.Background()
+	created := s.questOf(ctx, uuid.New())
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestCommentHTTPRequest(created.ID(), v1.AddQuestCommentRequest{Body: "Is a ladder needed?"}))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	var comment v1.QuestComment
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &comment))
+
+	// Act
+	editResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.EditQuestCommentHTTPRequest(created.ID(), comment.Id, v1.EditQuestCommentRequest{Body: "Is a tall ladder needed?"}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, editResp.StatusCode, editResp.Body)
+	var edited v1.QuestComment
+	s.Require().NoError(json.Unmarshal([]byte(editResp.Body), &edited))
+	s.Equal("Is a tall ladder needed?", edited.Body)
+	s.NotNil(edited.EditedAt)
+}
+
+func (s *Suite) TestEditQuestCommentHTTP_OtherAuthorIsForbidden() {
+	ctx := context.Background()
+	created := s.questOf(ctx, uuid.New())
+	comment, err := s.TestDIContainer.AddQuestCommentHandler.Handle(ctx, commands.AddQuestCommentCommand{
+		QuestID: created.ID(), UserID: uuid.New(), Body: "Is a ladder needed?",
+	})
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.EditQuestCommentHTTPRequest(created.ID(), comment.ID, v1.EditQuestCommentRequest{Body: "Changed"}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,105 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Geocoding of quest locations with the offline geocoder (testdata/geocoder_places.json)
+
+import (
+	"context"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/tests/integration/core/assertions"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+)
+
+func (s *Suite) TestCreateQuestHTTPWithAddressOnly() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	// Pre-condition - target given by address only, known to the offline geocoder
+	targetAddress := "Palace Square, Saint Petersburg"
+	questRequest := geocodingQuestRequest(v1.LocationInput{Address: &targetAddress})
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	created := httpAssertions.QuestHTTPCreatedSuccessfully(createResp, err)
+	s.Assert().InDelta(59.939, created.TargetLocation.Latitude, 1e-4)
+	s.Assert().InDelta(30.3158, created.TargetLocation.Longitude, 1e-4)
+
+	locations, err := s.TestDIContainer.LocationRepository.FindByAddress(ctx, targetAddress)
+	s.Require().NoError(err)
+	s.Require().Len(locations, 1, "Geocoded location should be stored and reused as a cache")
+}
+
+func (s *Suite) TestCreateQuestHTTPWithCoordinatesOnlyFillsAddress() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	// Pre-condition - target next to Kazan Cathedral, without address
+	questRequest := geocodingQuestRequest(v1.LocationInput{Latitude: float32Ptr(59.9343), Longitude: float32Ptr(30.3245)})
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	created := httpAssertions.QuestHTTPCreatedSuccessfully(createResp, err)
+	s.Require().NotNil(created.TargetLocationId)
+	locations, err := s.TestDIContainer.LocationRepository.FindByAddress(ctx, "Kazan Cathedral, Saint Petersburg")
+	s.Require().NoError(err)
+	s.Require().Len(locations, 1)
+	s.Assert().Equal(*created.TargetLocationId, locations[0].ID().String())
+}
+
+func (s *Suite) TestCreateQuestHTTPWithUnknownAddress() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	unknown := "Nowhere 1, Atlantis"
+	questRequest := geocodingQuestRequest(v1.LocationInput{Address: &unknown})
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	httpAssertions.QuestHTTPValidationError(createResp, err, "target_location")
+}
+
+func (s *Suite) TestCreateQuestHTTPWithoutCoordinatesAndAddress() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	name := "Somewhere"
+	questRequest := geocodingQuestRequest(v1.LocationInput{Name: &name})
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	httpAssertions.QuestHTTPValidationError(createResp, err, "target_location")
+}
+
+func (s *Suite) TestCreateQuestHTTPWithLatitudeOnly() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	questRequest := geocodingQuestRequest(v1.LocationInput{Latitude: float32Ptr(59.9343)})
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	httpAssertions.QuestHTTPValidationError(createResp, err, "target_location")
+}
+
+func geocodingQuestRequest(target v1.LocationInput) *v1.CreateQuestRequest {
+	return &v1.CreateQuestRequest{
+		Title:             "Geocoded Quest",
+		Description:       "Quest with a geocoded location",
+		Difficulty:        v1.CreateQuestRequestDifficultyEasy,
+		Reward:            1,
+		DurationMinutes:   30,
+		TargetLocation:    target,
+		ExecutionLocation: v1.LocationInput{Latitude: float32Ptr(59.9311), Longitude: float32Ptr(30.3609)},
+	}
+}
@@ -0,0 +1,149 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Prerequisite quests and the dependency graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) getQuestDependencies(ctx context.Context, questID uuid.UUID) v1.QuestDependencyGraph {
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetQuestDependenciesHTTPRequest(questID))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var graph v1.QuestDependencyGraph
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &graph))
+	return graph
+}
+
+func (s *Suite) TestAddQuestPrerequisiteHTTP() {
+	ctx := context.Background()
+	creator := s.TestDIContainer.MockAuthClient.DefaultUserID
+	first := s.questOf(ctx, creator)
+	second := s.questOf(ctx, creator)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestPrerequisiteHTTPRequest(second.ID(), v1.AddQuestPrerequisiteRequest{PrerequisiteId: first.ID()}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var updated v1.Quest
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &updated))
+	s.Require().NotNil(updated.Prerequisites)
+	s.Equal([]v1.QuestPrerequisite{{QuestId: first.ID(), Completed: false}}, *updated.Prerequisites)
+
+	graph := s.getQuestDependencies(ctx, first.ID())
+	s.Equal(first.ID(), graph.QuestId)
+	s.Require().Len(graph.Nodes, 2)
+	s.Equal(first.ID(), graph.Nodes[0].Id)
+	s.Equal(second.ID(), graph.Nodes[1].Id)
+	s.Equal([]v1.QuestDependencyEdge{{PrerequisiteId: first.ID(), QuestId: second.ID(), Completed: false}}, graph.Edges)
+
+	// Act & Assert - the reverse link would be a cycle
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestPrerequisiteHTTPRequest(first.ID(), v1.AddQuestPrerequisiteRequest{PrerequisiteId: second.ID()}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestAddQuestPrerequisiteHTTP_Errors() {
+	ctx := context.Background()
+	creator := s.TestDIContainer.MockAuthClient.DefaultUserID
+	own := s.questOf(ctx, creator)
+	foreign := s.questOf(ctx, uuid.New())
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestPrerequisiteHTTPRequest(foreign.ID(), v1.AddQuestPrerequisiteRequest{PrerequisiteId: own.ID()}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestPrerequisiteHTTPRequest(own.ID(), v1.AddQuestPrerequisiteRequest{PrerequisiteId: uuid.New()}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.AddQuestPrerequisiteHTTPRequest(own.ID(), v1.AddQuestPrerequisiteRequest{PrerequisiteId: own.ID()}))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetQuestDependenciesHTTPRequest(uuid.New()))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestRemoveQuestPrerequisiteHTTP() {
+	ctx := context.Background()
+	creator := s.TestDIContainer.MockAuthClient.DefaultUserID
+	first := s.questOf(ctx, creator)
+	second := s.questOf(ctx, creator)
+	_, err := s.TestDIContainer.AddQuestPrerequisiteHandler.Handle(ctx, commands.AddQuestPrerequisiteCommand{
+		QuestID: second.ID(), PrerequisiteID: first.ID(), UserID: creator,
+	})
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RemoveQuestPrerequisiteHTTPRequest(second.ID(), first.ID()))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode, resp.Body)
+	graph := s.getQuestDependencies(ctx, second.ID())
+	s.Len(graph.Nodes, 1)
+	s.Empty(graph.Edges)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RemoveQuestPrerequisiteHTTPRequest(second.ID(), first.ID()))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestQuestPrerequisitesHTTP_CompletionPostsDependentQuest() {
+	ctx := context.Background()
+	creator := s.TestDIContainer.MockAuthClient.DefaultUserID
+	participant := uuid.New()
+	first := s.questOf(ctx, creator)
+	second := s.questOf(ctx, creator)
+	_, err := s.TestDIContainer.AddQuestPrerequisiteHandler.Handle(ctx, commands.AddQuestPrerequisiteCommand{
+		QuestID: second.ID(), PrerequisiteID: first.ID(), UserID: creator,
+	})
+	s.Require().NoError(err)
+
+	// Pre-condition - the dependent quest cannot be assigned yet
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: second.ID(), UserID: participant})
+	s.Require().Error(err)
+
+	// Act - the prerequisite is assigned, started and completed
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: first.ID(), UserID: participant})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: first.ID(), UserID: participant, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: first.ID(), UserID: creator, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+
+	// Assert - the dependent quest is posted
+	graph := s.getQuestDependencies(ctx, second.ID())
+	s.Require().Len(graph.Nodes, 2)
+	s.Equal(v1.QuestStatus(quest.StatusCompleted), graph.Nodes[0].Status)
+	s.Equal(v1.QuestStatus(quest.StatusPosted), graph.Nodes[1].Status)
+	s.Require().Len(graph.Edges, 1)
+	s.True(graph.Edges[0].Completed)
+}
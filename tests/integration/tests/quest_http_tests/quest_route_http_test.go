@@ -0,0 +1,121 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Quest routes: waypoints in create/get responses and the max_route_km list filter
+
+import (
+	"context"
+	"math"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/tests/integration/core/assertions"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+)
+
+func (s *Suite) TestCreateQuestHTTPWithWaypoints() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	// Pre-condition - Berlin Mitte to Potsdam via Wannsee
+	waypoints := []v1.Waypoint{{Latitude: 52.421, Longitude: 13.179}}
+	questRequest := routeQuestRequest("Route Quest", 52.520008, 13.404954, 52.390569, 13.064473, waypoints)
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	created := httpAssertions.QuestHTTPCreatedSuccessfully(createResp, err)
+	s.Require().Len(created.Waypoints, 1)
+	s.Assert().InDelta(52.421, created.Waypoints[0].Latitude, 1e-4)
+	s.Assert().InDelta(13.179, created.Waypoints[0].Longitude, 1e-4)
+	// ~18.9km to Wannsee + ~8.5km to Potsdam, 5 km/h walking pace
+	s.Assert().InDelta(27.3, created.RouteDistanceKm, 0.1)
+	s.Assert().Equal(int(math.Ceil(created.RouteDistanceKm/5*60)), created.EstimatedTravelMinutes)
+
+	// Waypoints are persisted
+	getResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetQuestHTTPRequest(created.Id))
+	fetched := httpAssertions.QuestHTTPGetSuccessfully(getResp, err)
+	s.Assert().Equal(created.Waypoints, fetched.Waypoints)
+	s.Assert().InDelta(created.RouteDistanceKm, fetched.RouteDistanceKm, 1e-6)
+}
+
+func (s *Suite) TestCreateQuestHTTPWithoutWaypoints() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	questRequest := routeQuestRequest("Direct Quest", 52.520008, 13.404954, 52.390569, 13.064473, nil)
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert - route is the direct leg from target to execution
+	created := httpAssertions.QuestHTTPCreatedSuccessfully(createResp, err)
+	s.Assert().NotNil(created.Waypoints)
+	s.Assert().Empty(created.Waypoints)
+	s.Assert().InDelta(27.2, created.RouteDistanceKm, 0.1)
+}
+
+func (s *Suite) TestCreateQuestHTTPTooManyWaypoints() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	waypoints := make([]v1.Waypoint, 26)
+	for i := range waypoints {
+		waypoints[i] = v1.Waypoint{Latitude: 52.5, Longitude: 13.4}
+	}
+	questRequest := routeQuestRequest("Too Many Stops", 52.520008, 13.404954, 52.390569, 13.064473, waypoints)
+
+	// Act
+	createResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(questRequest))
+
+	// Assert
+	httpAssertions.QuestHTTPValidationError(createResp, err, "waypoints")
+}
+
+func (s *Suite) TestListQuestsHTTPByMaxRoute() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	// Pre-condition - a ~1km quest and a ~27km quest
+	short := routeQuestRequest("Short Route", 52.520008, 13.404954, 52.529, 13.404954, nil)
+	long := routeQuestRequest("Long Route", 52.520008, 13.404954, 52.390569, 13.064473, nil)
+	shortResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(short))
+	shortQuest := httpAssertions.QuestHTTPCreatedSuccessfully(shortResp, err)
+	longResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(long))
+	httpAssertions.QuestHTTPCreatedSuccessfully(longResp, err)
+
+	// Act
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestsByMaxRouteHTTPRequest(10))
+
+	// Assert
+	quests := httpAssertions.QuestHTTPListSuccessfully(listResp, err)
+	s.Require().Len(quests, 1)
+	s.Assert().Equal(shortQuest.Id, quests[0].Id)
+}
+
+func (s *Suite) TestListQuestsHTTPByMaxRouteInvalid() {
+	ctx := context.Background()
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+
+	// Act
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestsByMaxRouteHTTPRequest(0))
+
+	// Assert
+	httpAssertions.QuestHTTPValidationError(listResp, err, "max_route_km")
+}
+
+func routeQuestRequest(title string, targetLat, targetLon, execLat, execLon float32, waypoints []v1.Waypoint) *v1.CreateQuestRequest {
+	request := &v1.CreateQuestRequest{
+		Title:             title,
+		Description:       "Quest with a route",
+		Difficulty:        v1.CreateQuestRequestDifficultyEasy,
+		Reward:            2,
+		DurationMinutes:   60,
+		TargetLocation:    v1.LocationInput{Latitude: &targetLat, Longitude: &targetLon},
+		ExecutionLocation: v1.LocationInput{Latitude: &execLat, Longitude: &execLon},
+	}
+	if waypoints != nil {
+		request.Waypoints = &waypoints
+	}
+	return request
+}
@@ -0,0 +1,194 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Quest templates of the authenticated user and quests created from them
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/template"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+
+	"github.com/google/uuid"
+)
+
+func questTemplateRequest(name string, recurrence *v1.Recurrence) v1.QuestTemplateRequest {
+	lat, lon := float32(55.7558), float32(37.6173)
+	location := v1.LocationInput{Latitude: &lat, Longitude: &lon}
+	return v1.QuestTemplateRequest{
+		Name: name,
+		Quest: v1.CreateQuestRequest{
+			Title:             "Water the plants",
+			Description:       "Water every plant in the office",
+			Difficulty:        v1.CreateQuestRequestDifficultyEasy,
+			Reward:            1,
+			DurationMinutes:   15,
+			TargetLocation:    location,
+			ExecutionLocation: location,
+		},
+		Recurrence: recurrence,
+	}
+}
+
+func (s *Suite) createQuestTemplate(ctx context.Context, request v1.QuestTemplateRequest) v1.QuestTemplate {
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestTemplateHTTPRequest(request))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+
+	var created v1.QuestTemplate
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &created))
+	return created
+}
+
+// templateOf creates a template of another user directly through the handler
+func (s *Suite) templateOf(ctx context.Context, ownerID uuid.UUID) *template.QuestTemplate {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	created, err := s.TestDIContainer.CreateQuestTemplateHandler.Handle(ctx, commands.CreateQuestTemplateCommand{
+		OwnerID: ownerID,
+		Name:    "Fence",
+		Quest: template.Spec{
+			Title: "Fence", Description: "Fix the fence", Difficulty: "easy", Reward: 1, DurationMinutes: 30,
+			TargetLocation: &location, ExecutionLocation: &location,
+		},
+	})
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *Suite) TestQuestTemplatesHTTP_CreateGetListUpdateDelete() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	startsAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	// Act - create
+	created := s.createQuestTemplate(ctx, questTemplateRequest("Plants", &v1.Recurrence{Rule: "FREQ=WEEKLY;COUNT=4", StartsAt: &startsAt}))
+
+	// Assert
+	s.Equal("Plants", created.Name)
+	s.Equal(userID, created.OwnerId)
+	s.Equal("Water the plants", created.Quest.Title)
+	s.Require().NotNil(created.Recurrence)
+	s.Equal("FREQ=WEEKLY;COUNT=4", created.Recurrence.Rule)
+	s.Require().NotNil(created.NextOccurrenceAt)
+	s.True(startsAt.Equal(*created.NextOccurrenceAt))
+
+	// Act - get and list
+	getResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetQuestTemplateHTTPRequest(created.Id))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, getResp.StatusCode, getResp.Body)
+
+	s.templateOf(ctx, uuid.New())
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestTemplatesHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, listResp.StatusCode, listResp.Body)
+	var list []v1.QuestTemplate
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &list))
+	s.Require().Len(list, 1, "templates of other users are not listed")
+	s.Equal(created.Id, list[0].Id)
+
+	// Act - update without recurrence
+	updateResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateQuestTemplateHTTPRequest(created.Id, questTemplateRequest("Plants on demand", nil)))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, updateResp.StatusCode, updateResp.Body)
+	var updated v1.QuestTemplate
+	s.Require().NoError(json.Unmarshal([]byte(updateResp.Body), &updated))
+	s.Equal("Plants on demand", updated.Name)
+	s.Nil(updated.Recurrence)
+	s.Nil(updated.NextOccurrenceAt)
+
+	// Act - delete
+	deleteResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.DeleteQuestTemplateHTTPRequest(created.Id))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, deleteResp.StatusCode, deleteResp.Body)
+
+	getResp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetQuestTemplateHTTPRequest(created.Id))
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, getResp.StatusCode, getResp.Body)
+}
+
+func (s *Suite) TestQuestTemplatesHTTP_InvalidRecurrence() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.CreateQuestTemplateHTTPRequest(questTemplateRequest("Plants", &v1.Recurrence{Rule: "FREQ=WEEKLY;BYDAY=MO"})))
+
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+	s.Contains(resp.Body, "BYDAY")
+}
+
+func (s *Suite) TestQuestTemplatesHTTP_OtherUsersTemplateIsNotFound() {
+	ctx := context.Background()
+	other := s.templateOf(ctx, uuid.New())
+
+	requests := []casesteps.HTTPRequest{
+		casesteps.GetQuestTemplateHTTPRequest(other.ID()),
+		casesteps.UpdateQuestTemplateHTTPRequest(other.ID(), questTemplateRequest("Mine", nil)),
+		casesteps.DeleteQuestTemplateHTTPRequest(other.ID()),
+		casesteps.CreateQuestFromTemplateHTTPRequest(other.ID(), nil),
+	}
+	for _, request := range requests {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, request)
+		s.Require().NoError(err)
+		s.Equal(http.StatusNotFound, resp.StatusCode, "%s %s: %s", request.Method, request.URL, resp.Body)
+	}
+}
+
+func (s *Suite) TestQuestTemplatesHTTP_CreateQuestFromTemplate() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	created := s.createQuestTemplate(ctx, questTemplateRequest("Plants", nil))
+	title := "Water the plants on the second floor"
+	reward := 2
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.CreateQuestFromTemplateHTTPRequest(created.Id, v1.QuestOverrides{Title: &title, Reward: &reward}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	var q v1.Quest
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &q))
+	s.Equal(title, q.Title)
+	s.Equal(reward, q.Reward)
+	s.Equal(userID.String(), q.Creator)
+
+	// Without a body the template is used as is
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestFromTemplateHTTPRequest(created.Id, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &q))
+	s.Equal("Water the plants", q.Title)
+}
+
+func (s *Suite) TestQuestTemplatesHTTP_ScheduledQuestsAreCreatedOnce() {
+	ctx := context.Background()
+	startsAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	created := s.createQuestTemplate(ctx, questTemplateRequest("Plants", &v1.Recurrence{Rule: "FREQ=DAILY", StartsAt: &startsAt}))
+
+	// Act - two runs at the same time, one day after the start
+	now := startsAt.AddDate(0, 0, 1)
+	quests, err := s.TestDIContainer.CreateScheduledQuestsHandler.Handle(ctx, commands.CreateScheduledQuestsCommand{Now: now})
+	s.Require().NoError(err)
+	again, err := s.TestDIContainer.CreateScheduledQuestsHandler.Handle(ctx, commands.CreateScheduledQuestsCommand{Now: now})
+	s.Require().NoError(err)
+
+	// Assert
+	s.Len(quests, 2)
+	s.Empty(again)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetQuestTemplateHTTPRequest(created.Id))
+	s.Require().NoError(err)
+	var found v1.QuestTemplate
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &found))
+	s.Require().NotNil(found.NextOccurrenceAt)
+	s.True(startsAt.AddDate(0, 0, 2).Equal(*found.NextOccurrenceAt))
+}
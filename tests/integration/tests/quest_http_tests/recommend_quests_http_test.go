@@ -0,0 +1,129 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Quest recommendations near the user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) createPostedQuest(ctx context.Context, title string, location kernel.GeoCoordinate, skills []string) quest.Quest {
+	data := testdatagenerators.SimpleQuestData(title, "Quest for recommendations", "easy", 3, 30, location, location)
+	data.Skills = skills
+	created, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler, data)
+	s.Require().NoError(err)
+	posted, err := casesteps.ChangeQuestStatusStep(ctx, s.TestDIContainer.ChangeQuestStatusHandler,
+		s.TestDIContainer.QuestRepository, created.ID(), s.TestDIContainer.MockAuthClient.DefaultUserID, quest.StatusPosted)
+	s.Require().NoError(err)
+	return posted
+}
+
+func (s *Suite) TestRecommendQuestsHTTP() {
+	ctx := context.Background()
+
+	// Pre-condition - two posted quests nearby, one matching the user's skills, and one draft
+	position := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.405}
+	matching := s.createPostedQuest(ctx, "Photo walk", kernel.GeoCoordinate{Lat: 52.525, Lon: 13.405}, []string{"photography"})
+	s.createPostedQuest(ctx, "Diving", kernel.GeoCoordinate{Lat: 52.521, Lon: 13.405}, []string{"diving"})
+	_, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Draft", "Not posted yet", "easy", 5, 30, position, position))
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RecommendQuestsHTTPRequest(float32(position.Lat), float32(position.Lon), []string{"photography"}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var recommendations []v1.QuestRecommendation
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &recommendations))
+	s.Require().Len(recommendations, 2, "draft quests are not recommended")
+	s.Equal(matching.ID(), recommendations[0].Quest.Id)
+	s.Equal([]string{"photography"}, recommendations[0].Explanation.MatchedRequirements)
+	s.Empty(recommendations[0].Explanation.MissingRequirements)
+	s.GreaterOrEqual(recommendations[0].Score, recommendations[1].Score)
+	s.Equal([]string{"diving"}, recommendations[1].Explanation.MissingRequirements)
+}
+
+func (s *Suite) TestRecommendQuestsHTTP_InvalidRadius() {
+	ctx := context.Background()
+
+	req := casesteps.RecommendQuestsHTTPRequest(52.52, 13.405, nil)
+	req.URL += "&radius_km=500"
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *Suite) TestRecommendQuestsHTTP_PositionRequiredWithoutProfile() {
+	ctx := context.Background()
+
+	// Act - no lat/lon and no home location to fall back to
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.RecommendQuestsByProfileHTTPRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// lat without lon is rejected as well
+	req := casesteps.RecommendQuestsByProfileHTTPRequest()
+	req.URL += "?lat=52.52"
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *Suite) TestRecommendQuestsHTTP_RankedOnSavedProfile() {
+	ctx := context.Background()
+
+	// Pre-condition - the nearer quest needs diving, the farther one climbing
+	home := kernel.GeoCoordinate{Lat: 52.520, Lon: 13.405}
+	diving := s.createPostedQuest(ctx, "Diving", kernel.GeoCoordinate{Lat: 52.521, Lon: 13.405}, []string{"diving"})
+	climbing := s.createPostedQuest(ctx, "Climbing", kernel.GeoCoordinate{Lat: 52.530, Lon: 13.405}, []string{"climbing"})
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RecommendQuestsHTTPRequest(float32(home.Lat), float32(home.Lon), nil))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var before []v1.QuestRecommendation
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &before))
+	s.Require().Len(before, 2)
+	s.Equal(diving.ID(), before[0].Quest.Id, "without a profile the nearer quest comes first")
+
+	// Act - save skills and home location, then ask without parameters
+	skills := []string{"climbing"}
+	profile := v1.UpdateUserProfileRequest{
+		DisplayName:  "Alice",
+		Skills:       &skills,
+		HomeLocation: &v1.GeoPoint{Latitude: float32(home.Lat), Longitude: float32(home.Lon)},
+	}
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.UpdateMyProfileHTTPRequest(profile))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.RecommendQuestsByProfileHTTPRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var after []v1.QuestRecommendation
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &after))
+	s.Require().Len(after, 2)
+	s.Equal(climbing.ID(), after[0].Quest.Id, "the declared skill outweighs the distance")
+	s.Equal([]string{"climbing"}, after[0].Explanation.MatchedRequirements)
+	s.Equal(diving.ID(), after[1].Quest.Id)
+}
@@ -0,0 +1,216 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Reviews between the creator and the participants of a completed quest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// completedQuestBetween creates a quest of the creator, assigns it to the assignee and completes it
+func (s *Suite) completedQuestBetween(ctx context.Context, creator, assignee uuid.UUID) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	cmd := testdatagenerators.SimpleQuestData("Groceries", "Bring groceries", "easy", 1, 30, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: assignee})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: assignee, Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: creator, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *Suite) TestSubmitQuestReviewHTTP() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	assignee := uuid.New()
+	completed := s.completedQuestBetween(ctx, userID, assignee)
+	comment := "Quick and careful"
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.SubmitQuestReviewHTTPRequest(completed.ID(), v1.SubmitQuestReviewRequest{RevieweeId: assignee, Rating: 5, Comment: &comment}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	var submitted v1.QuestReview
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &submitted))
+	s.Equal(completed.ID(), submitted.QuestId)
+	s.Equal(userID, submitted.ReviewerId)
+	s.Equal(assignee, submitted.RevieweeId)
+	s.Equal(5, submitted.Rating)
+	s.Equal(comment, submitted.Comment)
+
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestReviewsHTTPRequest(completed.ID()))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, listResp.StatusCode, listResp.Body)
+	var reviews []v1.QuestReview
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &reviews))
+	s.Require().Len(reviews, 1)
+	s.Equal(submitted.Id, reviews[0].Id)
+}
+
+func (s *Suite) TestSubmitQuestReviewHTTP_OnlyOnce() {
+	ctx := context.Background()
+	assignee := uuid.New()
+	completed := s.completedQuestBetween(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID, assignee)
+	request := casesteps.SubmitQuestReviewHTTPRequest(completed.ID(), v1.SubmitQuestReviewRequest{RevieweeId: assignee, Rating: 4})
+
+	first, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, request)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, first.StatusCode, first.Body)
+
+	// Act
+	second, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, request)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, second.StatusCode, second.Body)
+}
+
+func (s *Suite) TestSubmitQuestReviewHTTP_Errors() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	strangerCreator := uuid.New()
+	strangers := s.completedQuestBetween(ctx, strangerCreator, uuid.New())
+	assignee := uuid.New()
+	own := s.completedQuestBetween(ctx, userID, assignee)
+
+	testCases := []struct {
+		name       string
+		questID    uuid.UUID
+		revieweeID uuid.UUID
+		rating     int
+		expected   int
+	}{
+		{name: "not a participant", questID: strangers.ID(), revieweeID: strangerCreator, rating: 5, expected: http.StatusForbidden},
+		{name: "rating out of range", questID: own.ID(), revieweeID: assignee, rating: 6, expected: http.StatusBadRequest},
+		{name: "reviewee not taking part", questID: own.ID(), revieweeID: uuid.New(), rating: 5, expected: http.StatusBadRequest},
+		{name: "unknown quest", questID: uuid.New(), revieweeID: assignee, rating: 5, expected: http.StatusNotFound},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+				casesteps.SubmitQuestReviewHTTPRequest(tc.questID, v1.SubmitQuestReviewRequest{RevieweeId: tc.revieweeID, Rating: tc.rating}))
+			s.Require().NoError(err)
+			s.Equal(tc.expected, resp.StatusCode, resp.Body)
+		})
+	}
+}
+
+func (s *Suite) TestSubmitQuestReviewHTTP_TeamQuest() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+	participants := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	// Pre-condition - completed team quest of the user with three participants
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	cmd := testdatagenerators.SimpleQuestData("Clean-up", "Clean the park", "easy", 1, 60, location, location).ToCreateCommand()
+	cmd.Creator = userID.String()
+	cmd.Capacity = len(participants)
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	for _, p := range participants {
+		_, err = s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: p})
+		s.Require().NoError(err)
+	}
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: participants[0], Status: quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(), UserID: userID, Status: quest.StatusCompleted,
+	})
+	s.Require().NoError(err)
+
+	// Act - the creator reviews every participant
+	for _, p := range participants {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+			casesteps.SubmitQuestReviewHTTPRequest(created.ID(), v1.SubmitQuestReviewRequest{RevieweeId: p, Rating: 5}))
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+	}
+	// The last participant reviews the creator
+	_, err = s.TestDIContainer.SubmitReviewHandler.Handle(ctx, commands.SubmitReviewCommand{
+		QuestID: created.ID(), UserID: participants[2], RevieweeID: userID, Rating: 4,
+	})
+	s.Require().NoError(err)
+
+	// Assert
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestReviewsHTTPRequest(created.ID()))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, listResp.StatusCode, listResp.Body)
+	var reviews []v1.QuestReview
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &reviews))
+	s.Require().Len(reviews, 4)
+	for i, p := range participants {
+		s.Equal(p, reviews[i].RevieweeId)
+	}
+	s.Equal(participants[2], reviews[3].ReviewerId)
+}
+
+func (s *Suite) TestReputationHTTP_ProfileAndQuestListing() {
+	ctx := context.Background()
+	userID := s.TestDIContainer.MockAuthClient.DefaultUserID
+
+	// Pre-condition - the assignees rate the user as creator 5 and 4
+	for _, rating := range []int{5, 4} {
+		assignee := uuid.New()
+		completed := s.completedQuestBetween(ctx, userID, assignee)
+		_, err := s.TestDIContainer.SubmitReviewHandler.Handle(ctx, commands.SubmitReviewCommand{
+			QuestID: completed.ID(), UserID: assignee, RevieweeID: userID, Rating: rating,
+		})
+		s.Require().NoError(err)
+	}
+	unrated := s.completedQuestBetween(ctx, uuid.New(), uuid.New())
+
+	putResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateMyProfileHTTPRequest(v1.UpdateUserProfileRequest{DisplayName: "Alice"}))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, putResp.StatusCode, putResp.Body)
+
+	// Act & Assert - profile
+	getResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMyProfileHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, getResp.StatusCode, getResp.Body)
+	var profile v1.UserProfile
+	s.Require().NoError(json.Unmarshal([]byte(getResp.Body), &profile))
+	s.Equal(4.5, profile.Reputation.AverageRating)
+	s.Equal(2, profile.Reputation.ReviewCount)
+
+	// Act & Assert - listing filtered by creator rating
+	listResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestsByCreatorRatingHTTPRequest(4.5))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, listResp.StatusCode, listResp.Body)
+	var quests []v1.Quest
+	s.Require().NoError(json.Unmarshal([]byte(listResp.Body), &quests))
+	s.Require().Len(quests, 2)
+	for _, q := range quests {
+		s.NotEqual(unrated.ID(), q.Id)
+		s.Require().NotNil(q.CreatorReputation)
+		s.Equal(4.5, q.CreatorReputation.AverageRating)
+	}
+
+	invalidResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ListQuestsByCreatorRatingHTTPRequest(0))
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, invalidResp.StatusCode, invalidResp.Body)
+}
@@ -0,0 +1,123 @@
+package quest_http_tests
+
+// API LAYER VALIDATION TESTS
+// Focused on OpenAPI-driven request validation behavior
+
+import (
+	"context"
+	"net/http"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/tests/integration/core/assertions"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+)
+
+// Square around central Moscow with a hole in the south-east corner
+const moscowPolygonGeoJSON = `{"type":"Polygon","coordinates":[` +
+	`[[37.5,55.7],[37.7,55.7],[37.7,55.8],[37.5,55.8],[37.5,55.7]],` +
+	`[[37.65,55.71],[37.69,55.71],[37.69,55.72],[37.65,55.72],[37.65,55.71]]]}`
+
+func (s *Suite) TestSearchQuestsByAreaHTTPPolygon() {
+	ctx := context.Background()
+
+	inside := kernel.GeoCoordinate{Lat: 55.75, Lon: 37.6}
+	inHole := kernel.GeoCoordinate{Lat: 55.715, Lon: 37.67}
+	outside := kernel.GeoCoordinate{Lat: 59.9311, Lon: 30.3609}
+
+	targetInside, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Target Inside", "Target in Moscow", "easy", 1, 30, inside, outside))
+	s.Require().NoError(err)
+	executionInside, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Execution Inside", "Execution in Moscow", "easy", 1, 30, outside, inside))
+	s.Require().NoError(err)
+	_, err = casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("In Hole", "Quest inside the hole", "easy", 1, 30, inHole, inHole))
+	s.Require().NoError(err)
+
+	tests := []struct {
+		match    string
+		expected []string
+	}{
+		{"", []string{targetInside.ID().String(), executionInside.ID().String()}},
+		{"target", []string{targetInside.ID().String()}},
+		{"execution", []string{executionInside.ID().String()}},
+	}
+
+	httpAssertions := assertions.NewQuestHTTPAssertions(s.Assert())
+	for _, tt := range tests {
+		// Act
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+			casesteps.SearchQuestsByAreaHTTPRequest(moscowPolygonGeoJSON, "", tt.match))
+
+		// Assert
+		found := httpAssertions.QuestHTTPListSuccessfully(resp, err)
+		ids := make([]string, len(found))
+		for i, q := range found {
+			ids[i] = q.Id.String()
+		}
+		s.ElementsMatch(tt.expected, ids, "match=%q", tt.match)
+	}
+}
+
+func (s *Suite) TestSearchQuestsByAreaHTTPBoundingBox() {
+	ctx := context.Background()
+
+	fiji := kernel.GeoCoordinate{Lat: -17.7, Lon: 178.5}
+	samoa := kernel.GeoCoordinate{Lat: -13.8, Lon: -172.1}
+	greenwich := kernel.GeoCoordinate{Lat: 51.48, Lon: 0.0}
+
+	fijiQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Fiji", "Quest in Fiji", "easy", 1, 30, fiji, fiji))
+	s.Require().NoError(err)
+	samoaQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Samoa", "Quest in Samoa", "easy", 1, 30, samoa, samoa))
+	s.Require().NoError(err)
+	_, err = casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Greenwich", "Quest in Greenwich", "easy", 1, 30, greenwich, greenwich))
+	s.Require().NoError(err)
+
+	// Act - box crossing the antimeridian
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.SearchQuestsByAreaHTTPRequest("", "175,-20,-170,-10", ""))
+
+	// Assert
+	found := assertions.NewQuestHTTPAssertions(s.Assert()).QuestHTTPListSuccessfully(resp, err)
+	ids := make([]string, len(found))
+	for i, q := range found {
+		ids[i] = q.Id.String()
+	}
+	s.ElementsMatch([]string{fijiQuest.ID().String(), samoaQuest.ID().String()}, ids)
+}
+
+func (s *Suite) TestSearchQuestsByAreaHTTPInvalidParams() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		polygon string
+		bbox    string
+		match   string
+	}{
+		{"No area", "", "", ""},
+		{"Both polygon and bbox", moscowPolygonGeoJSON, "37.5,55.7,37.7,55.8", ""},
+		{"Malformed GeoJSON", `{"type":"Polygon","coordinates":`, "", ""},
+		{"Wrong geometry type", `{"type":"Point","coordinates":[37.6,55.75]}`, "", ""},
+		{"Too few vertices", `{"type":"Polygon","coordinates":[[[37.5,55.7],[37.7,55.7],[37.5,55.7]]]}`, "", ""},
+		{"Latitude out of range", `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,95],[0,0]]]}`, "", ""},
+		{"Crosses the antimeridian", `{"type":"Polygon","coordinates":[[[175,-20],[-170,-20],[-170,-10],[175,-10],[175,-20]]]}`, "", ""},
+		{"Bbox with three values", "", "37.5,55.7,37.7", ""},
+		{"Bbox not a number", "", "a,55.7,37.7,55.8", ""},
+		{"Bbox min latitude above max", "", "37.5,55.8,37.7,55.7", ""},
+		{"Unknown match", "", "37.5,55.7,37.7,55.8", "nearest"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+				casesteps.SearchQuestsByAreaHTTPRequest(tt.polygon, tt.bbox, tt.match))
+			s.Require().NoError(err)
+			s.Equal(http.StatusBadRequest, resp.StatusCode, resp.Body)
+		})
+	}
+}
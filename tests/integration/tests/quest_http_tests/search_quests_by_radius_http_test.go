@@ -5,8 +5,11 @@ package quest_http_tests
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
+	v1 "quest-manager/api/http/quests/v1"
+
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/tests/integration/core/assertions"
 	casesteps "quest-manager/tests/integration/core/case_steps"
@@ -121,3 +124,56 @@ func (s *Suite) TestSearchQuestsByRadiusHTTPWithInvalidParams() {
 		})
 	}
 }
+
+func (s *Suite) TestSearchQuestsByRadiusHTTPReturnsDistancesSorted() {
+	ctx := context.Background()
+
+	// Pre-condition - quests at ~2km (reward 2) and ~1km (reward 4) north of the center
+	center := kernel.GeoCoordinate{Lat: 48.0, Lon: 2.0}
+	farLoc := kernel.GeoCoordinate{Lat: center.Lat + 0.018, Lon: center.Lon}
+	nearLoc := kernel.GeoCoordinate{Lat: center.Lat + 0.009, Lon: center.Lon}
+
+	farQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Far", "Far quest", "easy", 2, 30, farLoc, farLoc))
+	s.Require().NoError(err)
+	nearQuest, err := casesteps.CreateQuestStep(ctx, s.TestDIContainer.CreateQuestHandler,
+		testdatagenerators.SimpleQuestData("Near", "Near quest", "easy", 4, 30, nearLoc, nearLoc))
+	s.Require().NoError(err)
+
+	// Act - default sort (distance)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.SearchQuestsByRadiusSortedHTTPRequest(float32(center.Lat), float32(center.Lon), 5, "", 0))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var results []v1.QuestWithDistance
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &results))
+	s.Require().Len(results, 2)
+	s.Equal(nearQuest.ID(), results[0].Id)
+	s.Equal(farQuest.ID(), results[1].Id)
+	s.InDelta(1.0, results[0].TargetDistanceKm, 0.05)
+	s.InDelta(2.0, results[1].ExecutionDistanceKm, 0.05)
+
+	// Act - reward sort with limit
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.SearchQuestsByRadiusSortedHTTPRequest(float32(center.Lat), float32(center.Lon), 5, "reward", 1))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &results))
+	s.Require().Len(results, 1)
+	s.Equal(nearQuest.ID(), results[0].Id)
+}
+
+func (s *Suite) TestSearchQuestsByRadiusHTTPInvalidSortAndLimit() {
+	ctx := context.Background()
+
+	for _, req := range []casesteps.HTTPRequest{
+		casesteps.SearchQuestsByRadiusSortedHTTPRequest(50, 10, 5, "title", 0),
+		casesteps.SearchQuestsByRadiusSortedHTTPRequest(50, 10, 5, "distance", 501),
+	} {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, req.URL)
+	}
+}
@@ -33,3 +33,7 @@ func (s *Suite) TearDownTest() {
 func (s *Suite) TearDownSuite() {
 	s.DefaultSuite.TearDownSuite()
 }
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
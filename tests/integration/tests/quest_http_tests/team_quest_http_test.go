@@ -0,0 +1,133 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Team quests: capacity, participants and completion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	"quest-manager/internal/core/application/usecases/commands"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+	testdatagenerators "quest-manager/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// createTeamQuest creates a quest for several participants on behalf of creator
+func (s *Suite) createTeamQuest(ctx context.Context, creator uuid.UUID, capacity, quorum int) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	cmd := testdatagenerators.SimpleQuestData("Clean-up", "Community park clean-up", "easy", 2, 120, location, location).ToCreateCommand()
+	cmd.Creator = creator.String()
+	cmd.Capacity = capacity
+	cmd.CompletionQuorum = quorum
+
+	created, err := s.TestDIContainer.CreateQuestHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *Suite) changeStatusHTTP(ctx context.Context, questID uuid.UUID, status v1.QuestStatus) *casesteps.HTTPResponse {
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ChangeQuestStatusHTTPRequest(questID, v1.ChangeStatusRequest{Status: status}))
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *Suite) TestCreateTeamQuestHTTP() {
+	ctx := context.Background()
+	request := testdatagenerators.RandomCreateQuestRequest()
+	capacity := 5
+	request.Capacity = &capacity
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.CreateQuestHTTPRequest(request))
+
+	// Assert - quorum defaults to a majority of the capacity
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode, resp.Body)
+
+	var created v1.Quest
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &created))
+	s.Equal(5, created.Capacity)
+	s.Equal(3, created.CompletionQuorum)
+	s.Empty(created.Participants)
+}
+
+func (s *Suite) TestTeamQuestHTTP_JoinStartSubmitEvidence() {
+	ctx := context.Background()
+	created := s.createTeamQuest(ctx, uuid.New(), 2, 1)
+	other := uuid.New()
+	_, err := s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: other})
+	s.Require().NoError(err)
+
+	// Act - the authenticated user takes the second slot
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.AssignQuestHTTPRequest(created.ID()))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	var assigned v1.AssignQuestResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &assigned))
+	s.Require().Len(assigned.Participants, 2)
+	s.Equal(other, assigned.Participants[0].UserId)
+	s.Equal(s.TestDIContainer.MockAuthClient.DefaultUserID, assigned.Participants[1].UserId)
+
+	// Act - starting moves the quest in progress; completing directly is for the creator only
+	startResp := s.changeStatusHTTP(ctx, created.ID(), v1.QuestStatusInProgress)
+	s.Require().Equal(http.StatusOK, startResp.StatusCode, startResp.Body)
+
+	completeResp := s.changeStatusHTTP(ctx, created.ID(), v1.QuestStatusCompleted)
+	s.Equal(http.StatusBadRequest, completeResp.StatusCode, completeResp.Body)
+
+	// Act - one evidence reaches the quorum
+	req, err := casesteps.SubmitEvidenceHTTPRequest(created.ID(), "Done", "55.7558", "37.6173")
+	s.Require().NoError(err)
+	evidenceResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal(http.StatusCreated, evidenceResp.StatusCode, evidenceResp.Body)
+	var result v1.SubmitEvidenceResult
+	s.Require().NoError(json.Unmarshal([]byte(evidenceResp.Body), &result))
+	s.Equal(v1.QuestStatusPendingReview, result.QuestStatus)
+}
+
+func (s *Suite) TestTeamQuestHTTP_StartByNonParticipantForbidden() {
+	ctx := context.Background()
+	created := s.createTeamQuest(ctx, uuid.New(), 2, 0)
+	_, err := s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: uuid.New()})
+	s.Require().NoError(err)
+
+	// Act
+	resp := s.changeStatusHTTP(ctx, created.ID(), v1.QuestStatusInProgress)
+
+	// Assert
+	s.Equal(http.StatusForbidden, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestTeamQuestHTTP_CompleteByCreator() {
+	ctx := context.Background()
+	created := s.createTeamQuest(ctx, s.TestDIContainer.MockAuthClient.DefaultUserID, 3, 3)
+	participant := uuid.New()
+	_, err := s.TestDIContainer.AssignQuestHandler.Handle(ctx, commands.AssignQuestCommand{ID: created.ID(), UserID: participant})
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.ChangeQuestStatusHandler.Handle(ctx, commands.ChangeQuestStatusCommand{
+		QuestID: created.ID(),
+		UserID:  participant,
+		Status:  quest.StatusInProgress,
+	})
+	s.Require().NoError(err)
+
+	// Act - the creator completes the quest without waiting for the quorum
+	resp := s.changeStatusHTTP(ctx, created.ID(), v1.QuestStatusCompleted)
+
+	// Assert
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var result v1.ChangeQuestStatusResult
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &result))
+	s.Equal(v1.QuestStatusCompleted, result.Status)
+}
@@ -0,0 +1,101 @@
+package quest_http_tests
+
+// API LAYER TESTS
+// Profile of the authenticated user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	v1 "quest-manager/api/http/quests/v1"
+	casesteps "quest-manager/tests/integration/core/case_steps"
+)
+
+func (s *Suite) TestGetMyProfileHTTP_NotFound() {
+	ctx := context.Background()
+
+	// Act - no profile saved yet
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMyProfileHTTPRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestUpdateMyProfileHTTP() {
+	ctx := context.Background()
+
+	// Pre-condition - full profile
+	skills := []string{"photography", "Navigation"}
+	equipment := []string{"camera"}
+	radius := 20.0
+	request := v1.UpdateUserProfileRequest{
+		DisplayName:       "Alice",
+		Skills:            &skills,
+		Equipment:         &equipment,
+		HomeLocation:      &v1.GeoPoint{Latitude: 55.7558, Longitude: 37.6173},
+		MaxTravelRadiusKm: &radius,
+	}
+
+	// Act - save and read back
+	putResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.UpdateMyProfileHTTPRequest(request))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, putResp.StatusCode, putResp.Body)
+
+	getResp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMyProfileHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, getResp.StatusCode, getResp.Body)
+
+	// Assert
+	var profile v1.UserProfile
+	s.Require().NoError(json.Unmarshal([]byte(getResp.Body), &profile))
+	s.Equal(s.TestDIContainer.MockAuthClient.DefaultUserID, profile.UserId)
+	s.Equal("Alice", profile.DisplayName)
+	s.Equal(skills, profile.Skills)
+	s.Equal(equipment, profile.Equipment)
+	s.Require().NotNil(profile.HomeLocation)
+	s.InDelta(55.7558, profile.HomeLocation.Latitude, 1e-4)
+	s.Require().NotNil(profile.MaxTravelRadiusKm)
+	s.Equal(radius, *profile.MaxTravelRadiusKm)
+}
+
+func (s *Suite) TestUpdateMyProfileHTTP_ReplacesProfile() {
+	ctx := context.Background()
+
+	// Pre-condition - saved profile with skills
+	skills := []string{"climbing"}
+	first := v1.UpdateUserProfileRequest{DisplayName: "Alice", Skills: &skills}
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.UpdateMyProfileHTTPRequest(first))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+
+	// Act - replace with a profile without skills
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateMyProfileHTTPRequest(v1.UpdateUserProfileRequest{DisplayName: "Alice B."}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, resp.Body)
+	var profile v1.UserProfile
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &profile))
+	s.Equal("Alice B.", profile.DisplayName)
+	s.Empty(profile.Skills)
+	s.NotNil(profile.Skills, "skills must be an empty array, not null")
+}
+
+func (s *Suite) TestUpdateMyProfileHTTP_Invalid() {
+	ctx := context.Background()
+
+	testCases := map[string]map[string]interface{}{
+		"missing display name": {"skills": []string{"climbing"}},
+		"radius out of range":  {"display_name": "Alice", "max_travel_radius_km": 5000},
+		"skill with comma":     {"display_name": "Alice", "skills": []string{"rope, 20m"}},
+	}
+
+	for name, body := range testCases {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.UpdateMyProfileHTTPRequest(body))
+		s.Require().NoError(err, name)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, "%s: %s", name, resp.Body)
+	}
+}
@@ -0,0 +1,80 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for achievement facts and unlocked achievements persistence
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/core/domain/model/achievement"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) TestAchievementRepository_RecordFactIsIdempotent() {
+	ctx := context.Background()
+	userID := uuid.New()
+	fact := achievement.Fact{
+		UserID:      userID,
+		QuestID:     uuid.New(),
+		Kind:        achievement.FactCompleted,
+		Difficulty:  quest.DifficultyHard,
+		LocationKey: "ucfv0j3",
+		OccurredAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	// Act - the same fact twice, and the assignment of the same quest
+	recorded, err := s.TestDIContainer.AchievementRepository.RecordFact(ctx, fact)
+	s.Require().NoError(err)
+	s.True(recorded)
+	recorded, err = s.TestDIContainer.AchievementRepository.RecordFact(ctx, fact)
+	s.Require().NoError(err)
+	s.False(recorded)
+	assigned := fact
+	assigned.Kind = achievement.FactAssigned
+	recorded, err = s.TestDIContainer.AchievementRepository.RecordFact(ctx, assigned)
+	s.Require().NoError(err)
+	s.True(recorded)
+
+	// Assert
+	facts, err := s.TestDIContainer.AchievementRepository.FindFacts(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().Len(facts, 2)
+	s.Contains(facts, fact)
+
+	other, err := s.TestDIContainer.AchievementRepository.FindFacts(ctx, uuid.New())
+	s.Require().NoError(err)
+	s.Empty(other)
+}
+
+func (s *Suite) TestAchievementRepository_UnlockOnce() {
+	ctx := context.Background()
+	userID := uuid.New()
+	definitions := achievement.DefaultDefinitions()
+	first := achievement.Unlock(userID, definitions[0], time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond))
+	second := achievement.Unlock(userID, definitions[1], time.Now().UTC().Truncate(time.Microsecond))
+
+	// Act
+	added, err := s.TestDIContainer.AchievementRepository.Unlock(ctx, second)
+	s.Require().NoError(err)
+	s.True(added)
+	added, err = s.TestDIContainer.AchievementRepository.Unlock(ctx, first)
+	s.Require().NoError(err)
+	s.True(added)
+	added, err = s.TestDIContainer.AchievementRepository.Unlock(ctx, achievement.Unlock(userID, definitions[0], time.Now()))
+	s.Require().NoError(err)
+	s.False(added, "an achievement is unlocked once per user")
+
+	// Assert - oldest first, with the original time
+	unlocked, err := s.TestDIContainer.AchievementRepository.FindUnlocked(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().Len(unlocked, 2)
+	s.Equal(first.Code, unlocked[0].Code)
+	s.Equal(first.Title, unlocked[0].Title)
+	s.True(first.UnlockedAt.Equal(unlocked[0].UnlockedAt))
+	s.Equal(second.Code, unlocked[1].Code)
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for quest application persistence
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) saveReviewQuest(ctx context.Context) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	q, err := quest.NewQuest("Review", "Quest assigned by review", "medium", 3, 60,
+		location, location, uuid.New().String(), []string{}, []string{})
+	s.Require().NoError(err)
+	s.Require().NoError(q.SetAssignmentMode("review"))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	return q
+}
+
+func (s *Suite) TestApplicationRepository_SaveAndGet() {
+	ctx := context.Background()
+
+	// Pre-condition - application to a quest in review mode
+	q := s.saveReviewQuest(ctx)
+	application, err := q.Apply(uuid.New(), "I know the area", nil)
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, application))
+	saved, err := s.TestDIContainer.ApplicationRepository.GetByID(ctx, application.ID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(application.ID, saved.ID)
+	s.Equal(q.ID(), saved.QuestID)
+	s.Equal(application.ApplicantID, saved.ApplicantID)
+	s.Equal("I know the area", saved.Message)
+	s.Equal(quest.ApplicationStatusPending, saved.Status)
+
+	// The quest keeps its assignment mode
+	savedQuest, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Equal(quest.AssignmentReview, savedQuest.AssignmentMode)
+}
+
+func (s *Suite) TestApplicationRepository_SaveUpdatesStatus() {
+	ctx := context.Background()
+
+	// Pre-condition - saved pending application
+	q := s.saveReviewQuest(ctx)
+	application, err := q.Apply(uuid.New(), "", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, application))
+
+	// Act - reject it
+	s.Require().NoError(q.RejectApplication(&application))
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, application))
+
+	// Assert
+	saved, err := s.TestDIContainer.ApplicationRepository.GetByID(ctx, application.ID)
+	s.Require().NoError(err)
+	s.Equal(quest.ApplicationStatusRejected, saved.Status)
+}
+
+func (s *Suite) TestApplicationRepository_FindByQuest() {
+	ctx := context.Background()
+
+	// Pre-condition - two applications to one quest, one to another
+	q := s.saveReviewQuest(ctx)
+	other := s.saveReviewQuest(ctx)
+
+	first, err := q.Apply(uuid.New(), "first", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, first))
+	second, err := q.Apply(uuid.New(), "second", []quest.Application{first})
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, second))
+	foreign, err := other.Apply(uuid.New(), "other quest", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, foreign))
+
+	// Act
+	applications, err := s.TestDIContainer.ApplicationRepository.FindByQuest(ctx, q.ID())
+
+	// Assert - only the quest's applications, oldest first
+	s.Require().NoError(err)
+	s.Require().Len(applications, 2)
+	s.Equal(first.ID, applications[0].ID)
+	s.Equal(second.ID, applications[1].ID)
+}
+
+func (s *Suite) TestApplicationRepository_OneApplicationPerUser() {
+	ctx := context.Background()
+
+	// Pre-condition - saved application
+	q := s.saveReviewQuest(ctx)
+	applicant := uuid.New()
+	application, err := q.Apply(applicant, "", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.ApplicationRepository.Save(ctx, application))
+
+	// Act - a second row for the same quest and applicant bypassing the domain check
+	duplicate, err := q.Apply(applicant, "", nil)
+	s.Require().NoError(err)
+	err = s.TestDIContainer.ApplicationRepository.Save(ctx, duplicate)
+
+	// Assert - the unique index rejects it
+	s.Error(err)
+}
+
+func (s *Suite) TestApplicationRepository_GetNotFound() {
+	ctx := context.Background()
+
+	// Act
+	_, err := s.TestDIContainer.ApplicationRepository.GetByID(ctx, uuid.New())
+
+	// Assert
+	s.Require().Error(err)
+	s.True(errors.Is(err, ports.ErrApplicationNotFound))
+}
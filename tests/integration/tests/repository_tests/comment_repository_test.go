@@ -0,0 +1,85 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for the comment threads of quests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+func newComment(questID uuid.UUID, visibility quest.CommentVisibility, createdAt time.Time) quest.Comment {
+	return quest.Comment{
+		ID:         uuid.New(),
+		QuestID:    questID,
+		AuthorID:   uuid.New(),
+		Body:       fmt.Sprintf("%s comment", visibility),
+		Visibility: visibility,
+		CreatedAt:  createdAt.UTC().Truncate(time.Microsecond),
+	}
+}
+
+func (s *Suite) TestCommentRepository_SaveAndGetByID() {
+	ctx := context.Background()
+	comment := newComment(uuid.New(), quest.CommentPublic, time.Now())
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.CommentRepository.Save(ctx, comment))
+	editedAt := time.Now().UTC().Truncate(time.Microsecond)
+	comment.Body = "edited"
+	comment.EditedAt = &editedAt
+	s.Require().NoError(s.TestDIContainer.CommentRepository.Save(ctx, comment))
+
+	// Assert
+	stored, err := s.TestDIContainer.CommentRepository.GetByID(ctx, comment.ID)
+	s.Require().NoError(err)
+	s.Equal(comment.QuestID, stored.QuestID)
+	s.Equal(comment.AuthorID, stored.AuthorID)
+	s.Equal("edited", stored.Body)
+	s.Equal(quest.CommentPublic, stored.Visibility)
+	s.Require().NotNil(stored.EditedAt)
+	s.True(editedAt.Equal(*stored.EditedAt))
+
+	_, err = s.TestDIContainer.CommentRepository.GetByID(ctx, uuid.New())
+	s.True(errors.Is(err, ports.ErrCommentNotFound))
+}
+
+func (s *Suite) TestCommentRepository_FindByQuestPagesVisibleComments() {
+	ctx := context.Background()
+	questID := uuid.New()
+	start := time.Now().Add(-time.Hour)
+	var public []quest.Comment
+	for i := 0; i < 3; i++ {
+		c := newComment(questID, quest.CommentPublic, start.Add(time.Duration(2*i)*time.Minute))
+		public = append(public, c)
+		s.Require().NoError(s.TestDIContainer.CommentRepository.Save(ctx, c))
+	}
+	private := newComment(questID, quest.CommentPrivate, start.Add(time.Minute))
+	s.Require().NoError(s.TestDIContainer.CommentRepository.Save(ctx, private))
+	s.Require().NoError(s.TestDIContainer.CommentRepository.Save(ctx, newComment(uuid.New(), quest.CommentPublic, start)))
+
+	// Act & Assert - members see every comment, oldest first
+	all, total, err := s.TestDIContainer.CommentRepository.FindByQuest(ctx, questID, true, 10, 0)
+	s.Require().NoError(err)
+	s.Equal(4, total)
+	s.Require().Len(all, 4)
+	s.Equal(public[0].ID, all[0].ID)
+	s.Equal(private.ID, all[1].ID)
+
+	// Act & Assert - other users see public comments only, paged
+	page, total, err := s.TestDIContainer.CommentRepository.FindByQuest(ctx, questID, false, 2, 1)
+	s.Require().NoError(err)
+	s.Equal(3, total)
+	s.Require().Len(page, 2)
+	s.Equal(public[1].ID, page[0].ID)
+	s.Equal(public[2].ID, page[1].ID)
+}
@@ -0,0 +1,91 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for completion evidence persistence
+
+import (
+	"context"
+	"errors"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// saveStartedQuest saves a quest that the participant has started
+func (s *Suite) saveStartedQuest(ctx context.Context, participant uuid.UUID) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	q, err := quest.NewQuest("Evidence", "Quest completed with evidence", "easy", 2, 60,
+		location, location, uuid.New().String(), []string{}, []string{})
+	s.Require().NoError(err)
+	s.Require().NoError(q.AssignTo(participant))
+	s.Require().NoError(q.Start(participant))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	return q
+}
+
+func (s *Suite) TestEvidenceRepository_SaveAndGet() {
+	ctx := context.Background()
+
+	// Pre-condition - evidence with two photos
+	participant := uuid.New()
+	q := s.saveStartedQuest(ctx, participant)
+	attachments := []quest.Attachment{
+		{ID: uuid.New(), FileName: "before.jpg", ContentType: "image/jpeg", Size: 1200, StorageKey: "quests/x/evidence/1"},
+		{ID: uuid.New(), FileName: "after.png", ContentType: "image/png", Size: 3400, StorageKey: "quests/x/evidence/2"},
+	}
+	evidence, err := q.SubmitEvidence(participant, "Park is clean", kernel.GeoCoordinate{Lat: 55.756, Lon: 37.618}, attachments)
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.EvidenceRepository.Save(ctx, evidence))
+	saved, err := s.TestDIContainer.EvidenceRepository.GetByID(ctx, evidence.ID)
+
+	// Assert - attachments keep their order
+	s.Require().NoError(err)
+	s.Equal(q.ID(), saved.QuestID)
+	s.Equal(participant, saved.SubmittedBy)
+	s.Equal("Park is clean", saved.Note)
+	s.InDelta(55.756, saved.Position.Lat, 1e-9)
+	s.InDelta(37.618, saved.Position.Lon, 1e-9)
+	s.Equal(quest.EvidenceStatusPending, saved.Status)
+	s.Nil(saved.ReviewedAt)
+	s.Require().Len(saved.Attachments, 2)
+	s.Equal(attachments[0], saved.Attachments[0])
+	s.Equal(attachments[1], saved.Attachments[1])
+}
+
+func (s *Suite) TestEvidenceRepository_SaveUpdatesReview() {
+	ctx := context.Background()
+
+	// Pre-condition - submitted evidence
+	participant := uuid.New()
+	q := s.saveStartedQuest(ctx, participant)
+	evidence, err := q.SubmitEvidence(participant, "Done", kernel.GeoCoordinate{Lat: 55.756, Lon: 37.618}, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.EvidenceRepository.Save(ctx, evidence))
+
+	// Act - the creator rejects the completion
+	s.Require().NoError(q.RejectCompletion([]*quest.Evidence{&evidence}, "Wrong park"))
+	s.Require().NoError(s.TestDIContainer.EvidenceRepository.Save(ctx, evidence))
+
+	// Assert
+	found, err := s.TestDIContainer.EvidenceRepository.FindByQuest(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(quest.EvidenceStatusRejected, found[0].Status)
+	s.Equal("Wrong park", found[0].RejectionReason)
+	s.NotNil(found[0].ReviewedAt)
+	s.Empty(found[0].Attachments)
+}
+
+func (s *Suite) TestEvidenceRepository_GetByID_NotFound() {
+	_, err := s.TestDIContainer.EvidenceRepository.GetByID(context.Background(), uuid.New())
+
+	s.Require().Error(err)
+	s.True(errors.Is(err, ports.ErrEvidenceNotFound))
+}
@@ -0,0 +1,89 @@
+//go:build integration
+
+package repository
+
+// GEOHASH INDEX INTEGRATION TESTS
+// Checks that geohash columns are persisted, backfilled and used by the plain SQL geo queries.
+
+import (
+	"context"
+
+	"quest-manager/internal/adapters/out/postgres/locationrepo"
+	"quest-manager/internal/adapters/out/postgres/questrepo"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+)
+
+func (s *Suite) TestQuestRepository_Save_StoresGeohashes() {
+	ctx := context.Background()
+	q := s.createTestQuestAtLocation("Geohash Quest", "easy", kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954})
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Assert
+	var dto questrepo.QuestDTO
+	s.Require().NoError(s.TestDIContainer.DB.First(&dto, "id = ?", q.ID().String()).Error)
+	s.Equal(q.TargetLocation.Geohash(kernel.GeohashMaxPrecision), dto.TargetGeohash)
+	s.Equal(q.ExecutionLocation.Geohash(kernel.GeohashMaxPrecision), dto.ExecutionGeohash)
+}
+
+func (s *Suite) TestQuestRepository_FindWithinRadius_AcrossGeohashCells() {
+	ctx := context.Background()
+
+	// Pre-condition - the center and the quest lie on opposite sides of a geohash cell border
+	center := kernel.GeoCoordinate{Lat: 0.001, Lon: 0.001}
+	q := s.createTestQuestAtLocation("Across the border", "easy", kernel.GeoCoordinate{Lat: -0.001, Lon: -0.001})
+	s.Require().NotEqual(center.Geohash(1), q.TargetLocation.Geohash(1))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Act
+	found, err := s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, center, 1, quest.RadiusSortDistance, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(q.ID(), found[0].ID())
+}
+
+func (s *Suite) TestQuestRepository_MigrateGeohash_BackfillsMissingGeohashes() {
+	ctx := context.Background()
+	db := s.TestDIContainer.DB
+	q := s.createTestQuestAtLocation("Legacy Quest", "easy", kernel.GeoCoordinate{Lat: 55.7648, Lon: 37.6173})
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Pre-condition - a row stored before the geohash columns existed is not found by geo queries
+	s.Require().NoError(db.Exec("UPDATE quests SET target_geohash = NULL, execution_geohash = NULL").Error)
+	found, err := s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, q.TargetLocation, 1, quest.RadiusSortDistance, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(found)
+
+	// Act
+	s.Require().NoError(questrepo.MigrateGeohash(db))
+
+	// Assert
+	found, err = s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, q.TargetLocation, 1, quest.RadiusSortDistance, 0)
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(q.ID(), found[0].ID())
+}
+
+func (s *Suite) TestLocationRepository_MigrateGeohash_BackfillsMissingGeohashes() {
+	ctx := context.Background()
+	db := s.TestDIContainer.DB
+	l := s.createTestLocation("Legacy Location", 55.7648, 37.6173)
+	s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, l))
+	s.Require().NoError(db.Exec("UPDATE locations SET geohash = NULL").Error)
+
+	// Act
+	s.Require().NoError(locationrepo.MigrateGeohash(db))
+
+	// Assert
+	var dto locationrepo.LocationDTO
+	s.Require().NoError(db.First(&dto, "id = ?", l.ID().String()).Error)
+	s.Equal(l.Coordinate.Geohash(kernel.GeohashMaxPrecision), dto.Geohash)
+
+	found, err := s.TestDIContainer.LocationRepository.FindWithinRadius(ctx, l.Coordinate, 1)
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+}
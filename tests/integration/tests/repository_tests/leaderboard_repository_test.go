@@ -0,0 +1,168 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for the leaderboard projection
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/leaderboard"
+
+	"github.com/google/uuid"
+)
+
+// recordCompletion records a completed quest of the user at the location
+func (s *Suite) recordCompletion(ctx context.Context, userID uuid.UUID, points int, location kernel.GeoCoordinate, occurredAt time.Time) leaderboard.Score {
+	score, err := leaderboard.NewQuestCompletionScore(uuid.New(), userID, uuid.New(), points, location, occurredAt)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.LeaderboardRepository.Record(ctx, score))
+	return score
+}
+
+func (s *Suite) TestLeaderboardRepository_TopAnywhere() {
+	ctx := context.Background()
+	since := time.Now().Add(-time.Hour)
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	busy, rich := uuid.New(), uuid.New()
+
+	// Pre-condition - two completions of busy, one worth more points of rich, one of rich before the period
+	s.recordCompletion(ctx, busy, 10, moscow, time.Now())
+	s.recordCompletion(ctx, busy, 10, moscow, time.Now())
+	s.recordCompletion(ctx, rich, 50, moscow, time.Now())
+	s.recordCompletion(ctx, rich, 10, moscow, since.Add(-time.Hour))
+	bonus, err := leaderboard.NewScore(uuid.New(), busy, 5, time.Now())
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.LeaderboardRepository.Record(ctx, bonus))
+
+	// Act
+	completed, err := s.TestDIContainer.LeaderboardRepository.Top(ctx, leaderboard.Filter{Metric: leaderboard.MetricCompleted, Since: since, Limit: 10})
+	s.Require().NoError(err)
+	points, err := s.TestDIContainer.LeaderboardRepository.Top(ctx, leaderboard.Filter{Metric: leaderboard.MetricPoints, Since: since, Limit: 1})
+	s.Require().NoError(err)
+
+	// Assert - the bonus counts as points but not as a completed quest
+	s.Require().Len(completed, 2)
+	s.Equal(leaderboard.Standing{UserID: busy, Score: 2}, completed[0])
+	s.Equal(leaderboard.Standing{UserID: rich, Score: 1}, completed[1])
+	s.Require().Len(points, 1)
+	s.Equal(leaderboard.Standing{UserID: rich, Score: 50}, points[0])
+}
+
+func (s *Suite) TestLeaderboardRepository_TopInArea() {
+	ctx := context.Background()
+	since := time.Now().Add(-time.Hour)
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	local, remote := uuid.New(), uuid.New()
+
+	s.recordCompletion(ctx, local, 10, kernel.GeoCoordinate{Lat: 55.76, Lon: 37.62}, time.Now())
+	s.recordCompletion(ctx, remote, 10, kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3351}, time.Now())
+	s.recordCompletion(ctx, remote, 10, kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3351}, time.Now())
+
+	// Act
+	standings, err := s.TestDIContainer.LeaderboardRepository.Top(ctx, leaderboard.Filter{
+		Metric:   leaderboard.MetricCompleted,
+		Since:    since,
+		Center:   &moscow,
+		RadiusKm: 10,
+		Limit:    10,
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(standings, 1)
+	s.Equal(leaderboard.Standing{UserID: local, Score: 1}, standings[0])
+}
+
+func (s *Suite) TestLeaderboardRepository_TopInAreaOrdersAndLimits() {
+	ctx := context.Background()
+	since := time.Now().Add(-time.Hour)
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	first, second, third, idle := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// Pre-condition - scores ~5.6 km, ~8.9 km and ~12.5 km from the center; the idle user earns no points,
+	// the bonus of the third user is not tied to a place
+	near := kernel.GeoCoordinate{Lat: 55.8058, Lon: 37.6173}
+	edge := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.7593}
+	outside := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.8173}
+	s.recordCompletion(ctx, first, 30, near, time.Now())
+	s.recordCompletion(ctx, first, 20, edge, time.Now())
+	s.recordCompletion(ctx, second, 40, edge, time.Now())
+	s.recordCompletion(ctx, second, 100, outside, time.Now())
+	s.recordCompletion(ctx, third, 10, near, time.Now())
+	s.recordCompletion(ctx, idle, 0, near, time.Now())
+	bonus, err := leaderboard.NewScore(uuid.New(), third, 100, time.Now())
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.LeaderboardRepository.Record(ctx, bonus))
+
+	// Act
+	standings, err := s.TestDIContainer.LeaderboardRepository.Top(ctx, leaderboard.Filter{
+		Metric:   leaderboard.MetricPoints,
+		Since:    since,
+		Center:   &moscow,
+		RadiusKm: 10,
+		Limit:    2,
+	})
+
+	// Assert - only scores within the radius are summed; the idle user never ranks,
+	// points without a place are outside every area
+	s.Require().NoError(err)
+	s.Equal([]leaderboard.Standing{
+		{UserID: first, Score: 50},
+		{UserID: second, Score: 40},
+	}, standings)
+}
+
+func (s *Suite) TestLeaderboardRepository_PostGISTopInAreaMatchesSQL() {
+	ctx := context.Background()
+	postGISUoW := s.requirePostGIS()
+	since := time.Now().Add(-time.Hour)
+	moscow := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	local, remote := uuid.New(), uuid.New()
+
+	// Pre-condition
+	s.recordCompletion(ctx, local, 10, kernel.GeoCoordinate{Lat: 55.76, Lon: 37.62}, time.Now())
+	s.recordCompletion(ctx, local, 10, kernel.GeoCoordinate{Lat: 55.8058, Lon: 37.6173}, time.Now())
+	s.recordCompletion(ctx, remote, 10, kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.8173}, time.Now())
+	bonus, err := leaderboard.NewScore(uuid.New(), remote, 5, time.Now())
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.LeaderboardRepository.Record(ctx, bonus))
+	filter := leaderboard.Filter{
+		Metric:   leaderboard.MetricCompleted,
+		Since:    since,
+		Center:   &moscow,
+		RadiusKm: 10,
+		Limit:    10,
+	}
+
+	// Act
+	fromPostGIS, err := postGISUoW.LeaderboardRepository().Top(ctx, filter)
+	s.Require().NoError(err)
+	fromSQL, err := s.TestDIContainer.LeaderboardRepository.Top(ctx, filter)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Equal([]leaderboard.Standing{{UserID: local, Score: 2}}, fromSQL)
+	s.Equal(fromSQL, fromPostGIS)
+}
+
+func (s *Suite) TestLeaderboardRepository_RecordIsIdempotent() {
+	ctx := context.Background()
+	userID := uuid.New()
+	score := s.recordCompletion(ctx, userID, 10, kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}, time.Now())
+
+	// Act - the same ledger entry again
+	s.Require().NoError(s.TestDIContainer.LeaderboardRepository.Record(ctx, score))
+
+	// Assert
+	standings, err := s.TestDIContainer.LeaderboardRepository.Top(ctx, leaderboard.Filter{
+		Metric: leaderboard.MetricPoints,
+		Since:  time.Now().Add(-time.Hour),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(standings, 1)
+	s.Equal(10, standings[0].Score)
+}
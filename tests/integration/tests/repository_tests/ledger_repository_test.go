@@ -0,0 +1,106 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for the points ledger persistence
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/core/domain/model/ledger"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) TestLedgerRepository_AppendAndBalance() {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Pre-condition - rewards for two quests and one for another user
+	first, err := ledger.NewQuestReward(userID, uuid.New(), 20)
+	s.Require().NoError(err)
+	second, err := ledger.NewQuestReward(userID, uuid.New(), 15)
+	s.Require().NoError(err)
+	other, err := ledger.NewQuestReward(uuid.New(), uuid.New(), 100)
+	s.Require().NoError(err)
+
+	// Act
+	for _, entry := range []ledger.Entry{first, second, other} {
+		added, err := s.TestDIContainer.LedgerRepository.Append(ctx, entry)
+		s.Require().NoError(err)
+		s.True(added)
+	}
+	balance, err := s.TestDIContainer.LedgerRepository.Balance(ctx, userID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(35, balance)
+}
+
+func (s *Suite) TestLedgerRepository_AppendIsIdempotent() {
+	ctx := context.Background()
+	userID := uuid.New()
+	questID := uuid.New()
+
+	entry, err := ledger.NewQuestReward(userID, questID, 20)
+	s.Require().NoError(err)
+	added, err := s.TestDIContainer.LedgerRepository.Append(ctx, entry)
+	s.Require().NoError(err)
+	s.Require().True(added)
+
+	// Act - the same reward again, with a new entry ID
+	duplicate, err := ledger.NewQuestReward(userID, questID, 20)
+	s.Require().NoError(err)
+	added, err = s.TestDIContainer.LedgerRepository.Append(ctx, duplicate)
+
+	// Assert
+	s.Require().NoError(err)
+	s.False(added)
+	balance, err := s.TestDIContainer.LedgerRepository.Balance(ctx, userID)
+	s.Require().NoError(err)
+	s.Equal(20, balance)
+}
+
+func (s *Suite) TestLedgerRepository_BalanceWithoutEntries() {
+	balance, err := s.TestDIContainer.LedgerRepository.Balance(context.Background(), uuid.New())
+
+	s.Require().NoError(err)
+	s.Equal(0, balance)
+}
+
+func (s *Suite) TestLedgerRepository_FindByUserNewestFirst() {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Pre-condition - three entries an hour apart
+	var entries []ledger.Entry
+	for i := range 3 {
+		entry, err := ledger.NewQuestReward(userID, uuid.New(), 10*(i+1))
+		s.Require().NoError(err)
+		entry.CreatedAt = time.Now().Add(time.Duration(i-3) * time.Hour)
+		_, err = s.TestDIContainer.LedgerRepository.Append(ctx, entry)
+		s.Require().NoError(err)
+		entries = append(entries, entry)
+	}
+
+	// Act
+	page, total, err := s.TestDIContainer.LedgerRepository.FindByUser(ctx, userID, 2, 0)
+	s.Require().NoError(err)
+	rest, _, err := s.TestDIContainer.LedgerRepository.FindByUser(ctx, userID, 2, 2)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Equal(3, total)
+	s.Require().Len(page, 2)
+	s.Equal(entries[2].ID, page[0].ID)
+	s.Equal(entries[1].ID, page[1].ID)
+	s.Require().Len(rest, 1)
+	s.Equal(entries[0].ID, rest[0].ID)
+	s.Equal(ledger.KindQuestReward, rest[0].Kind)
+	s.Equal(10, rest[0].Points)
+	s.Equal(entries[0].IdempotencyKey, rest[0].IdempotencyKey)
+	s.Require().NotNil(rest[0].QuestID)
+	s.Equal(*entries[0].QuestID, *rest[0].QuestID)
+}
@@ -191,6 +191,163 @@ func (s *Suite) TestLocationRepository_FindByBoundingBox_Success() {
 	s.False(foundIDs[londonLoc.ID()]) // London should not be found
 }
 
+func (s *Suite) TestLocationRepository_FindWithinRadius_OrderedByDistance() {
+	ctx := context.Background()
+	center := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+
+	// Pre-condition - locations at ~5km, ~1km and ~630km from the center
+	farLoc := s.createTestLocation("Far", 55.8008, 37.6173)
+	nearLoc := s.createTestLocation("Near", 55.7648, 37.6173)
+	spbLoc := s.createTestLocation("Saint Petersburg", 59.9311, 30.3609)
+
+	for _, l := range []*location.Location{farLoc, nearLoc, spbLoc} {
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, l))
+	}
+
+	// Act
+	found, err := s.TestDIContainer.LocationRepository.FindWithinRadius(ctx, center, 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 2)
+	s.Equal(nearLoc.ID(), found[0].ID())
+	s.Equal(farLoc.ID(), found[1].ID())
+}
+
+func (s *Suite) TestLocationRepository_FindWithinRadius_AcrossAntimeridian() {
+	ctx := context.Background()
+
+	// Pre-condition - location just east of the dateline (~20km from center)
+	eastLoc := s.createTestLocation("East of dateline", -17.0, -179.9)
+	s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, eastLoc))
+
+	// Act - search from the western side
+	found, err := s.TestDIContainer.LocationRepository.FindWithinRadius(ctx, kernel.GeoCoordinate{Lat: -17.0, Lon: 179.9}, 50)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(eastLoc.ID(), found[0].ID())
+}
+
+func (s *Suite) TestLocationRepository_FindByName_MatchesNameAndAddress() {
+	ctx := context.Background()
+
+	// Pre-condition - one location matches by name, another by address
+	byName := s.createNamedTestLocation("Central Park", "5th Avenue", 40.7829, -73.9654)
+	byAddress := s.createNamedTestLocation("Zoo", "Inside the central park", 40.7678, -73.9718)
+	other := s.createNamedTestLocation("Museum", "Main street", 40.7794, -73.9632)
+	for _, loc := range []*location.Location{byName, byAddress, other} {
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, loc))
+	}
+
+	// Act
+	found, err := s.TestDIContainer.LocationRepository.FindByName(ctx, "central")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Len(found, 2)
+	foundIDs := make(map[uuid.UUID]bool)
+	for _, l := range found {
+		foundIDs[l.ID()] = true
+	}
+	s.True(foundIDs[byName.ID()])
+	s.True(foundIDs[byAddress.ID()])
+	s.False(foundIDs[other.ID()])
+}
+
+func (s *Suite) TestLocationRepository_FindByName_EscapesWildcards() {
+	ctx := context.Background()
+
+	loc := s.createNamedTestLocation("Gorky Park", "Krymsky Val", 55.7298, 37.6010)
+	s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, loc))
+
+	// Act - "%" must be matched literally, not as a wildcard
+	found, err := s.TestDIContainer.LocationRepository.FindByName(ctx, "%")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Empty(found)
+}
+
+func (s *Suite) TestLocationRepository_FindByAddress_ExactCaseInsensitive() {
+	ctx := context.Background()
+
+	// Pre-condition
+	match := s.createTestLocation("Palace Square, Saint Petersburg", 59.939, 30.3158)
+	longer := s.createTestLocation("Palace Square, Saint Petersburg, Russia", 59.939, 30.3158)
+	for _, loc := range []*location.Location{match, longer} {
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, loc))
+	}
+
+	// Act
+	found, err := s.TestDIContainer.LocationRepository.FindByAddress(ctx, "  palace square, saint petersburg ")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(match.ID(), found[0].ID())
+}
+
+func (s *Suite) TestLocationRepository_FindByCoordinate() {
+	ctx := context.Background()
+
+	// Pre-condition
+	loc := s.createTestLocation("Kazan Cathedral", 59.9343, 30.3245)
+	nearby := s.createTestLocation("Nevsky Prospect", 59.9344, 30.3245)
+	for _, l := range []*location.Location{loc, nearby} {
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, l))
+	}
+
+	// Act
+	found, err := s.TestDIContainer.LocationRepository.FindByCoordinate(ctx, kernel.GeoCoordinate{Lat: 59.9343, Lon: 30.3245})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(loc.ID(), found[0].ID())
+}
+
+func (s *Suite) TestLocationRepository_SearchByText_FuzzyRanking() {
+	ctx := context.Background()
+
+	// Pre-condition
+	exact := s.createNamedTestLocation("Red Square", "Moscow", 55.7539, 37.6208)
+	partial := s.createNamedTestLocation("Red Square Mall", "Moscow", 55.7540, 37.6210)
+	unrelated := s.createNamedTestLocation("Hermitage", "Saint Petersburg", 59.9398, 30.3146)
+	for _, loc := range []*location.Location{exact, partial, unrelated} {
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, loc))
+	}
+
+	// Act - query contains a typo
+	matches, err := s.TestDIContainer.LocationRepository.SearchByText(ctx, "red sqare", 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(matches)
+	s.Equal(exact.ID(), matches[0].Location.ID(), "Closest match should be ranked first")
+	for i := 1; i < len(matches); i++ {
+		s.GreaterOrEqual(matches[i-1].Score, matches[i].Score, "Results should be ordered by score")
+	}
+	for _, m := range matches {
+		s.NotEqual(unrelated.ID(), m.Location.ID())
+	}
+}
+
+func (s *Suite) TestLocationRepository_SearchByText_RespectsLimit() {
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		loc := s.createNamedTestLocation("Metro Station "+string(rune('A'+i)), "Moscow", 55.75+float64(i)*0.01, 37.61)
+		s.Require().NoError(s.TestDIContainer.LocationRepository.Save(ctx, loc))
+	}
+
+	matches, err := s.TestDIContainer.LocationRepository.SearchByText(ctx, "metro station", 3)
+
+	s.Require().NoError(err)
+	s.Len(matches, 3)
+}
+
 // ==========================================
 // POSTGRESQL-SPECIFIC TESTS
 // ==========================================
@@ -285,9 +442,17 @@ func (s *Suite) createTestLocation(address string, lat, lon float64) *location.L
 	return loc
 }
 
+func (s *Suite) createNamedTestLocation(name, address string, lat, lon float64) *location.Location {
+	coordinate := kernel.GeoCoordinate{Lat: lat, Lon: lon}
+	loc, err := location.NewNamedLocation(coordinate, &name, &address)
+	s.Require().NoError(err)
+	return loc
+}
+
 func (s *Suite) assertLocationEquals(expected, actual location.Location) {
 	s.Equal(expected.ID(), actual.ID())
 	s.Equal(expected.Coordinate, actual.Coordinate)
+	s.Equal(expected.Name, actual.Name)
 
 	if expected.Address == nil {
 		s.Nil(actual.Address)
@@ -0,0 +1,115 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for notification inboxes and notification preferences
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/domain/model/notification"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+func newNotification(userID uuid.UUID, createdAt time.Time) notification.Notification {
+	n, _ := notification.New(userID, notification.KindQuestCommentAdded, uuid.New(), "New comment on quest \"Fence\"",
+		createdAt.UTC().Truncate(time.Microsecond))
+	return n
+}
+
+func (s *Suite) TestNotificationRepository_SaveAndGetByID() {
+	ctx := context.Background()
+	n := newNotification(uuid.New(), time.Now())
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.NotificationRepository.Save(ctx, n))
+	readAt := time.Now().UTC().Truncate(time.Microsecond)
+	n.MarkRead(readAt)
+	s.Require().NoError(s.TestDIContainer.NotificationRepository.Save(ctx, n))
+
+	// Assert
+	stored, err := s.TestDIContainer.NotificationRepository.GetByID(ctx, n.ID)
+	s.Require().NoError(err)
+	s.Equal(n.UserID, stored.UserID)
+	s.Equal(notification.KindQuestCommentAdded, stored.Kind)
+	s.Equal(n.QuestID, stored.QuestID)
+	s.Equal(n.Message, stored.Message)
+	s.Require().NotNil(stored.ReadAt)
+	s.True(readAt.Equal(*stored.ReadAt))
+
+	_, err = s.TestDIContainer.NotificationRepository.GetByID(ctx, uuid.New())
+	s.True(errors.Is(err, ports.ErrNotificationNotFound))
+}
+
+func (s *Suite) TestNotificationRepository_FindByUserAndMarkAllRead() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.NotificationRepository
+	userID := uuid.New()
+	start := time.Now().Add(-time.Hour)
+	var inbox []notification.Notification
+	for i := 0; i < 3; i++ {
+		n := newNotification(userID, start.Add(time.Duration(i)*time.Minute))
+		inbox = append(inbox, n)
+		s.Require().NoError(repo.Save(ctx, n))
+	}
+	inbox[0].MarkRead(time.Now())
+	s.Require().NoError(repo.Save(ctx, inbox[0]))
+	s.Require().NoError(repo.Save(ctx, newNotification(uuid.New(), start)))
+
+	// Act & Assert - newest first, paged
+	page, total, err := repo.FindByUser(ctx, userID, false, 2, 1)
+	s.Require().NoError(err)
+	s.Equal(3, total)
+	s.Require().Len(page, 2)
+	s.Equal(inbox[1].ID, page[0].ID)
+	s.Equal(inbox[0].ID, page[1].ID)
+
+	unread, total, err := repo.FindByUser(ctx, userID, true, 10, 0)
+	s.Require().NoError(err)
+	s.Equal(2, total)
+	s.Len(unread, 2)
+
+	count, err := repo.CountUnread(ctx, userID)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	// Act & Assert - only unread notifications of the user are marked
+	marked, err := repo.MarkAllRead(ctx, userID, time.Now())
+	s.Require().NoError(err)
+	s.Equal(2, marked)
+	count, err = repo.CountUnread(ctx, userID)
+	s.Require().NoError(err)
+	s.Equal(0, count)
+}
+
+func (s *Suite) TestNotificationRepository_Preferences() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.NotificationRepository
+	userID := uuid.New()
+
+	// Users without saved preferences receive every kind
+	p, err := repo.Preferences(ctx, userID)
+	s.Require().NoError(err)
+	s.True(p.Receives(notification.KindQuestAssigned))
+
+	update, err := notification.NewPreferences(userID, map[notification.Kind]bool{notification.KindQuestAssigned: false})
+	s.Require().NoError(err)
+	s.Require().NoError(repo.SavePreferences(ctx, update))
+	update, err = notification.NewPreferences(userID, map[notification.Kind]bool{
+		notification.KindQuestAssigned:      true,
+		notification.KindQuestStatusChanged: false,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(repo.SavePreferences(ctx, update))
+
+	p, err = repo.Preferences(ctx, userID)
+	s.Require().NoError(err)
+	s.True(p.Receives(notification.KindQuestAssigned))
+	s.False(p.Receives(notification.KindQuestStatusChanged))
+	s.True(p.Receives(notification.KindQuestCommentAdded))
+}
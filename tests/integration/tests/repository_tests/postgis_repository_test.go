@@ -0,0 +1,141 @@
+//go:build integration
+
+package repository
+
+// POSTGIS REPOSITORY INTEGRATION TESTS
+// Checks that PostGIS-backed repositories return the same results as the plain SQL fallback.
+// Skipped when the postgis extension is not installed on the test database.
+
+import (
+	"context"
+
+	"quest-manager/internal/adapters/out/postgres"
+	"quest-manager/internal/adapters/out/postgres/leaderboardrepo"
+	"quest-manager/internal/adapters/out/postgres/locationrepo"
+	"quest-manager/internal/adapters/out/postgres/questrepo"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/location"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/ports"
+)
+
+func (s *Suite) TestPostGISRepository_QuestsMatchSQLFallback() {
+	ctx := context.Background()
+	postGISUoW := s.requirePostGIS()
+	sqlUoW, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+
+	center := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	coords := []kernel.GeoCoordinate{
+		{Lat: 55.8008, Lon: 37.6173}, // ~5km
+		{Lat: 55.7648, Lon: 37.6173}, // ~1km
+		{Lat: 55.7558, Lon: 37.7773}, // ~10km east
+		{Lat: 59.9311, Lon: 30.3609}, // SPB, outside radius
+	}
+	for i, c := range coords {
+		q := s.createTestQuestAtLocation("PostGIS Quest", "easy", c)
+		s.Require().NoError(sqlUoW.QuestRepository().Save(ctx, q), "quest %d", i)
+	}
+
+	// Act
+	fromPostGIS, err := postGISUoW.QuestRepository().FindWithinRadius(ctx, center, 15, quest.RadiusSortDistance, 0)
+	s.Require().NoError(err)
+	fromSQL, err := sqlUoW.QuestRepository().FindWithinRadius(ctx, center, 15, quest.RadiusSortDistance, 0)
+	s.Require().NoError(err)
+
+	// Assert - same quests in the same order
+	s.Require().Len(fromPostGIS, 3)
+	s.Equal(questIDs(fromSQL), questIDs(fromPostGIS))
+}
+
+func (s *Suite) TestPostGISRepository_LocationsMatchSQLFallback() {
+	ctx := context.Background()
+	postGISUoW := s.requirePostGIS()
+	sqlUoW, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+
+	center := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	for _, l := range []*location.Location{
+		s.createTestLocation("Far", 55.8008, 37.6173),
+		s.createTestLocation("Near", 55.7648, 37.6173),
+		s.createTestLocation("Saint Petersburg", 59.9311, 30.3609),
+	} {
+		s.Require().NoError(sqlUoW.LocationRepository().Save(ctx, l))
+	}
+
+	// Act
+	fromPostGIS, err := postGISUoW.LocationRepository().FindWithinRadius(ctx, center, 10)
+	s.Require().NoError(err)
+	fromSQL, err := sqlUoW.LocationRepository().FindWithinRadius(ctx, center, 10)
+	s.Require().NoError(err)
+
+	// Assert - same locations in the same order
+	s.Require().Len(fromPostGIS, 2)
+	s.Require().Len(fromSQL, 2)
+	for i := range fromSQL {
+		s.Equal(fromSQL[i].ID(), fromPostGIS[i].ID())
+	}
+}
+
+func (s *Suite) TestPostGISRepository_PolygonMatchesSQLFallback() {
+	ctx := context.Background()
+	postGISUoW := s.requirePostGIS()
+	sqlUoW, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+
+	// Boundary points lie on the equator or a meridian, where geodesic and straight edges agree
+	coords := []kernel.GeoCoordinate{
+		{Lat: 1, Lon: 1},   // inside
+		{Lat: 5, Lon: 5},   // inside the hole
+		{Lat: 0, Lon: 5},   // on the exterior edge
+		{Lat: 5, Lon: 4},   // on the hole edge
+		{Lat: 8, Lon: 5},   // in the notch of the concave polygon
+		{Lat: 11, Lon: 11}, // outside
+	}
+	for i, c := range coords {
+		q := s.createTestQuestAtLocation("PostGIS Polygon Quest", "easy", c)
+		s.Require().NoError(sqlUoW.QuestRepository().Save(ctx, q), "quest %d", i)
+	}
+
+	polygon, err := kernel.NewPolygon(
+		[]kernel.GeoCoordinate{
+			{Lat: 0, Lon: 0}, {Lat: 0, Lon: 10}, {Lat: 10, Lon: 10}, {Lat: 10, Lon: 7},
+			{Lat: 7, Lon: 7}, {Lat: 7, Lon: 3}, {Lat: 10, Lon: 3}, {Lat: 10, Lon: 0},
+		},
+		[]kernel.GeoCoordinate{{Lat: 4, Lon: 4}, {Lat: 4, Lon: 6}, {Lat: 6, Lon: 6}, {Lat: 6, Lon: 4}},
+	)
+	s.Require().NoError(err)
+
+	// Act
+	fromPostGIS, err := postGISUoW.QuestRepository().FindInPolygon(ctx, polygon, quest.LocationMatchAny)
+	s.Require().NoError(err)
+	fromSQL, err := sqlUoW.QuestRepository().FindInPolygon(ctx, polygon, quest.LocationMatchAny)
+	s.Require().NoError(err)
+
+	// Assert - same quests, boundaries included
+	s.Len(fromSQL, 3)
+	s.ElementsMatch(questIDs(fromSQL), questIDs(fromPostGIS))
+}
+
+// requirePostGIS migrates the PostGIS columns and returns a PostGIS-backed unit of work,
+// skipping the test when the extension is not available.
+func (s *Suite) requirePostGIS() ports.UnitOfWork {
+	db := s.TestDIContainer.DB
+	if err := questrepo.MigratePostGIS(db); err != nil {
+		s.T().Skipf("PostGIS is not available: %v", err)
+	}
+	s.Require().NoError(locationrepo.MigratePostGIS(db))
+	s.Require().NoError(leaderboardrepo.MigratePostGIS(db))
+
+	uow, err := postgres.NewUnitOfWork(db, postgres.WithPostGIS(true))
+	s.Require().NoError(err)
+	return uow
+}
+
+func questIDs(quests []quest.Quest) []string {
+	ids := make([]string, len(quests))
+	for i, q := range quests {
+		ids[i] = q.ID().String()
+	}
+	return ids
+}
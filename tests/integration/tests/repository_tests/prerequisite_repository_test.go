@@ -0,0 +1,155 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for the prerequisite links between quests
+
+import (
+	"context"
+	"time"
+
+	"quest-manager/internal/adapters/out/postgres"
+	"quest-manager/internal/core/domain/model/quest"
+
+	"github.com/google/uuid"
+)
+
+// createChain saves quests where each quest depends on the previous one, oldest first
+func (s *Suite) createChain(ctx context.Context, titles ...string) []quest.Quest {
+	start := time.Now().Add(-time.Hour)
+	var chain []quest.Quest
+	for i, title := range titles {
+		q := s.createTestQuest(title, "easy")
+		q.CreatedAt = start.Add(time.Duration(i) * time.Minute)
+		if i > 0 {
+			s.Require().NoError(q.AddPrerequisite(chain[i-1], quest.NewDependencyGraph(chain)))
+		}
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+		chain = append(chain, q)
+	}
+	return chain
+}
+
+func (s *Suite) TestQuestRepository_Save_Prerequisites() {
+	ctx := context.Background()
+	first := s.createTestQuest("Find the map", "easy")
+	second := s.createTestQuest("Find the key", "easy")
+	q := s.createTestQuest("Open the chest", "easy")
+	s.Require().NoError(q.AddPrerequisite(first, quest.NewDependencyGraph(nil)))
+	s.Require().NoError(q.AddPrerequisite(second, quest.NewDependencyGraph(nil)))
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	q.CompletePrerequisite(second.ID())
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Assert - prerequisites keep their order and completion
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Equal([]quest.Prerequisite{
+		{QuestID: first.ID(), Completed: false},
+		{QuestID: second.ID(), Completed: true},
+	}, saved.Prerequisites)
+
+	// Act & Assert - removed prerequisites are deleted
+	s.Require().NoError(q.RemovePrerequisite(first.ID()))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	saved, err = s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Equal([]quest.Prerequisite{{QuestID: second.ID(), Completed: true}}, saved.Prerequisites)
+}
+
+func (s *Suite) TestQuestRepository_FindDependents() {
+	ctx := context.Background()
+	chain := s.createChain(ctx, "Find the map", "Follow the map", "Open the chest")
+
+	// Act
+	dependents, err := s.TestDIContainer.QuestRepository.FindDependents(ctx, chain[0].ID())
+
+	// Assert - only direct dependents are returned
+	s.Require().NoError(err)
+	s.Require().Len(dependents, 1)
+	s.Equal(chain[1].ID(), dependents[0].ID())
+	s.Len(dependents[0].Prerequisites, 1)
+
+	dependents, err = s.TestDIContainer.QuestRepository.FindDependents(ctx, chain[2].ID())
+	s.Require().NoError(err)
+	s.Empty(dependents)
+}
+
+func (s *Suite) TestQuestRepository_FindLinkedByPrerequisites() {
+	ctx := context.Background()
+	chain := s.createChain(ctx, "Find the map", "Follow the map", "Open the chest")
+	unrelated := s.createTestQuest("Water the plants", "easy")
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, unrelated))
+
+	// Act & Assert - the whole chain is linked to each of its quests, oldest first
+	for _, q := range chain {
+		linked, err := s.TestDIContainer.QuestRepository.FindLinkedByPrerequisites(ctx, q.ID())
+		s.Require().NoError(err)
+		s.Require().Len(linked, 3)
+		for i := range chain {
+			s.Equal(chain[i].ID(), linked[i].ID())
+		}
+	}
+
+	linked, err := s.TestDIContainer.QuestRepository.FindLinkedByPrerequisites(ctx, unrelated.ID())
+	s.Require().NoError(err)
+	s.Require().Len(linked, 1)
+	s.Equal(unrelated.ID(), linked[0].ID())
+
+	linked, err = s.TestDIContainer.QuestRepository.FindLinkedByPrerequisites(ctx, uuid.New())
+	s.Require().NoError(err)
+	s.Empty(linked)
+}
+
+func (s *Suite) TestQuestRepository_LockLinkedByPrerequisites_WaitsForConcurrentLink() {
+	ctx := context.Background()
+	first := s.createTestQuest("Find the map", "easy")
+	second := s.createTestQuest("Open the chest", "easy")
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, first))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, second))
+
+	firstUoW, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+	secondUoW, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+
+	// Pre-condition - one transaction makes the second quest depend on the first one
+	s.Require().NoError(firstUoW.Begin(ctx))
+	defer func() { _ = firstUoW.Rollback() }()
+	s.Require().NoError(firstUoW.QuestRepository().LockLinkedByPrerequisites(ctx, second.ID(), first.ID()))
+	s.Require().NoError(second.AddPrerequisite(first, quest.NewDependencyGraph(nil)))
+	s.Require().NoError(firstUoW.QuestRepository().Save(ctx, second))
+
+	// Act - another transaction wants the first quest to depend on the second one
+	type result struct {
+		linked []quest.Quest
+		err    error
+	}
+	done := make(chan result, 1)
+	s.Require().NoError(secondUoW.Begin(ctx))
+	defer func() { _ = secondUoW.Rollback() }()
+	go func() {
+		if err := secondUoW.QuestRepository().LockLinkedByPrerequisites(ctx, first.ID(), second.ID()); err != nil {
+			done <- result{err: err}
+			return
+		}
+		linked, err := secondUoW.QuestRepository().FindLinkedByPrerequisites(ctx, second.ID())
+		done <- result{linked: linked, err: err}
+	}()
+
+	// Assert - it waits for the first transaction, then sees its link and detects the cycle
+	select {
+	case <-done:
+		s.Fail("the linked quests were not locked")
+	case <-time.After(200 * time.Millisecond):
+	}
+	s.Require().NoError(firstUoW.Commit(ctx))
+
+	res := <-done
+	s.Require().NoError(res.err)
+	s.Require().Len(res.linked, 2)
+	s.Error(first.AddPrerequisite(second, quest.NewDependencyGraph(res.linked)))
+}
@@ -8,6 +8,7 @@ package repository
 import (
 	"context"
 
+	"quest-manager/internal/adapters/out/postgres/questrepo"
 	"quest-manager/internal/core/domain/model/kernel"
 	"quest-manager/internal/core/domain/model/quest"
 
@@ -213,6 +214,316 @@ func (s *Suite) TestQuestRepository_FindByBoundingBox_Success() {
 	s.False(foundIDs[quest2.ID()]) // SPB quest should not be found
 }
 
+func (s *Suite) TestQuestRepository_FindWithinRadius_OrderedByDistance() {
+	ctx := context.Background()
+	center := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+
+	// Pre-condition - quests at ~5km, ~1km, ~10km and ~630km (SPB) from the center
+	farQuest := s.createTestQuestAtLocation("Far Quest", "easy", kernel.GeoCoordinate{Lat: 55.8008, Lon: 37.6173})
+	nearQuest := s.createTestQuestAtLocation("Near Quest", "easy", kernel.GeoCoordinate{Lat: 55.7648, Lon: 37.6173})
+	edgeQuest := s.createTestQuestAtLocation("Edge Quest", "easy", kernel.GeoCoordinate{Lat: 55.8458, Lon: 37.6173})
+	spbQuest := s.createTestQuestAtLocation("SPB Quest", "easy", kernel.GeoCoordinate{Lat: 59.9311, Lon: 30.3609})
+
+	for _, q := range []quest.Quest{farQuest, nearQuest, edgeQuest, spbQuest} {
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	}
+
+	// Act
+	found, err := s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, center, 15, quest.RadiusSortDistance, 0)
+
+	// Assert - SPB is excluded, the rest come closest first
+	s.Require().NoError(err)
+	s.Require().Len(found, 3)
+	s.Equal(nearQuest.ID(), found[0].ID())
+	s.Equal(farQuest.ID(), found[1].ID())
+	s.Equal(edgeQuest.ID(), found[2].ID())
+}
+
+func (s *Suite) TestQuestRepository_FindWithinRadius_SortedByRewardAndLimited() {
+	ctx := context.Background()
+	center := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+
+	// Pre-condition - two reward 5 quests at ~5km and ~1km, one reward 1 quest at the center
+	farRich := s.createTestQuestAtLocation("Far Rich Quest", "easy", kernel.GeoCoordinate{Lat: 55.8008, Lon: 37.6173})
+	farRich.Reward = 5
+	nearRich := s.createTestQuestAtLocation("Near Rich Quest", "easy", kernel.GeoCoordinate{Lat: 55.7648, Lon: 37.6173})
+	nearRich.Reward = 5
+	poor := s.createTestQuestAtLocation("Poor Quest", "easy", center)
+	poor.Reward = 1
+
+	for _, q := range []quest.Quest{farRich, nearRich, poor} {
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	}
+
+	// Act
+	found, err := s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, center, 15, quest.RadiusSortReward, 2)
+
+	// Assert - highest reward first, ties closest first, the limit cuts the poor quest
+	s.Require().NoError(err)
+	s.Require().Len(found, 2)
+	s.Equal(nearRich.ID(), found[0].ID())
+	s.Equal(farRich.ID(), found[1].ID())
+}
+
+func (s *Suite) TestQuestRepository_FindWithinRadius_MatchesExecutionLocation() {
+	ctx := context.Background()
+	center := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+
+	// Pre-condition - target is far away, execution location is at the center
+	q, err := quest.NewQuest(
+		"Execution Only Quest",
+		"Target is in SPB, execution in Moscow",
+		"medium",
+		3,
+		60,
+		kernel.GeoCoordinate{Lat: 59.9311, Lon: 30.3609},
+		center,
+		"test-creator",
+		[]string{},
+		[]string{},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Act
+	found, err := s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, center, 1, quest.RadiusSortDistance, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(q.ID(), found[0].ID())
+}
+
+func (s *Suite) TestQuestRepository_FindByBoundingBox_AcrossAntimeridian() {
+	ctx := context.Background()
+
+	// Pre-condition - quests on both sides of the dateline and one far away
+	eastQuest := s.createTestQuestAtLocation("Fiji East", "easy", kernel.GeoCoordinate{Lat: -17.0, Lon: 179.5})
+	westQuest := s.createTestQuestAtLocation("Fiji West", "easy", kernel.GeoCoordinate{Lat: -17.0, Lon: -179.5})
+	farQuest := s.createTestQuestAtLocation("Greenwich", "easy", kernel.GeoCoordinate{Lat: -17.0, Lon: 0.0})
+	for _, q := range []quest.Quest{eastQuest, westQuest, farQuest} {
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	}
+
+	// Act - wrapped box covering [179, 180] and [-180, -179]
+	found, err := s.TestDIContainer.QuestRepository.FindByBoundingBox(ctx,
+		kernel.BoundingBox{MinLat: -18, MaxLat: -16, MinLon: 179, MaxLon: -179})
+
+	// Assert
+	s.Require().NoError(err)
+	foundIDs := make(map[uuid.UUID]bool)
+	for _, q := range found {
+		foundIDs[q.ID()] = true
+	}
+	s.Len(found, 2)
+	s.True(foundIDs[eastQuest.ID()])
+	s.True(foundIDs[westQuest.ID()])
+	s.False(foundIDs[farQuest.ID()])
+}
+
+func (s *Suite) TestQuestRepository_ClusterByTile() {
+	ctx := context.Background()
+
+	// Pre-condition - two Berlin quests in one cell, one in Rostock, one whose only
+	// execution location is in the tile and one far away
+	berlin := []kernel.GeoCoordinate{{Lat: 52.52, Lon: 13.40}, {Lat: 52.50, Lon: 13.42}}
+	rostock := kernel.GeoCoordinate{Lat: 54.09, Lon: 12.10}
+	for i, c := range berlin {
+		q := s.createTestQuestAtLocation("Berlin Quest", "easy", c)
+		if i == 1 {
+			s.Require().NoError(q.ChangeStatus(quest.StatusPosted))
+		}
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	}
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, s.createTestQuestAtLocation("Rostock Quest", "easy", rostock)))
+	executionOnly, err := quest.NewQuest("Execution Only", "Target in Paris", "easy", 3, 60,
+		kernel.GeoCoordinate{Lat: 48.85, Lon: 2.35}, berlin[0], "test-creator", nil, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, executionOnly))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, s.createTestQuestAtLocation("Tokyo Quest", "easy", kernel.GeoCoordinate{Lat: 35.68, Lon: 139.69})))
+
+	// Act - zoom 6 tile of northern Germany, split into 8x8 cells
+	tile := kernel.TileForCoordinate(berlin[0], 6)
+	s.Require().Equal(tile, kernel.TileForCoordinate(rostock, 6))
+	clusters, err := s.TestDIContainer.QuestRepository.ClusterByTile(ctx, tile, 9)
+
+	// Assert - cells match the domain computation, Rostock (north) comes first
+	s.Require().NoError(err)
+	s.Require().Len(clusters, 2)
+	s.Equal(kernel.TileForCoordinate(rostock, 9), clusters[0].Cell)
+	s.Equal(1, clusters[0].Count)
+	s.Equal(kernel.TileForCoordinate(berlin[0], 9), clusters[1].Cell)
+	s.Equal(2, clusters[1].Count)
+	s.Equal(map[quest.Status]int{quest.StatusCreated: 1, quest.StatusPosted: 1}, clusters[1].StatusCounts)
+	s.InDelta(52.51, clusters[1].Center.Lat, 1e-9)
+	s.InDelta(13.41, clusters[1].Center.Lon, 1e-9)
+}
+
+func (s *Suite) TestQuestRepository_FindWithinRadius_AcrossAntimeridian() {
+	ctx := context.Background()
+
+	// Pre-condition - Chukotka quest just west of the dateline (~40km from center)
+	chukotkaQuest := s.createTestQuestAtLocation("Chukotka", "easy", kernel.GeoCoordinate{Lat: 65.0, Lon: -179.6})
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, chukotkaQuest))
+
+	// Act - search from the eastern side of the dateline
+	found, err := s.TestDIContainer.QuestRepository.FindWithinRadius(ctx, kernel.GeoCoordinate{Lat: 65.0, Lon: 179.6}, 50, quest.RadiusSortDistance, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(chukotkaQuest.ID(), found[0].ID())
+}
+
+func (s *Suite) TestQuestRepository_FindInPolygon_MatchModes() {
+	ctx := context.Background()
+	inside := kernel.GeoCoordinate{Lat: 55.75, Lon: 37.6}
+	outside := kernel.GeoCoordinate{Lat: 59.9311, Lon: 30.3609}
+
+	// Pre-condition - one quest per combination of target/execution inside the area
+	newQuest := func(title string, target, execution kernel.GeoCoordinate) quest.Quest {
+		q, err := quest.NewQuest(title, "Area search quest", "easy", 1, 30, target, execution,
+			"test-creator", []string{}, []string{})
+		s.Require().NoError(err)
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+		return q
+	}
+	targetInside := newQuest("Target Inside", inside, outside)
+	executionInside := newQuest("Execution Inside", outside, inside)
+	newQuest("Both Outside", outside, outside)
+
+	// Square around central Moscow with a hole that does not contain the quests
+	polygon, err := kernel.NewPolygon(
+		[]kernel.GeoCoordinate{{Lat: 55.7, Lon: 37.5}, {Lat: 55.7, Lon: 37.7}, {Lat: 55.8, Lon: 37.7}, {Lat: 55.8, Lon: 37.5}},
+		[]kernel.GeoCoordinate{{Lat: 55.71, Lon: 37.65}, {Lat: 55.71, Lon: 37.69}, {Lat: 55.72, Lon: 37.69}, {Lat: 55.72, Lon: 37.65}},
+	)
+	s.Require().NoError(err)
+
+	tests := []struct {
+		match    quest.LocationMatch
+		expected []string
+	}{
+		{quest.LocationMatchTarget, questIDs([]quest.Quest{targetInside})},
+		{quest.LocationMatchExecution, questIDs([]quest.Quest{executionInside})},
+		{quest.LocationMatchAny, questIDs([]quest.Quest{targetInside, executionInside})},
+	}
+
+	for _, tt := range tests {
+		// Act
+		found, err := s.TestDIContainer.QuestRepository.FindInPolygon(ctx, polygon, tt.match)
+
+		// Assert
+		s.Require().NoError(err)
+		s.ElementsMatch(tt.expected, questIDs(found), "match=%s", tt.match)
+	}
+}
+
+func (s *Suite) TestQuestRepository_FindInPolygon_ExcludesHole() {
+	ctx := context.Background()
+
+	// Pre-condition - quest in the middle of the hole
+	q := s.createTestQuestAtLocation("In Hole", "easy", kernel.GeoCoordinate{Lat: 5, Lon: 5})
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	polygon, err := kernel.NewPolygon(
+		[]kernel.GeoCoordinate{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 10}, {Lat: 10, Lon: 10}, {Lat: 10, Lon: 0}},
+		[]kernel.GeoCoordinate{{Lat: 4, Lon: 4}, {Lat: 4, Lon: 6}, {Lat: 6, Lon: 6}, {Lat: 6, Lon: 4}},
+	)
+	s.Require().NoError(err)
+
+	// Act
+	found, err := s.TestDIContainer.QuestRepository.FindInPolygon(ctx, polygon, quest.LocationMatchAny)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Empty(found)
+}
+
+func (s *Suite) TestQuestRepository_Save_Waypoints() {
+	ctx := context.Background()
+	q := s.createTestQuestAtLocation("Route Quest", "easy", kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954})
+	waypoints := []kernel.GeoCoordinate{{Lat: 52.5, Lon: 13.3}, {Lat: 52.45, Lon: 13.2}, {Lat: 52.42, Lon: 13.18}}
+	s.Require().NoError(q.SetWaypoints(waypoints))
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Assert - waypoints come back in route order from single and list queries
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Equal(waypoints, saved.Waypoints)
+
+	all, err := s.TestDIContainer.QuestRepository.FindAll(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(all, 1)
+	s.Equal(waypoints, all[0].Waypoints)
+}
+
+func (s *Suite) TestQuestRepository_Save_ReplacesWaypoints() {
+	ctx := context.Background()
+	q := s.createTestQuestAtLocation("Route Quest", "easy", kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954})
+	s.Require().NoError(q.SetWaypoints([]kernel.GeoCoordinate{{Lat: 52.5, Lon: 13.3}, {Lat: 52.45, Lon: 13.2}}))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Act - shorten the route to a single stop
+	s.Require().NoError(q.SetWaypoints([]kernel.GeoCoordinate{{Lat: 52.48, Lon: 13.25}}))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Assert
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Equal([]kernel.GeoCoordinate{{Lat: 52.48, Lon: 13.25}}, saved.Waypoints)
+}
+
+func (s *Suite) TestQuestRepository_FindByFilter_StatusAndRouteLength() {
+	ctx := context.Background()
+	berlin := kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954}
+
+	// Pre-condition - a short route, a long route and a short route with another status
+	short := s.createTestQuestAtLocation("Short Route", "easy", berlin)
+	long := s.createTestQuestAtLocation("Long Route", "easy", berlin)
+	s.Require().NoError(long.SetWaypoints([]kernel.GeoCoordinate{{Lat: 52.6, Lon: 13.2}}))
+	posted := s.createTestQuestAtLocation("Posted Short Route", "easy", berlin)
+	s.Require().NoError(posted.ChangeStatus(quest.StatusPosted))
+	for _, q := range []quest.Quest{short, long, posted} {
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	}
+
+	// Act
+	status := quest.StatusCreated
+	maxRouteKm := 10.0
+	found, err := s.TestDIContainer.QuestRepository.FindByFilter(ctx, quest.ListFilter{Status: &status, MaxRouteKm: &maxRouteKm})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(short.ID(), found[0].ID())
+}
+
+func (s *Suite) TestQuestRepository_MigrateRouteLength_BackfillsMissingRouteLengths() {
+	ctx := context.Background()
+	db := s.TestDIContainer.DB
+	q := s.createTestQuestAtLocation("Legacy Route", "easy", kernel.GeoCoordinate{Lat: 52.520008, Lon: 13.404954})
+	s.Require().NoError(q.SetWaypoints([]kernel.GeoCoordinate{{Lat: 52.6, Lon: 13.2}}))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Pre-condition - a row stored before the route_km column existed is not found by route filters
+	s.Require().NoError(db.Exec("UPDATE quests SET route_km = NULL").Error)
+	maxRouteKm := q.RouteDistanceKm()
+	found, err := s.TestDIContainer.QuestRepository.FindByFilter(ctx, quest.ListFilter{MaxRouteKm: &maxRouteKm})
+	s.Require().NoError(err)
+	s.Require().Empty(found)
+
+	// Act
+	s.Require().NoError(questrepo.MigrateRouteLength(db))
+
+	// Assert
+	found, err = s.TestDIContainer.QuestRepository.FindByFilter(ctx, quest.ListFilter{MaxRouteKm: &maxRouteKm})
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(q.ID(), found[0].ID())
+}
+
 func (s *Suite) TestQuestRepository_FindByAssignee_Success() {
 	ctx := context.Background()
 
@@ -524,3 +835,85 @@ func (s *Suite) assertQuestEquals(expected, actual quest.Quest) {
 	s.Equal(expected.Skills, actual.Skills)
 	s.Equal(expected.Status, actual.Status)
 }
+
+func (s *Suite) TestQuestRepository_SaveEligibilityPolicy() {
+	ctx := context.Background()
+
+	// Pre-condition - quest with the strict eligibility policy
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	q, err := quest.NewQuest("Strict", "Quest with eligibility policy", "easy", 1, 30,
+		location, location, "creator", []string{"rope"}, []string{"climbing"})
+	s.Require().NoError(err)
+	s.Require().NoError(q.SetEligibilityPolicy("strict"))
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(quest.EligibilityStrict, saved.EligibilityPolicy)
+}
+
+func (s *Suite) TestQuestRepository_SaveGeofenceRadius() {
+	ctx := context.Background()
+	q := s.createTestQuest("On-site", "easy")
+	s.Require().NoError(q.SetGeofenceRadius(250))
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(250, saved.GeofenceRadius)
+}
+
+func (s *Suite) TestQuestRepository_Save_Participants() {
+	ctx := context.Background()
+	q := s.createTestQuest("Team Quest", "easy")
+	s.Require().NoError(q.SetCapacity(3, 2))
+	first, second := uuid.New(), uuid.New()
+	s.Require().NoError(q.AssignTo(first))
+	s.Require().NoError(q.AssignTo(second))
+	s.Require().NoError(q.Start(first))
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Assert - participants come back in joining order with their progress
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Equal(3, saved.Capacity)
+	s.Equal(2, saved.CompletionQuorum)
+	s.Require().Len(saved.Participants, 2)
+	s.Equal(first, saved.Participants[0].UserID)
+	s.NotNil(saved.Participants[0].StartedAt)
+	s.Equal(second, saved.Participants[1].UserID)
+	s.Nil(saved.Participants[1].StartedAt)
+
+	// Every participant finds the quest among their assigned quests
+	secondQuests, err := s.TestDIContainer.QuestRepository.FindByAssignee(ctx, second)
+	s.Require().NoError(err)
+	s.Require().Len(secondQuests, 1)
+	s.Equal(q.ID(), secondQuests[0].ID())
+}
+
+func (s *Suite) TestQuestRepository_Save_ReleasesParticipants() {
+	ctx := context.Background()
+	q := s.createTestQuest("Team Quest", "easy")
+	s.Require().NoError(q.SetCapacity(2, 0))
+	s.Require().NoError(q.AssignTo(uuid.New()))
+	s.Require().NoError(q.AssignTo(uuid.New()))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Act - reposting the quest releases its participants
+	s.Require().NoError(q.ChangeStatus(quest.StatusPosted))
+	s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+
+	// Assert
+	saved, err := s.TestDIContainer.QuestRepository.GetByID(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Empty(saved.Participants)
+	s.Nil(saved.Assignee)
+}
@@ -0,0 +1,193 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for quest templates and their occurrences
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/adapters/out/postgres"
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/template"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+func newQuestTemplate(ownerID uuid.UUID, rule string, start, now time.Time) *template.QuestTemplate {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	address := "Red Square, Moscow"
+	spec := template.Spec{
+		Title: "Water the plants", Description: "Water every plant in the office", Difficulty: "easy",
+		Reward: 1, DurationMinutes: 15,
+		TargetLocation: &location, ExecutionAddress: &address,
+		Waypoints: []kernel.GeoCoordinate{{Lat: 55.75, Lon: 37.62}},
+		Equipment: []string{"watering can"}, Capacity: 2, CompletionQuorum: 1,
+	}
+	var recurrence *template.Recurrence
+	if rule != "" {
+		r, _ := template.ParseRecurrence(rule, start)
+		recurrence = &r
+	}
+	t, _ := template.NewQuestTemplate(ownerID, "Plants", spec, recurrence, now.UTC().Truncate(time.Microsecond))
+	return t
+}
+
+func (s *Suite) TestQuestTemplateRepository_SaveAndGetByID() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.TemplateRepository
+	start := time.Now().Add(time.Hour)
+	t := newQuestTemplate(uuid.New(), "FREQ=WEEKLY;UNTIL=20991231", start, time.Now())
+
+	// Act
+	s.Require().NoError(repo.Save(ctx, t))
+
+	// Assert
+	stored, err := repo.GetByID(ctx, t.ID())
+	s.Require().NoError(err)
+	s.Equal(t.OwnerID, stored.OwnerID)
+	s.Equal("Plants", stored.Name)
+	s.Equal(t.Quest, stored.Quest)
+	s.Require().NotNil(stored.Recurrence)
+	s.Equal(t.Recurrence.String(), stored.Recurrence.String())
+	s.True(t.Recurrence.Start.Equal(stored.Recurrence.Start))
+	s.Require().NotNil(stored.NextOccurrence)
+	s.True(t.NextOccurrence.Equal(*stored.NextOccurrence))
+
+	_, err = repo.GetByID(ctx, uuid.New())
+	s.True(errors.Is(err, ports.ErrQuestTemplateNotFound))
+}
+
+func (s *Suite) TestQuestTemplateRepository_FindByOwnerAndDelete() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.TemplateRepository
+	ownerID := uuid.New()
+	first := newQuestTemplate(ownerID, "", time.Now(), time.Now().Add(-time.Minute))
+	second := newQuestTemplate(ownerID, "", time.Now(), time.Now())
+	s.Require().NoError(repo.Save(ctx, second))
+	s.Require().NoError(repo.Save(ctx, first))
+	s.Require().NoError(repo.Save(ctx, newQuestTemplate(uuid.New(), "", time.Now(), time.Now())))
+
+	// Act & Assert - oldest first
+	owned, err := repo.FindByOwner(ctx, ownerID)
+	s.Require().NoError(err)
+	s.Require().Len(owned, 2)
+	s.Equal(first.ID(), owned[0].ID())
+	s.Equal(second.ID(), owned[1].ID())
+
+	s.Require().NoError(repo.Delete(ctx, first.ID()))
+	owned, err = repo.FindByOwner(ctx, ownerID)
+	s.Require().NoError(err)
+	s.Len(owned, 1)
+
+	err = repo.Delete(ctx, first.ID())
+	s.True(errors.Is(err, ports.ErrQuestTemplateNotFound))
+}
+
+func (s *Suite) TestQuestTemplateRepository_FindDue() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.TemplateRepository
+	now := time.Now()
+	due := newQuestTemplate(uuid.New(), "FREQ=DAILY", now.Add(time.Minute), now)
+	later := newQuestTemplate(uuid.New(), "FREQ=DAILY", now.Add(time.Hour), now)
+	unscheduled := newQuestTemplate(uuid.New(), "", now, now)
+	for _, t := range []*template.QuestTemplate{due, later, unscheduled} {
+		s.Require().NoError(repo.Save(ctx, t))
+	}
+
+	// Act
+	found, err := repo.FindDue(ctx, now.Add(10*time.Minute), 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(due.ID(), found[0].ID())
+}
+
+func (s *Suite) TestQuestTemplateRepository_OccurrenceIsAddedOnce() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.TemplateRepository
+	t := newQuestTemplate(uuid.New(), "FREQ=DAILY", time.Now(), time.Now())
+	s.Require().NoError(repo.Save(ctx, t))
+	o := template.Occurrence{TemplateID: t.ID(), At: *t.NextOccurrence, CreatedAt: time.Now()}
+
+	// Act
+	added, err := repo.AddOccurrence(ctx, o)
+	s.Require().NoError(err)
+	addedAgain, err := repo.AddOccurrence(ctx, o)
+	s.Require().NoError(err)
+
+	// Assert
+	s.True(added)
+	s.False(addedAgain, "the occurrence is already recorded")
+
+	questID := uuid.New()
+	o.QuestID = &questID
+	s.Require().NoError(repo.SaveOccurrence(ctx, o))
+}
+
+func (s *Suite) TestQuestTemplateRepository_FindRetryableOccurrences() {
+	ctx := context.Background()
+	repo := s.TestDIContainer.TemplateRepository
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	t := newQuestTemplate(uuid.New(), "FREQ=DAILY", now.AddDate(0, 0, -3), now)
+	s.Require().NoError(repo.Save(ctx, t))
+
+	// Pre-condition - a failed occurrence, one with a quest and one still claimed
+	claimedBefore := now.Add(-template.OccurrenceClaimTimeout)
+	failed := template.Occurrence{TemplateID: t.ID(), At: now.AddDate(0, 0, -3), CreatedAt: claimedBefore, ClaimedAt: claimedBefore}
+	questID := uuid.New()
+	done := template.Occurrence{TemplateID: t.ID(), At: now.AddDate(0, 0, -2), QuestID: &questID, CreatedAt: claimedBefore, ClaimedAt: claimedBefore}
+	claimed := template.Occurrence{TemplateID: t.ID(), At: now.AddDate(0, 0, -1), CreatedAt: now, ClaimedAt: now}
+	for _, o := range []template.Occurrence{failed, done, claimed} {
+		_, err := repo.AddOccurrence(ctx, o)
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(repo.SaveOccurrence(ctx, done))
+
+	// Act
+	found, err := repo.FindRetryableOccurrences(ctx, now, 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(t.ID(), found[0].TemplateID)
+	s.True(failed.At.Equal(found[0].At))
+	s.Nil(found[0].QuestID)
+}
+
+func (s *Suite) TestQuestTemplateRepository_RetryableOccurrenceLockedByAnotherRunIsSkipped() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	t := newQuestTemplate(uuid.New(), "FREQ=DAILY", now.AddDate(0, 0, -1), now)
+	s.Require().NoError(s.TestDIContainer.TemplateRepository.Save(ctx, t))
+	claimedBefore := now.Add(-template.OccurrenceClaimTimeout)
+	_, err := s.TestDIContainer.TemplateRepository.AddOccurrence(ctx, template.Occurrence{
+		TemplateID: t.ID(), At: now.AddDate(0, 0, -1), CreatedAt: claimedBefore, ClaimedAt: claimedBefore,
+	})
+	s.Require().NoError(err)
+
+	first, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+	second, err := postgres.NewUnitOfWork(s.TestDIContainer.DB)
+	s.Require().NoError(err)
+
+	// Act - two runs look for retryable occurrences at the same time
+	s.Require().NoError(first.Begin(ctx))
+	defer func() { _ = first.Rollback() }()
+	byFirst, err := first.QuestTemplateRepository().FindRetryableOccurrences(ctx, now, 10)
+	s.Require().NoError(err)
+
+	s.Require().NoError(second.Begin(ctx))
+	defer func() { _ = second.Rollback() }()
+	bySecond, err := second.QuestTemplateRepository().FindRetryableOccurrences(ctx, now, 10)
+	s.Require().NoError(err)
+
+	// Assert - only the first run gets the occurrence
+	s.Len(byFirst, 1)
+	s.Empty(bySecond)
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for reviews persistence and reputation aggregation
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/quest"
+	"quest-manager/internal/core/domain/model/review"
+
+	"github.com/google/uuid"
+)
+
+// completedQuestForReview builds a completed quest of the creator taken by the participants
+func (s *Suite) completedQuestForReview(creatorID uuid.UUID, participants ...uuid.UUID) quest.Quest {
+	location := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6176}
+	q, err := quest.NewQuest("Review Quest", "Walk the dog", "easy", 1, 30, location, location, creatorID.String(), nil, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(q.SetCapacity(len(participants), 0))
+	for _, p := range participants {
+		s.Require().NoError(q.AssignTo(p))
+	}
+	q.Status = quest.StatusCompleted
+	return q
+}
+
+func (s *Suite) TestReviewRepository_SaveAndFindByQuest() {
+	ctx := context.Background()
+	creatorID, assigneeID := uuid.New(), uuid.New()
+	q := s.completedQuestForReview(creatorID, assigneeID)
+
+	byCreator, err := review.NewReview(q, creatorID, assigneeID, 5, "Great job", nil)
+	s.Require().NoError(err)
+	byAssignee, err := review.NewReview(q, assigneeID, creatorID, 4, "", []review.Review{*byCreator})
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.ReviewRepository.Save(ctx, *byCreator))
+	s.Require().NoError(s.TestDIContainer.ReviewRepository.Save(ctx, *byAssignee))
+
+	// Assert
+	reviews, err := s.TestDIContainer.ReviewRepository.FindByQuest(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Require().Len(reviews, 2)
+	s.Equal(byCreator.ID(), reviews[0].ID())
+	s.Equal(assigneeID, reviews[0].RevieweeID)
+	s.Equal(5, reviews[0].Rating)
+	s.Equal("Great job", reviews[0].Comment)
+	s.Equal(byAssignee.ID(), reviews[1].ID())
+
+	other, err := s.TestDIContainer.ReviewRepository.FindByQuest(ctx, uuid.New())
+	s.Require().NoError(err)
+	s.Empty(other)
+}
+
+func (s *Suite) TestReviewRepository_OneReviewPerReviewerAndReviewee() {
+	ctx := context.Background()
+	creatorID, firstID, secondID := uuid.New(), uuid.New(), uuid.New()
+	q := s.completedQuestForReview(creatorID, firstID, secondID)
+
+	first, err := review.NewReview(q, creatorID, firstID, 5, "", nil)
+	s.Require().NoError(err)
+	// Bypass the domain check to hit the unique index
+	again, err := review.NewReview(q, creatorID, firstID, 1, "", nil)
+	s.Require().NoError(err)
+	// The creator of a team quest reviews every participant
+	second, err := review.NewReview(q, creatorID, secondID, 4, "", nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.TestDIContainer.ReviewRepository.Save(ctx, *first))
+	s.Error(s.TestDIContainer.ReviewRepository.Save(ctx, *again))
+	s.Require().NoError(s.TestDIContainer.ReviewRepository.Save(ctx, *second))
+
+	reviews, err := s.TestDIContainer.ReviewRepository.FindByQuest(ctx, q.ID())
+	s.Require().NoError(err)
+	s.Len(reviews, 2)
+}
+
+func (s *Suite) TestReviewRepository_Reputations() {
+	ctx := context.Background()
+	creatorID := uuid.New()
+	for _, rating := range []int{5, 4, 4} {
+		assigneeID := uuid.New()
+		q := s.completedQuestForReview(creatorID, assigneeID)
+		r, err := review.NewReview(q, assigneeID, creatorID, rating, "", nil)
+		s.Require().NoError(err)
+		s.Require().NoError(s.TestDIContainer.ReviewRepository.Save(ctx, *r))
+	}
+	unreviewed := uuid.New()
+
+	// Act
+	reputations, err := s.TestDIContainer.ReviewRepository.Reputations(ctx, []uuid.UUID{creatorID, unreviewed})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(reputations, 2)
+	s.Equal(4.33, reputations[creatorID].AverageRating)
+	s.Equal(3, reputations[creatorID].ReviewCount)
+	s.Equal(review.Reputation{UserID: unreviewed}, reputations[unreviewed])
+}
+
+func (s *Suite) TestQuestRepository_FindByFilter_MinCreatorRating() {
+	ctx := context.Background()
+	rated, lowRated, unrated := uuid.New(), uuid.New(), uuid.New()
+	first, second := uuid.New(), uuid.New()
+
+	// Pre-condition - creators averaging 4.5 and 4, and a creator without reviews
+	ratedQuest := s.completedQuestForReview(rated, first, second)
+	lowRatedQuest := s.completedQuestForReview(lowRated, first)
+	unratedQuest := s.completedQuestForReview(unrated, first)
+	for _, q := range []quest.Quest{ratedQuest, lowRatedQuest, unratedQuest} {
+		s.Require().NoError(s.TestDIContainer.QuestRepository.Save(ctx, q))
+	}
+	byFirst, err := review.NewReview(ratedQuest, first, rated, 5, "", nil)
+	s.Require().NoError(err)
+	bySecond, err := review.NewReview(ratedQuest, second, rated, 4, "", nil)
+	s.Require().NoError(err)
+	lowRating, err := review.NewReview(lowRatedQuest, first, lowRated, 4, "", nil)
+	s.Require().NoError(err)
+	for _, r := range []*review.Review{byFirst, bySecond, lowRating} {
+		s.Require().NoError(s.TestDIContainer.ReviewRepository.Save(ctx, *r))
+	}
+
+	// Act
+	minRating := 4.5
+	found, err := s.TestDIContainer.QuestRepository.FindByFilter(ctx, quest.ListFilter{MinCreatorRating: &minRating})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(ratedQuest.ID(), found[0].ID())
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for callbacks running after the commit of a unit of work
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) TestUnitOfWork_AfterCommit() {
+	ctx := context.Background()
+	uow := s.TestDIContainer.UnitOfWork
+	var delivered []uuid.UUID
+
+	// Rolled back: the callback is dropped together with the changes
+	rolledBack := newNotification(uuid.New(), time.Now())
+	s.Require().NoError(uow.Begin(ctx))
+	s.Require().NoError(uow.NotificationRepository().Save(ctx, rolledBack))
+	uow.AfterCommit(func() { delivered = append(delivered, rolledBack.ID) })
+	s.Require().NoError(uow.Rollback())
+
+	s.Empty(delivered)
+	_, err := uow.NotificationRepository().GetByID(ctx, rolledBack.ID)
+	s.True(errors.Is(err, ports.ErrNotificationNotFound))
+
+	// Committed: the callback runs once the changes are visible
+	committed := newNotification(uuid.New(), time.Now())
+	s.Require().NoError(uow.Begin(ctx))
+	s.Require().NoError(uow.NotificationRepository().Save(ctx, committed))
+	uow.AfterCommit(func() {
+		_, err := uow.NotificationRepository().GetByID(ctx, committed.ID)
+		s.NoError(err, "callback must see committed data")
+		delivered = append(delivered, committed.ID)
+	})
+	s.Empty(delivered)
+	s.Require().NoError(uow.Commit(ctx))
+
+	s.Equal([]uuid.UUID{committed.ID}, delivered)
+}
@@ -0,0 +1,77 @@
+//go:build integration
+
+package repository
+
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for user profile persistence
+
+import (
+	"context"
+
+	"quest-manager/internal/core/domain/model/kernel"
+	"quest-manager/internal/core/domain/model/user"
+	"quest-manager/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+func (s *Suite) TestUserRepository_SaveAndGet() {
+	ctx := context.Background()
+
+	// Pre-condition - profile with every field set
+	home := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	radius := 12.5
+	u, err := user.NewUser(uuid.New(), user.Profile{
+		DisplayName:       "Alice",
+		Skills:            []string{"photography", "navigation"},
+		Equipment:         []string{"camera"},
+		HomeLocation:      &home,
+		MaxTravelRadiusKm: &radius,
+	})
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(s.TestDIContainer.UserRepository.Save(ctx, u))
+	saved, err := s.TestDIContainer.UserRepository.GetByID(ctx, u.ID())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(u.ID(), saved.ID())
+	s.Equal("Alice", saved.DisplayName)
+	s.Equal([]string{"photography", "navigation"}, saved.Skills)
+	s.Equal([]string{"camera"}, saved.Equipment)
+	s.Require().NotNil(saved.HomeLocation)
+	s.InDelta(home.Lat, saved.HomeLocation.Lat, 1e-9)
+	s.InDelta(home.Lon, saved.HomeLocation.Lon, 1e-9)
+	s.Require().NotNil(saved.MaxTravelRadiusKm)
+	s.Equal(radius, *saved.MaxTravelRadiusKm)
+}
+
+func (s *Suite) TestUserRepository_SaveUpdatesExisting() {
+	ctx := context.Background()
+
+	// Pre-condition - saved profile with a home location
+	home := kernel.GeoCoordinate{Lat: 55.7558, Lon: 37.6173}
+	u, err := user.NewUser(uuid.New(), user.Profile{DisplayName: "Alice", Skills: []string{"climbing"}, HomeLocation: &home})
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Save(ctx, u))
+
+	// Act - replace the profile
+	s.Require().NoError(u.UpdateProfile(user.Profile{DisplayName: "Alice B."}))
+	s.Require().NoError(s.TestDIContainer.UserRepository.Save(ctx, u))
+
+	// Assert
+	saved, err := s.TestDIContainer.UserRepository.GetByID(ctx, u.ID())
+	s.Require().NoError(err)
+	s.Equal("Alice B.", saved.DisplayName)
+	s.Empty(saved.Skills)
+	s.Nil(saved.HomeLocation)
+}
+
+func (s *Suite) TestUserRepository_GetByID_NotFound() {
+	ctx := context.Background()
+
+	_, err := s.TestDIContainer.UserRepository.GetByID(ctx, uuid.New())
+
+	s.Require().ErrorIs(err, ports.ErrUserNotFound)
+}
@@ -4,16 +4,23 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"path/filepath"
+	"runtime"
 	"time"
 
 	"gorm.io/gorm"
 
 	"quest-manager/cmd"
+	"quest-manager/internal/adapters/out/blobstorage"
 	authclient "quest-manager/internal/adapters/out/client/auth"
+	"quest-manager/internal/adapters/out/client/geocoder"
+	"quest-manager/internal/adapters/out/notifier"
 	"quest-manager/internal/adapters/out/postgres"
 	"quest-manager/internal/adapters/out/postgres/eventrepo"
+	"quest-manager/internal/core/application/eventhandlers"
 	"quest-manager/internal/core/application/usecases/commands"
 	"quest-manager/internal/core/application/usecases/queries"
+	"quest-manager/internal/core/domain/model/ledger"
 	"quest-manager/internal/core/ports"
 	teststorage "quest-manager/tests/integration/core/storage"
 	integrationmock "quest-manager/tests/integration/mock"
@@ -28,9 +35,27 @@ func getTestConfig() cmd.Config {
 		DbPassword: getTestEnv("DB_PASSWORD", "password"),
 		DbName:     getTestEnv("DB_NAME", "quest_test"),
 		DbSslMode:  getTestEnv("DB_SSLMODE", "disable"),
+		GeoBackend: getTestEnv("GEO_BACKEND", cmd.GeoBackendSQL),
 	}
 }
 
+// geocoderPlacesFile возвращает путь к офлайн-справочнику адресов для геокодера
+func geocoderPlacesFile() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "testdata", "geocoder_places.json")
+}
+
+// achievementsFile возвращает путь к файлу с определениями достижений для тестов
+func achievementsFile() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "testdata", "achievements.yaml")
+}
+
+// blobStorageDir возвращает каталог для файлов доказательств выполнения в тестах
+func blobStorageDir() string {
+	return filepath.Join(os.TempDir(), "quest-manager-test-blobs")
+}
+
 // getTestEnv получает environment переменную или возвращает default значение
 func getTestEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -46,25 +71,90 @@ type TestDIContainer struct {
 	CloseDB    func()
 	UnitOfWork ports.UnitOfWork
 
+	// UsePostGIS is true when tests run against PostGIS-backed repositories (GEO_BACKEND=postgis)
+	UsePostGIS bool
+
 	// Auth (mock for tests)
 	MockAuthClient *integrationmock.AlwaysSuccessAuthClient
 
 	// Repositories
-	QuestRepository    ports.QuestRepository
-	LocationRepository ports.LocationRepository
-	EventPublisher     ports.EventPublisher
-	EventStorage       *teststorage.EventStorage
+	QuestRepository        ports.QuestRepository
+	LocationRepository     ports.LocationRepository
+	UserRepository         ports.UserRepository
+	ApplicationRepository  ports.ApplicationRepository
+	EvidenceRepository     ports.EvidenceRepository
+	LedgerRepository       ports.LedgerRepository
+	LeaderboardRepository  ports.LeaderboardRepository
+	AchievementRepository  ports.AchievementRepository
+	ReviewRepository       ports.ReviewRepository
+	CommentRepository      ports.CommentRepository
+	NotificationRepository ports.NotificationRepository
+	TemplateRepository     ports.QuestTemplateRepository
+	EventPublisher         ports.EventPublisher
+	EventStorage           *teststorage.EventStorage
+
+	// Geocoder (офлайн, по файлу testdata/geocoder_places.json)
+	Geocoder ports.Geocoder
+
+	// Хранилище фото доказательств (локальный каталог во временной директории)
+	BlobStorage ports.BlobStorage
 
 	// Command Handlers
 	CreateQuestHandler       commands.CreateQuestCommandHandler
 	AssignQuestHandler       commands.AssignQuestCommandHandler
 	ChangeQuestStatusHandler commands.ChangeQuestStatusCommandHandler
+	UpdateUserProfileHandler commands.UpdateUserProfileCommandHandler
+	ApplyToQuestHandler      commands.ApplyToQuestCommandHandler
+	ReviewApplicationHandler commands.ReviewApplicationCommandHandler
+
+	SubmitCompletionEvidenceHandler commands.SubmitCompletionEvidenceCommandHandler
+	ReviewCompletionHandler         commands.ReviewCompletionCommandHandler
+	SubmitReviewHandler             commands.SubmitReviewCommandHandler
+	AddQuestCommentHandler          commands.AddQuestCommentCommandHandler
+	EditQuestCommentHandler         commands.EditQuestCommentCommandHandler
+
+	MarkNotificationReadHandler          commands.MarkNotificationReadCommandHandler
+	MarkAllNotificationsReadHandler      commands.MarkAllNotificationsReadCommandHandler
+	UpdateNotificationPreferencesHandler commands.UpdateNotificationPreferencesCommandHandler
+
+	CreateQuestTemplateHandler     commands.CreateQuestTemplateCommandHandler
+	CreateQuestFromTemplateHandler commands.CreateQuestFromTemplateCommandHandler
+	CreateScheduledQuestsHandler   commands.CreateScheduledQuestsCommandHandler
+
+	AddQuestPrerequisiteHandler    commands.AddQuestPrerequisiteCommandHandler
+	RemoveQuestPrerequisiteHandler commands.RemoveQuestPrerequisiteCommandHandler
 
 	// Query Handlers
 	ListQuestsHandler           queries.ListQuestsQueryHandler
 	GetQuestByIDHandler         queries.GetQuestByIDQueryHandler
 	SearchQuestsByRadiusHandler queries.SearchQuestsByRadiusQueryHandler
+	SearchQuestsByAreaHandler   queries.SearchQuestsByAreaQueryHandler
+	GetQuestTileHandler         queries.GetQuestTileQueryHandler
 	ListAssignedQuestsHandler   queries.ListAssignedQuestsQueryHandler
+	RecommendQuestsHandler      queries.RecommendQuestsQueryHandler
+
+	AutocompleteLocationsHandler queries.AutocompleteLocationsQueryHandler
+	GetUserProfileHandler        queries.GetUserProfileQueryHandler
+	ListQuestApplicationsHandler queries.ListQuestApplicationsQueryHandler
+
+	ListCompletionEvidenceHandler queries.ListCompletionEvidenceQueryHandler
+	GetEvidenceAttachmentHandler  queries.GetEvidenceAttachmentQueryHandler
+
+	GetBalanceHandler        queries.GetBalanceQueryHandler
+	ListLedgerEntriesHandler queries.ListLedgerEntriesQueryHandler
+	GetLeaderboardHandler    queries.GetLeaderboardQueryHandler
+	ListAchievementsHandler  queries.ListAchievementsQueryHandler
+	ListQuestReviewsHandler  queries.ListQuestReviewsQueryHandler
+	GetReputationsHandler    queries.GetReputationsQueryHandler
+	ListQuestCommentsHandler queries.ListQuestCommentsQueryHandler
+
+	ListNotificationsHandler          queries.ListNotificationsQueryHandler
+	GetNotificationPreferencesHandler queries.GetNotificationPreferencesQueryHandler
+
+	GetQuestTemplateHandler   queries.GetQuestTemplateQueryHandler
+	ListQuestTemplatesHandler queries.ListQuestTemplatesQueryHandler
+
+	GetQuestDependenciesHandler queries.GetQuestDependenciesQueryHandler
 
 	// HTTP Router for API testing
 	HTTPRouter http.Handler
@@ -99,40 +189,142 @@ func NewTestDIContainer(suiteContainer SuiteDIContainer) TestDIContainer {
 	db, sqlDB, err := cmd.MustConnectDB(databaseURL)
 	suiteContainer.Require().NoError(err, "Failed to connect to test database")
 
+	usePostGIS, err := testConfig.UsePostGIS()
+	suiteContainer.Require().NoError(err, "Invalid GEO_BACKEND")
+
 	// Создание Unit of Work (он сам создает внутри себя quest и location репозитории)
-	unitOfWork, err := postgres.NewUnitOfWork(db)
+	unitOfWork, err := postgres.NewUnitOfWork(db, postgres.WithPostGIS(usePostGIS))
 	suiteContainer.Require().NoError(err, "Failed to create unit of work")
 
 	// Создание event репозитория отдельно
 	eventRepo, err := eventrepo.NewRepository(unitOfWork.(ports.Tracker), 5) // лимит горутин = 5
 	suiteContainer.Require().NoError(err, "Failed to create event repository")
 
+	// Диспетчер сохраняет события и вызывает обработчики (начисление очков) в той же транзакции
+	dispatcher, err := eventhandlers.NewDispatcher(eventRepo)
+	suiteContainer.Require().NoError(err, "Failed to create event dispatcher")
+	dispatcher.Subscribe("quest.status_changed",
+		eventhandlers.NewQuestRewardHandler(unitOfWork, dispatcher, ledger.DefaultRewardFormula()))
+	dispatcher.Subscribe("ledger.points_credited", eventhandlers.NewLeaderboardHandler(unitOfWork))
+
+	// Достижения загружаются из YAML-файла так же, как в приложении
+	achievements, err := cmd.Config{AchievementsFile: achievementsFile()}.AchievementDefinitions()
+	suiteContainer.Require().NoError(err, "Failed to load achievements")
+	achievementHandler := eventhandlers.NewAchievementHandler(unitOfWork, dispatcher, achievements)
+	dispatcher.Subscribe("quest.assigned", achievementHandler)
+	dispatcher.Subscribe("quest.status_changed", achievementHandler)
+
+	// Уведомления только пишутся в лог, как в приложении по умолчанию
+	notificationHandler := eventhandlers.NewNotificationHandler(unitOfWork, notifier.NewLogChannel())
+	dispatcher.Subscribe("quest.assigned", notificationHandler)
+	dispatcher.Subscribe("quest.status_changed", notificationHandler)
+	dispatcher.Subscribe("quest.comment_added", notificationHandler)
+
+	// Зависимые квесты публикуются, когда завершено последнее предварительное условие
+	dispatcher.Subscribe("quest.status_changed", eventhandlers.NewPrerequisiteHandler(unitOfWork, dispatcher))
+
 	// Получаем репозитории из UnitOfWork
 	questRepo := unitOfWork.QuestRepository()
 	locationRepo := unitOfWork.LocationRepository()
+	userRepo := unitOfWork.UserRepository()
+	applicationRepo := unitOfWork.ApplicationRepository()
+	evidenceRepo := unitOfWork.EvidenceRepository()
+	ledgerRepo := unitOfWork.LedgerRepository()
+	leaderboardRepo := unitOfWork.LeaderboardRepository()
+	achievementRepo := unitOfWork.AchievementRepository()
+	reviewRepo := unitOfWork.ReviewRepository()
+	commentRepo := unitOfWork.CommentRepository()
+	notificationRepo := unitOfWork.NotificationRepository()
+	templateRepo := unitOfWork.QuestTemplateRepository()
 
 	// Создание EventStorage для тестирования
 	eventStorage := teststorage.NewEventStorage(db)
 
+	// Офлайн-геокодер по файлу с известными адресами
+	fileGeocoder, err := geocoder.NewFileGeocoder(geocoderPlacesFile())
+	suiteContainer.Require().NoError(err, "Failed to load geocoder places")
+
+	localBlobStorage, err := blobstorage.NewLocalStorage(blobStorageDir())
+	suiteContainer.Require().NoError(err, "Failed to create blob storage")
+
 	// Создание обработчиков команд
 	createQuestHandler := commands.NewCreateQuestCommandHandler(
 		unitOfWork,
-		eventRepo,
+		dispatcher,
+		fileGeocoder,
 	)
 	assignQuestHandler := commands.NewAssignQuestCommandHandler(
 		unitOfWork,
-		eventRepo,
+		dispatcher,
 	)
 	changeQuestStatusHandler := commands.NewChangeQuestStatusCommandHandler(
 		unitOfWork,
-		eventRepo,
+		dispatcher,
+	)
+	updateUserProfileHandler := commands.NewUpdateUserProfileCommandHandler(
+		unitOfWork,
+		dispatcher,
+	)
+	applyToQuestHandler := commands.NewApplyToQuestCommandHandler(
+		unitOfWork,
+		dispatcher,
+	)
+	reviewApplicationHandler := commands.NewReviewApplicationCommandHandler(
+		unitOfWork,
+		dispatcher,
+	)
+	submitCompletionEvidenceHandler := commands.NewSubmitCompletionEvidenceCommandHandler(
+		unitOfWork,
+		dispatcher,
+		localBlobStorage,
+	)
+	reviewCompletionHandler := commands.NewReviewCompletionCommandHandler(
+		unitOfWork,
+		dispatcher,
+	)
+	submitReviewHandler := commands.NewSubmitReviewCommandHandler(
+		unitOfWork,
+		dispatcher,
 	)
+	addQuestCommentHandler := commands.NewAddQuestCommentCommandHandler(
+		unitOfWork,
+		dispatcher,
+	)
+	editQuestCommentHandler := commands.NewEditQuestCommentCommandHandler(unitOfWork)
+	markNotificationReadHandler := commands.NewMarkNotificationReadCommandHandler(unitOfWork)
+	markAllNotificationsReadHandler := commands.NewMarkAllNotificationsReadCommandHandler(unitOfWork)
+	updateNotificationPreferencesHandler := commands.NewUpdateNotificationPreferencesCommandHandler(unitOfWork)
+	createQuestTemplateHandler := commands.NewCreateQuestTemplateCommandHandler(unitOfWork)
+	createQuestFromTemplateHandler := commands.NewCreateQuestFromTemplateCommandHandler(unitOfWork, createQuestHandler)
+	createScheduledQuestsHandler := commands.NewCreateScheduledQuestsCommandHandler(unitOfWork, dispatcher, fileGeocoder)
+	addQuestPrerequisiteHandler := commands.NewAddQuestPrerequisiteCommandHandler(unitOfWork)
+	removeQuestPrerequisiteHandler := commands.NewRemoveQuestPrerequisiteCommandHandler(unitOfWork)
 
 	// Создание обработчиков запросов
 	listQuestsHandler := queries.NewListQuestsQueryHandler(questRepo)
 	getQuestByIDHandler := queries.NewGetQuestByIDQueryHandler(questRepo)
 	searchQuestsByRadiusHandler := queries.NewSearchQuestsByRadiusQueryHandler(questRepo)
+	searchQuestsByAreaHandler := queries.NewSearchQuestsByAreaQueryHandler(questRepo)
+	getQuestTileHandler := queries.NewGetQuestTileQueryHandler(questRepo)
 	listAssignedQuestsHandler := queries.NewListAssignedQuestsQueryHandler(questRepo)
+	recommendQuestsHandler := queries.NewRecommendQuestsQueryHandler(questRepo, userRepo)
+	autocompleteLocationsHandler := queries.NewAutocompleteLocationsQueryHandler(locationRepo)
+	getUserProfileHandler := queries.NewGetUserProfileQueryHandler(userRepo)
+	listQuestApplicationsHandler := queries.NewListQuestApplicationsQueryHandler(questRepo, applicationRepo)
+	listCompletionEvidenceHandler := queries.NewListCompletionEvidenceQueryHandler(questRepo, evidenceRepo)
+	getEvidenceAttachmentHandler := queries.NewGetEvidenceAttachmentQueryHandler(questRepo, evidenceRepo, localBlobStorage)
+	getBalanceHandler := queries.NewGetBalanceQueryHandler(ledgerRepo)
+	listLedgerEntriesHandler := queries.NewListLedgerEntriesQueryHandler(ledgerRepo)
+	getLeaderboardHandler := queries.NewGetLeaderboardQueryHandler(leaderboardRepo)
+	listAchievementsHandler := queries.NewListAchievementsQueryHandler(achievementRepo, achievements)
+	listQuestReviewsHandler := queries.NewListQuestReviewsQueryHandler(questRepo, reviewRepo)
+	getReputationsHandler := queries.NewGetReputationsQueryHandler(reviewRepo)
+	listQuestCommentsHandler := queries.NewListQuestCommentsQueryHandler(questRepo, commentRepo)
+	listNotificationsHandler := queries.NewListNotificationsQueryHandler(notificationRepo)
+	getNotificationPreferencesHandler := queries.NewGetNotificationPreferencesQueryHandler(notificationRepo)
+	getQuestTemplateHandler := queries.NewGetQuestTemplateQueryHandler(templateRepo)
+	listQuestTemplatesHandler := queries.NewListQuestTemplatesQueryHandler(templateRepo)
+	getQuestDependenciesHandler := queries.NewGetQuestDependenciesQueryHandler(questRepo)
 
 	// Create Mock Auth Client for tests (always returns successful authentication)
 	mockAuthClient := integrationmock.NewAlwaysSuccessAuthClient()
@@ -141,6 +333,13 @@ func NewTestDIContainer(suiteContainer SuiteDIContainer) TestDIContainer {
 	appConfig := cmd.Config{
 		EventGoroutineLimit: 5,
 		AuthGRPC:            "", // Empty - using mock
+		GeoBackend:          testConfig.GeoBackend,
+		Geocoder: cmd.GeocoderConfig{
+			Provider: cmd.GeocoderFile,
+			File:     geocoderPlacesFile(),
+		},
+		BlobStorageDir:   blobStorageDir(),
+		AchievementsFile: achievementsFile(),
 		Middleware: cmd.MiddlewareConfig{
 			DevAuth: cmd.DevAuthConfig{
 				Enabled: false, // Use production mode but with injected mock
@@ -164,22 +363,83 @@ func NewTestDIContainer(suiteContainer SuiteDIContainer) TestDIContainer {
 			}
 		},
 		UnitOfWork: unitOfWork,
+		UsePostGIS: usePostGIS,
 
 		MockAuthClient: mockAuthClient,
 
-		QuestRepository:    questRepo,
-		LocationRepository: locationRepo,
-		EventPublisher:     eventRepo,
-		EventStorage:       eventStorage,
+		QuestRepository:        questRepo,
+		LocationRepository:     locationRepo,
+		UserRepository:         userRepo,
+		ApplicationRepository:  applicationRepo,
+		EvidenceRepository:     evidenceRepo,
+		LedgerRepository:       ledgerRepo,
+		LeaderboardRepository:  leaderboardRepo,
+		AchievementRepository:  achievementRepo,
+		ReviewRepository:       reviewRepo,
+		CommentRepository:      commentRepo,
+		NotificationRepository: notificationRepo,
+		TemplateRepository:     templateRepo,
+		EventPublisher:         eventRepo,
+		EventStorage:           eventStorage,
+
+		Geocoder: fileGeocoder,
+
+		BlobStorage: localBlobStorage,
 
 		CreateQuestHandler:       createQuestHandler,
 		AssignQuestHandler:       assignQuestHandler,
 		ChangeQuestStatusHandler: changeQuestStatusHandler,
+		UpdateUserProfileHandler: updateUserProfileHandler,
+		ApplyToQuestHandler:      applyToQuestHandler,
+		ReviewApplicationHandler: reviewApplicationHandler,
+
+		SubmitCompletionEvidenceHandler: submitCompletionEvidenceHandler,
+		ReviewCompletionHandler:         reviewCompletionHandler,
+		SubmitReviewHandler:             submitReviewHandler,
+		AddQuestCommentHandler:          addQuestCommentHandler,
+		EditQuestCommentHandler:         editQuestCommentHandler,
+
+		MarkNotificationReadHandler:          markNotificationReadHandler,
+		MarkAllNotificationsReadHandler:      markAllNotificationsReadHandler,
+		UpdateNotificationPreferencesHandler: updateNotificationPreferencesHandler,
+
+		CreateQuestTemplateHandler:     createQuestTemplateHandler,
+		CreateQuestFromTemplateHandler: createQuestFromTemplateHandler,
+		CreateScheduledQuestsHandler:   createScheduledQuestsHandler,
+
+		AddQuestPrerequisiteHandler:    addQuestPrerequisiteHandler,
+		RemoveQuestPrerequisiteHandler: removeQuestPrerequisiteHandler,
 
 		ListQuestsHandler:           listQuestsHandler,
 		GetQuestByIDHandler:         getQuestByIDHandler,
 		SearchQuestsByRadiusHandler: searchQuestsByRadiusHandler,
+		SearchQuestsByAreaHandler:   searchQuestsByAreaHandler,
+		GetQuestTileHandler:         getQuestTileHandler,
 		ListAssignedQuestsHandler:   listAssignedQuestsHandler,
+		RecommendQuestsHandler:      recommendQuestsHandler,
+
+		AutocompleteLocationsHandler: autocompleteLocationsHandler,
+		GetUserProfileHandler:        getUserProfileHandler,
+		ListQuestApplicationsHandler: listQuestApplicationsHandler,
+
+		ListCompletionEvidenceHandler: listCompletionEvidenceHandler,
+		GetEvidenceAttachmentHandler:  getEvidenceAttachmentHandler,
+
+		GetBalanceHandler:        getBalanceHandler,
+		ListLedgerEntriesHandler: listLedgerEntriesHandler,
+		GetLeaderboardHandler:    getLeaderboardHandler,
+		ListAchievementsHandler:  listAchievementsHandler,
+		ListQuestReviewsHandler:  listQuestReviewsHandler,
+		GetReputationsHandler:    getReputationsHandler,
+		ListQuestCommentsHandler: listQuestCommentsHandler,
+
+		ListNotificationsHandler:          listNotificationsHandler,
+		GetNotificationPreferencesHandler: getNotificationPreferencesHandler,
+
+		GetQuestTemplateHandler:   getQuestTemplateHandler,
+		ListQuestTemplatesHandler: listQuestTemplatesHandler,
+
+		GetQuestDependenciesHandler: getQuestDependenciesHandler,
 
 		HTTPRouter: httpRouter,
 	}
@@ -190,6 +450,8 @@ func (c *TestDIContainer) NewHTTPRouterWithAuthClient(authClient authclient.Clie
 	appConfig := cmd.Config{
 		EventGoroutineLimit: 5,
 		AuthGRPC:            "", // Empty - using injected client
+		GeoBackend:          getTestConfig().GeoBackend,
+		BlobStorageDir:      blobStorageDir(),
 		Middleware: cmd.MiddlewareConfig{
 			DevAuth: cmd.DevAuthConfig{
 				Enabled: false, // Use production mode but with custom injected client
@@ -217,12 +479,54 @@ func (c *TestDIContainer) CleanupDatabase() error {
 	if err := c.DB.Exec("TRUNCATE TABLE events CASCADE").Error; err != nil {
 		return err
 	}
+	if err := c.DB.Exec("TRUNCATE TABLE quest_template_occurrences CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE quest_templates CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE notifications CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE notification_preferences CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE quest_comments CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE quest_prerequisites CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE reviews CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE user_achievements CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE achievement_facts CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE leaderboard_scores CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE ledger_entries CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE quest_applications CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE quest_evidence CASCADE").Error; err != nil {
+		return err
+	}
 	if err := c.DB.Exec("TRUNCATE TABLE quests CASCADE").Error; err != nil {
 		return err
 	}
 	if err := c.DB.Exec("TRUNCATE TABLE locations CASCADE").Error; err != nil {
 		return err
 	}
+	if err := c.DB.Exec("TRUNCATE TABLE users CASCADE").Error; err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19,6 +19,14 @@ func TestDomainValidationError(t *testing.T) {
 	assert.EqualError(t, errWithCause, "domain validation error: field 'field' is invalid (cause: cause)")
 }
 
+func TestDomainValidationErrorWithViolations(t *testing.T) {
+	violations := []errs.Violation{{Field: "skills", Message: "missing required skills", Values: []string{"diving"}}}
+	err := errs.NewDomainValidationErrorWithViolations("eligibility", "is not met", violations)
+
+	assert.EqualError(t, err, "domain validation error: field 'eligibility' is not met")
+	assert.Equal(t, violations, err.Violations)
+}
+
 func TestNotFoundError(t *testing.T) {
 	err := errs.NewNotFoundError("Resource", "123")
 	assert.EqualError(t, err, "Resource with id '123' not found")
@@ -28,6 +36,11 @@ func TestNotFoundError(t *testing.T) {
 	assert.EqualError(t, errWithCause, "Resource with id '123' not found (cause: cause)")
 }
 
+func TestForbiddenError(t *testing.T) {
+	err := errs.NewForbiddenError("review application", "only the quest creator can review applications")
+	assert.EqualError(t, err, "forbidden to review application: only the quest creator can review applications")
+}
+
 func TestErrorWithStatus(t *testing.T) {
 	baseErr := errors.New("boom")
 	e := &errs.ErrorWithStatus{Err: baseErr, StatusCode: http.StatusBadRequest}